@@ -18,6 +18,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
@@ -28,15 +29,20 @@ import (
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	_ "vitess.io/vitess/go/vt/vtctl/grpcvtctlclient"
+	"vitess.io/vitess/go/vt/vtgate/debezium"
 	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 )
 
+var format = flag.String("format", "vevent", "output format of the events: vevent or debezium")
+
 /*
 	This is a sample client for streaming using the vstream API. It is setup to work with the local example and you can
     either stream from the unsharded commerce keyspace or the customer keyspace after the sharding step.
+    With -format=debezium, the row changes and DDLs are printed as Debezium change events, one JSON document per line.
 */
 func main() {
+	flag.Parse()
 	ctx := context.Background()
 	streamCustomer := true
 	var vgtid *binlogdatapb.VGtid
@@ -76,7 +82,21 @@ func main() {
 		//MinimizeSkew:      false,
 		//HeartbeatInterval: 60, //seconds
 	}
+	if *format == "debezium" {
+		flags.Format = vtgatepb.VStreamFormat_DEBEZIUM
+	}
 	reader, err := conn.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, filter, flags)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *format == "debezium" {
+		changeEventReader, err := debezium.NewReader(reader)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printChangeEvents(changeEventReader)
+		return
+	}
 	for {
 		e, err := reader.Recv()
 		switch err {
@@ -92,3 +112,19 @@ func main() {
 		}
 	}
 }
+
+func printChangeEvents(reader *debezium.Reader) {
+	for {
+		e, err := reader.Recv()
+		switch err {
+		case nil:
+			fmt.Printf("%s\n", e)
+		case io.EOF:
+			fmt.Printf("stream ended\n")
+			return
+		default:
+			fmt.Printf("%s:: remote error: %v\n", time.Now(), err)
+			return
+		}
+	}
+}
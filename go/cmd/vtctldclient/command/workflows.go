@@ -33,6 +33,13 @@ var (
 		Args: cobra.ExactArgs(1),
 		RunE: commandGetWorkflows,
 	}
+	// VDiffShow makes a VDiffShow gRPC call to a vtctld.
+	VDiffShow = &cobra.Command{
+		Use:   "VDiffShow <keyspace> <workflow> <uuid|last>",
+		Short: "Shows the state and progress of a vdiff running on the target primaries of a workflow.",
+		Args:  cobra.ExactArgs(3),
+		RunE:  commandVDiffShow,
+	}
 )
 
 var getWorkflowsOptions = struct {
@@ -63,7 +70,32 @@ func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandVDiffShow(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiffShow(commandCtx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     cmd.Flags().Arg(2),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
 	GetWorkflows.Flags().BoolVarP(&getWorkflowsOptions.ShowAll, "show-all", "a", false, "Show all workflows instead of just active workflows")
 	Root.AddCommand(GetWorkflows)
+
+	Root.AddCommand(VDiffShow)
 }
@@ -32,9 +32,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -89,6 +86,13 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the files were compressed with, if they were.
+	// It is empty for backups created before the field existed, which used pgzip.
+	CompressionEngine string
+
+	// Encryption describes how the files were encrypted, if they were.
+	Encryption *BackupEncryption
 }
 
 // FileEntry is one file to backup
@@ -145,7 +149,7 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v, Encryption key provider: %v", *backupStorageHook, *backupStorageCompress, *backupCompressionEngine, *backupEncryptionKeyProvider)
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -293,6 +297,12 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	// All the files are encrypted with the same data key, which is stored wrapped in the MANIFEST.
+	encryption, dataKey, err := newBackupEncryption(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "can't set up backup encryption")
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -311,7 +321,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], dataKey, name))
 		}(i)
 	}
 
@@ -354,6 +364,10 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		FileEntries:   fes,
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
+		Encryption:    encryption,
+	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -449,7 +463,7 @@ func (bp *backupPipe) ReportProgress(period time.Duration, logger logutil.Logger
 }
 
 // backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, dataKey []byte, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -484,6 +498,17 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 
 	var writer io.Writer = bw
 
+	// Create the encryption pipe, if necessary. It is the last step before
+	// the destination, so the stored data never contains plaintext.
+	var encryptor io.WriteCloser
+	if dataKey != nil {
+		encryptor, err = newEncryptingWriter(writer, dataKey)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create encryptor")
+		}
+		writer = encryptor
+	}
+
 	// Create the external write pipe, if any.
 	var pipe io.WriteCloser
 	var wait hook.WaitFunc
@@ -497,27 +522,31 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		engine, err := getCompressionEngine(*backupCompressionEngine)
+		if err != nil {
+			return err
+		}
+		compressor, err = engine.NewCompressor(writer)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot create %v compressor", *backupCompressionEngine)
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
-	// optional pipe, tee, output file and hasher).
+	// Copy from the source file to writer (optional compression,
+	// optional pipe, optional encryption, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrapf(err, "cannot close %v compressor", *backupCompressionEngine)
 		}
 	}
 
@@ -535,6 +564,13 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		}
 	}
 
+	// Close the encryptor to write the last encrypted chunk.
+	if encryptor != nil {
+		if err := encryptor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close encryptor")
+		}
+	}
+
 	// Close the backupPipe to finish writing on destination.
 	if err = bw.Close(); err != nil {
 		return vterrors.Wrapf(err, "cannot flush destination: %v", name)
@@ -580,6 +616,15 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+
+	var dataKey []byte
+	if bm.Encryption != nil {
+		var err error
+		if dataKey, err = bm.Encryption.DataKey(ctx); err != nil {
+			return err
+		}
+	}
+
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -599,7 +644,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], &bm, dataKey, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -610,7 +655,9 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm *builtinBackupManifest, dataKey []byte, name string) (finalErr error) {
+	transformHook := bm.TransformHook
+
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -640,6 +687,14 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	dst := bufio.NewWriterSize(dstFile, writerBufferSize)
 	var reader io.Reader = bp
 
+	// Create the decryptor if needed.
+	if dataKey != nil {
+		reader, err = newDecryptingReader(reader, dataKey)
+		if err != nil {
+			return vterrors.Wrap(err, "can't create decryptor")
+		}
+	}
+
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
 	if transformHook != "" {
@@ -652,22 +707,26 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !bm.SkipCompress {
+		engine, err := getDecompressionEngine(bm.CompressionEngine)
+		if err != nil {
+			return err
+		}
+		decompressor, err := engine.NewDecompressor(reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// PgzipCompressionEngine is the compression engine that was used by all the backups
+	// created before the engine was recorded in the MANIFEST.
+	PgzipCompressionEngine = "pgzip"
+	// ZstdCompressionEngine compresses with zstd, in process.
+	ZstdCompressionEngine = "zstd"
+	// Lz4CompressionEngine compresses by running the lz4 command.
+	Lz4CompressionEngine = "lz4"
+	// ExternalCompressionEngine compresses by running the commands given by
+	// -backup_storage_external_compressor and -backup_storage_external_decompressor.
+	ExternalCompressionEngine = "external"
+)
+
+var (
+	// backupCompressionEngine is the name of the CompressionEngine used to
+	// compress new backups. It is recorded in the MANIFEST, so restores
+	// always use the engine the backup was created with.
+	backupCompressionEngine = flag.String("backup_storage_compression_engine", PgzipCompressionEngine, "if backup_storage_compress is true, the engine used to compress the backup files: pgzip, zstd, lz4 (requires the lz4 command) or external.")
+
+	// externalCompressorCmd and externalDecompressorCmd are the commands used by
+	// the external compression engine. Only the name of the engine is recorded in
+	// the MANIFEST, so restores need the decompressor to be set on the tablet.
+	externalCompressorCmd   = flag.String("backup_storage_external_compressor", "", "if backup_storage_compression_engine is external, the command that compresses its standard input to its standard output.")
+	externalDecompressorCmd = flag.String("backup_storage_external_decompressor", "", "the command that decompresses its standard input to its standard output, required to restore backups compressed with the external engine.")
+)
+
+// CompressionEngine compresses the files of a backup, and decompresses them on restore.
+type CompressionEngine interface {
+	// NewCompressor returns a writer that compresses into w. The compressed
+	// data is only completely written after the writer is closed.
+	NewCompressor(w io.Writer) (io.WriteCloser, error)
+
+	// NewDecompressor returns a reader that decompresses r.
+	NewDecompressor(r io.Reader) (io.ReadCloser, error)
+
+	// Extension is the suffix of the names of the files compressed by the engine, if any.
+	Extension() string
+}
+
+// CompressionEngineMap contains the registered implementations of CompressionEngine.
+var CompressionEngineMap = make(map[string]CompressionEngine)
+
+// getCompressionEngine returns the CompressionEngine with the given name. Backups with
+// an empty compression engine were created before the field existed, and use pgzip.
+func getCompressionEngine(name string) (CompressionEngine, error) {
+	if name == "" {
+		name = PgzipCompressionEngine
+	}
+	engine, ok := CompressionEngineMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown CompressionEngine implementation %q", name)
+	}
+	return engine, nil
+}
+
+// getDecompressionEngine returns the CompressionEngine to decompress the files of a backup,
+// given the compression engine recorded in its MANIFEST. The commands of the external
+// compression engine are never read from the MANIFEST: the decompressor must be given
+// to the tablet with -backup_storage_external_decompressor.
+func getDecompressionEngine(name string) (CompressionEngine, error) {
+	if name == ExternalCompressionEngine && *externalDecompressorCmd == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup was compressed with an external compressor, but -backup_storage_external_decompressor is not set")
+	}
+	return getCompressionEngine(name)
+}
+
+// pgzipCompressionEngine compresses in parallel blocks with pargzip, and decompresses with pgzip.
+type pgzipCompressionEngine struct{}
+
+func (pgzipCompressionEngine) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	gzip := pargzip.NewWriter(w)
+	gzip.ChunkSize = *backupCompressBlockSize
+	gzip.Parallel = *backupCompressBlocks
+	gzip.CompressionLevel = pargzip.BestSpeed
+	return gzip, nil
+}
+
+func (pgzipCompressionEngine) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+func (pgzipCompressionEngine) Extension() string {
+	return ".gz"
+}
+
+// zstdCompressionEngine compresses with zstd, using backup_storage_number_blocks goroutines.
+type zstdCompressionEngine struct{}
+
+func (zstdCompressionEngine) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(*backupCompressBlocks))
+}
+
+func (zstdCompressionEngine) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+func (zstdCompressionEngine) Extension() string {
+	return ".zst"
+}
+
+// externalCompressionEngine pipes the files through external commands.
+type externalCompressionEngine struct {
+	compressor   string
+	decompressor string
+	extension    string
+}
+
+func (e *externalCompressionEngine) compressorCmd() string {
+	if e.compressor == "" {
+		return *externalCompressorCmd
+	}
+	return e.compressor
+}
+
+func (e *externalCompressionEngine) decompressorCmd() string {
+	if e.decompressor == "" {
+		return *externalDecompressorCmd
+	}
+	return e.decompressor
+}
+
+// externalCommand prepares the given command line to run. The command is not run in a shell.
+func externalCommand(cmdLine string) (*exec.Cmd, error) {
+	args := strings.Fields(cmdLine)
+	if len(args) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "external compression command is not set")
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = &bytes.Buffer{}
+	return cmd, nil
+}
+
+// waitExternalCommand waits for an external command to finish, and includes what
+// it wrote to its standard error in the error it returns.
+func waitExternalCommand(cmd *exec.Cmd) error {
+	if err := cmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "%v failed: %v", cmd.Path, strings.TrimSpace(cmd.Stderr.(*bytes.Buffer).String()))
+	}
+	return nil
+}
+
+func (e *externalCompressionEngine) NewCompressor(w io.Writer) (io.WriteCloser, error) {
+	cmd, err := externalCommand(e.compressorCmd())
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start external compressor %q", e.compressorCmd())
+	}
+	return &externalCompressor{cmd: cmd, stdin: stdin}, nil
+}
+
+func (e *externalCompressionEngine) NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	cmd, err := externalCommand(e.decompressorCmd())
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start external decompressor %q", e.decompressorCmd())
+	}
+	return &externalDecompressor{cmd: cmd, stdout: stdout}, nil
+}
+
+func (e *externalCompressionEngine) Extension() string {
+	return e.extension
+}
+
+// externalCompressor writes to the standard input of the compression command,
+// which writes the compressed data to the underlying writer.
+type externalCompressor struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+}
+
+func (c *externalCompressor) Write(p []byte) (int, error) {
+	return c.stdin.Write(p)
+}
+
+// Close closes the standard input of the command, and waits for it to write all the compressed data.
+func (c *externalCompressor) Close() error {
+	if err := c.stdin.Close(); err != nil {
+		return err
+	}
+	return waitExternalCommand(c.cmd)
+}
+
+// externalDecompressor reads the standard output of the decompression command.
+type externalDecompressor struct {
+	cmd    *exec.Cmd
+	stdout io.ReadCloser
+}
+
+func (d *externalDecompressor) Read(p []byte) (int, error) {
+	return d.stdout.Read(p)
+}
+
+// Close waits for the command to finish. Any output that was not read is discarded,
+// so the command does not block writing it.
+func (d *externalDecompressor) Close() error {
+	if _, err := io.Copy(io.Discard, d.stdout); err != nil {
+		log.Warningf("failed to drain the output of %v: %v", d.cmd.Path, err)
+	}
+	return waitExternalCommand(d.cmd)
+}
+
+func init() {
+	CompressionEngineMap[PgzipCompressionEngine] = pgzipCompressionEngine{}
+	CompressionEngineMap[ZstdCompressionEngine] = zstdCompressionEngine{}
+	CompressionEngineMap[Lz4CompressionEngine] = &externalCompressionEngine{
+		compressor:   "lz4 -c -z",
+		decompressor: "lz4 -c -d",
+		extension:    ".lz4",
+	}
+	CompressionEngineMap[ExternalCompressionEngine] = &externalCompressionEngine{}
+}
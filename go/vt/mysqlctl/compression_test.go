@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compressAndDecompress(t *testing.T, compression, decompression CompressionEngine, data []byte) []byte {
+	t.Helper()
+
+	var compressed bytes.Buffer
+	compressor, err := compression.NewCompressor(&compressed)
+	require.NoError(t, err)
+	_, err = compressor.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+
+	decompressor, err := decompression.NewDecompressor(&compressed)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(decompressor)
+	require.NoError(t, err)
+	require.NoError(t, decompressor.Close())
+	return decompressed
+}
+
+func TestCompressionEngines(t *testing.T) {
+	data := []byte(strings.Repeat("vitess backup data ", 100000))
+
+	for _, name := range []string{"", PgzipCompressionEngine, ZstdCompressionEngine} {
+		t.Run(name, func(t *testing.T) {
+			engine, err := getCompressionEngine(name)
+			require.NoError(t, err)
+			assert.Equal(t, data, compressAndDecompress(t, engine, engine, data))
+		})
+	}
+
+	_, err := getCompressionEngine("snappy")
+	assert.EqualError(t, err, `unknown CompressionEngine implementation "snappy"`)
+}
+
+func TestExternalCompressionEngine(t *testing.T) {
+	defer func(compressor, decompressor string) {
+		*externalCompressorCmd, *externalDecompressorCmd = compressor, decompressor
+	}(*externalCompressorCmd, *externalDecompressorCmd)
+
+	data := []byte(strings.Repeat("vitess backup data ", 1000))
+
+	*externalCompressorCmd = "gzip -c"
+	engine, err := getCompressionEngine(ExternalCompressionEngine)
+	require.NoError(t, err)
+
+	// the decompressor is never taken from the MANIFEST, so it must be set on the tablet
+	_, err = getDecompressionEngine(ExternalCompressionEngine)
+	assert.Error(t, err)
+
+	*externalDecompressorCmd = "gzip -d -c"
+	decompression, err := getDecompressionEngine(ExternalCompressionEngine)
+	require.NoError(t, err)
+	assert.Equal(t, data, compressAndDecompress(t, engine, decompression, data))
+
+	// backups compressed by an external command can be decompressed in process
+	decompression, err = getDecompressionEngine(PgzipCompressionEngine)
+	require.NoError(t, err)
+	assert.Equal(t, data, compressAndDecompress(t, engine, decompression, data))
+
+	// a failing command is reported when the compressor is closed
+	*externalCompressorCmd = "false"
+	engine, err = getCompressionEngine(ExternalCompressionEngine)
+	require.NoError(t, err)
+	compressor, err := engine.NewCompressor(io.Discard)
+	require.NoError(t, err)
+	assert.Error(t, compressor.Close())
+}
@@ -0,0 +1,443 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// backupCipherAES256GCM is the only cipher backups are encrypted with for now.
+	// It is recorded in the MANIFEST so other ciphers can be added later.
+	backupCipherAES256GCM = "aes-256-gcm"
+
+	// encryptionChunkSize is the size of the plaintext of each chunk of an encrypted file.
+	encryptionChunkSize = 64 * 1024
+
+	dataKeySize = 32
+)
+
+var (
+	// backupEncryptionKeyProvider is the name of the KeyProvider that wraps the data keys of new
+	// backups. Backups are not encrypted if it is empty. It is recorded in the MANIFEST, so restores
+	// always unwrap the data key with the provider the backup was created with.
+	backupEncryptionKeyProvider = flag.String("backup_storage_encryption_key_provider", "", "if set, the backup files are encrypted with AES-256-GCM, under a data key wrapped by this key provider: file or kms_stub.")
+
+	// backupEncryptionKeyFile is where the key providers read their master keys from.
+	backupEncryptionKeyFile = flag.String("backup_storage_encryption_key_file", "", "the file with the master keys of the backup encryption key provider. For the file provider, it holds a hex-encoded 256-bit key. For the kms_stub provider, it holds a JSON object mapping key ids to hex-encoded 256-bit keys.")
+
+	// backupEncryptionKeyID selects the master key of the kms_stub key provider.
+	backupEncryptionKeyID = flag.String("backup_storage_encryption_key_id", "", "the id of the master key the kms_stub key provider wraps the data keys of new backups with.")
+)
+
+// BackupEncryption describes how the files of a backup were encrypted.
+// It is recorded in the MANIFEST of encrypted backups.
+type BackupEncryption struct {
+	// Cipher is the cipher the files were encrypted with.
+	Cipher string
+
+	// KeyProvider is the name of the KeyProvider that wrapped the data key.
+	KeyProvider string
+
+	// KeyID identifies the master key that wrapped the data key.
+	KeyID string
+
+	// WrappedKey is the data key the files were encrypted with, wrapped by the key provider.
+	WrappedKey []byte
+}
+
+// KeyProvider protects the data keys backups are encrypted with. The data key of each
+// backup is wrapped by a master key the KeyProvider manages, and only the wrapped key
+// is stored with the backup.
+type KeyProvider interface {
+	// WrapKey encrypts a data key, and returns the id of the master key it used.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+
+	// UnwrapKey decrypts a data key wrapped by the master key with the given id.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// KeyProviderMap contains the registered implementations of KeyProvider. The providers
+// are created when they are needed, since they depend on flags.
+var KeyProviderMap = make(map[string]func() (KeyProvider, error))
+
+func getKeyProvider(name string) (KeyProvider, error) {
+	factory, ok := KeyProviderMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown KeyProvider implementation %q", name)
+	}
+	return factory()
+}
+
+// newBackupEncryption generates the data key of a new backup and wraps it with the configured
+// key provider. It returns nil if backups are not encrypted.
+func newBackupEncryption(ctx context.Context) (*BackupEncryption, []byte, error) {
+	if *backupEncryptionKeyProvider == "" {
+		return nil, nil, nil
+	}
+	provider, err := getKeyProvider(*backupEncryptionKeyProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, vterrors.Wrap(err, "cannot generate backup data key")
+	}
+	keyID, wrapped, err := provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, vterrors.Wrapf(err, "cannot wrap backup data key with %v key provider", *backupEncryptionKeyProvider)
+	}
+	return &BackupEncryption{
+		Cipher:      backupCipherAES256GCM,
+		KeyProvider: *backupEncryptionKeyProvider,
+		KeyID:       keyID,
+		WrappedKey:  wrapped,
+	}, dataKey, nil
+}
+
+// DataKey unwraps the data key the files of the backup were encrypted with.
+func (enc *BackupEncryption) DataKey(ctx context.Context) ([]byte, error) {
+	if enc.Cipher != backupCipherAES256GCM {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "unsupported backup cipher %q", enc.Cipher)
+	}
+	provider, err := getKeyProvider(enc.KeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := provider.UnwrapKey(ctx, enc.KeyID, enc.WrappedKey)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot unwrap backup data key with %v key provider", enc.KeyProvider)
+	}
+	return dataKey, nil
+}
+
+// sealKey wraps a data key with AES-GCM under the given master key. The key id is
+// authenticated, so a wrapped key cannot be presented as belonging to another key.
+func sealKey(masterKey []byte, keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+// openKey unwraps a data key wrapped by sealKey.
+func openKey(masterKey []byte, keyID string, wrapped []byte) ([]byte, error) {
+	aead, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "wrapped key is too short")
+	}
+	dataKey, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot unwrap data key with master key %v", keyID)
+	}
+	return dataKey, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != dataKeySize {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "encryption keys must be %v bytes, got %v", dataKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func decodeHexKey(key string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot decode hex-encoded key")
+	}
+	if len(decoded) != dataKeySize {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "encryption keys must be %v bytes, got %v", dataKeySize, len(decoded))
+	}
+	return decoded, nil
+}
+
+// fileKeyProvider wraps data keys with a single master key read from a local file.
+// The id of the key is derived from the key, so restoring with another key fails early.
+type fileKeyProvider struct {
+	masterKey []byte
+	keyID     string
+}
+
+func newFileKeyProvider() (KeyProvider, error) {
+	if *backupEncryptionKeyFile == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup_storage_encryption_key_file is required by the file key provider")
+	}
+	data, err := os.ReadFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot read backup encryption key file")
+	}
+	masterKey, err := decodeHexKey(string(data))
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := sha256.Sum256(masterKey)
+	return &fileKeyProvider{
+		masterKey: masterKey,
+		keyID:     hex.EncodeToString(fingerprint[:8]),
+	}, nil
+}
+
+func (p *fileKeyProvider) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	wrapped, err := sealKey(p.masterKey, p.keyID, dataKey)
+	return p.keyID, wrapped, err
+}
+
+func (p *fileKeyProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	if keyID != p.keyID {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup data key was wrapped with master key %v, but the key file holds master key %v", keyID, p.keyID)
+	}
+	return openKey(p.masterKey, keyID, wrapped)
+}
+
+// kmsStubKeyProvider behaves like a key management service: it holds several named master
+// keys, wraps the data keys of new backups with the key selected by backup_storage_encryption_key_id,
+// and unwraps data keys with whichever key wrapped them, so master keys can be rotated.
+// The keys are read from a local file, which makes it suitable for testing and development.
+type kmsStubKeyProvider struct {
+	masterKeys map[string][]byte
+}
+
+func newKMSStubKeyProvider() (KeyProvider, error) {
+	if *backupEncryptionKeyFile == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup_storage_encryption_key_file is required by the kms_stub key provider")
+	}
+	data, err := os.ReadFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot read backup encryption key file")
+	}
+	var keys map[string]string
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, vterrors.Wrap(err, "cannot parse backup encryption key file")
+	}
+
+	p := &kmsStubKeyProvider{masterKeys: make(map[string][]byte, len(keys))}
+	for id, key := range keys {
+		masterKey, err := decodeHexKey(key)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "invalid master key %v", id)
+		}
+		p.masterKeys[id] = masterKey
+	}
+	return p, nil
+}
+
+func (p *kmsStubKeyProvider) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	keyID := *backupEncryptionKeyID
+	masterKey, ok := p.masterKeys[keyID]
+	if !ok {
+		return "", nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown master key %q", keyID)
+	}
+	wrapped, err := sealKey(masterKey, keyID, dataKey)
+	return keyID, wrapped, err
+}
+
+func (p *kmsStubKeyProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	masterKey, ok := p.masterKeys[keyID]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown master key %q", keyID)
+	}
+	return openKey(masterKey, keyID, wrapped)
+}
+
+// The encrypted files start with a random nonce prefix, followed by chunks of at most
+// encryptionChunkSize bytes of plaintext. Each chunk is stored as its length and its
+// ciphertext. The nonce of each chunk is the prefix xor'ed with the index of the chunk,
+// and the last chunk is authenticated as such, so truncated files are detected.
+const (
+	chunkNotLast byte = 0
+	chunkLast    byte = 1
+)
+
+func chunkNonce(prefix []byte, index uint64) []byte {
+	nonce := make([]byte, len(prefix))
+	copy(nonce, prefix)
+	counter := binary.BigEndian.Uint64(nonce[len(nonce)-8:])
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], counter^index)
+	return nonce
+}
+
+// encryptingWriter encrypts the data written to it into the underlying writer.
+type encryptingWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint64
+	buf    []byte
+}
+
+func newEncryptingWriter(w io.Writer, dataKey []byte) (io.WriteCloser, error) {
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(prefix); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(e.buf[len(e.buf):cap(e.buf)], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+
+		// the chunk is only sealed once more data is written, since the last
+		// chunk must be sealed differently, when the writer is closed
+		if len(e.buf) == cap(e.buf) && len(p) > 0 {
+			if err := e.writeChunk(chunkNotLast); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (e *encryptingWriter) writeChunk(last byte) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.prefix, e.index), e.buf, []byte{last})
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(sealed)))
+	if _, err := e.w.Write(length[:]); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+	e.index++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+// Close writes the last chunk. It does not close the underlying writer.
+func (e *encryptingWriter) Close() error {
+	return e.writeChunk(chunkLast)
+}
+
+// decryptingReader decrypts the data read from the underlying reader.
+type decryptingReader struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint64
+	buf    []byte
+	last   bool
+}
+
+func newDecryptingReader(r io.Reader, dataKey []byte) (io.Reader, error) {
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(r)
+	prefix := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(br, prefix); err != nil {
+		return nil, vterrors.Wrap(err, "cannot read encryption header")
+	}
+	return &decryptingReader{r: br, aead: aead, prefix: prefix}, nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.last {
+			if _, err := d.r.ReadByte(); err != io.EOF {
+				return 0, vterrors.Errorf(vtrpc.Code_DATA_LOSS, "unexpected data after the last encrypted chunk")
+			}
+			return 0, io.EOF
+		}
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) readChunk() error {
+	var length [4]byte
+	if _, err := io.ReadFull(d.r, length[:]); err != nil {
+		if err == io.EOF {
+			return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "encrypted file is truncated")
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > encryptionChunkSize+uint32(d.aead.Overhead()) {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "invalid encrypted chunk size %v", size)
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		return vterrors.Wrap(err, "encrypted file is truncated")
+	}
+
+	nonce := chunkNonce(d.prefix, d.index)
+	plain, err := d.aead.Open(nil, nonce, sealed, []byte{chunkNotLast})
+	if err != nil {
+		plain, err = d.aead.Open(nil, nonce, sealed, []byte{chunkLast})
+		if err != nil {
+			return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "cannot decrypt chunk %v: %v", d.index, err)
+		}
+		d.last = true
+	}
+	d.index++
+	d.buf = plain
+	return nil
+}
+
+func init() {
+	KeyProviderMap["file"] = newFileKeyProvider
+	KeyProviderMap["kms_stub"] = newKMSStubKeyProvider
+}
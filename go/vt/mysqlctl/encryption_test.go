@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMasterKey1 = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	testMasterKey2 = "1f1e1d1c1b1a191817161514131211100f0e0d0c0b0a09080706050403020100"
+)
+
+func encrypt(t *testing.T, dataKey, data []byte) []byte {
+	t.Helper()
+	var encrypted bytes.Buffer
+	w, err := newEncryptingWriter(&encrypted, dataKey)
+	require.NoError(t, err)
+	// write in uneven pieces, to cross the chunk boundaries
+	for len(data) > 0 {
+		n := 1000 + len(data)%7777
+		if n > len(data) {
+			n = len(data)
+		}
+		_, err := w.Write(data[:n])
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+	return encrypted.Bytes()
+}
+
+func decrypt(dataKey, encrypted []byte) ([]byte, error) {
+	r, err := newDecryptingReader(bytes.NewReader(encrypted), dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{42}, dataKeySize)
+
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, encryptionChunkSize + 1, 5*encryptionChunkSize + 123} {
+		data := []byte(strings.Repeat("x", size))
+		encrypted := encrypt(t, dataKey, data)
+		assert.NotContains(t, string(encrypted), strings.Repeat("x", 16))
+
+		decrypted, err := decrypt(dataKey, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted, "size %d", size)
+	}
+}
+
+func TestEncryptionTampering(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{42}, dataKeySize)
+	data := []byte(strings.Repeat("vitess", 3*encryptionChunkSize/6))
+	encrypted := encrypt(t, dataKey, data)
+
+	// a file truncated at a chunk boundary is detected, since the last chunk is missing
+	chunk := 4 + encryptionChunkSize + 16
+	_, err := decrypt(dataKey, encrypted[:12+chunk])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "truncated")
+
+	// modified data fails authentication
+	modified := append([]byte(nil), encrypted...)
+	modified[100] ^= 1
+	_, err = decrypt(dataKey, modified)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot decrypt chunk 0")
+
+	// the wrong data key fails authentication
+	_, err = decrypt(bytes.Repeat([]byte{43}, dataKeySize), encrypted)
+	assert.Error(t, err)
+
+	// data appended after the last chunk is detected
+	_, err = decrypt(dataKey, append(append([]byte(nil), encrypted...), 0))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after the last encrypted chunk")
+}
+
+func setEncryptionFlags(t *testing.T, provider, keyFileContents, keyID string) {
+	t.Helper()
+	oldProvider, oldFile, oldID := *backupEncryptionKeyProvider, *backupEncryptionKeyFile, *backupEncryptionKeyID
+	t.Cleanup(func() {
+		*backupEncryptionKeyProvider, *backupEncryptionKeyFile, *backupEncryptionKeyID = oldProvider, oldFile, oldID
+	})
+
+	keyFile := path.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte(keyFileContents), 0600))
+	*backupEncryptionKeyProvider, *backupEncryptionKeyFile, *backupEncryptionKeyID = provider, keyFile, keyID
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	setEncryptionFlags(t, "", "", "")
+	enc, dataKey, err := newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, enc)
+	assert.Nil(t, dataKey)
+
+	setEncryptionFlags(t, "file", testMasterKey1+"\n", "")
+	enc, dataKey, err = newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "file", enc.KeyProvider)
+	assert.Equal(t, backupCipherAES256GCM, enc.Cipher)
+	assert.NotEqual(t, dataKey, enc.WrappedKey)
+
+	unwrapped, err := enc.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// restoring with another master key fails before reading any file
+	setEncryptionFlags(t, "file", testMasterKey2, "")
+	_, err = enc.DataKey(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was wrapped with master key "+enc.KeyID)
+
+	setEncryptionFlags(t, "file", "not a key", "")
+	_, _, err = newBackupEncryption(ctx)
+	assert.Error(t, err)
+}
+
+func TestKMSStubKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	setEncryptionFlags(t, "kms_stub", `{"2021": "`+testMasterKey1+`"}`, "2021")
+	oldEnc, oldDataKey, err := newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2021", oldEnc.KeyID)
+
+	// after rotating the master key, old backups can still be restored
+	setEncryptionFlags(t, "kms_stub", `{"2021": "`+testMasterKey1+`", "2022": "`+testMasterKey2+`"}`, "2022")
+	newEnc, newDataKey, err := newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2022", newEnc.KeyID)
+
+	unwrapped, err := oldEnc.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, oldDataKey, unwrapped)
+	unwrapped, err = newEnc.DataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, newDataKey, unwrapped)
+
+	// a wrapped key cannot be unwrapped as if it belonged to another master key
+	newEnc.KeyID = "2021"
+	_, err = newEnc.DataKey(ctx)
+	assert.Error(t, err)
+
+	setEncryptionFlags(t, "kms_stub", `{"2022": "`+testMasterKey2+`"}`, "2022")
+	_, err = oldEnc.DataKey(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown master key "2021"`)
+
+	setEncryptionFlags(t, "kms_stub", `{"2022": "`+testMasterKey2+`"}`, "2023")
+	_, _, err = newBackupEncryption(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown master key "2023"`)
+
+	oldEnc.KeyProvider = "vault"
+	_, err = oldEnc.DataKey(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown KeyProvider implementation "vault"`)
+}
@@ -31,9 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -99,6 +96,13 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the files were compressed with, if they were.
+	// It is empty for backups created before the field existed, which used pgzip.
+	CompressionEngine string
+
+	// Encryption describes how the stripes were encrypted, if they were.
+	Encryption *BackupEncryption
 }
 
 func (be *XtrabackupEngine) backupFileName() string {
@@ -108,7 +112,9 @@ func (be *XtrabackupEngine) backupFileName() string {
 		fileName += *xtrabackupStreamMode
 	}
 	if *backupStorageCompress {
-		fileName += ".gz"
+		if engine, err := getCompressionEngine(*backupCompressionEngine); err == nil {
+			fileName += engine.Extension()
+		}
 	}
 	return fileName
 }
@@ -154,8 +160,14 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	// do not write the MANIFEST unless all files were closed successfully,
 	// maintaining the contract that a MANIFEST file should only exist if the
 	// backup was created successfully.
+	// All the stripes are encrypted with the same data key, which is stored wrapped in the MANIFEST.
+	encryption, dataKey, err := newBackupEncryption(ctx)
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't set up backup encryption")
+	}
+
 	params.Logger.Infof("Starting backup with %v stripe(s)", numStripes)
-	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor)
+	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor, dataKey)
 	if err != nil {
 		return false, err
 	}
@@ -185,6 +197,10 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		Params:          *xtrabackupBackupFlags,
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
+		Encryption:      encryption,
+	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
 	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
@@ -199,7 +215,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	return true, nil
 }
 
-func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string) (replicationPosition mysql.Position, finalErr error) {
+func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string, dataKey []byte) (replicationPosition mysql.Position, finalErr error) {
 
 	backupProgram := path.Join(*xtrabackupEnginePath, xtrabackupBinaryName)
 	flagsToExec := []string{"--defaults-file=" + params.Cnf.path,
@@ -272,17 +288,33 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
 	destCompressors := []io.WriteCloser{}
+	destEncryptors := []io.WriteCloser{}
 	for _, file := range destFiles {
 		buffer := bufio.NewWriterSize(file, writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
+		// Create the encryption pipe, if necessary. It is the last step before
+		// the destination, so the stored data never contains plaintext.
+		if dataKey != nil {
+			encryptor, err := newEncryptingWriter(writer, dataKey)
+			if err != nil {
+				return replicationPosition, vterrors.Wrap(err, "cannot create encryptor")
+			}
+			writer = encryptor
+			destEncryptors = append(destEncryptors, encryptor)
+		}
+
+		// Create the compression pipe, if necessary.
 		if *backupStorageCompress {
-			compressor := pargzip.NewWriter(writer)
-			compressor.ChunkSize = *backupCompressBlockSize
-			compressor.Parallel = *backupCompressBlocks
-			compressor.CompressionLevel = pargzip.BestSpeed
+			engine, err := getCompressionEngine(*backupCompressionEngine)
+			if err != nil {
+				return replicationPosition, err
+			}
+			compressor, err := engine.NewCompressor(writer)
+			if err != nil {
+				return replicationPosition, vterrors.Wrapf(err, "cannot create %v compressor", *backupCompressionEngine)
+			}
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -326,7 +358,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		}
 	}()
 
-	// Copy from the stream output to destination file (optional gzip)
+	// Copy from the stream output to destination file (optional compression)
 	blockSize := int64(*xtrabackupStripeBlockSize)
 	if blockSize < 1024 {
 		// Enforce minimum block size.
@@ -343,7 +375,14 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrapf(err, "cannot close %v compressor", *backupCompressionEngine)
+		}
+	}
+
+	// Close the encryptors to write the last encrypted chunks.
+	for _, encryptor := range destEncryptors {
+		if err := encryptor.Close(); err != nil {
+			return replicationPosition, vterrors.Wrap(err, "cannot close encryptor")
 		}
 	}
 
@@ -510,6 +549,14 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 		baseFileName = be.backupFileName()
 	}
 
+	var dataKey []byte
+	if bm.Encryption != nil {
+		var err error
+		if dataKey, err = bm.Encryption.DataKey(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Open the source files for reading.
 	srcFiles, err := readStripeFiles(ctx, bh, baseFileName, int(bm.NumStripes), logger)
 	if err != nil {
@@ -526,11 +573,23 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	for _, file := range srcFiles {
 		reader := io.Reader(file)
 
+		// Create the decryptor if needed.
+		if dataKey != nil {
+			reader, err = newDecryptingReader(reader, dataKey)
+			if err != nil {
+				return vterrors.Wrap(err, "can't create decryptor")
+			}
+		}
+
 		// Create the decompressor if needed.
 		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+			engine, err := getDecompressionEngine(bm.CompressionEngine)
+			if err != nil {
+				return err
+			}
+			decompressor, err := engine.NewDecompressor(reader)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return vterrors.Wrap(err, "can't create decompressor")
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -541,7 +600,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
@@ -115,3 +115,51 @@ func TestStripeRoundTrip(t *testing.T) {
 	// Test block size and stripe count that don't evenly divide data size.
 	test(6000, 7)
 }
+
+func TestEncryptedStripeRoundTrip(t *testing.T) {
+	dataKey := bytes.Repeat([]byte{42}, dataKeySize)
+	input := make([]byte, 3*encryptionChunkSize+123)
+	rand.New(rand.NewSource(1)).Read(input)
+
+	// Each stripe is encrypted on its own, like in backupFiles.
+	blockSize := int64(3000)
+	buffers := make([]bytes.Buffer, 3)
+	writers := []io.Writer{}
+	encryptors := []io.WriteCloser{}
+	for i := range buffers {
+		encryptor, err := newEncryptingWriter(&buffers[i], dataKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writers = append(writers, encryptor)
+		encryptors = append(encryptors, encryptor)
+	}
+	if _, err := copyToStripes(writers, bytes.NewReader(input), blockSize); err != nil {
+		t.Fatal(err)
+	}
+	for _, encryptor := range encryptors {
+		if err := encryptor.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// And decrypted on its own, like in extractFiles.
+	readers := []io.Reader{}
+	for i := range buffers {
+		if bytes.Contains(buffers[i].Bytes(), input[:blockSize]) {
+			t.Errorf("stripe %d contains plaintext", i)
+		}
+		reader, err := newDecryptingReader(&buffers[i], dataKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		readers = append(readers, reader)
+	}
+	output, err := io.ReadAll(stripeReader(readers, blockSize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, output) {
+		t.Errorf("output bytes are not the same as input")
+	}
+}
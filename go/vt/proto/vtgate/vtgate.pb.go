@@ -157,6 +157,57 @@ func (CommitOrder) EnumDescriptor() ([]byte, []int) {
 	return file_vtgate_proto_rawDescGZIP(), []int{1}
 }
 
+// VStreamFormat is the format of the responses of a VStream.
+type VStreamFormat int32
+
+const (
+	// VEVENT sends the VEvents of the stream.
+	VStreamFormat_VEVENT VStreamFormat = 0
+	// DEBEZIUM sends the row changes and DDLs as Debezium JSON change
+	// events. The VEvents of the responses are then limited to the VGTID
+	// and HEARTBEAT events.
+	VStreamFormat_DEBEZIUM VStreamFormat = 1
+)
+
+// Enum value maps for VStreamFormat.
+var (
+	VStreamFormat_name = map[int32]string{
+		0: "VEVENT",
+		1: "DEBEZIUM",
+	}
+	VStreamFormat_value = map[string]int32{
+		"VEVENT":   0,
+		"DEBEZIUM": 1,
+	}
+)
+
+func (x VStreamFormat) Enum() *VStreamFormat {
+	p := new(VStreamFormat)
+	*p = x
+	return p
+}
+
+func (x VStreamFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VStreamFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_vtgate_proto_enumTypes[2].Descriptor()
+}
+
+func (VStreamFormat) Type() protoreflect.EnumType {
+	return &file_vtgate_proto_enumTypes[2]
+}
+
+func (x VStreamFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VStreamFormat.Descriptor instead.
+func (VStreamFormat) EnumDescriptor() ([]byte, []int) {
+	return file_vtgate_proto_rawDescGZIP(), []int{2}
+}
+
 // Session objects are exchanged like cookies through various
 // calls to VTGate. The behavior differs between V2 & V3 APIs.
 // V3 APIs are Execute, ExecuteBatch and StreamExecute. All
@@ -1074,6 +1125,8 @@ type VStreamFlags struct {
 	HeartbeatInterval uint32 `protobuf:"varint,2,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
 	// stop streams on a reshard (journal event)
 	StopOnReshard bool `protobuf:"varint,3,opt,name=stop_on_reshard,json=stopOnReshard,proto3" json:"stop_on_reshard,omitempty"`
+	// format of the responses
+	Format VStreamFormat `protobuf:"varint,4,opt,name=format,proto3,enum=vtgate.VStreamFormat" json:"format,omitempty"`
 }
 
 func (x *VStreamFlags) Reset() {
@@ -1129,6 +1182,13 @@ func (x *VStreamFlags) GetStopOnReshard() bool {
 	return false
 }
 
+func (x *VStreamFlags) GetFormat() VStreamFormat {
+	if x != nil {
+		return x.Format
+	}
+	return VStreamFormat_VEVENT
+}
+
 // VStreamRequest is the payload for VStream.
 type VStreamRequest struct {
 	state         protoimpl.MessageState
@@ -1219,6 +1279,9 @@ type VStreamResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Events []*binlogdata.VEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// change_events are the Debezium JSON change events of the response,
+	// if the stream was requested in the DEBEZIUM format.
+	ChangeEvents [][]byte `protobuf:"bytes,2,rep,name=change_events,json=changeEvents,proto3" json:"change_events,omitempty"`
 }
 
 func (x *VStreamResponse) Reset() {
@@ -1260,6 +1323,13 @@ func (x *VStreamResponse) GetEvents() []*binlogdata.VEvent {
 	return nil
 }
 
+func (x *VStreamResponse) GetChangeEvents() [][]byte {
+	if x != nil {
+		return x.ChangeEvents
+	}
+	return nil
+}
+
 // PrepareRequest is the payload to Prepare.
 type PrepareRequest struct {
 	state         protoimpl.MessageState
@@ -1783,7 +1853,7 @@ var file_vtgate_proto_rawDesc = []byte{
 	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x64, 0x74, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
 	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a,
 	0x65, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x69,
 	0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x6b, 0x65, 0x77, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65,
@@ -1792,67 +1862,75 @@ var file_vtgate_proto_rawDesc = []byte{
 	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f,
 	0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x67, 0x74,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f,
-	0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x47, 0x74, 0x69, 0x64, 0x52, 0x05, 0x76, 0x67, 0x74,
-	0x69, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a,
-	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c,
-	0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x56, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09,
-	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44,
-	0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x89,
-	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x44, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x55, 0x4c,
-	0x54, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x57, 0x4f, 0x50, 0x43, 0x10, 0x03, 0x2a,
-	0x3c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0a,
-	0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x52,
-	0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x41, 0x55, 0x54, 0x4f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x42, 0x36, 0x0a,
-	0x0f, 0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x5a, 0x23, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
-	0x74, 0x67, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x67, 0x74, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x47, 0x74, 0x69, 0x64, 0x52, 0x05, 0x76, 0x67, 0x74, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x62, 0x0a, 0x0f, 0x56, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01,
+	0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50,
+	0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6e,
+	0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d,
+	0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50,
+	0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x44, 0x0a,
+	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x57, 0x4f, 0x50,
+	0x43, 0x10, 0x03, 0x2a, 0x3c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x50, 0x52, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55, 0x54, 0x4f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10,
+	0x03, 0x2a, 0x29, 0x0a, 0x0d, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x45, 0x42, 0x45, 0x5a, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x42, 0x36, 0x0a, 0x0f,
+	0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5a,
+	0x23, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x74,
+	0x67, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1867,104 +1945,106 @@ func file_vtgate_proto_rawDescGZIP() []byte {
 	return file_vtgate_proto_rawDescData
 }
 
-var file_vtgate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_vtgate_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_vtgate_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_vtgate_proto_goTypes = []interface{}{
 	(TransactionMode)(0),               // 0: vtgate.TransactionMode
 	(CommitOrder)(0),                   // 1: vtgate.CommitOrder
-	(*Session)(nil),                    // 2: vtgate.Session
-	(*ReadAfterWrite)(nil),             // 3: vtgate.ReadAfterWrite
-	(*ExecuteRequest)(nil),             // 4: vtgate.ExecuteRequest
-	(*ExecuteResponse)(nil),            // 5: vtgate.ExecuteResponse
-	(*ExecuteBatchRequest)(nil),        // 6: vtgate.ExecuteBatchRequest
-	(*ExecuteBatchResponse)(nil),       // 7: vtgate.ExecuteBatchResponse
-	(*StreamExecuteRequest)(nil),       // 8: vtgate.StreamExecuteRequest
-	(*StreamExecuteResponse)(nil),      // 9: vtgate.StreamExecuteResponse
-	(*ResolveTransactionRequest)(nil),  // 10: vtgate.ResolveTransactionRequest
-	(*ResolveTransactionResponse)(nil), // 11: vtgate.ResolveTransactionResponse
-	(*VStreamFlags)(nil),               // 12: vtgate.VStreamFlags
-	(*VStreamRequest)(nil),             // 13: vtgate.VStreamRequest
-	(*VStreamResponse)(nil),            // 14: vtgate.VStreamResponse
-	(*PrepareRequest)(nil),             // 15: vtgate.PrepareRequest
-	(*PrepareResponse)(nil),            // 16: vtgate.PrepareResponse
-	(*CloseSessionRequest)(nil),        // 17: vtgate.CloseSessionRequest
-	(*CloseSessionResponse)(nil),       // 18: vtgate.CloseSessionResponse
-	(*Session_ShardSession)(nil),       // 19: vtgate.Session.ShardSession
-	nil,                                // 20: vtgate.Session.UserDefinedVariablesEntry
-	nil,                                // 21: vtgate.Session.SystemVariablesEntry
-	(*query.ExecuteOptions)(nil),       // 22: query.ExecuteOptions
-	(*query.QueryWarning)(nil),         // 23: query.QueryWarning
-	(*vtrpc.CallerID)(nil),             // 24: vtrpc.CallerID
-	(*query.BoundQuery)(nil),           // 25: query.BoundQuery
-	(topodata.TabletType)(0),           // 26: topodata.TabletType
-	(*vtrpc.RPCError)(nil),             // 27: vtrpc.RPCError
-	(*query.QueryResult)(nil),          // 28: query.QueryResult
-	(*query.ResultWithError)(nil),      // 29: query.ResultWithError
-	(*binlogdata.VGtid)(nil),           // 30: binlogdata.VGtid
-	(*binlogdata.Filter)(nil),          // 31: binlogdata.Filter
-	(*binlogdata.VEvent)(nil),          // 32: binlogdata.VEvent
-	(*query.Field)(nil),                // 33: query.Field
-	(*query.Target)(nil),               // 34: query.Target
-	(*topodata.TabletAlias)(nil),       // 35: topodata.TabletAlias
-	(*query.BindVariable)(nil),         // 36: query.BindVariable
+	(VStreamFormat)(0),                 // 2: vtgate.VStreamFormat
+	(*Session)(nil),                    // 3: vtgate.Session
+	(*ReadAfterWrite)(nil),             // 4: vtgate.ReadAfterWrite
+	(*ExecuteRequest)(nil),             // 5: vtgate.ExecuteRequest
+	(*ExecuteResponse)(nil),            // 6: vtgate.ExecuteResponse
+	(*ExecuteBatchRequest)(nil),        // 7: vtgate.ExecuteBatchRequest
+	(*ExecuteBatchResponse)(nil),       // 8: vtgate.ExecuteBatchResponse
+	(*StreamExecuteRequest)(nil),       // 9: vtgate.StreamExecuteRequest
+	(*StreamExecuteResponse)(nil),      // 10: vtgate.StreamExecuteResponse
+	(*ResolveTransactionRequest)(nil),  // 11: vtgate.ResolveTransactionRequest
+	(*ResolveTransactionResponse)(nil), // 12: vtgate.ResolveTransactionResponse
+	(*VStreamFlags)(nil),               // 13: vtgate.VStreamFlags
+	(*VStreamRequest)(nil),             // 14: vtgate.VStreamRequest
+	(*VStreamResponse)(nil),            // 15: vtgate.VStreamResponse
+	(*PrepareRequest)(nil),             // 16: vtgate.PrepareRequest
+	(*PrepareResponse)(nil),            // 17: vtgate.PrepareResponse
+	(*CloseSessionRequest)(nil),        // 18: vtgate.CloseSessionRequest
+	(*CloseSessionResponse)(nil),       // 19: vtgate.CloseSessionResponse
+	(*Session_ShardSession)(nil),       // 20: vtgate.Session.ShardSession
+	nil,                                // 21: vtgate.Session.UserDefinedVariablesEntry
+	nil,                                // 22: vtgate.Session.SystemVariablesEntry
+	(*query.ExecuteOptions)(nil),       // 23: query.ExecuteOptions
+	(*query.QueryWarning)(nil),         // 24: query.QueryWarning
+	(*vtrpc.CallerID)(nil),             // 25: vtrpc.CallerID
+	(*query.BoundQuery)(nil),           // 26: query.BoundQuery
+	(topodata.TabletType)(0),           // 27: topodata.TabletType
+	(*vtrpc.RPCError)(nil),             // 28: vtrpc.RPCError
+	(*query.QueryResult)(nil),          // 29: query.QueryResult
+	(*query.ResultWithError)(nil),      // 30: query.ResultWithError
+	(*binlogdata.VGtid)(nil),           // 31: binlogdata.VGtid
+	(*binlogdata.Filter)(nil),          // 32: binlogdata.Filter
+	(*binlogdata.VEvent)(nil),          // 33: binlogdata.VEvent
+	(*query.Field)(nil),                // 34: query.Field
+	(*query.Target)(nil),               // 35: query.Target
+	(*topodata.TabletAlias)(nil),       // 36: topodata.TabletAlias
+	(*query.BindVariable)(nil),         // 37: query.BindVariable
 }
 var file_vtgate_proto_depIdxs = []int32{
-	19, // 0: vtgate.Session.shard_sessions:type_name -> vtgate.Session.ShardSession
-	22, // 1: vtgate.Session.options:type_name -> query.ExecuteOptions
+	20, // 0: vtgate.Session.shard_sessions:type_name -> vtgate.Session.ShardSession
+	23, // 1: vtgate.Session.options:type_name -> query.ExecuteOptions
 	0,  // 2: vtgate.Session.transaction_mode:type_name -> vtgate.TransactionMode
-	23, // 3: vtgate.Session.warnings:type_name -> query.QueryWarning
-	19, // 4: vtgate.Session.pre_sessions:type_name -> vtgate.Session.ShardSession
-	19, // 5: vtgate.Session.post_sessions:type_name -> vtgate.Session.ShardSession
-	20, // 6: vtgate.Session.user_defined_variables:type_name -> vtgate.Session.UserDefinedVariablesEntry
-	21, // 7: vtgate.Session.system_variables:type_name -> vtgate.Session.SystemVariablesEntry
-	19, // 8: vtgate.Session.lock_session:type_name -> vtgate.Session.ShardSession
-	3,  // 9: vtgate.Session.read_after_write:type_name -> vtgate.ReadAfterWrite
-	24, // 10: vtgate.ExecuteRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 11: vtgate.ExecuteRequest.session:type_name -> vtgate.Session
-	25, // 12: vtgate.ExecuteRequest.query:type_name -> query.BoundQuery
-	26, // 13: vtgate.ExecuteRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 14: vtgate.ExecuteRequest.options:type_name -> query.ExecuteOptions
-	27, // 15: vtgate.ExecuteResponse.error:type_name -> vtrpc.RPCError
-	2,  // 16: vtgate.ExecuteResponse.session:type_name -> vtgate.Session
-	28, // 17: vtgate.ExecuteResponse.result:type_name -> query.QueryResult
-	24, // 18: vtgate.ExecuteBatchRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 19: vtgate.ExecuteBatchRequest.session:type_name -> vtgate.Session
-	25, // 20: vtgate.ExecuteBatchRequest.queries:type_name -> query.BoundQuery
-	26, // 21: vtgate.ExecuteBatchRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 22: vtgate.ExecuteBatchRequest.options:type_name -> query.ExecuteOptions
-	27, // 23: vtgate.ExecuteBatchResponse.error:type_name -> vtrpc.RPCError
-	2,  // 24: vtgate.ExecuteBatchResponse.session:type_name -> vtgate.Session
-	29, // 25: vtgate.ExecuteBatchResponse.results:type_name -> query.ResultWithError
-	24, // 26: vtgate.StreamExecuteRequest.caller_id:type_name -> vtrpc.CallerID
-	25, // 27: vtgate.StreamExecuteRequest.query:type_name -> query.BoundQuery
-	26, // 28: vtgate.StreamExecuteRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 29: vtgate.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
-	2,  // 30: vtgate.StreamExecuteRequest.session:type_name -> vtgate.Session
-	28, // 31: vtgate.StreamExecuteResponse.result:type_name -> query.QueryResult
-	24, // 32: vtgate.ResolveTransactionRequest.caller_id:type_name -> vtrpc.CallerID
-	24, // 33: vtgate.VStreamRequest.caller_id:type_name -> vtrpc.CallerID
-	26, // 34: vtgate.VStreamRequest.tablet_type:type_name -> topodata.TabletType
-	30, // 35: vtgate.VStreamRequest.vgtid:type_name -> binlogdata.VGtid
-	31, // 36: vtgate.VStreamRequest.filter:type_name -> binlogdata.Filter
-	12, // 37: vtgate.VStreamRequest.flags:type_name -> vtgate.VStreamFlags
-	32, // 38: vtgate.VStreamResponse.events:type_name -> binlogdata.VEvent
-	24, // 39: vtgate.PrepareRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 40: vtgate.PrepareRequest.session:type_name -> vtgate.Session
-	25, // 41: vtgate.PrepareRequest.query:type_name -> query.BoundQuery
-	27, // 42: vtgate.PrepareResponse.error:type_name -> vtrpc.RPCError
-	2,  // 43: vtgate.PrepareResponse.session:type_name -> vtgate.Session
-	33, // 44: vtgate.PrepareResponse.fields:type_name -> query.Field
-	24, // 45: vtgate.CloseSessionRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 46: vtgate.CloseSessionRequest.session:type_name -> vtgate.Session
-	27, // 47: vtgate.CloseSessionResponse.error:type_name -> vtrpc.RPCError
-	34, // 48: vtgate.Session.ShardSession.target:type_name -> query.Target
-	35, // 49: vtgate.Session.ShardSession.tablet_alias:type_name -> topodata.TabletAlias
-	36, // 50: vtgate.Session.UserDefinedVariablesEntry.value:type_name -> query.BindVariable
-	51, // [51:51] is the sub-list for method output_type
-	51, // [51:51] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	24, // 3: vtgate.Session.warnings:type_name -> query.QueryWarning
+	20, // 4: vtgate.Session.pre_sessions:type_name -> vtgate.Session.ShardSession
+	20, // 5: vtgate.Session.post_sessions:type_name -> vtgate.Session.ShardSession
+	21, // 6: vtgate.Session.user_defined_variables:type_name -> vtgate.Session.UserDefinedVariablesEntry
+	22, // 7: vtgate.Session.system_variables:type_name -> vtgate.Session.SystemVariablesEntry
+	20, // 8: vtgate.Session.lock_session:type_name -> vtgate.Session.ShardSession
+	4,  // 9: vtgate.Session.read_after_write:type_name -> vtgate.ReadAfterWrite
+	25, // 10: vtgate.ExecuteRequest.caller_id:type_name -> vtrpc.CallerID
+	3,  // 11: vtgate.ExecuteRequest.session:type_name -> vtgate.Session
+	26, // 12: vtgate.ExecuteRequest.query:type_name -> query.BoundQuery
+	27, // 13: vtgate.ExecuteRequest.tablet_type:type_name -> topodata.TabletType
+	23, // 14: vtgate.ExecuteRequest.options:type_name -> query.ExecuteOptions
+	28, // 15: vtgate.ExecuteResponse.error:type_name -> vtrpc.RPCError
+	3,  // 16: vtgate.ExecuteResponse.session:type_name -> vtgate.Session
+	29, // 17: vtgate.ExecuteResponse.result:type_name -> query.QueryResult
+	25, // 18: vtgate.ExecuteBatchRequest.caller_id:type_name -> vtrpc.CallerID
+	3,  // 19: vtgate.ExecuteBatchRequest.session:type_name -> vtgate.Session
+	26, // 20: vtgate.ExecuteBatchRequest.queries:type_name -> query.BoundQuery
+	27, // 21: vtgate.ExecuteBatchRequest.tablet_type:type_name -> topodata.TabletType
+	23, // 22: vtgate.ExecuteBatchRequest.options:type_name -> query.ExecuteOptions
+	28, // 23: vtgate.ExecuteBatchResponse.error:type_name -> vtrpc.RPCError
+	3,  // 24: vtgate.ExecuteBatchResponse.session:type_name -> vtgate.Session
+	30, // 25: vtgate.ExecuteBatchResponse.results:type_name -> query.ResultWithError
+	25, // 26: vtgate.StreamExecuteRequest.caller_id:type_name -> vtrpc.CallerID
+	26, // 27: vtgate.StreamExecuteRequest.query:type_name -> query.BoundQuery
+	27, // 28: vtgate.StreamExecuteRequest.tablet_type:type_name -> topodata.TabletType
+	23, // 29: vtgate.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
+	3,  // 30: vtgate.StreamExecuteRequest.session:type_name -> vtgate.Session
+	29, // 31: vtgate.StreamExecuteResponse.result:type_name -> query.QueryResult
+	25, // 32: vtgate.ResolveTransactionRequest.caller_id:type_name -> vtrpc.CallerID
+	2,  // 33: vtgate.VStreamFlags.format:type_name -> vtgate.VStreamFormat
+	25, // 34: vtgate.VStreamRequest.caller_id:type_name -> vtrpc.CallerID
+	27, // 35: vtgate.VStreamRequest.tablet_type:type_name -> topodata.TabletType
+	31, // 36: vtgate.VStreamRequest.vgtid:type_name -> binlogdata.VGtid
+	32, // 37: vtgate.VStreamRequest.filter:type_name -> binlogdata.Filter
+	13, // 38: vtgate.VStreamRequest.flags:type_name -> vtgate.VStreamFlags
+	33, // 39: vtgate.VStreamResponse.events:type_name -> binlogdata.VEvent
+	25, // 40: vtgate.PrepareRequest.caller_id:type_name -> vtrpc.CallerID
+	3,  // 41: vtgate.PrepareRequest.session:type_name -> vtgate.Session
+	26, // 42: vtgate.PrepareRequest.query:type_name -> query.BoundQuery
+	28, // 43: vtgate.PrepareResponse.error:type_name -> vtrpc.RPCError
+	3,  // 44: vtgate.PrepareResponse.session:type_name -> vtgate.Session
+	34, // 45: vtgate.PrepareResponse.fields:type_name -> query.Field
+	25, // 46: vtgate.CloseSessionRequest.caller_id:type_name -> vtrpc.CallerID
+	3,  // 47: vtgate.CloseSessionRequest.session:type_name -> vtgate.Session
+	28, // 48: vtgate.CloseSessionResponse.error:type_name -> vtrpc.RPCError
+	35, // 49: vtgate.Session.ShardSession.target:type_name -> query.Target
+	36, // 50: vtgate.Session.ShardSession.tablet_alias:type_name -> topodata.TabletAlias
+	37, // 51: vtgate.Session.UserDefinedVariablesEntry.value:type_name -> query.BindVariable
+	52, // [52:52] is the sub-list for method output_type
+	52, // [52:52] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_vtgate_proto_init() }
@@ -2195,7 +2275,7 @@ func file_vtgate_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vtgate_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -35,6 +35,9 @@ const (
 	allowZeroInDateFlag    = "allow-zero-in-date"
 	postponeCompletionFlag = "postpone-completion"
 	allowConcurrentFlag    = "allow-concurrent"
+	atomicContextFlag      = "atomic-context"
+	coordinatedCutOverFlag = "coordinated-cutover"
+	revertibleFlag         = "revertible"
 	vreplicationTestSuite  = "vreplication-test-suite"
 )
 
@@ -150,6 +153,21 @@ func (setting *DDLStrategySetting) IsAllowConcurrent() bool {
 	return setting.hasFlag(allowConcurrentFlag)
 }
 
+// IsAtomicContext checks if strategy options include -atomic-context
+func (setting *DDLStrategySetting) IsAtomicContext() bool {
+	return setting.hasFlag(atomicContextFlag)
+}
+
+// IsCoordinatedCutOver checks if strategy options include -coordinated-cutover
+func (setting *DDLStrategySetting) IsCoordinatedCutOver() bool {
+	return setting.hasFlag(coordinatedCutOverFlag)
+}
+
+// IsRevertible checks if strategy options include -revertible
+func (setting *DDLStrategySetting) IsRevertible() bool {
+	return setting.hasFlag(revertibleFlag)
+}
+
 // IsVreplicationTestSuite checks if strategy options include -vreplicatoin-test-suite
 func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
@@ -168,6 +186,9 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, allowZeroInDateFlag):
 		case isFlag(opt, postponeCompletionFlag):
 		case isFlag(opt, allowConcurrentFlag):
+		case isFlag(opt, atomicContextFlag):
+		case isFlag(opt, coordinatedCutOverFlag):
+		case isFlag(opt, revertibleFlag):
 		case isFlag(opt, vreplicationTestSuite):
 		default:
 			validOpts = append(validOpts, opt)
@@ -46,6 +46,9 @@ func TestParseDDLStrategy(t *testing.T) {
 		isSingleton          bool
 		isPostponeCompletion bool
 		isAllowConcurrent    bool
+		isAtomicContext      bool
+		isCoordinatedCutOver bool
+		isRevertible         bool
 		runtimeOptions       string
 		err                  error
 	}{
@@ -121,6 +124,28 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:    "",
 			isAllowConcurrent: true,
 		},
+		{
+			strategyVariable: "vitess -declarative -atomic-context",
+			strategy:         DDLStrategyVitess,
+			options:          "-declarative -atomic-context",
+			runtimeOptions:   "",
+			isDeclarative:    true,
+			isAtomicContext:  true,
+		},
+		{
+			strategyVariable:     "vitess -coordinated-cutover",
+			strategy:             DDLStrategyVitess,
+			options:              "-coordinated-cutover",
+			runtimeOptions:       "",
+			isCoordinatedCutOver: true,
+		},
+		{
+			strategyVariable: "gh-ost -revertible --max-load=Threads_running=100",
+			strategy:         DDLStrategyGhost,
+			options:          "-revertible --max-load=Threads_running=100",
+			runtimeOptions:   "--max-load=Threads_running=100",
+			isRevertible:     true,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -131,6 +156,9 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
 		assert.Equal(t, ts.isPostponeCompletion, setting.IsPostponeCompletion())
 		assert.Equal(t, ts.isAllowConcurrent, setting.IsAllowConcurrent())
+		assert.Equal(t, ts.isAtomicContext, setting.IsAtomicContext())
+		assert.Equal(t, ts.isCoordinatedCutOver, setting.IsCoordinatedCutOver())
+		assert.Equal(t, ts.isRevertible, setting.IsRevertible())
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
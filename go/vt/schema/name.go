@@ -69,3 +69,13 @@ func IsInternalOperationTableName(tableName string) bool {
 	}
 	return false
 }
+
+// MessageStreamName returns the name with which a consumer group of a message table is streamed and
+// acked. The default consumer group is streamed with the table name.
+// Example: for table "msg" and consumer group "billing" we return "msg@billing"
+func MessageStreamName(tableName, consumerGroup string) string {
+	if consumerGroup == "" {
+		return tableName
+	}
+	return tableName + "@" + consumerGroup
+}
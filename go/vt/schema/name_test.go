@@ -58,3 +58,8 @@ func TestIsInternalOperationTableName(t *testing.T) {
 		assert.False(t, IsInternalOperationTableName(tableName))
 	}
 }
+
+func TestMessageStreamName(t *testing.T) {
+	assert.Equal(t, "msg", MessageStreamName("msg", ""))
+	assert.Equal(t, "msg@billing", MessageStreamName("msg", "billing"))
+}
@@ -128,3 +128,18 @@ func DiffViews(create1 *sqlparser.CreateView, create2 *sqlparser.CreateView, hin
 		return c1.Diff(c2, hints)
 	}
 }
+
+// DiffSchemasSQL compares two schemas and returns the list of diffs that turn schema1 into schema2.
+// The schemas are given as SQL blobs of CREATE TABLE and CREATE VIEW statements, either or both of
+// which can be empty. The diffs are ordered so that they can be applied in sequence.
+func DiffSchemasSQL(sql1 string, sql2 string, hints *DiffHints) ([]EntityDiff, error) {
+	schema1, err := NewSchemaFromSQL(sql1)
+	if err != nil {
+		return nil, err
+	}
+	schema2, err := NewSchemaFromSQL(sql2)
+	if err != nil {
+		return nil, err
+	}
+	return schema1.Diff(schema2, hints)
+}
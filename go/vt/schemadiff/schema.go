@@ -0,0 +1,449 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Schema is a set of tables and views, as loaded from their CREATE statements.
+// A Schema is validated upon creation: entity names are unique, views only reference
+// existing tables and views, and foreign keys only reference existing tables.
+type Schema struct {
+	tables []*CreateTableEntity
+	views  []*CreateViewEntity
+
+	named map[string]Entity
+	// sorted lists the entities in dependency order: every entity appears after
+	// the entities it depends on. Tables always precede views.
+	sorted []Entity
+}
+
+func newEmptySchema() *Schema {
+	return &Schema{
+		named: map[string]Entity{},
+	}
+}
+
+// NewSchemaFromEntities creates a valid and normalized schema based on a list of entities
+func NewSchemaFromEntities(entities []Entity) (*Schema, error) {
+	schema := newEmptySchema()
+	for _, e := range entities {
+		switch c := e.(type) {
+		case *CreateTableEntity:
+			schema.tables = append(schema.tables, c)
+		case *CreateViewEntity:
+			schema.views = append(schema.views, c)
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedEntity, e)
+		}
+	}
+	if err := schema.normalize(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// NewSchemaFromStatements creates a valid and normalized schema based on list of valid statements
+func NewSchemaFromStatements(statements []sqlparser.Statement) (*Schema, error) {
+	entities := make([]Entity, 0, len(statements))
+	for _, s := range statements {
+		switch stmt := s.(type) {
+		case *sqlparser.CreateTable:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateTableEntity(stmt))
+		case *sqlparser.CreateView:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateViewEntity(stmt))
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedEntity, sqlparser.String(s))
+		}
+	}
+	return NewSchemaFromEntities(entities)
+}
+
+// NewSchemaFromQueries creates a valid and normalized schema based on list of queries
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	statements := make([]sqlparser.Statement, 0, len(queries))
+	for _, q := range queries {
+		stmt, err := sqlparser.ParseStrictDDL(q)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	return NewSchemaFromStatements(statements)
+}
+
+// NewSchemaFromSQL creates a valid and normalized schema based on a SQL blob that contains
+// CREATE statements for tables and views, separated by semicolons
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	pieces, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]string, 0, len(pieces))
+	for _, piece := range pieces {
+		if strings.TrimSpace(piece) == "" {
+			continue
+		}
+		queries = append(queries, piece)
+	}
+	return NewSchemaFromQueries(queries)
+}
+
+// viewDependencies returns the names of the tables and views the given view reads from.
+// Names qualified with a database, common table expressions and DUAL are not dependencies.
+func viewDependencies(v *CreateViewEntity) []string {
+	cteNames := map[string]bool{}
+	names := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			cteNames[node.TableID.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() {
+				names[tableName.Name.String()] = true
+			}
+		}
+		return true, nil
+	}, v.CreateView.Select)
+
+	var dependencies []string
+	for name := range names {
+		if !cteNames[name] && !strings.EqualFold(name, "dual") {
+			dependencies = append(dependencies, name)
+		}
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// foreignKeyDependencies returns the names of the tables the given table references by foreign keys,
+// other than the table itself.
+func foreignKeyDependencies(t *CreateTableEntity) []string {
+	names := map[string]bool{}
+	for _, cs := range t.CreateTable.TableSpec.Constraints {
+		fk, ok := cs.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok || fk.ReferenceDefinition == nil {
+			continue
+		}
+		referenced := fk.ReferenceDefinition.ReferencedTable
+		if !referenced.Qualifier.IsEmpty() {
+			continue
+		}
+		if name := referenced.Name.String(); name != t.Name() {
+			names[name] = true
+		}
+	}
+	var dependencies []string
+	for name := range names {
+		dependencies = append(dependencies, name)
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// dependencyOrder returns the given names so that each name appears after all of its dependencies.
+// Names are otherwise kept in their given order. The names that take part in a dependency
+// cycle are returned separately, in their given order.
+func dependencyOrder(names []string, dependencies map[string][]string, resolved map[string]bool) (ordered []string, cyclic []string) {
+	pending := names
+	for len(pending) > 0 {
+		// Each round resolves the names whose dependencies were all resolved in earlier rounds
+		var ready, remaining []string
+		for _, name := range pending {
+			isReady := true
+			for _, dep := range dependencies[name] {
+				if !resolved[dep] {
+					isReady = false
+					break
+				}
+			}
+			if isReady {
+				ready = append(ready, name)
+			} else {
+				remaining = append(remaining, name)
+			}
+		}
+		if len(ready) == 0 {
+			return ordered, remaining
+		}
+		for _, name := range ready {
+			resolved[name] = true
+		}
+		ordered = append(ordered, ready...)
+		pending = remaining
+	}
+	return ordered, nil
+}
+
+// normalize is called as part of Schema creation process. It validates the schema and
+// sorts the entities in dependency order.
+func (s *Schema) normalize() error {
+	sort.SliceStable(s.tables, func(i, j int) bool {
+		return s.tables[i].Name() < s.tables[j].Name()
+	})
+	sort.SliceStable(s.views, func(i, j int) bool {
+		return s.views[i].Name() < s.views[j].Name()
+	})
+
+	// Tables and views share the same namespace
+	for _, t := range s.tables {
+		if _, ok := s.named[t.Name()]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, t.Name())
+		}
+		s.named[t.Name()] = t
+	}
+	for _, v := range s.views {
+		if _, ok := s.named[v.Name()]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, v.Name())
+		}
+		s.named[v.Name()] = v
+	}
+
+	dependencies := map[string][]string{}
+
+	// Foreign keys may only reference tables. Tables are created after the tables they reference,
+	// so that the schema can be applied with foreign key checks enabled. Tables with circular
+	// foreign keys can only be created with foreign key checks disabled, and keep their name order.
+	tableNames := make([]string, 0, len(s.tables))
+	for _, t := range s.tables {
+		deps := foreignKeyDependencies(t)
+		for _, dep := range deps {
+			if _, ok := s.named[dep].(*CreateTableEntity); !ok {
+				return fmt.Errorf("%w: %s references %s", ErrForeignKeyDependencyUnresolved, t.Name(), dep)
+			}
+		}
+		dependencies[t.Name()] = deps
+		tableNames = append(tableNames, t.Name())
+	}
+	resolved := map[string]bool{}
+	orderedTables, cyclicTables := dependencyOrder(tableNames, dependencies, resolved)
+	for _, name := range cyclicTables {
+		resolved[name] = true
+	}
+	orderedTables = append(orderedTables, cyclicTables...)
+
+	// Views may reference tables and other views. Each view is created after everything it reads from.
+	viewNames := make([]string, 0, len(s.views))
+	for _, v := range s.views {
+		deps := viewDependencies(v)
+		for _, dep := range deps {
+			if _, ok := s.named[dep]; !ok {
+				return fmt.Errorf("%w: %s references %s", ErrViewDependencyUnresolved, v.Name(), dep)
+			}
+		}
+		dependencies[v.Name()] = deps
+		viewNames = append(viewNames, v.Name())
+	}
+	orderedViews, cyclicViews := dependencyOrder(viewNames, dependencies, resolved)
+	if len(cyclicViews) > 0 {
+		return fmt.Errorf("%w: %s", ErrViewDependencyCycle, strings.Join(cyclicViews, ", "))
+	}
+
+	s.sorted = make([]Entity, 0, len(s.named))
+	for _, name := range orderedTables {
+		s.sorted = append(s.sorted, s.named[name])
+	}
+	for _, name := range orderedViews {
+		s.sorted = append(s.sorted, s.named[name])
+	}
+	return nil
+}
+
+// Entities returns this schema's entities in dependency order: tables first, each after the tables
+// it references by foreign key, then views, each after the tables and views it reads from
+func (s *Schema) Entities() []Entity {
+	return s.sorted
+}
+
+// EntityNames is a convenience function that returns just the names of entities, in dependency order
+func (s *Schema) EntityNames() []string {
+	var names []string
+	for _, e := range s.Entities() {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// Tables returns this schema's tables, sorted by name
+func (s *Schema) Tables() []*CreateTableEntity {
+	return s.tables
+}
+
+// Views returns this schema's views, sorted by name
+func (s *Schema) Views() []*CreateViewEntity {
+	return s.views
+}
+
+// Entity returns an entity by name, or nil if nonexistent
+func (s *Schema) Entity(name string) Entity {
+	return s.named[name]
+}
+
+// Table returns a table by name, or nil if nonexistent
+func (s *Schema) Table(name string) *CreateTableEntity {
+	if t, ok := s.named[name].(*CreateTableEntity); ok {
+		return t
+	}
+	return nil
+}
+
+// View returns a view by name, or nil if nonexistent
+func (s *Schema) View(name string) *CreateViewEntity {
+	if v, ok := s.named[name].(*CreateViewEntity); ok {
+		return v
+	}
+	return nil
+}
+
+// ToQueries returns the CREATE statements of this schema's entities, in dependency order
+func (s *Schema) ToQueries() []string {
+	queries := make([]string, 0, len(s.sorted))
+	for _, e := range s.Entities() {
+		switch e := e.(type) {
+		case *CreateTableEntity:
+			queries = append(queries, sqlparser.String(&e.CreateTable))
+		case *CreateViewEntity:
+			queries = append(queries, sqlparser.String(&e.CreateView))
+		}
+	}
+	return queries
+}
+
+// ToSQL returns a SQL blob with the CREATE statements of this schema's entities, in dependency order
+func (s *Schema) ToSQL() string {
+	var buf strings.Builder
+	for _, query := range s.ToQueries() {
+		buf.WriteString(query)
+		buf.WriteString(";\n")
+	}
+	return buf.String()
+}
+
+// Diff compares this schema with another schema, and returns the list of diffs that turn this
+// schema into the other. The diffs are ordered so that they can be applied one by one:
+// - views that only exist in this schema are dropped, dependent views first
+// - tables that only exist in the other schema are created, referenced tables first
+// - tables that exist in both schemas are altered
+// - views of the other schema are altered or created, each after the tables and views it reads from
+// - tables that only exist in this schema are dropped, referencing tables first
+// Tables are dropped last so that no view, old or new, and no foreign key reference them at that time.
+func (s *Schema) Diff(other *Schema, hints *DiffHints) ([]EntityDiff, error) {
+	var diffs []EntityDiff
+
+	// Drop views, in reverse dependency order
+	for i := len(s.sorted) - 1; i >= 0; i-- {
+		fromView, ok := s.sorted[i].(*CreateViewEntity)
+		if !ok {
+			continue
+		}
+		if other.View(fromView.Name()) != nil {
+			continue
+		}
+		diff, err := DiffViews(&fromView.CreateView, nil, hints)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+
+	// Create and alter tables, in dependency order
+	var alterDiffs []EntityDiff
+	for _, e := range other.sorted {
+		toTable, ok := e.(*CreateTableEntity)
+		if !ok {
+			continue
+		}
+		fromEntity := s.Entity(toTable.Name())
+		switch fromEntity := fromEntity.(type) {
+		case nil:
+			diff, err := DiffTables(nil, &toTable.CreateTable, hints)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		case *CreateTableEntity:
+			diff, err := fromEntity.Diff(toTable, hints)
+			if err != nil {
+				return nil, err
+			}
+			// a table diff may be followed by more diffs, such as partition management statements
+			for ; diff != nil && !diff.IsEmpty(); diff = diff.SubsequentDiff() {
+				alterDiffs = append(alterDiffs, diff)
+			}
+		default:
+			// A view in this schema is a table in the other schema
+			return nil, fmt.Errorf("%w: %s", ErrEntityTypeMismatch, toTable.Name())
+		}
+	}
+	diffs = append(diffs, alterDiffs...)
+
+	// Create and alter views, in dependency order
+	for _, e := range other.sorted {
+		toView, ok := e.(*CreateViewEntity)
+		if !ok {
+			continue
+		}
+		fromEntity := s.Entity(toView.Name())
+		switch fromEntity := fromEntity.(type) {
+		case nil:
+			diff, err := DiffViews(nil, &toView.CreateView, hints)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		case *CreateViewEntity:
+			diff, err := fromEntity.Diff(toView, hints)
+			if err != nil {
+				return nil, err
+			}
+			if diff != nil && !diff.IsEmpty() {
+				diffs = append(diffs, diff)
+			}
+		default:
+			// A table in this schema is a view in the other schema
+			return nil, fmt.Errorf("%w: %s", ErrEntityTypeMismatch, toView.Name())
+		}
+	}
+
+	// Drop tables, in reverse dependency order
+	for i := len(s.sorted) - 1; i >= 0; i-- {
+		fromTable, ok := s.sorted[i].(*CreateTableEntity)
+		if !ok {
+			continue
+		}
+		if other.Entity(fromTable.Name()) != nil {
+			continue
+		}
+		diff, err := DiffTables(&fromTable.CreateTable, nil, hints)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var createQueries = []string{
+	"create view v5 as select * from t1, (select * from v3) as some_alias",
+	"create table t3(id int, type enum('foo', 'bar') NOT NULL DEFAULT 'foo')",
+	"create table t1(id int)",
+	"create view v6 as select * from v4",
+	"create view v4 as select * from t2 as something_else, v3",
+	"create table t2(id int)",
+	"create view v3 as select *, id+1 as id_plus, id+2 from t3 as t3",
+	"create table t5(id int)",
+	"create view v2 as select * from v3, t2",
+	"create view v1 as select * from v3",
+}
+
+func TestNewSchemaFromQueries(t *testing.T) {
+	schema, err := NewSchemaFromQueries(createQueries)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	assert.Equal(t, []string{"t1", "t2", "t3", "t5", "v3", "v1", "v2", "v4", "v5", "v6"}, schema.EntityNames())
+	assert.Equal(t, 4, len(schema.Tables()))
+	assert.Equal(t, 6, len(schema.Views()))
+	assert.NotNil(t, schema.Table("t3"))
+	assert.Nil(t, schema.Table("v3"))
+	assert.NotNil(t, schema.View("v3"))
+	assert.Nil(t, schema.Entity("v7"))
+}
+
+func TestNewSchemaFromSQL(t *testing.T) {
+	sql := `
+		create view v1 as select * from t1;
+		create table t1 (id int primary key);
+		create table t2 (id int primary key, t1_id int, foreign key (t1_id) references t1 (id));
+	`
+	schema, err := NewSchemaFromSQL(sql)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "v1"}, schema.EntityNames())
+
+	// ToSQL output loads into an identical schema
+	reloaded, err := NewSchemaFromSQL(schema.ToSQL())
+	require.NoError(t, err)
+	assert.Equal(t, schema.ToQueries(), reloaded.ToQueries())
+}
+
+func TestNewSchemaFromQueriesErrors(t *testing.T) {
+	tt := []struct {
+		name    string
+		queries []string
+		err     error
+	}{
+		{
+			name:    "duplicate table",
+			queries: []string{"create table t1(id int)", "create table t1(id bigint)"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "table and view with the same name",
+			queries: []string{"create table t1(id int)", "create view t1 as select 1 from dual"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "view on missing table",
+			queries: []string{"create table t1(id int)", "create view v1 as select * from t1 join t2 on (t1.id = t2.id)"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "view on missing table in subquery",
+			queries: []string{"create table t1(id int)", "create view v1 as select * from t1 where id in (select id from t9)"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "view cycle",
+			queries: []string{"create view v1 as select * from v2", "create view v2 as select * from v1"},
+			err:     ErrViewDependencyCycle,
+		},
+		{
+			name:    "foreign key to missing table",
+			queries: []string{"create table t1(id int primary key, t2_id int, foreign key (t2_id) references t2 (id))"},
+			err:     ErrForeignKeyDependencyUnresolved,
+		},
+		{
+			name:    "foreign key to view",
+			queries: []string{"create table t1(id int primary key)", "create view v1 as select * from t1", "create table t2(id int primary key, v1_id int, foreign key (v1_id) references v1 (id))"},
+			err:     ErrForeignKeyDependencyUnresolved,
+		},
+		{
+			name:    "not a create statement",
+			queries: []string{"create table t1(id int)", "drop table t2"},
+			err:     ErrUnsupportedEntity,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			_, err := NewSchemaFromQueries(ts.queries)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ts.err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestNewSchemaFromQueriesValid(t *testing.T) {
+	tt := []struct {
+		name    string
+		queries []string
+		names   []string
+	}{
+		{
+			name:    "self referencing foreign key",
+			queries: []string{"create table t1(id int primary key, parent_id int, foreign key (parent_id) references t1 (id))"},
+			names:   []string{"t1"},
+		},
+		{
+			name: "foreign key order",
+			queries: []string{
+				"create table a(id int primary key, z_id int, foreign key (z_id) references z (id))",
+				"create table z(id int primary key)",
+			},
+			names: []string{"z", "a"},
+		},
+		{
+			name: "circular foreign keys",
+			queries: []string{
+				"create table t2(id int primary key, t1_id int, foreign key (t1_id) references t1 (id))",
+				"create table t1(id int primary key, t2_id int, foreign key (t2_id) references t2 (id))",
+			},
+			names: []string{"t1", "t2"},
+		},
+		{
+			name: "common table expression",
+			queries: []string{
+				"create table t1(id int)",
+				"create view v1 as with cte as (select id from t1) select * from cte",
+			},
+			names: []string{"t1", "v1"},
+		},
+		{
+			name:    "qualified table",
+			queries: []string{"create view v1 as select * from other_db.t1"},
+			names:   []string{"v1"},
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			schema, err := NewSchemaFromQueries(ts.queries)
+			require.NoError(t, err)
+			assert.Equal(t, ts.names, schema.EntityNames())
+		})
+	}
+}
+
+func TestSchemaDiff(t *testing.T) {
+	tt := []struct {
+		name  string
+		from  string
+		to    string
+		diffs []string
+	}{
+		{
+			name: "identical",
+			from: "create table t1 (id int primary key); create view v1 as select * from t1",
+			to:   "create view v1 as select * from t1; create table t1 (id int primary key)",
+		},
+		{
+			name:  "create from empty",
+			to:    "create view v1 as select * from t1; create table t1 (id int primary key)",
+			diffs: []string{"create table t1 (\n\tid int primary key\n)", "create view v1 as select * from t1"},
+		},
+		{
+			name:  "drop to empty",
+			from:  "create view v1 as select * from t1; create table t1 (id int primary key)",
+			diffs: []string{"drop view v1", "drop table t1"},
+		},
+		{
+			name: "views dropped before their tables, dependents first",
+			from: "create table t1 (id int primary key); create view v1 as select * from t1; create view v2 as select * from v1",
+			to:   "create table t2 (id int primary key)",
+			diffs: []string{
+				"drop view v2",
+				"drop view v1",
+				"create table t2 (\n\tid int primary key\n)",
+				"drop table t1",
+			},
+		},
+		{
+			name: "views created after their tables, dependencies first",
+			from: "create table t1 (id int primary key)",
+			to:   "create table t1 (id int primary key); create view v2 as select * from v1; create view v1 as select * from t1, t2; create table t2 (id int primary key)",
+			diffs: []string{
+				"create table t2 (\n\tid int primary key\n)",
+				"create view v1 as select * from t1, t2",
+				"create view v2 as select * from v1",
+			},
+		},
+		{
+			name: "view moves to a new table",
+			from: "create table t1 (id int primary key); create view v1 as select * from t1",
+			to:   "create table t2 (id int primary key); create view v1 as select * from t2",
+			diffs: []string{
+				"create table t2 (\n\tid int primary key\n)",
+				"alter view v1 as select * from t2",
+				"drop table t1",
+			},
+		},
+		{
+			name: "alter table and foreign keys",
+			from: "create table t1 (id int primary key); create table t2 (id int primary key, t1_id int, foreign key (t1_id) references t1 (id))",
+			to:   "create table t1 (id int primary key, i int)",
+			diffs: []string{
+				"alter table t1 add column i int",
+				"drop table t2",
+			},
+		},
+		{
+			name: "referencing tables dropped first",
+			from: "create table t1 (id int primary key); create table t2 (id int primary key, t1_id int, foreign key (t1_id) references t1 (id))",
+			to:   "",
+			diffs: []string{
+				"drop table t2",
+				"drop table t1",
+			},
+		},
+	}
+	hints := &DiffHints{}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			diffs, err := DiffSchemasSQL(ts.from, ts.to, hints)
+			require.NoError(t, err)
+			var statements []string
+			for _, d := range diffs {
+				statements = append(statements, d.StatementString())
+			}
+			assert.Equal(t, ts.diffs, statements)
+		})
+	}
+}
+
+func TestSchemaDiffEntityTypeMismatch(t *testing.T) {
+	_, err := DiffSchemasSQL("create table t1 (id int)", "create view t1 as select 1 from dual", &DiffHints{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrEntityTypeMismatch))
+}
+
+func TestSchemaDiffSubsequentDiffs(t *testing.T) {
+	from := "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))"
+	to := "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30))"
+	diffs, err := DiffSchemasSQL(from, to, &DiffHints{RangeRotationStrategy: RangeRotationDistinctStatements})
+	require.NoError(t, err)
+	var statements []string
+	for _, d := range diffs {
+		statements = append(statements, d.StatementString())
+	}
+	assert.Equal(t, []string{
+		"alter table t1 drop partition p1",
+		"alter table t1 add partition (partition p3 values less than (30))",
+	}, statements)
+}
@@ -26,6 +26,8 @@ import (
 //
 type AlterTableEntityDiff struct {
 	alterTable *sqlparser.AlterTable
+
+	subsequentDiff *AlterTableEntityDiff
 }
 
 // IsEmpty implements EntityDiff
@@ -49,6 +51,14 @@ func (d *AlterTableEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *AlterTableEntityDiff) SubsequentDiff() EntityDiff {
+	if d == nil || d.subsequentDiff == nil {
+		return nil
+	}
+	return d.subsequentDiff
+}
+
 //
 type CreateTableEntityDiff struct {
 	createTable *sqlparser.CreateTable
@@ -75,6 +85,11 @@ func (d *CreateTableEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *CreateTableEntityDiff) SubsequentDiff() EntityDiff {
+	return nil
+}
+
 //
 type DropTableEntityDiff struct {
 	dropTable *sqlparser.DropTable
@@ -101,6 +116,11 @@ func (d *DropTableEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *DropTableEntityDiff) SubsequentDiff() EntityDiff {
+	return nil
+}
+
 //
 type CreateTableEntity struct {
 	sqlparser.CreateTable
@@ -110,6 +130,11 @@ func NewCreateTableEntity(c *sqlparser.CreateTable) *CreateTableEntity {
 	return &CreateTableEntity{CreateTable: *c}
 }
 
+// Name implements Entity interface
+func (c *CreateTableEntity) Name() string {
+	return c.CreateTable.GetTable().Name.String()
+}
+
 // Diff implements Entity interface function
 func (c *CreateTableEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, error) {
 	otherCreateTable, ok := other.(*CreateTableEntity)
@@ -181,14 +206,17 @@ func (c *CreateTableEntity) TableDiff(other *CreateTableEntity, hints *DiffHints
 		t2Constraints := other.CreateTable.TableSpec.Constraints
 		c.diffConstraints(alterTable, t1Constraints, t2Constraints, hints)
 	}
+	var partitionSpecs []*sqlparser.PartitionSpec
 	{
 		// diff partitions
 		// ordered keys for both tables:
 		t1Partitions := c.CreateTable.TableSpec.PartitionOption
 		t2Partitions := other.CreateTable.TableSpec.PartitionOption
-		if err := c.diffPartitions(alterTable, t1Partitions, t2Partitions, hints); err != nil {
+		specs, err := c.diffPartitions(alterTable, t1Partitions, t2Partitions, hints)
+		if err != nil {
 			return nil, err
 		}
+		partitionSpecs = specs
 	}
 	{
 		// diff table options
@@ -203,9 +231,27 @@ func (c *CreateTableEntity) TableDiff(other *CreateTableEntity, hints *DiffHints
 		// it's possible that the table definitions are different, and still there's no
 		// "real" difference. Reasons could be:
 		// - reordered keys -- we treat that as non-diff
-		return nil, nil
+		// - ignored partition rotation
+		if len(partitionSpecs) == 0 {
+			return nil, nil
+		}
+		alterTable.PartitionSpec = partitionSpecs[0]
+		partitionSpecs = partitionSpecs[1:]
+	}
+	diff := &AlterTableEntityDiff{alterTable: alterTable}
+	// MySQL does not allow partition management in the same ALTER TABLE statement as other
+	// changes, nor more than one partition management operation per statement.
+	last := diff
+	for _, spec := range partitionSpecs {
+		last.subsequentDiff = &AlterTableEntityDiff{
+			alterTable: &sqlparser.AlterTable{
+				Table:         otherStmt.Table,
+				PartitionSpec: spec,
+			},
+		}
+		last = last.subsequentDiff
 	}
-	return &AlterTableEntityDiff{alterTable: alterTable}, nil
+	return diff, nil
 }
 
 func (c *CreateTableEntity) diffTableCharset(
@@ -365,14 +411,17 @@ func (c *CreateTableEntity) diffOptions(alterTable *sqlparser.AlterTable,
 	return nil
 }
 
+// diffPartitions diffs the partitioning of two tables. Changes that are expressed in the PARTITION BY
+// clause or in a REMOVE PARTITIONING are set in the given alterTable. Changes that take partition
+// management statements are returned as partition specs, one per statement.
 func (c *CreateTableEntity) diffPartitions(alterTable *sqlparser.AlterTable,
 	t1Partitions *sqlparser.PartitionOption,
 	t2Partitions *sqlparser.PartitionOption,
 	hints *DiffHints,
-) error {
+) (partitionSpecs []*sqlparser.PartitionSpec, err error) {
 	switch {
 	case t1Partitions == nil && t2Partitions == nil:
-		return nil
+		return nil, nil
 	case t1Partitions == nil:
 		// add partitioning
 		alterTable.PartitionOption = t2Partitions
@@ -385,9 +434,18 @@ func (c *CreateTableEntity) diffPartitions(alterTable *sqlparser.AlterTable,
 		alterTable.PartitionSpec = partitionSpec
 	case sqlparser.String(t1Partitions) == sqlparser.String(t2Partitions):
 		// identical partitioning
-		return nil
+		return nil, nil
 	default:
 		// partitioning was changed
+		if hints.RangeRotationStrategy != RangeRotationFullSpec {
+			if specs, ok := rangeRotationSpecs(t1Partitions, t2Partitions); ok {
+				if hints.RangeRotationStrategy == RangeRotationIgnore {
+					return nil, nil
+				}
+				return specs, nil
+			}
+		}
+		// Unless the change is a range rotation and the hints say otherwise,
 		// we produce a complete re-partitioing schema. What we don't do is try and figure out the minimal
 		// needed change. For example, maybe the minimal change is to REORGANIZE a specific partition and split
 		// into two, thus unaffecting the rest of the partitions. But we don't evaluate that, we just set a
@@ -396,7 +454,105 @@ func (c *CreateTableEntity) diffPartitions(alterTable *sqlparser.AlterTable,
 		// Online DDL alters, where we create a new table anyway. Thus, the optimization is meaningless.
 		alterTable.PartitionOption = t2Partitions
 	}
-	return nil
+	return nil, nil
+}
+
+// rangeRotationSpecs checks whether t2Partitions is t1Partitions, rotated: both partition by the same RANGE,
+// and t2Partitions is the result of dropping the first partitions of t1Partitions, and appending new ones.
+// A trailing MAXVALUE partition is kept in place, and new ranges are reorganized out of it.
+// If so, it returns the partition management statements that apply the rotation: DROP PARTITION for the removed
+// partitions, then either a single REORGANIZE PARTITION or an ADD PARTITION per appended partition.
+func rangeRotationSpecs(t1Partitions *sqlparser.PartitionOption, t2Partitions *sqlparser.PartitionOption) (specs []*sqlparser.PartitionSpec, ok bool) {
+	if t1Partitions.Type != sqlparser.RangeType || t2Partitions.Type != sqlparser.RangeType {
+		return nil, false
+	}
+	{
+		// all but the partition definitions must be identical
+		t1Options := *t1Partitions
+		t2Options := *t2Partitions
+		t1Options.Definitions = nil
+		t2Options.Definitions = nil
+		if sqlparser.String(&t1Options) != sqlparser.String(&t2Options) {
+			return nil, false
+		}
+	}
+	t1Definitions := t1Partitions.Definitions
+	t2Definitions := t2Partitions.Definitions
+	var maxvalueDefinition *sqlparser.PartitionDefinition
+	{
+		isMaxvalue := func(definitions []*sqlparser.PartitionDefinition) bool {
+			if len(definitions) == 0 {
+				return false
+			}
+			last := definitions[len(definitions)-1]
+			return last.ValueRange != nil && last.ValueRange.Maxvalue
+		}
+		if isMaxvalue(t1Definitions) && isMaxvalue(t2Definitions) {
+			t1Maxvalue := t1Definitions[len(t1Definitions)-1]
+			t2Maxvalue := t2Definitions[len(t2Definitions)-1]
+			if sqlparser.String(t1Maxvalue) != sqlparser.String(t2Maxvalue) {
+				return nil, false
+			}
+			maxvalueDefinition = t2Maxvalue
+			t1Definitions = t1Definitions[:len(t1Definitions)-1]
+			t2Definitions = t2Definitions[:len(t2Definitions)-1]
+		}
+	}
+	if len(t1Definitions) == 0 || len(t2Definitions) == 0 {
+		return nil, false
+	}
+	// the first remaining partition of t2 is where the partitions of both tables start overlapping
+	overlap := -1
+	for i, definition := range t1Definitions {
+		if sqlparser.String(definition) == sqlparser.String(t2Definitions[0]) {
+			overlap = i
+			break
+		}
+	}
+	if overlap < 0 {
+		return nil, false
+	}
+	shared := len(t1Definitions) - overlap
+	if shared > len(t2Definitions) {
+		return nil, false
+	}
+	for i := 0; i < shared; i++ {
+		if sqlparser.String(t1Definitions[overlap+i]) != sqlparser.String(t2Definitions[i]) {
+			return nil, false
+		}
+	}
+	dropped := t1Definitions[:overlap]
+	added := t2Definitions[shared:]
+
+	if len(dropped) > 0 {
+		spec := &sqlparser.PartitionSpec{
+			Action: sqlparser.DropAction,
+		}
+		for _, definition := range dropped {
+			spec.Names = append(spec.Names, definition.Name)
+		}
+		specs = append(specs, spec)
+	}
+	if len(added) > 0 {
+		if maxvalueDefinition != nil {
+			// a partition cannot be added after MAXVALUE, so the new ranges are split out of it
+			spec := &sqlparser.PartitionSpec{
+				Action: sqlparser.ReorganizeAction,
+				Names:  sqlparser.Partitions{maxvalueDefinition.Name},
+			}
+			spec.Definitions = append(spec.Definitions, added...)
+			spec.Definitions = append(spec.Definitions, maxvalueDefinition)
+			specs = append(specs, spec)
+		} else {
+			for _, definition := range added {
+				specs = append(specs, &sqlparser.PartitionSpec{
+					Action:      sqlparser.AddAction,
+					Definitions: []*sqlparser.PartitionDefinition{definition},
+				})
+			}
+		}
+	}
+	return specs, len(specs) > 0
 }
 
 func (c *CreateTableEntity) diffConstraints(alterTable *sqlparser.AlterTable,
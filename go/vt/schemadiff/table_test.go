@@ -34,6 +34,9 @@ func TestCreateTableDiff(t *testing.T) {
 		isError  bool
 		errorMsg string
 		autoinc  int
+		rotation int
+		// subsequent lists the statements of the diffs that follow the main diff
+		subsequent []string
 	}{
 		{
 			name: "identical",
@@ -330,6 +333,80 @@ func TestCreateTableDiff(t *testing.T) {
 			to:   "create table t1 (id int primary key) partition by list (id) (partition p1 values in(11,21), partition p2 values in (12,22))",
 			diff: "alter table t1 partition by list (id) (partition p1 values in (11, 21), partition p2 values in (12, 22))",
 		},
+		{
+			name: "change partitioning range: rotate, full spec",
+			from: "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			to:   "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30), partition p4 values less than (40))",
+			diff: "alter table t1 partition by range (id) (partition p2 values less than (20), partition p3 values less than (30), partition p4 values less than (40))",
+		},
+		{
+			name:       "change partitioning range: rotate, statements",
+			from:       "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			to:         "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30), partition p4 values less than (40))",
+			rotation:   RangeRotationDistinctStatements,
+			diff:       "alter table t1 drop partition p1",
+			subsequent: []string{"alter table t1 add partition (partition p4 values less than (40))"},
+		},
+		{
+			name:     "change partitioning range: rotate, ignore",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			to:       "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30), partition p4 values less than (40))",
+			rotation: RangeRotationIgnore,
+		},
+		{
+			name:       "change partitioning range: append several, statements",
+			from:       "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10))",
+			to:         "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			rotation:   RangeRotationDistinctStatements,
+			diff:       "alter table t1 add partition (partition p2 values less than (20))",
+			subsequent: []string{"alter table t1 add partition (partition p3 values less than (30))"},
+		},
+		{
+			name:     "change partitioning range: drop several, statements",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			to:       "create table t1 (id int primary key) partition by range (id) (partition p3 values less than (30))",
+			rotation: RangeRotationDistinctStatements,
+			diff:     "alter table t1 drop partition p1, p2",
+		},
+		{
+			name:     "change partitioning range: maxvalue, statements",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition pmax values less than maxvalue)",
+			to:       "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30), partition pmax values less than maxvalue)",
+			rotation: RangeRotationDistinctStatements,
+			diff:     "alter table t1 drop partition p1",
+			subsequent: []string{
+				"alter table t1 reorganize partition pmax into (partition p3 values less than (30), partition pmax values less than maxvalue)",
+			},
+		},
+		{
+			name:       "change partitioning range: rotate along with other changes, statements",
+			from:       "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			to:         "create table t1 (id int primary key, i int) partition by range (id) (partition p2 values less than (20), partition p3 values less than (30))",
+			rotation:   RangeRotationDistinctStatements,
+			diff:       "alter table t1 add column i int",
+			subsequent: []string{"alter table t1 drop partition p1", "alter table t1 add partition (partition p3 values less than (30))"},
+		},
+		{
+			name:     "change partitioning range: changed range is not a rotation",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			to:       "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (15), partition p2 values less than (20))",
+			rotation: RangeRotationDistinctStatements,
+			diff:     "alter table t1 partition by range (id) (partition p1 values less than (15), partition p2 values less than (20))",
+		},
+		{
+			name:     "change partitioning range: changed expression is not a rotation",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			to:       "create table t1 (id int primary key) partition by range (id+1) (partition p2 values less than (20), partition p3 values less than (30))",
+			rotation: RangeRotationIgnore,
+			diff:     "alter table t1 partition by range (id + 1) (partition p2 values less than (20), partition p3 values less than (30))",
+		},
+		{
+			name:     "change partitioning range: all partitions replaced is not a rotation",
+			from:     "create table t1 (id int primary key) partition by range (id) (partition p1 values less than (10))",
+			to:       "create table t1 (id int primary key) partition by range (id) (partition p2 values less than (20))",
+			rotation: RangeRotationDistinctStatements,
+			diff:     "alter table t1 partition by range (id) (partition p2 values less than (20))",
+		},
 
 		//
 		// table options
@@ -503,6 +580,7 @@ func TestCreateTableDiff(t *testing.T) {
 			other := NewCreateTableEntity(toCreateTable)
 			hints := standardHints
 			hints.AutoIncrementStrategy = ts.autoinc
+			hints.RangeRotationStrategy = ts.rotation
 			alter, err := c.Diff(other, &hints)
 			switch {
 			case ts.isError:
@@ -522,6 +600,12 @@ func TestCreateTableDiff(t *testing.T) {
 				assert.False(t, alter.IsEmpty(), "expected changes, found empty diff")
 				diff := alter.StatementString()
 				assert.Equal(t, ts.diff, diff)
+
+				var subsequent []string
+				for d := alter.SubsequentDiff(); d != nil; d = d.SubsequentDiff() {
+					subsequent = append(subsequent, d.StatementString())
+				}
+				assert.Equal(t, ts.subsequent, subsequent)
 			}
 		})
 	}
@@ -32,9 +32,15 @@ var (
 	ErrNotFullyParsed                 = errors.New("unable to fully parse statement")
 	ErrExpectedCreateTable            = errors.New("expected a CREATE TABLE statement")
 	ErrExpectedCreateView             = errors.New("expected a CREATE VIEW statement")
+	ErrUnsupportedEntity              = errors.New("unsupported entity type")
+	ErrDuplicateName                  = errors.New("duplicate entity name")
+	ErrViewDependencyUnresolved       = errors.New("view references a nonexistent table or view")
+	ErrViewDependencyCycle            = errors.New("cyclic view definitions")
+	ErrForeignKeyDependencyUnresolved = errors.New("foreign key references a nonexistent table")
 )
 
 type Entity interface {
+	Name() string
 	Diff(other Entity, hints *DiffHints) (diff EntityDiff, err error)
 }
 
@@ -42,6 +48,9 @@ type EntityDiff interface {
 	IsEmpty() bool
 	Statement() sqlparser.Statement
 	StatementString() string
+	// SubsequentDiff returns a diff that must be applied after this one, or nil. Some changes,
+	// such as partition management, cannot be expressed in a single statement.
+	SubsequentDiff() EntityDiff
 }
 
 const (
@@ -50,7 +59,18 @@ const (
 	AutoIncrementApplyAlways
 )
 
+const (
+	// RangeRotationFullSpec diffs any change of partitions with a complete PARTITION BY clause
+	RangeRotationFullSpec int = iota
+	// RangeRotationDistinctStatements diffs RANGE partitions that were only appended or removed
+	// with ADD PARTITION, DROP PARTITION and REORGANIZE PARTITION statements
+	RangeRotationDistinctStatements
+	// RangeRotationIgnore does not diff RANGE partitions that were only appended or removed
+	RangeRotationIgnore
+)
+
 type DiffHints struct {
 	StrictIndexOrdering   bool
 	AutoIncrementStrategy int
+	RangeRotationStrategy int
 }
@@ -44,6 +44,11 @@ func (d *AlterViewEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *AlterViewEntityDiff) SubsequentDiff() EntityDiff {
+	return nil
+}
+
 //
 type CreateViewEntityDiff struct {
 	createView *sqlparser.CreateView
@@ -70,6 +75,11 @@ func (d *CreateViewEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *CreateViewEntityDiff) SubsequentDiff() EntityDiff {
+	return nil
+}
+
 //
 type DropViewEntityDiff struct {
 	dropView *sqlparser.DropView
@@ -96,6 +106,11 @@ func (d *DropViewEntityDiff) StatementString() (s string) {
 	return s
 }
 
+// SubsequentDiff implements EntityDiff
+func (d *DropViewEntityDiff) SubsequentDiff() EntityDiff {
+	return nil
+}
+
 //
 type CreateViewEntity struct {
 	sqlparser.CreateView
@@ -105,6 +120,11 @@ func NewCreateViewEntity(c *sqlparser.CreateView) *CreateViewEntity {
 	return &CreateViewEntity{CreateView: *c}
 }
 
+// Name implements Entity interface
+func (c *CreateViewEntity) Name() string {
+	return c.CreateView.ViewName.Name.String()
+}
+
 // Diff implements Entity interface function
 func (c *CreateViewEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, error) {
 	otherCreateView, ok := other.(*CreateViewEntity)
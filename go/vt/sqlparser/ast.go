@@ -134,7 +134,7 @@ type (
 
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
-		ctes      []*CommonTableExpr
+		CTEs      []*CommonTableExpr
 		Recursive bool
 	}
 
@@ -2077,6 +2077,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -2249,6 +2250,36 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the window specification of a window function call,
+// i.e. OVER ([PARTITION BY ...] [ORDER BY ...] [frame_clause]).
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification, e.g.
+// ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW.
+// End is nil when the frame only specifies a starting point.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for the unit of a window frame - rows or range.
+type FrameUnitType int8
+
+// FramePoint represents one of the boundaries of a window frame.
+// Expr is only set for the N PRECEDING and N FOLLOWING boundaries.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for the different window frame boundaries.
+type FramePointType int8
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
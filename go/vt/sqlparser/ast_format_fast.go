@@ -195,12 +195,12 @@ func (node *With) formatFast(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.WriteString("recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		node.ctes[i].formatFast(buf)
+		node.CTEs[i].formatFast(buf)
 		buf.WriteString(", ")
 	}
-	node.ctes[ctesLength-1].formatFast(buf)
+	node.CTEs[ctesLength-1].formatFast(buf)
 }
 
 // formatFast formats the node.
@@ -1658,6 +1658,61 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	if node.Over != nil {
+		buf.WriteByte(' ')
+		node.Over.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("over (")
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		buf.WriteString("partition by ")
+		node.PartitionBy.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderBy {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			order.formatFast(buf)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.WriteString(prefix)
+		node.Frame.formatFast(buf)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(node.Type.ToString())
 }
 
 // formatFast formats the node
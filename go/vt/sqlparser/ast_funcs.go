@@ -588,10 +588,20 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregate functions used as window functions are not aggregates,
+// since they do not collapse the rows of the result.
 func (node *FuncExpr) IsAggregate() bool {
+	if node.Over != nil {
+		return false
+	}
 	return Aggregates[node.Name.Lowered()]
 }
 
+// IsWindowFunction returns true if the function call has an OVER clause.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
+}
+
 // NewColIdent makes a new ColIdent.
 func NewColIdent(str string) ColIdent {
 	return ColIdent{
@@ -1255,6 +1265,36 @@ func (dir OrderDirection) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsStr
+	case RangeUnit:
+		return RangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return PrecedingStr
+	case ExprFollowingType:
+		return FollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the type as a string
 func (ty IndexHintsType) ToString() string {
 	switch ty {
@@ -1554,6 +1594,19 @@ func ContainsAggregation(e SQLNode) bool {
 	return hasAggregates
 }
 
+// ContainsWindowFunction returns true if the expression contains a window function call
+func ContainsWindowFunction(e SQLNode) bool {
+	hasWindowFunction := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if fExpr, ok := node.(*FuncExpr); ok && fExpr.IsWindowFunction() {
+			hasWindowFunction = true
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindowFunction
+}
+
 // IsAggregation returns true if the node is an aggregation expression
 func IsAggregation(node SQLNode) bool {
 	switch node := node.(type) {
@@ -1613,6 +1666,12 @@ func (es *ExtractedSubquery) GetHasValuesArg() string {
 
 }
 
+// GetAlternative returns the expression that is used in place of the subquery
+// once it has been pulled out, and its result is available as bind variables.
+func (es *ExtractedSubquery) GetAlternative() Expr {
+	return es.alternative
+}
+
 func (es *ExtractedSubquery) updateAlternative() {
 	switch original := es.Original.(type) {
 	case *ExistsExpr:
@@ -43,6 +43,8 @@ const (
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 	// DirectiveQueryPlanner lets the user specify per query which planner should be used
 	DirectiveQueryPlanner = "PLANNER"
+	// DirectiveConsumerGroup specifies the consumer group from which a message stream reads
+	DirectiveConsumerGroup = "CONSUMER_GROUP"
 )
 
 func isNonSpace(r rune) bool {
@@ -225,6 +225,17 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -458,6 +469,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	RowsUnit FrameUnitType = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - IndexHintsType
 const (
 	UseOp IndexHintsType = iota
@@ -2079,6 +2079,31 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input: "select id, row_number() over (partition by col order by id asc) from t",
+	}, {
+		input:  "select id, RANK() OVER (ORDER BY score DESC), DENSE_RANK() OVER (ORDER BY score DESC) from t",
+		output: "select id, RANK() over (order by score desc), DENSE_RANK() over (order by score desc) from t",
+	}, {
+		input: "select id, sum(score) over () from t",
+	}, {
+		input: "select id, count(*) over (partition by a, b) from t",
+	}, {
+		input: "select id, sum(score) over (partition by col order by id asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select id, avg(score) over (order by id asc rows between 2 preceding and 2 following) from t",
+	}, {
+		input: "select id, max(score) over (order by id asc range unbounded preceding) from t",
+	}, {
+		input: "select id, min(score) over (order by id asc rows between current row and unbounded following) from t",
+	}, {
+		input: "select id, sum(score) over (order by id asc rows :n preceding) from t",
+	}, {
+		input:  "select current, `rank`, row_number, `rows` from t",
+		output: "select current, `rank`, `row_number`, `rows` from t",
+	}, {
+		input:  "select sum(a) over (order by b rows current row) from t",
+		output: "select sum(a) over (order by b asc rows current row) from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -3775,6 +3800,18 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select row_number() over from t",
+		output: "syntax error at position 30 near 'from'",
+	}, {
+		input:  "select sum(a) over (rows between 1 preceding) from t",
+		output: "syntax error at position 46",
+	}, {
+		input:  "select sum(a) over (rows this row) from t",
+		output: "expecting current row at position 34 near 'row'",
+	}, {
+		input:  "select sum(a) over (row current row) from t",
+		output: "expecting rows or range at position 24 near 'row'",
 	}, {
 		input:  "select x'78 from t",
 		output: "syntax error at position 12 near '78'",
@@ -441,35 +441,35 @@ const SEPARATOR = 57738
 const TIMESTAMPADD = 57739
 const TIMESTAMPDIFF = 57740
 const WEIGHT_STRING = 57741
-const MATCH = 57742
-const AGAINST = 57743
-const BOOLEAN = 57744
-const LANGUAGE = 57745
-const WITH = 57746
-const QUERY = 57747
-const EXPANSION = 57748
-const WITHOUT = 57749
-const VALIDATION = 57750
-const UNUSED = 57751
-const ARRAY = 57752
-const CUME_DIST = 57753
-const DESCRIPTION = 57754
-const DENSE_RANK = 57755
-const EMPTY = 57756
-const EXCEPT = 57757
-const FIRST_VALUE = 57758
-const GROUPING = 57759
-const GROUPS = 57760
-const JSON_TABLE = 57761
-const LAG = 57762
-const LAST_VALUE = 57763
-const LATERAL = 57764
-const LEAD = 57765
-const MEMBER = 57766
-const NTH_VALUE = 57767
-const NTILE = 57768
-const OF = 57769
-const OVER = 57770
+const OVER = 57742
+const MATCH = 57743
+const AGAINST = 57744
+const BOOLEAN = 57745
+const LANGUAGE = 57746
+const WITH = 57747
+const QUERY = 57748
+const EXPANSION = 57749
+const WITHOUT = 57750
+const VALIDATION = 57751
+const UNUSED = 57752
+const ARRAY = 57753
+const CUME_DIST = 57754
+const DESCRIPTION = 57755
+const DENSE_RANK = 57756
+const EMPTY = 57757
+const EXCEPT = 57758
+const FIRST_VALUE = 57759
+const GROUPING = 57760
+const GROUPS = 57761
+const JSON_TABLE = 57762
+const LAG = 57763
+const LAST_VALUE = 57764
+const LATERAL = 57765
+const LEAD = 57766
+const MEMBER = 57767
+const NTH_VALUE = 57768
+const NTILE = 57769
+const OF = 57770
 const PERCENT_RANK = 57771
 const RANK = 57772
 const RECURSIVE = 57773
@@ -997,6 +997,7 @@ var yyToknames = [...]string{
 	"TIMESTAMPADD",
 	"TIMESTAMPDIFF",
 	"WEIGHT_STRING",
+	"OVER",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -1025,7 +1026,6 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
 	"RECURSIVE",
@@ -1173,7 +1173,7 @@ var yyExca = [...]int{
 	217, 648,
 	-2, 646,
 	-1, 108,
-	214, 1102,
+	214, 1118,
 	-2, 116,
 	-1, 110,
 	1, 138,
@@ -1189,26 +1189,26 @@ var yyExca = [...]int{
 	309, 143,
 	-2, 453,
 	-1, 602,
-	200, 1123,
-	-2, 1119,
+	200, 1139,
+	-2, 1135,
 	-1, 603,
-	200, 1124,
-	-2, 1120,
+	200, 1140,
+	-2, 1136,
 	-1, 674,
 	57, 716,
 	-2, 724,
 	-1, 711,
-	132, 1482,
+	132, 1498,
 	-2, 109,
 	-1, 712,
-	132, 1359,
+	132, 1375,
 	-2, 110,
 	-1, 718,
-	132, 1413,
-	-2, 1096,
+	132, 1429,
+	-2, 1112,
 	-1, 860,
-	132, 1292,
-	-2, 1093,
+	132, 1308,
+	-2, 1109,
 	-1, 898,
 	225, 38,
 	230, 38,
@@ -1237,8 +1237,8 @@ var yyExca = [...]int{
 	230, 39,
 	-2, 359,
 	-1, 1563,
-	200, 1128,
-	-2, 1122,
+	200, 1144,
+	-2, 1138,
 	-1, 1640,
 	116, 143,
 	156, 143,
@@ -1251,10 +1251,10 @@ var yyExca = [...]int{
 	75, 91,
 	84, 91,
 	-2, 782,
-	-1, 2051,
-	47, 1064,
-	-2, 1058,
-	-1, 2254,
+	-1, 2053,
+	47, 1080,
+	-2, 1074,
+	-1, 2257,
 	5, 50,
 	16, 50,
 	18, 50,
@@ -1264,1082 +1264,1171 @@ var yyExca = [...]int{
 
 const yyPrivate = 57344
 
-const yyLast = 34008
+const yyLast = 34246
 
 var yyAct = [...]int{
-	602, 2506, 1594, 2500, 2313, 2471, 2172, 2459, 2392, 2102,
-	2109, 2143, 597, 596, 34, 1038, 2338, 90, 1155, 667,
-	3, 2065, 2155, 1828, 1700, 2111, 2154, 1188, 689, 2063,
-	2062, 2066, 553, 2225, 1856, 2219, 2343, 557, 1577, 605,
-	594, 1613, 2052, 2245, 2060, 595, 1670, 550, 176, 1879,
-	1848, 176, 986, 517, 176, 2330, 1915, 1944, 1675, 533,
-	579, 176, 2157, 1985, 1690, 863, 1916, 1868, 1626, 176,
-	551, 1917, 148, 716, 35, 1175, 1840, 33, 1618, 690,
-	1557, 176, 1507, 545, 1617, 671, 1598, 675, 669, 549,
-	134, 928, 2001, 1637, 1514, 1689, 1466, 1709, 1742, 1677,
-	1909, 1218, 888, 533, 1885, 692, 533, 176, 533, 893,
-	1197, 1417, 85, 1579, 89, 1526, 1620, 1158, 713, 1484,
-	1414, 1057, 562, 1310, 867, 1317, 896, 1560, 1687, 1605,
-	870, 871, 1400, 1666, 894, 899, 1217, 895, 1201, 971,
-	1215, 1036, 1278, 906, 681, 1031, 703, 92, 677, 679,
-	1422, 676, 70, 1123, 1326, 1302, 91, 1015, 117, 118,
-	540, 1127, 2433, 1599, 1937, 178, 179, 180, 83, 2507,
-	1702, 1703, 1704, 1702, 2140, 1964, 1963, 151, 111, 112,
-	1935, 1740, 79, 678, 71, 1570, 1993, 1994, 178, 179,
-	180, 697, 1473, 702, 1472, 520, 1471, 683, 8, 7,
-	6, 119, 1470, 1386, 1574, 1575, 1469, 1468, 1455, 543,
-	1460, 544, 933, 1826, 2485, 864, 490, 2048, 2126, 84,
-	874, 1058, 113, 541, 670, 1850, 2278, 668, 930, 2388,
-	879, 2387, 2306, 699, 96, 2307, 507, 72, 932, 931,
-	74, 944, 945, 710, 948, 949, 950, 951, 909, 2516,
-	954, 955, 956, 957, 958, 959, 960, 961, 962, 963,
-	964, 965, 966, 967, 968, 910, 717, 934, 935, 936,
-	1283, 684, 98, 99, 691, 102, 172, 886, 108, 72,
-	2469, 173, 506, 72, 485, 2510, 72, 2444, 113, 885,
-	884, 941, 2499, 504, 1780, 2314, 1682, 2460, 546, 1728,
-	114, 2468, 2000, 1859, 666, 2443, 2205, 674, 1292, 2099,
-	2100, 1827, 1972, 156, 81, 946, 1971, 2098, 1894, 974,
-	1680, 1893, 1992, 1068, 1895, 1632, 1633, 693, 1860, 1777,
-	1631, 501, 2356, 1010, 1011, 705, 706, 1034, 1005, 883,
-	515, 980, 981, 1219, 664, 1220, 1576, 663, 993, 993,
-	1938, 1906, 113, 994, 994, 512, 81, 172, 1778, 1022,
-	81, 1024, 992, 81, 991, 1650, 1649, 1058, 531, 1006,
-	153, 999, 154, 2174, 2222, 2196, 2027, 520, 520, 2194,
-	1162, 114, 171, 1459, 535, 1406, 529, 1945, 521, 1710,
-	1967, 1401, 1743, 878, 156, 881, 880, 1021, 1023, 1461,
-	1462, 1463, 2509, 1028, 970, 2167, 1748, 1014, 520, 1754,
-	1751, 1753, 1752, 2168, 2002, 976, 1064, 947, 491, 1056,
-	493, 508, 1012, 523, 1376, 522, 497, 1980, 495, 499,
-	509, 500, 1013, 494, 1759, 505, 1899, 1033, 496, 510,
-	511, 513, 527, 526, 514, 1679, 503, 524, 1007, 1089,
-	1000, 153, 2175, 154, 883, 969, 1756, 520, 1757, 1749,
-	1758, 1008, 1009, 171, 2486, 953, 1377, 952, 1378, 1068,
-	2397, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097,
-	1099, 1100, 1747, 2176, 1745, 176, 157, 176, 1019, 883,
-	176, 875, 1020, 2299, 2004, 162, 917, 887, 877, 876,
-	2151, 1713, 1025, 915, 81, 882, 585, 1614, 1026, 889,
-	1778, 890, 890, 926, 925, 1295, 908, 973, 533, 533,
-	533, 1101, 1746, 924, 1018, 2028, 923, 922, 1984, 921,
-	920, 919, 914, 927, 1101, 2115, 533, 533, 868, 2511,
-	2497, 868, 1316, 902, 989, 881, 995, 996, 997, 998,
-	901, 34, 2125, 1003, 2014, 2013, 2012, 2006, 1050, 2010,
-	868, 2005, 1064, 2003, 866, 1415, 1688, 157, 2008, 1035,
-	521, 521, 1407, 1829, 1831, 704, 162, 2007, 943, 1063,
-	1060, 1061, 1062, 1067, 1069, 1066, 1981, 1065, 1734, 1411,
-	1044, 525, 2009, 2011, 1059, 2035, 937, 2133, 2432, 1966,
-	1936, 521, 908, 1996, 149, 908, 972, 1939, 2150, 518,
-	1969, 2034, 2033, 907, 1290, 1289, 1791, 1027, 911, 901,
-	882, 1288, 1600, 1601, 519, 1956, 1412, 1153, 912, 1104,
-	1105, 1106, 1107, 918, 2504, 1730, 1286, 2223, 75, 1112,
-	916, 1115, 1681, 489, 484, 1315, 913, 2423, 1979, 1148,
-	521, 1978, 80, 2442, 1987, 882, 1102, 1103, 2259, 1986,
-	1040, 1041, 908, 2241, 176, 1890, 1855, 1818, 2384, 1029,
-	1569, 1205, 1987, 908, 1135, 984, 1100, 1986, 1101, 110,
-	1638, 176, 1165, 2097, 686, 149, 1169, 1108, 669, 1168,
-	1166, 1154, 671, 1032, 80, 1779, 1163, 988, 80, 907,
-	533, 80, 907, 1016, 176, 2438, 979, 911, 901, 533,
-	1388, 1387, 1389, 1390, 1391, 533, 982, 912, 990, 1830,
-	2295, 71, 2235, 929, 713, 1063, 1060, 1061, 1062, 1067,
-	1069, 1066, 1125, 1065, 1126, 1002, 1053, 1051, 1052, 1405,
-	1059, 1423, 1489, 1744, 1129, 1408, 1004, 1221, 2020, 2398,
-	1154, 1167, 1054, 1527, 105, 89, 1490, 1491, 1488, 907,
-	1141, 1142, 1143, 1144, 1928, 901, 904, 905, 1073, 868,
-	907, 2352, 942, 898, 902, 178, 179, 180, 2270, 1509,
-	1159, 1074, 598, 2493, 580, 582, 599, 600, 92, 578,
-	581, 601, 897, 2269, 1072, 1073, 150, 155, 152, 158,
-	159, 160, 161, 163, 164, 165, 166, 1722, 1717, 1121,
-	1729, 106, 167, 168, 169, 170, 1727, 1325, 583, 584,
-	2369, 1324, 1314, 1156, 987, 2477, 1527, 668, 1805, 2475,
-	1187, 670, 2502, 1017, 1726, 2503, 1725, 2501, 2479, 2480,
-	1479, 1481, 1482, 2113, 2114, 917, 1510, 546, 908, 915,
-	2476, 1164, 1211, 1212, 975, 1095, 1096, 1098, 1097, 1099,
-	1100, 1480, 1184, 176, 2200, 1182, 1813, 1279, 1402, 2447,
-	1403, 1424, 717, 1404, 2202, 2495, 1287, 150, 155, 152,
-	158, 159, 160, 161, 163, 164, 165, 166, 2260, 1071,
-	2512, 1072, 1073, 167, 168, 169, 170, 533, 2022, 1312,
-	1071, 2448, 1072, 1073, 1216, 1206, 2416, 1321, 1170, 2514,
-	1071, 1323, 1072, 1073, 533, 533, 2105, 533, 2379, 533,
-	533, 1722, 533, 533, 533, 533, 533, 533, 1198, 1093,
-	1094, 1095, 1096, 1098, 1097, 1099, 1100, 533, 2417, 1182,
-	81, 176, 1359, 1182, 2329, 907, 2112, 1322, 1724, 1395,
-	1531, 901, 904, 905, 1487, 868, 1794, 176, 2115, 898,
-	902, 2106, 1308, 1071, 2513, 1072, 1073, 2328, 533, 2277,
-	176, 2276, 1354, 1355, 1071, 2141, 1072, 1073, 1293, 1294,
-	2131, 1413, 1913, 1301, 1912, 176, 2108, 1783, 1784, 1785,
-	2103, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099,
-	1100, 176, 1356, 1685, 2113, 2114, 1396, 708, 176, 1393,
-	1381, 2104, 1394, 1285, 1606, 1607, 1380, 176, 176, 176,
-	176, 176, 176, 176, 176, 176, 533, 533, 533, 1379,
-	1320, 1319, 1071, 1383, 1072, 1073, 1370, 1364, 1362, 1363,
-	1361, 1311, 1360, 2110, 1368, 1369, 1335, 1298, 1318, 1318,
-	1299, 1297, 1427, 1071, 2494, 1072, 1073, 176, 1419, 1431,
-	2420, 1433, 1434, 1435, 1436, 616, 617, 618, 1440, 1328,
-	1372, 1329, 1392, 1331, 1333, 2419, 2418, 1337, 1339, 1341,
-	1343, 1345, 1454, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1098, 1097, 1099, 1100, 2171, 2351, 1382, 1485, 1089, 2349,
-	1995, 1357, 1508, 1416, 178, 179, 180, 2112, 2267, 1071,
-	1191, 1072, 1073, 2325, 1291, 1517, 533, 2274, 2266, 2115,
-	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099,
-	1100, 533, 533, 1922, 1492, 603, 1494, 1495, 1496, 1497,
-	1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1425,
-	1426, 1561, 1429, 113, 885, 884, 1910, 1738, 1493, 1192,
-	1528, 176, 1801, 1430, 2429, 1737, 1483, 1450, 1451, 1452,
-	1437, 1438, 1439, 1597, 1453, 178, 179, 180, 1583, 1796,
-	1582, 1195, 1512, 177, 1511, 1456, 177, 176, 1795, 177,
-	533, 1420, 1384, 1371, 534, 1584, 177, 1585, 1367, 1071,
-	176, 1072, 1073, 533, 177, 1366, 1365, 1486, 176, 1563,
-	176, 1193, 176, 176, 533, 1030, 177, 533, 1565, 1566,
-	2107, 1561, 1421, 1043, 1071, 1182, 1072, 1073, 533, 713,
-	1846, 2508, 713, 89, 1800, 1846, 2466, 1513, 534, 2377,
-	88, 534, 177, 534, 1519, 1520, 1194, 1562, 1182, 89,
-	2405, 1590, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1075,
-	1071, 1616, 1072, 1073, 1564, 1846, 2453, 1567, 1568, 2404,
-	1071, 2373, 1072, 1073, 1071, 2296, 1072, 1073, 2372, 1563,
-	1846, 2451, 1071, 533, 1072, 1073, 1846, 2434, 2312, 1691,
-	1692, 1693, 94, 1642, 1695, 1697, 1946, 683, 1182, 1641,
-	1589, 1914, 2304, 2431, 1474, 1475, 1476, 1477, 533, 1624,
-	1071, 2208, 1072, 1073, 533, 1321, 2092, 1611, 1321, 1925,
-	1321, 2406, 1182, 1647, 1672, 1778, 1721, 1656, 1657, 1658,
-	1659, 1592, 1711, 2207, 1846, 2380, 1071, 1645, 1072, 1073,
-	1646, 1609, 95, 2234, 1515, 1516, 1678, 1071, 1857, 1072,
-	1073, 1629, 1521, 94, 1628, 93, 533, 95, 1508, 1089,
-	1792, 1790, 1644, 1508, 1508, 1643, 2236, 1556, 94, 1071,
-	93, 1072, 1073, 1708, 178, 179, 180, 717, 1897, 88,
-	717, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097,
-	1099, 1100, 178, 179, 180, 546, 1698, 2304, 1182, 176,
-	178, 179, 180, 1673, 1696, 1070, 176, 2437, 1668, 1669,
-	1846, 176, 176, 1684, 1683, 176, 86, 176, 1686, 1865,
-	1694, 1731, 1182, 176, 86, 1846, 2302, 87, 1602, 1603,
-	176, 88, 1865, 909, 2121, 87, 1673, 1182, 1716, 1089,
-	1733, 1719, 1718, 1720, 1715, 1735, 1736, 1714, 1722, 1182,
-	910, 2239, 1182, 1630, 1732, 1636, 1886, 1555, 176, 533,
-	1318, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097,
-	1099, 1100, 2123, 2122, 2119, 2120, 2119, 2118, 1769, 1770,
-	1864, 1182, 1792, 1772, 1865, 1182, 1792, 1182, 1857, 1182,
-	1778, 1965, 1773, 1282, 1950, 1741, 1942, 1943, 1842, 1886,
-	1810, 1485, 1846, 1845, 1809, 1651, 1723, 1652, 1653, 1654,
-	1655, 1070, 1182, 88, 1674, 1282, 1281, 1227, 1226, 1887,
-	2061, 1722, 1705, 1662, 1663, 1664, 1665, 2279, 1889, 1604,
-	2234, 1186, 1572, 1762, 1865, 1464, 1410, 1787, 1213, 1789,
-	1547, 1536, 1537, 1538, 1539, 1549, 1540, 1541, 1542, 1554,
-	1550, 1543, 1544, 1551, 1552, 1553, 1545, 1546, 1548, 2234,
-	673, 1788, 1887, 1722, 1350, 892, 891, 2483, 2456, 2173,
-	1792, 1778, 81, 176, 2394, 1189, 2370, 2280, 2281, 2282,
-	2363, 176, 2294, 2291, 2272, 1824, 1776, 2211, 2210, 533,
-	1284, 1870, 1873, 1874, 1875, 1871, 1671, 1872, 1876, 2169,
-	533, 2246, 2247, 2146, 2142, 1951, 1667, 1661, 1786, 1660,
-	2283, 1486, 1398, 1313, 1351, 1352, 1353, 1309, 1918, 1280,
-	177, 107, 177, 176, 176, 177, 1347, 34, 2144, 1919,
-	974, 2246, 2247, 2395, 1861, 1682, 1881, 81, 2490, 1896,
-	2472, 1804, 2249, 1563, 2138, 2137, 2136, 2061, 1929, 1763,
-	1802, 1457, 2252, 534, 534, 534, 2083, 2284, 2285, 2081,
-	2085, 2084, 1874, 1875, 2082, 1919, 1843, 2251, 2080, 2079,
-	2488, 534, 534, 1348, 1349, 2467, 1596, 1190, 1588, 2240,
-	2041, 1562, 2040, 533, 1847, 1948, 1815, 1816, 176, 1159,
-	1825, 2227, 2415, 2342, 1833, 176, 2053, 2055, 2344, 2226,
-	1880, 587, 1839, 687, 2230, 2056, 2050, 533, 1409, 1941,
-	662, 688, 2117, 1900, 533, 1854, 1844, 1904, 1321, 1321,
-	1884, 1923, 1523, 533, 939, 938, 86, 86, 2183, 1918,
-	1888, 1042, 1891, 88, 1990, 1962, 1524, 87, 87, 1907,
-	1908, 1678, 1898, 95, 1958, 1957, 176, 176, 176, 176,
-	176, 114, 1901, 2232, 94, 88, 93, 1606, 1607, 2134,
-	532, 1806, 1911, 176, 176, 88, 1870, 1873, 1874, 1875,
-	1871, 1921, 1872, 1876, 1766, 2430, 1920, 2390, 2116, 176,
-	1878, 1593, 695, 696, 2039, 1926, 1755, 1930, 1931, 1932,
-	1960, 1782, 2038, 93, 2350, 2348, 1301, 1508, 2347, 177,
-	2340, 2292, 95, 2231, 715, 2229, 2147, 865, 1706, 872,
-	1296, 1180, 1176, 94, 95, 93, 177, 694, 94, 533,
-	669, 2339, 2019, 1842, 2220, 94, 1177, 1961, 1857, 1959,
-	2492, 2491, 2492, 533, 2032, 534, 1811, 1207, 1198, 177,
-	1199, 1952, 1953, 176, 534, 100, 101, 533, 2421, 2265,
-	534, 1586, 1587, 1179, 685, 1178, 533, 97, 82, 1982,
-	1, 611, 1998, 533, 533, 2474, 176, 176, 176, 176,
-	176, 2032, 502, 2067, 1573, 2043, 675, 1157, 176, 2016,
-	1997, 516, 2470, 176, 176, 2058, 176, 1999, 2015, 176,
-	176, 176, 2044, 1385, 1375, 2073, 1169, 2064, 2031, 2315,
-	2391, 1947, 2064, 1712, 2290, 1676, 900, 139, 1639, 1640,
-	2462, 104, 861, 103, 903, 1001, 2042, 1707, 2305, 2045,
-	1905, 1648, 2091, 1233, 2132, 1231, 1988, 1232, 1230, 1989,
-	176, 1235, 1234, 1229, 1812, 2093, 1458, 677, 2094, 530,
-	676, 1877, 174, 533, 2086, 2075, 2076, 1222, 2078, 1200,
-	2074, 940, 533, 2077, 2153, 492, 2124, 176, 2095, 1739,
-	2090, 89, 498, 1113, 1419, 2037, 1892, 176, 714, 707,
-	2069, 2224, 2101, 2049, 2051, 1849, 2054, 2047, 2414, 1180,
-	1176, 2341, 176, 2149, 2128, 176, 2454, 2036, 2127, 1902,
-	1196, 1803, 1120, 1525, 1177, 2184, 1621, 1581, 177, 2161,
-	1478, 555, 554, 552, 2160, 1835, 1858, 1076, 2148, 2152,
-	2129, 2130, 606, 1208, 1869, 1678, 1867, 2164, 2072, 1173,
-	1174, 1179, 1866, 1178, 1764, 1625, 2248, 2244, 1619, 1841,
-	563, 556, 534, 548, 2017, 2018, 604, 2262, 176, 2021,
-	2179, 2181, 2182, 2023, 2024, 2025, 2178, 2159, 1968, 534,
-	534, 2170, 534, 2192, 534, 534, 1970, 534, 534, 534,
-	534, 534, 534, 1903, 2166, 1055, 1172, 2186, 542, 873,
-	1522, 2396, 534, 2382, 1781, 2204, 177, 2218, 1171, 1534,
-	1535, 2139, 1699, 60, 38, 2185, 537, 2484, 1046, 701,
-	32, 2059, 177, 176, 2228, 31, 30, 29, 2221, 28,
-	23, 22, 21, 534, 20, 177, 19, 2233, 2243, 25,
-	18, 2268, 17, 16, 109, 2253, 176, 47, 44, 42,
-	177, 2250, 116, 115, 45, 41, 977, 39, 2257, 2258,
-	27, 26, 15, 2255, 176, 14, 177, 176, 176, 176,
-	13, 12, 2256, 177, 11, 10, 2161, 533, 533, 2297,
-	2298, 2160, 177, 177, 177, 177, 177, 177, 177, 177,
-	177, 534, 534, 534, 2300, 9, 2263, 5, 2264, 4,
-	1049, 24, 2145, 2, 533, 533, 533, 533, 1934, 1701,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 2311, 0, 0, 0, 0, 0, 0,
-	0, 0, 2309, 2310, 0, 2273, 0, 2275, 0, 0,
-	0, 0, 0, 0, 533, 533, 533, 176, 0, 715,
-	715, 715, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2324, 0, 2320, 0, 1045, 1047, 0,
-	533, 0, 533, 0, 0, 0, 0, 0, 0, 2067,
-	0, 534, 34, 2067, 2337, 2336, 2206, 0, 2346, 2357,
-	2345, 669, 2359, 2361, 2353, 0, 534, 534, 2355, 0,
-	2334, 2335, 2064, 2189, 2190, 0, 2191, 2321, 0, 2193,
-	0, 2195, 0, 2197, 0, 0, 0, 0, 0, 0,
-	2365, 2366, 0, 0, 0, 533, 177, 2378, 2368, 0,
-	0, 0, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2381, 1151, 2374, 533, 0, 2376, 2375, 0,
-	0, 0, 177, 0, 0, 534, 0, 2386, 2385, 0,
-	2393, 0, 0, 0, 0, 177, 0, 0, 534, 0,
-	0, 0, 0, 177, 0, 177, 0, 177, 177, 534,
-	0, 0, 534, 0, 2411, 0, 0, 0, 0, 2413,
-	2410, 0, 0, 534, 533, 0, 2425, 0, 0, 2067,
-	0, 533, 0, 2428, 0, 0, 2293, 0, 2422, 0,
-	0, 0, 0, 669, 0, 2426, 0, 0, 1250, 0,
-	2424, 0, 2308, 0, 0, 533, 176, 2439, 0, 0,
-	0, 1203, 0, 0, 34, 0, 0, 533, 0, 0,
-	715, 2436, 0, 0, 0, 0, 1223, 0, 534, 0,
-	0, 0, 0, 0, 533, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2322, 0, 2323, 0, 533, 533,
-	0, 2326, 2327, 534, 34, 2457, 2461, 0, 0, 534,
-	0, 2455, 2449, 2393, 2463, 2452, 0, 0, 0, 2064,
-	0, 0, 0, 2481, 2473, 2478, 0, 0, 0, 2354,
-	0, 0, 0, 2487, 0, 0, 2489, 0, 0, 0,
-	2362, 0, 0, 2364, 533, 0, 2496, 0, 0, 0,
-	0, 534, 2498, 0, 0, 2367, 2505, 1089, 0, 0,
-	1085, 0, 1086, 0, 0, 0, 2371, 0, 0, 0,
-	2515, 0, 0, 0, 0, 1238, 1087, 1088, 1084, 1090,
+	602, 2533, 2483, 2316, 2527, 2174, 1594, 2491, 2471, 1038,
+	2104, 2111, 2397, 2157, 3, 2145, 689, 2113, 1700, 1155,
+	2156, 2343, 596, 34, 2067, 1828, 597, 1188, 553, 2064,
+	594, 2068, 1879, 1577, 667, 1856, 2065, 2228, 605, 595,
+	2348, 557, 2248, 1598, 579, 2159, 2054, 2222, 176, 1613,
+	2062, 176, 1848, 517, 176, 1670, 1690, 550, 549, 533,
+	1985, 176, 986, 1915, 2335, 1944, 1675, 1917, 863, 176,
+	1916, 148, 1626, 716, 1868, 35, 33, 1618, 690, 1175,
+	1617, 176, 1840, 669, 545, 551, 928, 1514, 1677, 1637,
+	1283, 1557, 2001, 134, 671, 1709, 675, 1466, 1507, 1689,
+	1742, 888, 1885, 533, 1197, 1909, 533, 176, 533, 893,
+	562, 1218, 713, 89, 692, 1417, 85, 1620, 90, 1579,
+	1158, 1526, 1484, 1057, 1414, 870, 1687, 1015, 1326, 1317,
+	871, 894, 1560, 899, 1400, 867, 1217, 1605, 895, 1201,
+	1215, 896, 1036, 1031, 681, 1278, 1302, 703, 679, 677,
+	676, 92, 1666, 151, 111, 971, 70, 1599, 906, 91,
+	1422, 112, 540, 1310, 117, 1127, 1123, 118, 2440, 1937,
+	79, 83, 2534, 2142, 2454, 1570, 1702, 1703, 1704, 1702,
+	1964, 1963, 1740, 1935, 697, 8, 702, 678, 71, 7,
+	6, 1993, 119, 1994, 178, 179, 180, 1473, 113, 1574,
+	1575, 683, 1472, 1386, 1471, 1470, 1469, 1468, 1455, 84,
+	490, 933, 543, 1460, 544, 864, 2505, 1826, 2050, 2514,
+	879, 2485, 874, 930, 1850, 541, 2281, 2488, 670, 96,
+	668, 2489, 2510, 2128, 2393, 2392, 944, 945, 932, 948,
+	949, 950, 951, 710, 2513, 954, 955, 956, 957, 958,
+	959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
+	717, 684, 691, 909, 113, 885, 2512, 98, 99, 1058,
+	102, 886, 884, 108, 172, 2485, 173, 72, 931, 485,
+	910, 2488, 934, 935, 936, 2489, 2309, 72, 2537, 2310,
+	74, 2511, 2544, 2481, 1780, 2451, 2525, 2455, 114, 666,
+	72, 1859, 674, 2317, 72, 2472, 941, 1058, 1728, 946,
+	2480, 156, 1682, 2000, 2450, 2484, 2030, 178, 179, 180,
+	172, 2207, 1292, 974, 878, 1827, 1860, 880, 113, 1894,
+	705, 706, 1893, 1632, 1633, 1895, 1680, 585, 1219, 1972,
+	1220, 1576, 2100, 1971, 114, 1992, 1022, 520, 1024, 2361,
+	2101, 2102, 1777, 1899, 81, 1631, 1005, 156, 1778, 883,
+	1034, 980, 981, 664, 81, 663, 1906, 993, 153, 1938,
+	154, 1068, 994, 1010, 1011, 1650, 1649, 81, 993, 2027,
+	171, 81, 2198, 994, 1021, 1023, 1006, 2196, 507, 999,
+	2176, 992, 2225, 991, 970, 520, 520, 1459, 531, 535,
+	529, 1162, 1461, 1462, 1463, 1945, 1967, 520, 1743, 1068,
+	1754, 1751, 1753, 1752, 153, 881, 154, 1710, 1748, 1406,
+	883, 2536, 875, 2169, 1401, 1089, 171, 1008, 1009, 877,
+	876, 2170, 1028, 976, 506, 947, 887, 1756, 1014, 1757,
+	1980, 1758, 1759, 953, 1376, 504, 2402, 1090, 1091, 1092,
+	1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100, 883, 969,
+	1033, 1679, 1012, 1747, 1064, 1007, 2506, 1056, 1000, 2177,
+	952, 1749, 1013, 2178, 1745, 1019, 881, 2302, 889, 1020,
+	890, 1713, 917, 501, 157, 176, 1377, 176, 1378, 1025,
+	176, 1295, 515, 162, 81, 2153, 2334, 915, 1614, 890,
+	926, 2523, 1064, 1746, 925, 924, 923, 512, 1026, 922,
+	921, 1018, 920, 919, 914, 927, 2117, 868, 533, 533,
+	533, 973, 902, 2539, 868, 882, 520, 901, 2028, 1101,
+	157, 2531, 1101, 1778, 1984, 1316, 533, 533, 868, 162,
+	521, 1415, 866, 1829, 1831, 1688, 704, 1981, 1734, 1003,
+	1411, 1044, 1050, 937, 989, 2135, 995, 996, 997, 998,
+	34, 1966, 2037, 2036, 2035, 1290, 1289, 2127, 1405, 1288,
+	491, 1956, 493, 508, 1412, 523, 1286, 522, 497, 1035,
+	495, 499, 509, 500, 489, 494, 882, 505, 521, 521,
+	496, 510, 511, 513, 527, 526, 514, 484, 503, 524,
+	521, 908, 149, 2152, 2439, 1936, 1407, 1730, 2430, 1979,
+	972, 1996, 1978, 598, 1791, 580, 582, 599, 600, 918,
+	578, 581, 601, 1027, 882, 2262, 1939, 1063, 1060, 1061,
+	1062, 1067, 1069, 1066, 916, 1065, 1153, 1969, 1315, 1029,
+	2486, 2244, 1059, 1890, 1104, 1105, 1106, 1107, 149, 583,
+	584, 2374, 1855, 1148, 1112, 2226, 1115, 1818, 2389, 1681,
+	908, 1040, 1041, 2449, 176, 1063, 1060, 1061, 1062, 1067,
+	1069, 1066, 908, 1065, 1569, 1108, 1205, 1987, 1987, 1135,
+	1059, 176, 1986, 1986, 1163, 1102, 1103, 984, 75, 1830,
+	1154, 1165, 1101, 80, 2486, 1169, 1779, 1402, 907, 1403,
+	533, 671, 1404, 80, 176, 1166, 982, 110, 979, 533,
+	1388, 1387, 1389, 1390, 1391, 533, 80, 990, 713, 521,
+	80, 1638, 1100, 1053, 943, 71, 2403, 1051, 1052, 2529,
+	2099, 1002, 2530, 686, 2528, 178, 179, 180, 1016, 1509,
+	1600, 1601, 1004, 525, 1032, 1125, 2445, 1126, 1129, 1154,
+	1167, 1423, 105, 2298, 89, 1168, 2238, 907, 988, 90,
+	929, 518, 911, 901, 1141, 1142, 1143, 1144, 908, 907,
+	1744, 1489, 912, 1408, 911, 901, 519, 1928, 1221, 1054,
+	2526, 1159, 1729, 2020, 912, 1490, 1491, 1488, 1527, 908,
+	913, 1527, 92, 1805, 150, 155, 152, 158, 159, 160,
+	161, 163, 164, 165, 166, 1071, 1510, 1072, 1073, 106,
+	167, 168, 169, 170, 2022, 1606, 1607, 1073, 1071, 908,
+	1072, 1073, 1072, 1073, 2357, 1156, 2273, 2272, 1717, 1187,
+	668, 1325, 1324, 1314, 1727, 670, 1725, 1722, 917, 1164,
+	150, 155, 152, 158, 159, 160, 161, 163, 164, 165,
+	166, 1722, 1184, 1211, 1212, 915, 167, 168, 169, 170,
+	2519, 1813, 2263, 176, 1726, 907, 717, 1279, 1017, 2542,
+	2497, 901, 904, 905, 2495, 868, 1287, 2459, 1724, 898,
+	902, 1424, 975, 2499, 2500, 987, 907, 2204, 2115, 2116,
+	2540, 81, 901, 904, 905, 2496, 868, 533, 897, 1312,
+	898, 902, 1206, 1170, 2423, 1487, 1395, 1321, 2384, 2460,
+	1071, 1323, 1072, 1073, 533, 533, 907, 533, 942, 533,
+	533, 1393, 533, 533, 533, 533, 533, 533, 1093, 1094,
+	1095, 1096, 1098, 1097, 1099, 1100, 2424, 533, 1479, 1481,
+	1482, 176, 1359, 1095, 1096, 1098, 1097, 1099, 1100, 1182,
+	2332, 1322, 1182, 1783, 1784, 1785, 1531, 176, 1071, 1480,
+	1072, 1073, 2331, 2280, 2541, 1308, 1796, 1383, 533, 1394,
+	176, 2202, 1182, 1293, 1294, 1795, 1354, 1355, 616, 617,
+	618, 1413, 2279, 2520, 1392, 176, 1301, 1071, 1216, 1072,
+	1073, 2114, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1075,
+	1195, 176, 2143, 2117, 2133, 1356, 1913, 1071, 176, 1072,
+	1073, 1071, 1912, 1072, 1073, 1685, 1396, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 533, 533, 533, 1285,
+	1382, 1381, 1380, 1362, 1363, 1319, 1379, 1370, 1298, 1368,
+	1369, 1364, 1361, 1328, 1311, 1329, 1360, 1331, 1333, 1335,
+	2427, 1337, 1339, 1341, 1343, 1345, 1299, 176, 1419, 1297,
+	2426, 2210, 1427, 1318, 1318, 1194, 1801, 2173, 1191, 1431,
+	1320, 1433, 1434, 1435, 1436, 2521, 1372, 2425, 1440, 1090,
 	1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100,
-	0, 0, 0, 0, 177, 546, 0, 0, 0, 0,
+	2436, 708, 1454, 2356, 2354, 1357, 2328, 1071, 2002, 1072,
+	1073, 1071, 1508, 1072, 1073, 2277, 2269, 1416, 1922, 1291,
+	1071, 1485, 1072, 1073, 1910, 1517, 533, 1192, 1738, 1425,
+	1426, 1737, 178, 179, 180, 1071, 2270, 1072, 1073, 113,
+	885, 533, 533, 1430, 1597, 1483, 1583, 884, 1800, 1512,
+	1437, 1438, 1439, 1511, 1492, 1561, 1494, 1495, 1496, 1497,
+	1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1182,
+	1456, 176, 1429, 1794, 1528, 1420, 1384, 2382, 1450, 1451,
+	1452, 1371, 1453, 1493, 1071, 1367, 1072, 1073, 2004, 1366,
+	1365, 1582, 1193, 1584, 2299, 1585, 1030, 176, 1043, 1182,
+	533, 178, 179, 180, 1071, 1486, 1072, 1073, 1914, 2410,
+	176, 2409, 1071, 533, 1072, 1073, 1846, 2535, 176, 1563,
+	176, 2378, 176, 176, 533, 1561, 86, 533, 2209, 1071,
+	2377, 1072, 1073, 713, 88, 1886, 713, 87, 533, 1565,
+	1566, 2315, 89, 1071, 1946, 1072, 1073, 90, 2014, 2013,
+	2012, 2006, 1513, 2010, 1616, 2005, 1925, 2003, 89, 1519,
+	1520, 1562, 2008, 90, 1071, 1590, 1072, 1073, 1846, 2478,
+	1071, 2007, 1072, 1073, 178, 179, 180, 86, 1897, 1564,
+	1846, 2465, 1567, 1568, 88, 1646, 2009, 2011, 87, 1563,
+	1864, 1182, 1182, 533, 1656, 1657, 1658, 1659, 1887, 1691,
+	1692, 1693, 1846, 2463, 1695, 1697, 1642, 1889, 1641, 2063,
+	95, 683, 178, 179, 180, 1589, 1698, 94, 533, 2237,
+	1624, 94, 2237, 93, 533, 1321, 1846, 2441, 1321, 1647,
+	1321, 1611, 88, 1711, 1857, 1651, 1721, 1652, 1653, 1654,
+	1655, 1592, 1182, 1672, 1865, 603, 1070, 1645, 1678, 2307,
+	2438, 2239, 1609, 1662, 1663, 1664, 1665, 1842, 178, 179,
+	180, 1629, 1696, 2444, 1628, 1846, 533, 95, 1508, 1644,
+	2413, 1182, 88, 1508, 1508, 1723, 1643, 1865, 94, 1857,
+	93, 717, 1846, 2385, 717, 1792, 1708, 2307, 1182, 2503,
+	1182, 1846, 2305, 177, 1722, 1182, 177, 2242, 1182, 177,
+	2125, 2124, 2121, 2122, 534, 1865, 177, 2121, 2120, 176,
+	1865, 1182, 1792, 1182, 177, 1886, 176, 1778, 1965, 1282,
+	1950, 176, 176, 1683, 1673, 176, 177, 176, 1694, 1792,
+	1686, 1684, 1722, 176, 1731, 1942, 1943, 1668, 1669, 2123,
+	176, 1846, 1845, 1555, 1070, 1182, 673, 1182, 534, 1715,
+	2237, 534, 177, 534, 1630, 1714, 1718, 1673, 909, 1282,
+	1281, 2094, 1732, 1227, 1226, 2282, 1733, 1792, 176, 533,
+	1778, 1735, 1736, 1810, 1809, 910, 2107, 1722, 1887, 1705,
+	1604, 1186, 1572, 1464, 1410, 1318, 1213, 1778, 1716, 892,
+	891, 1719, 2468, 1720, 81, 2399, 1189, 2375, 1769, 1770,
+	2368, 1350, 2297, 1772, 2294, 2275, 1741, 2213, 2212, 1284,
+	1671, 2146, 1773, 2171, 2148, 2283, 2284, 2285, 2144, 2286,
+	1951, 2108, 1667, 81, 1661, 1485, 1547, 1536, 1537, 1538,
+	1539, 1549, 1540, 1541, 1542, 1554, 1550, 1543, 1544, 1551,
+	1552, 1553, 1545, 1546, 1548, 1660, 2110, 1762, 1398, 1313,
+	2105, 1351, 1352, 1353, 1309, 1919, 1280, 1787, 107, 1789,
+	1918, 974, 2249, 2250, 2115, 2116, 2287, 2288, 1347, 2175,
+	2400, 2106, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097,
+	1099, 1100, 1682, 176, 2516, 2492, 1788, 2252, 2140, 1824,
+	2139, 176, 2138, 2063, 1929, 1763, 1457, 2255, 1776, 533,
+	1089, 2085, 1995, 2112, 2254, 2082, 2086, 1919, 2083, 1486,
+	533, 2081, 2508, 2084, 2479, 1348, 1349, 2087, 1596, 1874,
+	1875, 1786, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098,
+	1097, 1099, 1100, 176, 176, 1190, 1588, 2243, 1861, 2043,
+	2042, 1870, 1873, 1874, 1875, 1871, 34, 1872, 1876, 1896,
+	1948, 1847, 2422, 1563, 2230, 1881, 2347, 1804, 2349, 2055,
+	2057, 687, 2229, 2233, 2052, 1802, 1409, 2114, 2058, 688,
+	662, 1180, 1176, 2119, 1904, 1923, 1523, 939, 938, 2117,
+	1843, 86, 587, 86, 2185, 1042, 1177, 1918, 88, 1990,
+	1524, 1958, 87, 533, 87, 1562, 1957, 114, 176, 2235,
+	1159, 1815, 1816, 1825, 88, 176, 1907, 1908, 2136, 1880,
+	1833, 1586, 1587, 1179, 1844, 1178, 1839, 533, 1766, 1854,
+	1606, 1607, 1900, 1941, 533, 1180, 1176, 1755, 1321, 1321,
+	2437, 1884, 2395, 533, 2118, 1878, 1593, 95, 1888, 1782,
+	1177, 532, 95, 1678, 93, 1962, 695, 696, 94, 94,
+	93, 1898, 1891, 94, 2344, 93, 176, 176, 176, 176,
+	176, 2412, 1901, 2041, 88, 1173, 1174, 1179, 2223, 1178,
+	2355, 2040, 2353, 176, 176, 1920, 1921, 1911, 2352, 2345,
+	2109, 2295, 2234, 2232, 2149, 715, 1706, 1296, 865, 176,
+	872, 1089, 694, 95, 1085, 1857, 1086, 1926, 1842, 2518,
+	2517, 1930, 1931, 1932, 94, 1811, 1207, 1508, 1960, 1301,
+	1087, 1088, 1084, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
+	1098, 1097, 1099, 1100, 1199, 100, 101, 2518, 2428, 533,
+	177, 2268, 177, 1961, 685, 177, 97, 1959, 2034, 82,
+	1, 611, 2494, 533, 502, 1573, 1157, 2019, 516, 2490,
+	1385, 1375, 2318, 176, 2396, 1947, 1712, 533, 2293, 1997,
+	1676, 900, 139, 534, 534, 534, 533, 1639, 1640, 2474,
+	1982, 104, 861, 533, 533, 2034, 176, 176, 176, 176,
+	176, 534, 534, 2060, 103, 2046, 2045, 1998, 176, 903,
+	1999, 1952, 1953, 176, 176, 675, 176, 2069, 2015, 176,
+	176, 176, 1001, 1707, 2075, 2016, 1988, 2308, 1905, 1989,
+	1648, 2066, 1233, 1231, 1232, 1169, 2066, 1230, 1235, 2033,
+	1234, 1229, 1812, 1458, 530, 1877, 174, 2093, 2095, 1222,
+	1200, 2096, 2044, 2047, 2134, 1870, 1873, 1874, 1875, 1871,
+	176, 1872, 1876, 940, 492, 2249, 2250, 2126, 677, 676,
+	1739, 498, 1113, 533, 2077, 2078, 2076, 2080, 2039, 2079,
+	1892, 2088, 533, 714, 707, 2071, 2227, 176, 2155, 2092,
+	89, 2038, 2097, 2051, 1419, 90, 2053, 176, 1849, 2056,
+	2049, 2103, 2421, 2346, 2466, 1902, 1196, 1803, 1120, 1525,
+	1621, 1581, 176, 1478, 2129, 176, 2487, 2151, 2130, 2163,
+	2453, 2452, 2074, 2333, 2029, 2186, 555, 554, 2162, 177,
+	552, 1835, 1858, 1076, 606, 2131, 2132, 1678, 1208, 1869,
+	1867, 2150, 1866, 1764, 1625, 2251, 177, 2154, 2247, 1619,
+	1841, 563, 556, 548, 604, 2166, 2265, 2161, 1968, 2172,
+	1970, 1903, 2168, 1055, 1172, 534, 542, 873, 1522, 177,
+	176, 2401, 2387, 2180, 534, 1781, 1089, 2181, 1790, 2206,
+	534, 2183, 2184, 1171, 2187, 1534, 1535, 2141, 1699, 60,
+	38, 537, 2194, 2504, 1046, 701, 32, 31, 1090, 1091,
+	1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100, 30,
+	2221, 29, 2188, 28, 23, 22, 21, 20, 19, 25,
+	18, 17, 16, 2191, 2192, 176, 2193, 109, 47, 2195,
+	2231, 2197, 2224, 2199, 44, 42, 116, 115, 45, 41,
+	977, 2246, 39, 2271, 27, 2236, 26, 2256, 176, 15,
+	14, 13, 2253, 12, 11, 10, 9, 2259, 5, 4,
+	1049, 2260, 2261, 24, 2, 1934, 176, 1701, 2163, 176,
+	176, 176, 0, 2258, 0, 0, 0, 2162, 0, 533,
+	533, 0, 2266, 2267, 0, 2300, 2301, 0, 0, 0,
+	0, 0, 0, 0, 2276, 0, 2278, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 533, 533, 533, 533,
+	2303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	715, 715, 715, 0, 0, 2314, 0, 0, 177, 0,
+	0, 0, 0, 2312, 2313, 0, 0, 0, 1045, 1047,
+	0, 0, 0, 0, 0, 0, 0, 533, 533, 533,
+	176, 0, 0, 2327, 0, 699, 0, 0, 0, 0,
+	0, 0, 534, 0, 0, 0, 2324, 0, 0, 0,
+	0, 0, 0, 533, 0, 533, 0, 2323, 0, 534,
+	534, 0, 534, 2341, 534, 534, 2362, 534, 534, 534,
+	534, 534, 534, 0, 34, 2351, 2069, 2350, 2364, 2342,
+	2069, 2360, 534, 0, 2358, 0, 177, 0, 0, 2066,
+	0, 2366, 2339, 2340, 1151, 0, 0, 0, 0, 0,
+	546, 0, 177, 0, 2370, 2371, 0, 2373, 533, 0,
+	0, 0, 0, 534, 2383, 177, 0, 0, 0, 0,
+	0, 0, 0, 0, 2381, 2380, 0, 2379, 533, 693,
+	177, 2391, 0, 2390, 0, 0, 0, 2398, 0, 2386,
+	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
+	0, 0, 0, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 534, 534, 534, 2418, 2420, 0, 2417, 2411, 533,
+	0, 0, 1203, 0, 0, 2432, 533, 0, 0, 0,
+	0, 715, 2435, 2431, 0, 0, 2429, 1223, 2069, 0,
+	0, 0, 177, 0, 0, 0, 0, 1089, 0, 0,
+	533, 176, 0, 0, 0, 2433, 2446, 0, 0, 0,
+	2443, 0, 0, 0, 533, 0, 0, 0, 34, 1090,
+	1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100,
+	0, 533, 2456, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 533, 533, 0, 0, 0,
+	2464, 534, 2467, 0, 2398, 2475, 2469, 2473, 2461, 0,
+	34, 0, 0, 0, 0, 0, 534, 534, 0, 0,
+	2066, 172, 0, 0, 0, 0, 2501, 2493, 2498, 0,
+	0, 0, 1940, 0, 2507, 0, 0, 2509, 0, 0,
+	0, 0, 0, 0, 0, 114, 177, 136, 2515, 0,
+	0, 0, 0, 0, 533, 0, 0, 0, 156, 0,
+	2522, 2524, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 2532, 0, 534, 0, 0, 0, 2538,
+	0, 0, 0, 0, 0, 177, 0, 2543, 534, 146,
+	0, 0, 0, 177, 135, 177, 0, 177, 177, 534,
+	0, 0, 534, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 534, 0, 153, 0, 154, 0, 865,
+	0, 0, 0, 1304, 1305, 145, 144, 171, 0, 0,
+	0, 0, 1151, 0, 0, 0, 1327, 1327, 0, 1327,
+	0, 1327, 1327, 0, 1336, 1327, 1327, 1327, 1327, 1327,
+	0, 0, 0, 0, 0, 0, 0, 1151, 1151, 865,
+	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1397, 0, 0, 534, 0, 0, 0, 0, 0, 534,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 1306, 147, 0, 1303, 0, 141, 142, 0, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 534, 0, 0, 0, 0, 0, 0, 715, 715,
+	715, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
 	0, 177, 0, 0, 0, 0, 177, 177, 0, 0,
-	177, 0, 177, 0, 0, 0, 0, 0, 177, 0,
+	177, 0, 177, 0, 0, 0, 1181, 0, 177, 0,
 	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 0, 2412, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 534, 1251, 0, 0, 865, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1151, 0, 0, 0, 1327, 1327, 546, 1327, 0,
-	1327, 1327, 0, 1336, 1327, 1327, 1327, 1327, 1327, 0,
-	0, 0, 0, 0, 0, 0, 1151, 1151, 865, 0,
-	0, 0, 0, 0, 588, 0, 0, 1264, 1267, 1268,
-	1269, 1270, 1271, 1272, 0, 1273, 1274, 1275, 1276, 1277,
-	1252, 1253, 1254, 1255, 1236, 1237, 1265, 0, 1239, 1397,
-	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
-	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 0, 0,
-	2482, 0, 175, 0, 0, 488, 0, 0, 528, 0,
-	0, 0, 0, 0, 0, 488, 0, 0, 177, 0,
-	0, 0, 0, 488, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 0, 534, 682, 0, 715, 715, 715,
+	0, 0, 0, 1074, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 588, 0, 1518, 0,
+	0, 0, 0, 177, 534, 1151, 0, 0, 0, 149,
+	0, 1121, 0, 1532, 1533, 0, 0, 0, 715, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 0, 0, 488, 0, 546,
+	528, 0, 0, 0, 0, 0, 0, 488, 0, 0,
+	0, 0, 0, 0, 0, 488, 0, 0, 0, 0,
+	0, 0, 1595, 0, 0, 0, 0, 682, 0, 0,
+	0, 0, 0, 0, 143, 1203, 0, 0, 715, 0,
+	0, 0, 0, 700, 0, 700, 715, 0, 137, 715,
+	0, 138, 0, 488, 0, 0, 0, 0, 0, 0,
+	865, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
+	1198, 0, 0, 0, 534, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 534, 0, 0, 0, 0,
-	1181, 700, 0, 700, 0, 0, 0, 0, 0, 0,
-	0, 488, 0, 0, 0, 0, 0, 0, 177, 177,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1266, 0, 0,
+	0, 0, 0, 0, 0, 872, 0, 0, 177, 177,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	865, 0, 0, 0, 0, 0, 872, 0, 0, 0,
+	0, 150, 155, 152, 158, 159, 160, 161, 163, 164,
+	165, 166, 0, 0, 0, 0, 0, 167, 168, 169,
+	170, 0, 0, 0, 0, 0, 0, 0, 534, 0,
+	0, 0, 0, 177, 0, 0, 0, 0, 865, 0,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 534, 0, 0, 0, 0, 0, 0, 534,
+	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1518, 534, 0,
-	0, 0, 0, 177, 1151, 0, 0, 0, 0, 0,
-	177, 0, 1532, 1533, 0, 0, 0, 715, 0, 0,
-	0, 0, 534, 172, 0, 0, 0, 0, 0, 534,
-	0, 0, 0, 0, 1940, 0, 0, 0, 534, 0,
-	0, 0, 0, 0, 0, 0, 0, 114, 0, 136,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	156, 177, 177, 177, 177, 177, 0, 0, 0, 0,
-	0, 1595, 0, 0, 0, 0, 0, 0, 177, 177,
-	0, 0, 0, 0, 1203, 0, 0, 715, 0, 0,
-	0, 146, 0, 0, 177, 715, 135, 0, 715, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 865,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 154,
-	0, 0, 0, 0, 0, 1304, 1305, 145, 144, 171,
+	0, 177, 177, 177, 177, 177, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 177,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1775, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 534, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 534, 0, 872, 0, 0, 0, 0, 0,
+	0, 0, 534, 0, 0, 0, 0, 0, 0, 0,
 	0, 534, 0, 0, 0, 0, 0, 0, 534, 534,
-	0, 177, 177, 177, 177, 177, 0, 0, 0, 865,
-	0, 0, 0, 177, 0, 872, 0, 0, 177, 177,
+	0, 177, 177, 177, 177, 177, 0, 0, 0, 0,
+	0, 0, 0, 177, 1421, 0, 0, 0, 177, 177,
 	0, 177, 0, 0, 177, 177, 177, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 140, 1306, 147, 0, 1303, 0, 141, 142,
-	0, 0, 0, 157, 0, 0, 0, 865, 0, 0,
-	0, 0, 162, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
+	0, 0, 715, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1836, 0, 0, 0, 177, 0, 0, 0, 0,
+	0, 488, 1851, 488, 0, 0, 488, 0, 534, 0,
 	0, 0, 0, 0, 0, 0, 0, 534, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 0, 0, 1474, 1475, 1476, 1477,
 	0, 0, 177, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	177, 613, 73, 0, 0, 0, 0, 0, 0, 488,
-	0, 488, 0, 0, 488, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1775, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 149, 0, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 73, 0, 0, 72, 36,
-	37, 74, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 78, 0,
-	0, 0, 40, 66, 67, 0, 64, 68, 177, 0,
-	0, 0, 0, 0, 0, 65, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 1152, 0, 0, 137,
-	0, 0, 138, 0, 53, 0, 0, 0, 0, 177,
-	0, 715, 177, 177, 177, 81, 0, 0, 0, 0,
-	0, 0, 534, 534, 0, 0, 0, 0, 0, 0,
-	1836, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1851, 0, 0, 0, 0, 0, 0, 488, 534,
-	534, 534, 534, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 488, 534,
-	534, 534, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 150, 155, 152, 158, 159, 160, 161,
-	163, 164, 165, 166, 0, 534, 0, 534, 0, 167,
-	168, 169, 170, 0, 1924, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 43, 46, 49, 48, 51,
-	0, 63, 0, 0, 69, 0, 0, 0, 1595, 0,
-	0, 0, 0, 0, 0, 1949, 0, 1183, 1185, 0,
-	0, 0, 0, 0, 1954, 0, 52, 77, 76, 0,
-	534, 61, 62, 50, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	534, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 55, 0, 56, 57, 58, 59,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 534,
-	0, 0, 0, 0, 0, 0, 534, 488, 0, 0,
-	0, 487, 0, 0, 0, 0, 0, 0, 0, 0,
-	715, 536, 0, 0, 0, 0, 0, 0, 0, 665,
-	534, 177, 0, 0, 1327, 0, 0, 0, 0, 0,
-	0, 0, 534, 0, 0, 0, 0, 0, 2046, 0,
-	0, 0, 0, 0, 1152, 0, 0, 715, 0, 534,
-	0, 1151, 0, 0, 2071, 1327, 1151, 869, 0, 0,
-	0, 0, 0, 534, 534, 0, 0, 0, 0, 1152,
-	1152, 0, 0, 0, 0, 488, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
-	0, 1373, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 80, 488, 0, 0, 0, 0, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1418,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1037,
-	1037, 1037, 0, 0, 865, 488, 0, 1151, 0, 0,
-	0, 0, 488, 1595, 0, 0, 0, 0, 0, 73,
-	0, 1441, 1442, 488, 488, 488, 488, 488, 488, 488,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 672, 1109, 1110, 1111,
-	0, 1114, 0, 1116, 1117, 1118, 1119, 0, 1122, 1124,
-	1124, 488, 1124, 1128, 1128, 1130, 1131, 1132, 1133, 1134,
-	0, 1136, 1137, 1138, 1139, 1140, 0, 0, 0, 0,
-	1128, 1128, 1128, 1128, 0, 0, 0, 0, 0, 0,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1515, 1516, 0, 0,
+	0, 0, 0, 0, 1521, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1924, 0, 0, 0, 1556,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 0, 0, 1595,
+	0, 0, 0, 0, 0, 0, 1949, 546, 0, 0,
+	0, 0, 0, 0, 0, 1954, 1250, 0, 1152, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 700, 0, 0, 0, 0, 0,
-	0, 700, 700, 0, 0, 0, 0, 1152, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 700,
-	1418, 700, 700, 700, 700, 700, 0, 1161, 0, 0,
-	672, 0, 0, 0, 672, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 1373, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 700, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 488, 0, 0, 0, 1595, 1595,
-	1418, 0, 488, 0, 488, 0, 488, 1627, 0, 0,
-	0, 0, 0, 0, 0, 1529, 0, 0, 0, 1530,
-	0, 0, 0, 0, 0, 2316, 2317, 2318, 2319, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1183, 1571, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2332, 2332, 2332, 0, 0,
-	0, 0, 0, 0, 0, 1591, 0, 172, 0, 0,
-	0, 0, 0, 0, 0, 0, 1151, 0, 1300, 0,
-	0, 2358, 0, 2360, 0, 978, 0, 983, 0, 0,
-	985, 114, 0, 136, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 146, 1595, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 715, 0, 0, 0,
-	0, 153, 0, 154, 0, 0, 0, 0, 0, 1304,
-	1305, 145, 144, 171, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 488, 0, 0, 0, 0, 0, 0,
-	488, 0, 0, 0, 0, 488, 488, 0, 0, 488,
-	0, 1767, 0, 0, 0, 1595, 0, 488, 0, 0,
-	0, 0, 1595, 0, 488, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1595, 0, 0, 0,
-	0, 0, 488, 0, 0, 0, 0, 0, 2445, 0,
+	1602, 1603, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 0, 1183, 1185, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1636, 0, 0,
+	488, 0, 0, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 0, 0,
+	0, 177, 0, 0, 177, 177, 177, 0, 0, 0,
+	0, 0, 0, 0, 534, 534, 0, 0, 0, 0,
+	488, 715, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1327, 1674, 0, 0, 0,
+	0, 534, 534, 534, 534, 0, 0, 0, 0, 2048,
+	0, 0, 0, 1238, 0, 0, 0, 0, 715, 0,
+	0, 0, 1151, 0, 0, 2073, 1327, 1151, 1160, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1151, 0, 2450, 140, 1306, 147, 0,
-	1303, 0, 141, 142, 0, 0, 0, 157, 0, 715,
-	715, 0, 0, 0, 0, 0, 162, 1037, 1037, 1037,
+	0, 0, 534, 534, 534, 177, 0, 0, 613, 73,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1210, 0, 0, 700, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
+	534, 0, 0, 0, 0, 0, 0, 0, 0, 487,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 536,
+	0, 0, 0, 1251, 0, 0, 0, 665, 0, 0,
+	0, 0, 0, 0, 0, 865, 0, 0, 1151, 0,
+	0, 0, 0, 0, 1595, 0, 0, 0, 0, 0,
+	672, 0, 73, 534, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 869, 0, 0, 0, 488,
+	672, 0, 0, 534, 0, 1264, 1267, 1268, 1269, 1270,
+	1271, 1272, 0, 1273, 1274, 1275, 1276, 1277, 1252, 1253,
+	1254, 1255, 1236, 1237, 1265, 0, 1239, 0, 1240, 1241,
+	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1256, 1257,
+	1258, 1259, 1260, 1261, 1262, 1263, 1152, 0, 0, 0,
+	0, 0, 0, 0, 534, 0, 0, 0, 0, 0,
+	0, 534, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1152, 1152, 0, 0, 0, 0, 488, 0, 0,
+	0, 0, 0, 0, 0, 534, 177, 0, 0, 0,
+	0, 0, 0, 1373, 0, 0, 0, 0, 0, 534,
+	0, 0, 0, 1806, 0, 0, 488, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 534, 0, 0, 0,
+	0, 1418, 0, 0, 0, 0, 0, 0, 0, 0,
+	534, 534, 0, 0, 0, 0, 1266, 488, 0, 0,
+	0, 0, 0, 0, 488, 0, 0, 0, 0, 0,
+	0, 0, 0, 1441, 1442, 488, 488, 488, 488, 488,
+	488, 488, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1595, 1595, 0, 0, 0, 0, 0, 0, 0,
+	1198, 0, 0, 0, 0, 0, 0, 0, 0, 534,
+	0, 0, 0, 488, 0, 0, 0, 0, 2319, 2320,
+	2321, 2322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1529, 0, 0, 0, 1530, 0, 0, 0, 2337,
+	2337, 2337, 0, 0, 0, 0, 700, 0, 0, 0,
+	0, 0, 0, 700, 700, 0, 0, 0, 0, 1152,
+	1151, 1183, 1571, 0, 0, 2363, 0, 2365, 0, 0,
+	0, 700, 1418, 700, 700, 700, 700, 700, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1591, 0, 0, 0, 0, 0, 1373, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 700,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1595, 0, 0, 0, 0,
+	1595, 0, 0, 682, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 488, 0, 0, 0,
+	715, 0, 1418, 0, 488, 0, 488, 0, 488, 1627,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 700, 700, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1418, 0, 0, 488, 0, 0,
-	0, 0, 0, 0, 0, 1373, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 978, 0, 983, 2017, 2018, 985, 0,
+	0, 2021, 0, 0, 0, 2023, 2024, 2025, 0, 0,
+	0, 1595, 0, 0, 0, 0, 0, 0, 1595, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1793, 149, 0, 0, 1797, 0,
-	1798, 1799, 0, 0, 0, 0, 0, 488, 488, 1807,
-	0, 0, 1808, 81, 0, 0, 0, 0, 0, 607,
-	614, 615, 616, 617, 618, 608, 610, 0, 0, 0,
-	609, 0, 0, 612, 619, 620, 0, 0, 1814, 0,
-	0, 0, 0, 0, 0, 1819, 1820, 1821, 1822, 1823,
-	0, 1591, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1834, 1228, 0, 0, 0, 0, 0, 0,
-	143, 0, 488, 1622, 0, 0, 2162, 2163, 0, 1933,
-	0, 0, 0, 137, 0, 0, 138, 0, 621, 622,
-	623, 624, 625, 626, 627, 628, 629, 630, 631, 632,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 656, 657, 658, 659, 660, 661, 0,
-	488, 488, 488, 488, 488, 0, 0, 0, 0, 0,
-	0, 1358, 0, 0, 0, 0, 0, 488, 488, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 488, 0, 0, 0, 0, 0, 0,
-	1399, 0, 0, 0, 0, 0, 0, 700, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 150, 155, 152,
-	158, 159, 160, 161, 163, 164, 165, 166, 0, 0,
-	0, 1428, 700, 167, 168, 169, 170, 0, 1432, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1443,
-	1444, 1445, 1446, 1447, 1448, 1449, 0, 488, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1152, 0, 0, 0, 0, 1152,
-	488, 488, 488, 488, 488, 0, 0, 1467, 0, 0,
-	0, 0, 2087, 0, 0, 0, 0, 488, 1373, 0,
-	488, 0, 0, 488, 2096, 1418, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2026, 0, 0, 2029, 2030, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 598, 0,
-	0, 0, 599, 600, 488, 0, 0, 601, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 488, 0, 2070, 0, 0, 0, 0, 0, 0,
-	0, 488, 0, 0, 0, 0, 0, 0, 0, 0,
-	2088, 2089, 0, 0, 0, 0, 488, 0, 0, 488,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1608, 0, 0, 0, 0, 0, 0, 0, 1612, 0,
-	1615, 0, 0, 1467, 0, 0, 0, 0, 0, 0,
-	0, 0, 488, 1817, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1832, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1862, 1863, 488, 0, 0,
-	0, 0, 2188, 0, 1882, 0, 0, 0, 114, 0,
-	136, 0, 0, 0, 0, 0, 2198, 2199, 2201, 2203,
-	488, 156, 0, 0, 0, 0, 2209, 0, 0, 0,
-	0, 0, 2212, 0, 0, 0, 0, 2216, 488, 0,
-	0, 488, 488, 488, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2237,
-	2238, 0, 0, 2242, 0, 0, 0, 0, 153, 0,
-	154, 0, 0, 0, 0, 0, 123, 124, 145, 144,
-	171, 2254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1955, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1467,
-	0, 1373, 0, 0, 0, 0, 1750, 0, 0, 0,
-	0, 1760, 1761, 0, 0, 1765, 0, 0, 0, 1152,
-	0, 0, 0, 1768, 0, 0, 0, 0, 0, 0,
-	1771, 0, 0, 0, 0, 2303, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1774, 0,
-	0, 0, 0, 140, 121, 147, 128, 120, 0, 141,
-	142, 0, 0, 0, 157, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 129, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2331, 0, 0, 0, 132, 130,
-	125, 126, 127, 131, 1622, 0, 0, 0, 122, 0,
-	0, 0, 0, 0, 0, 0, 0, 133, 0, 0,
-	0, 0, 2068, 0, 73, 0, 0, 1622, 1622, 1622,
-	1622, 1622, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1882, 0, 0, 1622, 0, 0,
-	1622, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	488, 0, 0, 0, 0, 0, 0, 0, 0, 2389,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2399, 2400, 2401, 1152, 2402, 2403, 0,
-	0, 2407, 0, 0, 0, 2408, 2409, 0, 0, 0,
-	2158, 0, 0, 0, 1883, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2427, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2187, 0, 0, 0, 0, 143, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2441, 0, 0,
-	137, 0, 0, 138, 0, 0, 0, 0, 1927, 0,
-	0, 0, 0, 0, 0, 2213, 2214, 2215, 0, 0,
+	0, 0, 1595, 0, 0, 0, 1037, 1037, 1037, 0,
+	0, 0, 0, 2061, 0, 0, 2457, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 73, 0, 0, 0,
+	0, 1151, 0, 2462, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 715, 715, 0,
+	0, 0, 0, 672, 1109, 1110, 1111, 0, 1114, 0,
+	1116, 1117, 1118, 1119, 0, 1122, 1124, 1124, 0, 1124,
+	1128, 1128, 1130, 1131, 1132, 1133, 1134, 0, 1136, 1137,
+	1138, 1139, 1140, 0, 0, 0, 0, 1128, 1128, 1128,
+	1128, 0, 0, 0, 2147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1595, 0, 0, 0,
+	0, 0, 0, 0, 0, 488, 0, 0, 0, 0,
+	0, 0, 488, 0, 0, 0, 0, 488, 488, 0,
+	0, 488, 0, 1767, 0, 0, 0, 0, 0, 488,
+	0, 0, 0, 0, 0, 0, 488, 0, 0, 0,
+	0, 0, 0, 0, 1161, 0, 0, 672, 0, 0,
+	0, 672, 0, 0, 0, 0, 0, 672, 0, 0,
+	0, 0, 1210, 0, 488, 0, 0, 0, 2208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1793, 0, 0, 0, 1797, 0, 1798, 1799, 0, 0,
+	0, 0, 0, 0, 0, 1807, 0, 0, 1808, 0,
+	0, 0, 0, 0, 0, 0, 546, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 700,
+	0, 0, 0, 0, 1814, 0, 0, 0, 0, 0,
+	0, 1819, 1820, 1821, 1822, 1823, 0, 1591, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1834, 0,
+	0, 0, 0, 0, 0, 700, 700, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1418, 0, 0, 488,
+	0, 0, 0, 0, 0, 0, 0, 1373, 0, 0,
+	2296, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1228, 0, 0, 0, 0, 0, 0, 0, 488,
+	488, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2325, 0,
+	2326, 0, 0, 0, 0, 2329, 2330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2359, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 488, 2367, 0, 0, 2369, 1358,
+	0, 1933, 0, 0, 0, 0, 0, 0, 0, 0,
+	2372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2376, 0, 0, 0, 0, 0, 0, 1399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	546, 0, 488, 488, 488, 488, 488, 0, 0, 1428,
+	0, 0, 0, 0, 0, 0, 1432, 0, 0, 488,
+	488, 0, 0, 0, 0, 0, 0, 1443, 1444, 1445,
+	1446, 1447, 1448, 1449, 0, 488, 0, 0, 0, 2026,
+	2419, 546, 2031, 2032, 0, 0, 0, 0, 0, 700,
+	0, 0, 0, 0, 1037, 1037, 1037, 0, 0, 0,
+	0, 0, 0, 0, 0, 1467, 0, 0, 0, 0,
+	0, 0, 0, 0, 700, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 546, 0, 0, 0, 0, 2072,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 488,
+	0, 0, 0, 0, 0, 0, 2090, 2091, 546, 0,
+	0, 0, 0, 0, 0, 0, 1152, 0, 0, 0,
+	0, 1152, 488, 488, 488, 488, 488, 0, 0, 0,
+	0, 0, 0, 0, 2089, 0, 0, 0, 0, 488,
+	1373, 0, 488, 0, 0, 488, 2098, 1418, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2502, 0, 0, 0, 0, 0,
+	72, 36, 37, 74, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 488, 0, 0, 0,
+	78, 0, 0, 0, 40, 66, 67, 0, 64, 68,
+	0, 0, 1152, 0, 0, 0, 0, 65, 1608, 0,
+	0, 0, 0, 488, 0, 0, 1612, 0, 1615, 0,
+	0, 1467, 0, 488, 0, 0, 0, 0, 2190, 0,
+	0, 0, 0, 0, 0, 0, 53, 0, 488, 0,
+	1622, 488, 2200, 2201, 2203, 2205, 0, 81, 0, 0,
+	0, 0, 2211, 172, 0, 0, 0, 0, 0, 0,
+	2215, 0, 0, 0, 0, 2219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 114, 0, 136,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2458, 0, 0, 0,
+	156, 0, 0, 0, 0, 0, 488, 2240, 2241, 0,
+	0, 2245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2257,
+	0, 146, 0, 0, 0, 0, 135, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 154,
+	0, 488, 0, 0, 0, 123, 124, 145, 144, 171,
+	0, 0, 0, 0, 0, 0, 0, 43, 46, 49,
+	48, 51, 0, 63, 488, 0, 69, 0, 0, 0,
+	0, 0, 0, 2306, 0, 0, 0, 0, 0, 0,
+	0, 0, 488, 0, 0, 488, 488, 488, 52, 77,
+	76, 0, 0, 61, 62, 50, 0, 1467, 0, 0,
+	0, 0, 0, 0, 1750, 0, 0, 0, 0, 1760,
+	1761, 0, 0, 1765, 0, 0, 0, 0, 0, 0,
+	0, 1768, 0, 0, 0, 0, 0, 0, 1771, 0,
+	0, 0, 0, 2336, 0, 54, 55, 0, 56, 57,
+	58, 59, 140, 121, 147, 128, 120, 0, 141, 142,
+	0, 0, 0, 157, 0, 0, 1774, 0, 0, 0,
+	0, 0, 162, 129, 0, 0, 1373, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 130, 125,
+	126, 127, 131, 0, 1152, 0, 0, 122, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2404, 2405, 2406, 0, 2407, 2408, 0, 0,
+	0, 0, 2414, 0, 0, 0, 2415, 2416, 0, 0,
+	0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 149, 0, 0, 0, 0, 80, 0, 0, 0,
+	1817, 0, 0, 0, 0, 0, 0, 0, 0, 2434,
+	0, 0, 0, 0, 0, 0, 0, 1832, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 672, 0, 0, 0, 0, 0, 2448, 0,
+	0, 0, 1883, 0, 172, 0, 0, 0, 0, 0,
+	0, 0, 1862, 1863, 0, 1300, 0, 0, 0, 0,
+	0, 1882, 0, 0, 0, 0, 143, 488, 114, 0,
+	136, 0, 0, 0, 0, 0, 0, 0, 0, 2470,
+	137, 156, 0, 138, 0, 0, 0, 0, 0, 2482,
+	0, 0, 0, 0, 0, 1152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1927, 0, 0, 0,
+	0, 0, 146, 0, 0, 0, 0, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1973, 1974, 1975, 1976,
-	1977, 0, 0, 0, 1622, 0, 0, 0, 0, 0,
-	0, 0, 0, 1467, 1983, 0, 0, 2261, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1991,
-	0, 0, 0, 0, 150, 155, 152, 158, 159, 160,
-	161, 163, 164, 165, 166, 0, 0, 0, 0, 0,
-	167, 168, 169, 170, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2301, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	154, 0, 0, 0, 0, 0, 1304, 1305, 145, 144,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1955, 0, 1973, 1974, 1975, 1976, 1977, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1467, 1983, 150, 155, 152, 158, 159, 160, 161,
+	163, 164, 165, 166, 0, 0, 0, 1991, 0, 167,
+	168, 169, 170, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 1306, 147, 0, 1303, 0, 141,
+	142, 0, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1622, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2070,
+	0, 73, 0, 0, 1622, 1622, 1622, 1622, 1622, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1882, 0, 0, 1622, 0, 0, 1622, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2068, 0,
-	73, 0, 2068, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2137, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 0, 0, 2158, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2160, 0, 0,
+	2179, 0, 81, 2182, 0, 0, 0, 0, 607, 614,
+	615, 616, 617, 618, 608, 610, 0, 0, 0, 609,
+	0, 0, 612, 619, 620, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2189,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2220, 0,
+	0, 137, 0, 0, 138, 2164, 2165, 0, 0, 2214,
+	0, 0, 0, 0, 2216, 2217, 2218, 621, 622, 623,
+	624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
+	634, 635, 636, 637, 638, 639, 640, 641, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 656, 657, 658, 659, 660, 661, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2156, 0, 0,
-	0, 2383, 0, 0, 0, 0, 0, 2165, 0, 0,
+	0, 81, 0, 1622, 0, 0, 2274, 607, 614, 615,
+	616, 617, 618, 608, 610, 0, 2264, 0, 609, 0,
+	0, 612, 619, 620, 2289, 0, 0, 2290, 2291, 2292,
+	0, 0, 0, 0, 150, 155, 152, 158, 159, 160,
+	161, 163, 164, 165, 166, 0, 0, 0, 0, 0,
+	167, 168, 169, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 2304, 0, 2164, 2165, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 621, 622, 623, 624,
+	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 659, 660, 661, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2177, 0, 0, 2180, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2070, 0,
+	73, 0, 2070, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 598, 0, 0,
+	0, 599, 600, 0, 0, 0, 601, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2068, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1133, 0, 0, 0, 0, 0, 0, 2217, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
+	0, 2388, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2271, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2286, 0, 0, 2287, 2288, 2289,
+	2070, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1133, 0, 0, 0, 0, 0, 2447,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 73, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 2464, 0, 2465,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 2440, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 2097, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 2057, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 1610, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	81, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 203, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 1039, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 860, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 730, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 718, 712, 711, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 860, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	1214, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 730, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 718, 712, 711, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 843, 829, 410, 0, 777, 846, 747, 765,
-	856, 768, 771, 811, 726, 790, 333, 762, 0, 751,
-	722, 757, 723, 749, 779, 237, 746, 831, 794, 845,
-	289, 234, 728, 752, 347, 767, 187, 813, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 852, 293, 800, 0, 395, 318, 0, 0,
-	0, 781, 835, 788, 825, 776, 812, 736, 799, 847,
-	763, 808, 848, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 759,
-	805, 842, 760, 807, 232, 277, 239, 231, 414, 853,
-	834, 0, 0, 860, 844, 783, 0, 810, 0, 859,
-	721, 802, 0, 724, 727, 855, 838, 755, 242, 0,
-	0, 0, 0, 0, 0, 0, 780, 789, 822, 774,
-	0, 0, 0, 0, 0, 0, 0, 753, 0, 798,
-	0, 0, 0, 732, 725, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 778, 0, 0, 0,
-	735, 0, 754, 823, 0, 719, 260, 729, 319, 0,
-	827, 837, 775, 447, 841, 773, 772, 817, 733, 833,
-	766, 288, 731, 285, 182, 199, 0, 764, 329, 369,
-	375, 832, 750, 758, 223, 756, 373, 343, 431, 207,
-	250, 366, 348, 371, 797, 815, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	709, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 730, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 745, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 828, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 718, 712, 711, 286, 295, 820, 858,
-	342, 374, 213, 433, 394, 740, 744, 738, 739, 792,
-	793, 741, 849, 850, 851, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 824, 734, 0, 742, 743, 0,
-	830, 839, 840, 483, 796, 181, 196, 291, 854, 363,
-	253, 461, 441, 437, 720, 737, 229, 748, 0, 0,
-	761, 769, 770, 782, 784, 785, 786, 787, 315, 803,
-	804, 806, 814, 816, 819, 821, 826, 836, 857, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	795, 801, 302, 247, 265, 276, 809, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 791, 818, 298, 411,
-	412, 272, 410, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 0, 0, 1558, 0, 564,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 73, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 2476, 0, 2477, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 2099, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 2059, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 1610, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 81, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 203, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 1039, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 860, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 730, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 718, 712, 711, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 860, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 1214, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 730, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 718, 712, 711, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	843, 829, 410, 0, 777, 846, 747, 765, 856, 768,
+	771, 811, 726, 790, 333, 762, 0, 751, 722, 757,
+	723, 749, 779, 237, 746, 831, 794, 845, 289, 234,
+	728, 752, 347, 767, 187, 813, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	852, 293, 800, 0, 395, 318, 0, 0, 0, 781,
+	835, 788, 825, 776, 812, 736, 799, 847, 763, 808,
+	848, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 759, 805, 842,
+	760, 807, 232, 277, 239, 231, 414, 853, 834, 0,
+	0, 860, 844, 783, 0, 810, 0, 859, 721, 802,
+	0, 724, 727, 855, 838, 755, 242, 0, 0, 0,
+	0, 0, 0, 0, 780, 789, 822, 774, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 798, 0, 0,
+	0, 732, 725, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 778, 0, 0, 0, 735, 0,
+	754, 823, 0, 719, 260, 729, 319, 0, 827, 837,
+	775, 447, 841, 773, 772, 817, 733, 833, 766, 288,
+	731, 285, 182, 199, 0, 764, 329, 369, 375, 832,
+	750, 758, 223, 756, 373, 343, 431, 207, 250, 366,
+	348, 371, 797, 815, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 709, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 730, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 745, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 828,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 718, 712, 711, 286, 295, 820, 858, 342, 374,
+	213, 433, 394, 740, 744, 738, 739, 792, 793, 741,
+	849, 850, 851, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 824, 734, 0, 742, 743, 0, 830, 839,
+	840, 483, 814, 796, 181, 196, 291, 854, 363, 253,
+	461, 441, 437, 720, 737, 229, 748, 0, 0, 761,
+	769, 770, 782, 784, 785, 786, 787, 315, 803, 804,
+	806, 816, 819, 821, 826, 836, 857, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 795, 801,
+	302, 247, 265, 276, 809, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 791, 818, 298, 411, 412, 272,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 1558, 0, 564, 0, 0,
+	0, 237, 569, 0, 0, 0, 289, 234, 0, 1559,
+	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
+	240, 236, 221, 273, 305, 345, 404, 339, 576, 293,
+	0, 0, 395, 318, 0, 0, 0, 0, 0, 571,
+	572, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	220, 186, 330, 396, 252, 0, 81, 0, 0, 178,
+	179, 180, 607, 614, 615, 616, 617, 618, 608, 610,
+	0, 0, 211, 609, 218, 585, 612, 619, 620, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 547, 561, 0,
+	575, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 558,
+	559, 698, 0, 0, 0, 592, 0, 560, 0, 0,
+	568, 621, 622, 623, 624, 625, 626, 627, 628, 629,
+	630, 631, 632, 633, 634, 635, 636, 637, 638, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
+	660, 661, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 591, 0, 0, 447,
+	0, 0, 589, 0, 0, 0, 0, 288, 0, 285,
+	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
+	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
+	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
+	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
+	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
+	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
+	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
+	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
+	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
+	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
+	394, 598, 590, 580, 582, 599, 600, 577, 578, 581,
+	601, 465, 466, 467, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
+	593, 567, 566, 0, 573, 574, 0, 583, 584, 586,
+	0, 565, 181, 196, 291, 0, 363, 253, 461, 441,
+	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
+	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
+	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
+	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
+	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
+	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 564,
 	0, 0, 0, 237, 569, 0, 0, 0, 289, 234,
-	0, 1559, 347, 0, 187, 0, 386, 222, 299, 296,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
 	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
 	576, 293, 0, 0, 395, 318, 0, 0, 0, 0,
-	0, 571, 572, 0, 0, 0, 0, 0, 0, 0,
+	0, 571, 572, 0, 0, 0, 0, 0, 0, 1634,
 	0, 279, 220, 186, 330, 396, 252, 0, 81, 0,
 	0, 178, 179, 180, 607, 614, 615, 616, 617, 618,
 	608, 610, 0, 0, 211, 609, 218, 585, 612, 619,
-	620, 0, 232, 277, 239, 231, 414, 0, 0, 0,
+	620, 1635, 232, 277, 239, 231, 414, 0, 0, 0,
 	0, 203, 0, 0, 0, 0, 0, 0, 0, 547,
 	561, 0, 575, 0, 0, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 558, 559, 698, 0, 0, 0, 592, 0, 560,
+	0, 558, 559, 0, 0, 0, 0, 592, 0, 560,
 	0, 0, 568, 621, 622, 623, 624, 625, 626, 627,
 	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
 	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
@@ -2361,80 +2450,80 @@ var yyAct = [...]int{
 	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
 	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
 	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
-	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
-	213, 433, 394, 598, 590, 580, 582, 599, 600, 577,
-	578, 581, 601, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 0, 593, 567, 566, 0, 573, 574, 0, 583,
-	584, 586, 565, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
-	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
-	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
-	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
-	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
-	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
-	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
-	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
-	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
-	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
-	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
-	0, 564, 0, 0, 0, 237, 569, 0, 0, 0,
-	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 576, 293, 0, 0, 395, 318, 0, 0,
-	0, 0, 0, 571, 572, 0, 0, 0, 0, 0,
-	0, 1634, 0, 279, 220, 186, 330, 396, 252, 0,
-	81, 0, 0, 178, 179, 180, 607, 614, 615, 616,
-	617, 618, 608, 610, 0, 0, 211, 609, 218, 585,
-	612, 619, 620, 1635, 232, 277, 239, 231, 414, 0,
-	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
-	0, 547, 561, 0, 575, 0, 0, 0, 242, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 558, 559, 0, 0, 0, 0, 592,
-	0, 560, 0, 0, 568, 621, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 570, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
-	591, 0, 0, 447, 0, 0, 589, 0, 0, 0,
-	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
-	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
-	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
-	342, 374, 213, 433, 394, 598, 590, 580, 582, 599,
-	600, 577, 578, 581, 601, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 593, 567, 566, 0, 573, 574,
-	0, 583, 584, 586, 565, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 72, 410, 298, 411,
-	412, 272, 0, 0, 0, 0, 0, 0, 0, 333,
+	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	213, 433, 394, 598, 590, 580, 582, 599, 600, 577,
+	578, 581, 601, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 593, 567, 566, 0, 573, 574, 0, 583,
+	584, 586, 0, 565, 181, 196, 291, 0, 363, 253,
+	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
+	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 72, 410, 298, 411, 412, 272,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	0, 0, 564, 0, 0, 0, 237, 569, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 576, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 571, 572, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
+	0, 81, 0, 0, 178, 179, 180, 607, 614, 615,
+	616, 617, 618, 608, 610, 0, 0, 211, 609, 218,
+	585, 612, 619, 620, 0, 232, 277, 239, 231, 414,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 547, 561, 0, 575, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 558, 559, 0, 0, 0, 0,
+	592, 0, 560, 0, 0, 568, 621, 622, 623, 624,
+	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 659, 660, 661, 570, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
+	0, 591, 0, 0, 447, 0, 0, 589, 0, 0,
+	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
+	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
+	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
+	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
+	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
+	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
+	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 213, 433, 394, 598, 590, 580, 582,
+	599, 600, 577, 578, 581, 601, 465, 466, 467, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 0, 593, 567, 566, 0, 573,
+	574, 0, 583, 584, 586, 0, 565, 181, 196, 291,
+	80, 363, 253, 461, 441, 437, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
+	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
+	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
+	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
+	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
+	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
+	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
 	0, 0, 0, 0, 564, 0, 0, 0, 237, 569,
 	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
 	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
@@ -2457,7 +2546,7 @@ var yyAct = [...]int{
 	0, 319, 0, 591, 0, 0, 447, 0, 0, 589,
 	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
 	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
-	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
+	343, 431, 207, 250, 366, 348, 371, 2442, 0, 372,
 	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
 	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
 	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
@@ -2474,10 +2563,10 @@ var yyAct = [...]int{
 	580, 582, 599, 600, 577, 578, 581, 601, 465, 466,
 	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
 	477, 478, 479, 480, 481, 482, 0, 593, 567, 566,
-	0, 573, 574, 0, 583, 584, 586, 565, 181, 196,
-	291, 80, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 573, 574, 0, 583, 584, 586, 0, 565, 181,
+	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
 	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
 	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
@@ -2495,7 +2584,7 @@ var yyAct = [...]int{
 	236, 221, 273, 305, 345, 404, 339, 576, 293, 0,
 	0, 395, 318, 0, 0, 0, 0, 0, 571, 572,
 	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 81, 0, 0, 178, 179,
+	186, 330, 396, 252, 0, 81, 0, 1182, 178, 179,
 	180, 607, 614, 615, 616, 617, 618, 608, 610, 0,
 	0, 211, 609, 218, 585, 612, 619, 620, 0, 232,
 	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
@@ -2511,7 +2600,7 @@ var yyAct = [...]int{
 	0, 260, 0, 319, 0, 591, 0, 0, 447, 0,
 	0, 589, 0, 0, 0, 0, 288, 0, 285, 182,
 	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
-	0, 373, 343, 431, 207, 250, 366, 348, 371, 2435,
+	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
 	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
 	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
 	316, 415, 416, 284, 391, 258, 185, 292, 454, 198,
@@ -2528,10 +2617,10 @@ var yyAct = [...]int{
 	598, 590, 580, 582, 599, 600, 577, 578, 581, 601,
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
 	475, 476, 477, 478, 479, 480, 481, 482, 0, 593,
-	567, 566, 0, 573, 574, 0, 583, 584, 586, 565,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	567, 566, 0, 573, 574, 0, 583, 584, 586, 0,
+	565, 181, 196, 291, 0, 363, 253, 461, 441, 437,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
 	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
 	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
@@ -2549,14 +2638,14 @@ var yyAct = [...]int{
 	248, 240, 236, 221, 273, 305, 345, 404, 339, 576,
 	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
 	571, 572, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 81, 0, 1182,
+	279, 220, 186, 330, 396, 252, 0, 81, 0, 0,
 	178, 179, 180, 607, 614, 615, 616, 617, 618, 608,
 	610, 0, 0, 211, 609, 218, 585, 612, 619, 620,
 	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
 	203, 0, 0, 0, 0, 0, 0, 0, 547, 561,
 	0, 575, 0, 0, 0, 242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	558, 559, 0, 0, 0, 0, 592, 0, 560, 0,
+	558, 559, 698, 0, 0, 0, 592, 0, 560, 0,
 	0, 568, 621, 622, 623, 624, 625, 626, 627, 628,
 	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
 	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
@@ -2583,9 +2672,9 @@ var yyAct = [...]int{
 	581, 601, 465, 466, 467, 468, 469, 470, 471, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
 	0, 593, 567, 566, 0, 573, 574, 0, 583, 584,
-	586, 565, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	586, 0, 565, 181, 196, 291, 0, 363, 253, 461,
+	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
 	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
 	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
@@ -2610,7 +2699,7 @@ var yyAct = [...]int{
 	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
 	547, 561, 0, 575, 0, 0, 0, 242, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 559, 698, 0, 0, 0, 592, 0,
+	0, 0, 558, 559, 0, 0, 0, 0, 592, 0,
 	560, 0, 0, 568, 621, 622, 623, 624, 625, 626,
 	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
 	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
@@ -2637,9 +2726,9 @@ var yyAct = [...]int{
 	577, 578, 581, 601, 465, 466, 467, 468, 469, 470,
 	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
 	481, 482, 0, 593, 567, 566, 0, 573, 574, 0,
-	583, 584, 586, 565, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	583, 584, 586, 0, 565, 181, 196, 291, 0, 363,
+	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
 	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
 	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
@@ -2662,7 +2751,7 @@ var yyAct = [...]int{
 	616, 617, 618, 608, 610, 0, 0, 211, 609, 218,
 	585, 612, 619, 620, 0, 232, 277, 239, 231, 414,
 	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
-	0, 0, 547, 561, 0, 575, 0, 0, 0, 242,
+	0, 0, 0, 561, 0, 575, 0, 0, 0, 242,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 558, 559, 0, 0, 0, 0,
 	592, 0, 560, 0, 0, 568, 621, 622, 623, 624,
@@ -2691,10 +2780,10 @@ var yyAct = [...]int{
 	599, 600, 577, 578, 581, 601, 465, 466, 467, 468,
 	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
 	479, 480, 481, 482, 0, 593, 567, 566, 0, 573,
-	574, 0, 583, 584, 586, 565, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	574, 0, 583, 584, 586, 0, 565, 181, 196, 291,
+	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
 	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
 	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
@@ -2706,28 +2795,28 @@ var yyAct = [...]int{
 	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
 	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
 	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 0, 564, 0, 0, 0, 237, 569,
+	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
 	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
 	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
-	273, 305, 345, 404, 339, 576, 293, 0, 0, 395,
-	318, 0, 0, 0, 0, 0, 571, 572, 0, 0,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 81, 0, 0, 178, 179, 180, 607,
-	614, 615, 616, 617, 618, 608, 610, 0, 0, 211,
-	609, 218, 585, 612, 619, 620, 0, 232, 277, 239,
-	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
-	0, 0, 0, 0, 0, 561, 0, 575, 0, 0,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
+	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 203, 0, 908, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 558, 559, 0, 0,
-	0, 0, 592, 0, 560, 0, 0, 568, 621, 622,
-	623, 624, 625, 626, 627, 628, 629, 630, 631, 632,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 656, 657, 658, 659, 660, 661, 570,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 319, 0, 591, 0, 0, 447, 0, 0, 589,
-	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
-	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	0, 319, 0, 0, 0, 907, 447, 0, 0, 0,
+	0, 0, 904, 905, 288, 868, 285, 182, 199, 898,
+	902, 329, 369, 375, 0, 0, 0, 223, 0, 373,
 	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
 	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
 	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
@@ -2741,14 +2830,14 @@ var yyAct = [...]int{
 	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
 	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
 	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
-	295, 0, 0, 342, 374, 213, 433, 394, 598, 590,
-	580, 582, 599, 600, 577, 578, 581, 601, 465, 466,
+	295, 0, 0, 342, 374, 213, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
 	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 481, 482, 0, 593, 567, 566,
-	0, 573, 574, 0, 583, 584, 586, 565, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 483, 0, 0, 181,
+	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
 	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
 	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
@@ -2760,17 +2849,17 @@ var yyAct = [...]int{
 	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
 	214, 385, 405, 406, 407, 409, 314, 233, 349, 410,
 	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
-	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 0, 1202, 0, 0, 0, 0,
 	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
 	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
 	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
 	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
 	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 0, 1204, 0, 0, 0, 0, 0, 0, 0,
 	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
 	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
-	908, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1071, 0, 1072, 1073, 0, 0, 0, 0,
 	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2779,9 +2868,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 319, 0, 0, 0, 907, 447, 0,
-	0, 0, 0, 0, 904, 905, 288, 868, 285, 182,
-	199, 898, 902, 329, 369, 375, 0, 0, 0, 223,
+	0, 260, 0, 319, 0, 0, 0, 0, 447, 0,
+	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
+	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
 	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
 	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
 	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
@@ -2800,9 +2889,9 @@ var yyAct = [...]int{
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
 	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
 	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
 	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
@@ -2814,17 +2903,17 @@ var yyAct = [...]int{
 	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
 	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
 	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 1202, 0, 0,
+	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
 	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
 	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
 	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
 	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 1204, 0, 0, 0, 0, 0,
-	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
+	178, 179, 180, 1147, 1150, 0, 0, 0, 0, 1146,
+	1149, 0, 0, 211, 1145, 218, 0, 0, 0, 0,
 	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 1071, 0, 1072, 1073, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2854,9 +2943,9 @@ var yyAct = [...]int{
 	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	483, 0, 0, 181, 196, 291, 0, 363, 253, 461,
+	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
 	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
 	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
@@ -2867,124 +2956,124 @@ var yyAct = [...]int{
 	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
 	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
 	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 0,
-	0, 0, 178, 179, 180, 1147, 1150, 0, 0, 0,
-	0, 1146, 1149, 0, 0, 211, 1145, 218, 0, 0,
-	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	314, 233, 349, 72, 410, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
+	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
+	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
+	81, 0, 1182, 178, 179, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
+	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 72, 410, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 0, 333, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
-	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
-	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 81, 0, 1182, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
-	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
+	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
+	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
+	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
+	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
+	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
+	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
+	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
+	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
+	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
+	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
+	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
+	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
+	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
+	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
+	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
+	342, 374, 213, 433, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 483, 0, 0, 181, 196, 291, 80,
+	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
+	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
+	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
+	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
+	406, 407, 409, 314, 233, 349, 72, 410, 298, 411,
+	412, 272, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
+	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
+	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
+	396, 252, 0, 81, 0, 0, 178, 179, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
+	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	319, 0, 0, 0, 0, 447, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
-	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
-	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
-	419, 361, 429, 448, 449, 230, 323, 438, 408, 444,
-	460, 200, 227, 337, 401, 434, 392, 316, 415, 416,
-	284, 391, 258, 185, 292, 454, 198, 381, 215, 205,
-	191, 403, 427, 212, 384, 0, 0, 462, 193, 425,
-	400, 312, 281, 282, 192, 0, 365, 235, 256, 225,
-	332, 422, 423, 224, 463, 202, 443, 195, 0, 442,
-	325, 418, 426, 313, 304, 194, 424, 311, 303, 287,
-	246, 267, 359, 297, 360, 268, 321, 320, 322, 188,
-	435, 0, 189, 0, 397, 436, 464, 208, 209, 210,
-	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
-	358, 356, 362, 0, 413, 430, 439, 446, 452, 453,
-	455, 456, 457, 458, 459, 324, 270, 393, 286, 295,
-	0, 0, 342, 374, 213, 433, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	80, 363, 253, 461, 441, 437, 0, 0, 229, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
-	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
-	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
-	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
-	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
-	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
-	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
-	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
-	385, 405, 406, 407, 409, 314, 233, 349, 72, 410,
-	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
+	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
+	205, 191, 403, 427, 212, 384, 0, 0, 462, 193,
+	425, 400, 312, 281, 282, 192, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 463, 202, 443, 195, 0,
+	442, 325, 418, 426, 313, 304, 194, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	188, 435, 0, 189, 0, 397, 436, 464, 208, 209,
+	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
+	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
+	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
+	295, 0, 0, 342, 374, 213, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
+	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 483, 0, 0, 181,
+	196, 291, 80, 363, 253, 461, 441, 437, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 445, 450, 262, 428,
+	451, 0, 300, 0, 0, 302, 247, 265, 276, 0,
+	440, 399, 201, 370, 254, 190, 219, 204, 226, 241,
+	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
+	214, 385, 405, 406, 407, 409, 314, 233, 349, 410,
+	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 0, 1580, 0, 0, 0, 0,
 	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
 	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
 	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
 	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 81, 0, 0, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
+	180, 0, 1374, 0, 0, 0, 0, 0, 0, 0,
 	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
 	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3000,7 +3089,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
 	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
 	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
-	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
+	1578, 372, 294, 419, 361, 429, 448, 449, 230, 323,
 	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
 	316, 415, 416, 284, 391, 258, 185, 292, 454, 198,
 	381, 215, 205, 191, 403, 427, 212, 384, 0, 0,
@@ -3017,9 +3106,9 @@ var yyAct = [...]int{
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
 	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 80, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
 	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
 	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
@@ -3031,19 +3120,19 @@ var yyAct = [...]int{
 	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
 	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
 	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 1580, 0, 0,
+	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
 	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
 	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
 	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
 	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 1374, 0, 0, 0, 0, 0,
+	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
 	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
 	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 862, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3051,10 +3140,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
-	447, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
+	447, 0, 0, 0, 0, 0, 0, 0, 288, 868,
+	285, 182, 199, 866, 0, 329, 369, 375, 0, 0,
 	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 1578, 372, 294, 419, 361, 429, 448, 449,
+	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
 	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
 	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
 	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
@@ -3071,9 +3160,9 @@ var yyAct = [...]int{
 	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	483, 0, 0, 181, 196, 291, 0, 363, 253, 461,
+	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
 	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
 	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
@@ -3085,19 +3174,19 @@ var yyAct = [...]int{
 	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
 	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
 	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 0, 1580,
 	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
 	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
 	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
 	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 279, 220, 186, 330, 396, 252, 0, 0,
-	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 178, 179, 180, 0, 1374, 0, 0, 0,
 	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
 	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
 	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 862, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3106,7 +3195,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
 	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	288, 868, 285, 182, 199, 866, 0, 329, 369, 375,
+	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
 	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
 	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
 	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
@@ -3125,9 +3214,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
 	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
 	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	0, 0, 483, 0, 0, 181, 196, 291, 0, 363,
+	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
 	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
 	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
@@ -3140,13 +3229,13 @@ var yyAct = [...]int{
 	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
 	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
 	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 1580, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
 	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
 	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
 	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 0, 0, 0, 178, 179, 180, 0, 1374, 0,
+	0, 0, 0, 1182, 178, 179, 180, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 211, 0, 218,
 	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
 	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
@@ -3159,7 +3248,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 447, 0, 0, 0, 2338, 0,
 	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
 	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
 	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
@@ -3179,10 +3268,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
 	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
 	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 483, 0, 0, 181, 196, 291,
+	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
 	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
 	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
@@ -3200,21 +3289,21 @@ var yyAct = [...]int{
 	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
 	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 0, 0, 1182, 178, 179, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	1852, 0, 0, 0, 0, 0, 0, 0, 0, 211,
 	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
 	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1853, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
 	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
-	2333, 0, 0, 0, 288, 0, 285, 182, 199, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
 	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
 	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
 	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
@@ -3233,10 +3322,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
 	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
 	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 483, 0, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 483, 0, 0, 181,
+	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
 	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
 	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
@@ -3255,13 +3344,13 @@ var yyAct = [...]int{
 	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
 	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
-	180, 0, 1852, 0, 0, 0, 0, 0, 0, 0,
+	180, 0, 0, 0, 1837, 0, 0, 0, 1838, 0,
 	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
 	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1853,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3288,9 +3377,9 @@ var yyAct = [...]int{
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
 	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
 	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
 	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
@@ -3303,14 +3392,14 @@ var yyAct = [...]int{
 	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
 	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
 	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
+	0, 0, 237, 1225, 0, 0, 0, 289, 234, 0,
 	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
 	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
 	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 0, 0, 1837, 0, 0, 0,
-	1838, 0, 0, 211, 0, 218, 0, 0, 0, 0,
+	178, 179, 180, 0, 1224, 0, 0, 0, 0, 0,
+	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
 	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
 	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
@@ -3342,9 +3431,9 @@ var yyAct = [...]int{
 	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	483, 0, 0, 181, 196, 291, 0, 363, 253, 461,
+	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
 	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
 	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
@@ -3357,13 +3446,13 @@ var yyAct = [...]int{
 	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
 	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
 	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 1225, 0, 0, 0, 289,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
 	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
 	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
 	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 279, 220, 186, 330, 396, 252, 0, 0,
-	0, 0, 178, 179, 180, 0, 1224, 0, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
 	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
 	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
@@ -3376,7 +3465,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 447, 0, 0, 0, 2458, 0, 0, 0,
 	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
 	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
 	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
@@ -3396,9 +3485,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
 	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
 	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	0, 0, 483, 0, 0, 181, 196, 291, 0, 363,
+	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
 	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
 	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
@@ -3430,7 +3519,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 2446, 0,
+	0, 0, 0, 0, 447, 0, 0, 0, 2338, 0,
 	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
 	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
 	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
@@ -3450,10 +3539,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
 	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
 	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 483, 0, 0, 181, 196, 291,
+	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
 	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
 	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
@@ -3471,7 +3560,7 @@ var yyAct = [...]int{
 	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
 	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	396, 252, 0, 81, 0, 0, 178, 179, 180, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
 	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
 	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
@@ -3485,7 +3574,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
 	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
-	2333, 0, 0, 0, 288, 0, 285, 182, 199, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
 	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
 	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
 	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
@@ -3504,10 +3593,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
 	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
 	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 483, 0, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 483, 0, 0, 181,
+	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
 	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
 	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
@@ -3525,8 +3614,8 @@ var yyAct = [...]int{
 	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
 	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 81, 0, 0, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
+	180, 0, 1374, 0, 0, 0, 0, 0, 0, 0,
 	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
 	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3559,9 +3648,9 @@ var yyAct = [...]int{
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
 	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
 	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
 	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
@@ -3572,61 +3661,61 @@ var yyAct = [...]int{
 	276, 0, 440, 399, 201, 370, 254, 190, 219, 204,
 	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
 	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
-	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
-	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
-	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
-	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 1374, 0, 0, 0, 0, 0,
-	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
-	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	349, 0, 410, 298, 411, 412, 272, 1623, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 220, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
+	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
-	447, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
-	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
-	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
-	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
-	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
-	0, 0, 462, 193, 425, 400, 312, 281, 282, 192,
-	0, 365, 235, 256, 225, 332, 422, 423, 224, 463,
-	202, 443, 195, 0, 442, 325, 418, 426, 313, 304,
-	194, 424, 311, 303, 287, 246, 267, 359, 297, 360,
-	268, 321, 320, 322, 188, 435, 0, 189, 0, 397,
-	436, 464, 208, 209, 210, 0, 245, 249, 255, 257,
-	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
-	430, 439, 446, 452, 453, 455, 456, 457, 458, 459,
-	324, 270, 393, 286, 295, 0, 0, 342, 374, 213,
-	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
-	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
-	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
-	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
-	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
-	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
-	445, 450, 262, 428, 451, 0, 300, 0, 0, 302,
-	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
-	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
-	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 0, 410, 298, 411, 412, 272, 1623,
+	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 288,
+	0, 285, 182, 199, 0, 0, 329, 369, 375, 0,
+	0, 0, 223, 0, 373, 343, 431, 207, 250, 366,
+	348, 371, 0, 0, 372, 294, 419, 361, 429, 448,
+	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
+	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
+	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	463, 202, 443, 195, 0, 442, 325, 418, 426, 313,
+	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
+	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
+	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
+	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	213, 433, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 483, 0, 0, 181, 196, 291, 0, 363, 253,
+	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
+	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
+	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
+	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
+	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
 	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
 	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
 	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
@@ -3634,7 +3723,7 @@ var yyAct = [...]int{
 	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
+	0, 0, 0, 178, 179, 180, 0, 1204, 0, 0,
 	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
 	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
 	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
@@ -3667,9 +3756,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
 	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 483, 0, 0, 181, 196, 291, 0,
+	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
 	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
@@ -3688,14 +3777,14 @@ var yyAct = [...]int{
 	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 0, 0, 0, 178, 179, 180, 0, 1204,
+	252, 0, 0, 0, 0, 178, 179, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
 	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
 	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1101, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3721,10 +3810,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
 	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
 	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 181, 196,
+	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
 	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
 	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
@@ -3749,7 +3838,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1101, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3775,10 +3864,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
 	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
 	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
+	181, 196, 291, 1465, 363, 253, 461, 441, 437, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
 	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
 	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
@@ -3790,7 +3879,7 @@ var yyAct = [...]int{
 	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
 	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
 	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 1346, 0, 0, 0, 0, 0,
 	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
 	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
 	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
@@ -3830,9 +3919,9 @@ var yyAct = [...]int{
 	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
 	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 1465, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 181, 196, 291, 0, 363, 253, 461, 441,
+	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
 	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
 	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
@@ -3844,7 +3933,7 @@ var yyAct = [...]int{
 	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
 	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
 	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 1346, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 1344, 0, 0, 0,
 	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
 	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
 	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
@@ -3884,9 +3973,9 @@ var yyAct = [...]int{
 	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
 	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 483, 0, 0, 181, 196, 291, 0, 363, 253,
+	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
 	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
 	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
@@ -3898,7 +3987,7 @@ var yyAct = [...]int{
 	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
 	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
 	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 1344, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 1342, 0,
 	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
 	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
 	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
@@ -3938,9 +4027,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
 	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 483, 0, 0, 181, 196, 291, 0,
+	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
 	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
@@ -3953,7 +4042,7 @@ var yyAct = [...]int{
 	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
 	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
 	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
-	1342, 0, 0, 0, 0, 0, 0, 237, 0, 0,
+	1340, 0, 0, 0, 0, 0, 0, 237, 0, 0,
 	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
 	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
 	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
@@ -3992,10 +4081,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
 	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
 	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 181, 196,
+	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
 	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
 	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
@@ -4007,7 +4096,7 @@ var yyAct = [...]int{
 	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
 	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
 	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 1340, 0, 0, 0, 0, 0, 0, 237,
+	333, 0, 1338, 0, 0, 0, 0, 0, 0, 237,
 	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
 	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
 	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
@@ -4046,10 +4135,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
 	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
 	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
+	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
 	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
 	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
@@ -4061,7 +4150,7 @@ var yyAct = [...]int{
 	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
 	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
 	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 1338, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 1334, 0, 0, 0, 0, 0,
 	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
 	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
 	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
@@ -4101,9 +4190,9 @@ var yyAct = [...]int{
 	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
 	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 181, 196, 291, 0, 363, 253, 461, 441,
+	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
 	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
 	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
@@ -4115,7 +4204,7 @@ var yyAct = [...]int{
 	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
 	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
 	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 1334, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 1332, 0, 0, 0,
 	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
 	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
 	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
@@ -4155,9 +4244,9 @@ var yyAct = [...]int{
 	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
 	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 483, 0, 0, 181, 196, 291, 0, 363, 253,
+	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
 	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
 	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
@@ -4169,7 +4258,7 @@ var yyAct = [...]int{
 	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
 	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
 	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 1332, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 1330, 0,
 	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
 	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
 	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
@@ -4209,9 +4298,9 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
 	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 483, 0, 0, 181, 196, 291, 0,
+	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
 	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
@@ -4224,13 +4313,13 @@ var yyAct = [...]int{
 	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
 	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
 	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
-	1330, 0, 0, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
 	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
 	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
 	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 0, 0, 0, 178, 179, 180, 0, 0,
+	252, 0, 1307, 0, 0, 178, 179, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
 	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
 	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
@@ -4263,10 +4352,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
 	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
 	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 181, 196,
+	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
 	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
 	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
@@ -4278,13 +4367,13 @@ var yyAct = [...]int{
 	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
 	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
 	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	333, 0, 0, 0, 0, 0, 0, 0, 1209, 237,
 	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
 	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
 	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
 	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 279, 220, 186,
-	330, 396, 252, 0, 1307, 0, 0, 178, 179, 180,
+	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	211, 0, 218, 0, 0, 0, 0, 0, 232, 277,
 	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
@@ -4317,10 +4406,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
 	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
 	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
+	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
 	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
 	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
@@ -4333,13 +4422,13 @@ var yyAct = [...]int{
 	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
 	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
 	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
-	1209, 237, 0, 0, 0, 0, 289, 234, 0, 0,
+	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
 	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
 	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
 	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
 	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	179, 180, 0, 1048, 0, 0, 0, 0, 0, 0,
 	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
 	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4372,9 +4461,9 @@ var yyAct = [...]int{
 	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
 	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 181, 196, 291, 0, 363, 253, 461, 441,
+	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
 	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
 	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
@@ -4393,7 +4482,7 @@ var yyAct = [...]int{
 	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 279, 220, 186, 330, 396, 252, 0, 0, 0,
-	0, 178, 179, 180, 0, 1048, 0, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
 	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
 	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4426,10 +4515,10 @@ var yyAct = [...]int{
 	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
 	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
+	0, 483, 0, 0, 181, 196, 291, 0, 363, 253,
+	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	0, 0, 0, 680, 0, 0, 0, 183, 184, 197,
 	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
 	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
 	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
@@ -4459,7 +4548,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	0, 0, 0, 0, 539, 0, 260, 0, 319, 0,
 	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
 	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
 	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
@@ -4480,16 +4569,16 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
 	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 680, 0, 0, 0, 183,
+	0, 0, 0, 483, 0, 0, 181, 196, 291, 0,
+	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
 	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
 	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
 	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
 	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
+	420, 421, 432, 445, 450, 538, 428, 451, 0, 300,
 	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
 	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
 	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
@@ -4513,8 +4602,8 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 539, 0, 260, 0,
-	319, 0, 0, 0, 0, 447, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	319, 0, 0, 486, 0, 447, 0, 0, 0, 0,
 	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
 	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
 	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
@@ -4534,16 +4623,16 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
 	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
 	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 181, 196,
+	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
 	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
 	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
 	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
 	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 538, 428, 451,
+	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
 	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
 	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
 	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
@@ -4568,7 +4657,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 0, 486, 0, 447, 0, 0,
+	260, 0, 319, 0, 0, 0, 0, 447, 0, 0,
 	0, 0, 0, 0, 0, 288, 0, 285, 182, 199,
 	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
 	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
@@ -4588,10 +4677,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
 	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
 	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
+	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
 	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
 	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
@@ -4602,93 +4691,28 @@ var yyAct = [...]int{
 	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
 	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
 	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 81, 0, 298, 411, 412, 272, 607, 614,
-	615, 616, 617, 618, 608, 610, 0, 0, 0, 609,
-	0, 0, 612, 619, 620, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2162, 2163, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 621, 622, 623,
-	624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
-	634, 635, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661,
+	0, 0, 298, 411, 412, 272,
 }
 
 var yyPact = [...]int{
-	3192, -1000, -387, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	4694, -1000, -384, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1706, 1738, -1000, -1000, -1000, -1000, 1835,
-	-1000, 671, 1538, -1000, 1722, 4719, -1000, 33343, 430, -1000,
-	32801, 429, 79, 33343, -1000, 103, -1000, 80, 33343, 98,
-	32259, -1000, -1000, -303, 13828, 1668, -8, -11, 33343, -1000,
-	1809, 1554, -1000, 280, -1000, -1000, -1000, -1000, -1000, -1000,
-	31717, -1000, -1000, -1000, 1728, 1707, 1844, 542, 1663, -1000,
-	1775, 1554, -1000, 13828, 1800, 1761, 13286, -1000, 13286, 357,
-	-1000, -1000, 10027, -1000, -1000, 18166, 33343, 33343, 276, -1000,
-	1722, -1000, -1000, 341, -1000, 263, 1482, -1000, 1481, -1000,
-	544, 398, 284, 392, 385, 283, 282, 281, 279, 278,
-	275, 266, 265, 288, -1000, 591, 591, -186, -187, 271,
-	329, 329, 329, 379, 1692, 1691, -1000, 555, -1000, 591,
-	591, 261, 591, 591, 591, 591, 211, 209, 591, 591,
-	591, 591, 591, 591, 591, 591, 591, 591, 591, 591,
-	591, 591, 591, 241, 1722, 157, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1651, 1727, -1000, -1000, -1000, -1000, 1805,
+	-1000, 669, 1465, -1000, 1658, 4778, -1000, 33691, 383, -1000,
+	33149, 370, 231, 33691, -1000, 117, -1000, 110, 33691, 113,
+	32607, -1000, -1000, -300, 14176, 1618, 10, 8, 33691, -1000,
+	1778, 1430, -1000, 298, -1000, -1000, -1000, -1000, -1000, -1000,
+	32065, -1000, -1000, -1000, 1667, 1653, 1814, 591, 1611, -1000,
+	1716, 1430, -1000, 14176, 1765, 1715, 13634, -1000, 13634, 328,
+	-1000, -1000, 10375, -1000, -1000, 18514, 33691, 33691, 207, -1000,
+	1658, -1000, -1000, 280, -1000, 232, 1396, -1000, 1395, -1000,
+	650, 542, 266, 386, 371, 265, 264, 262, 261, 258,
+	257, 256, 252, 270, -1000, 628, 628, -148, -188, 315,
+	306, 306, 306, 336, 1635, 1634, -1000, 701, -1000, 628,
+	628, 279, 628, 628, 628, 628, 214, 187, 628, 628,
+	628, 628, 628, 628, 628, 628, 628, 628, 628, 628,
+	628, 628, 628, 245, 1658, 175, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4719,32 +4743,32 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 33343, 126, 33343, -1000, 475, 33343,
-	684, 684, 9, 684, 684, 684, 684, 112, 520, -17,
-	-1000, 110, 202, 74, 148, 691, 145, 102, -1000, -1000,
-	143, 691, 1125, 553, 82, -1000, 684, 7827, 7827, 7827,
-	-1000, 1701, -1000, -1000, -1000, -1000, -1000, -1000, 1136, -1000,
-	373, -1000, -1000, -1000, -1000, 33343, 31175, 231, 620, -1000,
-	-1000, -1000, 64, -1000, -1000, 1321, 912, 13828, 1120, -1000,
-	2376, 522, -1000, -1000, -1000, -1000, -1000, 455, 14370, 14370,
-	14370, 14370, -1000, -1000, 1489, 1489, 1489, 1489, 14370, 1489,
-	14370, 1489, 1489, 1489, 1489, 13828, 1489, 1489, 1489, -1000,
-	1489, 1489, 1489, 1489, 1489, 1489, 1489, 1489, 474, 1489,
-	1489, 1489, 1489, 1489, -1000, -1000, -1000, -1000, 1489, 1489,
-	1489, 1489, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 15996, -1000, 11660, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 33691, 146, 33691, -1000, 487, 33691,
+	745, 745, 38, 745, 745, 745, 745, 130, 516, 1,
+	-1000, 127, 168, 114, 179, 726, 132, 108, -1000, -1000,
+	172, 726, 1096, 604, 105, -1000, 745, 8175, 8175, 8175,
+	-1000, 1645, -1000, -1000, -1000, -1000, -1000, -1000, 1101, -1000,
+	334, -1000, -1000, -1000, -1000, 33691, 31523, 281, 647, -1000,
+	-1000, -1000, 112, -1000, -1000, 1252, 698, 14176, 860, -1000,
+	1660, 536, -1000, -1000, -1000, -1000, -1000, 484, 14718, 14718,
+	14718, 14718, -1000, -1000, 1401, 1401, 1401, 1401, 14718, 1401,
+	14718, 1401, 1401, 1401, 1401, 14176, 1401, 1401, 1401, -1000,
+	1401, 1401, 1401, 1401, 1401, 1401, 1401, 1401, 479, 1401,
+	1401, 1401, 1401, 1401, -1000, -1000, -1000, -1000, 1401, 1401,
+	1401, 1401, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 16344, -1000, 12008, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 33343, -1000, 1489, 104, 1775, 1554, -1000,
-	1809, 1797, 280, -1000, 1958, 1352, 1404, 1163, 1554, 1447,
-	33343, -1000, 1492, -1000, -1000, -1000, 1618, 1069, 1121, -1000,
-	-1000, -1000, -1000, 1162, 13828, -1000, -1000, 1828, -1000, 15454,
-	471, 807, 1825, 30633, -1000, 357, 357, 1454, 9477, -26,
-	-1000, -1000, -1000, 615, 20876, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 33691, -1000, 1401, 125, 1716, 1430, -1000,
+	1778, 1722, 298, -1000, 1694, 1295, 1247, 1104, 1430, 1387,
+	33691, -1000, 1403, -1000, -1000, -1000, 1566, 1027, 1092, -1000,
+	-1000, -1000, -1000, 981, 14176, -1000, -1000, 1802, -1000, 15802,
+	476, 804, 1784, 30981, -1000, 328, 328, 1392, 9825, -31,
+	-1000, -1000, -1000, 646, 21224, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1701, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1645, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4757,211 +4781,215 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1433, 33343, -1000, -1000, 2358, 1089, -1000, 1536, -1000,
-	1431, -1000, 1507, 1552, 422, 1089, 406, 400, 399, -1000,
-	-99, -1000, -1000, -1000, -1000, -1000, 591, 591, -1000, 270,
-	1793, 4719, 3922, -1000, -1000, -1000, 30091, 1534, 1089, -1000,
-	1530, -1000, 704, 425, 484, 484, 1089, -1000, -1000, 33343,
-	1089, 703, 699, 33343, 33343, -1000, 29549, -1000, 29007, 28465,
-	954, 33343, 27923, 27381, 26839, 26297, 25755, -1000, 1597, -1000,
-	1535, -1000, -1000, -1000, 33343, 33343, 33343, 262, -1000, -1000,
-	33343, 1089, -1000, -1000, 950, 948, 591, 591, 945, 1116,
-	1115, 1108, 591, 591, 944, 1103, 23044, 193, 937, 924,
-	918, 1004, 1102, 174, 980, 920, 914, 33343, 1529, 33343,
-	-1000, 128, 665, 295, 613, 1722, 1666, 1452, 372, 412,
-	1089, 346, 346, -1000, 8377, -1000, -1000, 1101, 13828, -1000,
-	729, 691, 691, -1000, -1000, -1000, -1000, -1000, -1000, 684,
-	33343, 729, -1000, -1000, -1000, 691, 684, 33343, 684, 684,
-	684, 684, 691, 691, 691, 684, 33343, 33343, 33343, 33343,
-	33343, 33343, 33343, 33343, 33343, 7827, 7827, 7827, 553, 684,
-	-307, -1000, 1095, -1000, 1577, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 97, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -110, 1451, 25213, -1000, -308, -309,
-	-313, -319, -1000, -1000, -1000, -321, -323, -1000, -1000, -1000,
-	13828, 13828, 13828, 13828, -1000, 737, 14370, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 857, 616, 14370, 14370, 14370, 14370,
-	14370, 14370, 14370, 14370, 14370, 14370, 14370, 14370, 14370, 14370,
-	14370, 689, 1094, 1092, 522, 522, 522, 522, -1000, 13286,
-	13828, 13828, 522, -1000, 1089, 24671, 13286, 13286, 13828, 1700,
-	625, 912, 33343, -1000, 1163, -1000, -1000, -1000, 858, -1000,
-	33343, 33343, 1152, 13828, 10575, 8377, 13286, 13286, 13286, 13286,
-	13286, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 470, 1337, 1396, 1427, -1000, 1448, -1000, -167,
-	17624, 13828, 1088, -1000, -1000, -1000, 1775, -1000, 1775, 1337,
-	1790, 1621, 13286, -1000, -1000, 1790, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1213, -1000, 33343, 1447, 1758, 33343,
-	1616, 1083, 292, -1000, 13828, 13828, 1445, -1000, 989, 33343,
-	-1000, -1000, 24129, -1000, -1000, 7277, -1000, 33343, 259, 33343,
-	-1000, 22502, 23587, 8927, -26, -1000, 8927, 1369, -1000, -40,
-	-47, 11117, 524, -1000, -1000, -1000, 271, 14912, 1255, 524,
-	30, -1000, -1000, -1000, 1507, -1000, 1507, 1507, 1507, 1507,
-	292, 292, 292, 292, -1000, -1000, -1000, -1000, -1000, 1526,
-	1524, -1000, 1507, 1507, 1507, 1507, -1000, -1000, -1000, -1000,
+	-1000, 1369, 33691, -1000, -1000, 3366, 1105, -1000, 1463, -1000,
+	1365, -1000, 1416, 1473, 362, 1105, 354, 351, 350, -1000,
+	-85, -1000, -1000, -1000, -1000, -1000, 628, 628, -1000, 246,
+	1760, 4778, 5159, -1000, -1000, -1000, 30439, 1461, 1105, -1000,
+	1456, -1000, 715, 418, 483, 483, 1105, -1000, -1000, 33691,
+	1105, 714, 713, 33691, 33691, -1000, 29897, -1000, 29355, 28813,
+	957, 33691, 28271, 27729, 27187, 26645, 26103, -1000, 1529, -1000,
+	1462, -1000, -1000, -1000, 33691, 33691, 33691, 291, -1000, -1000,
+	33691, 1105, -1000, -1000, 954, 950, 628, 628, 949, 1090,
+	1089, 1085, 628, 628, 945, 1081, 23392, 213, 944, 940,
+	939, 938, 1076, 174, 892, 877, 924, 33691, 1455, 33691,
+	-1000, 161, 494, 329, 641, 1658, 1614, 1390, 333, 360,
+	1105, 322, 322, -1000, 8725, -1000, -1000, 1075, 14176, -1000,
+	739, 726, 726, -1000, -1000, -1000, -1000, -1000, -1000, 745,
+	33691, 739, -1000, -1000, -1000, 726, 745, 33691, 745, 745,
+	745, 745, 726, 726, 726, 745, 33691, 33691, 33691, 33691,
+	33691, 33691, 33691, 33691, 33691, 8175, 8175, 8175, 604, 745,
+	-307, -1000, 1070, -1000, 1512, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 111, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -107, 1389, 25561, -1000, -308, -309,
+	-310, -311, -1000, -1000, -1000, -313, -318, -1000, -1000, -1000,
+	14176, 14176, 14176, 14176, -1000, 835, 14718, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 808, 645, 14718, 14718, 14718, 14718,
+	14718, 14718, 14718, 14718, 14718, 14718, 14718, 14718, 14718, 14718,
+	14718, 649, 1053, 1049, 536, 536, 536, 536, -1000, 13634,
+	14176, 14176, 536, -1000, 1105, 25019, 13634, 13634, 14176, 1644,
+	660, 698, 33691, -1000, 1104, -1000, -1000, -1000, 864, -1000,
+	33691, 33691, 1128, 14176, 10923, 8725, 13634, 13634, 13634, 13634,
+	13634, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 474, 1352, 1196, 1350, -1000, 1388, -1000, -172,
+	17972, 14176, 1046, -1000, -1000, -1000, 1716, -1000, 1716, 1352,
+	1640, 1569, 13634, -1000, -1000, 1640, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1197, -1000, 33691, 1387, 1703, 33691,
+	1548, 1044, 410, -1000, 14176, 14176, 1386, -1000, 790, 33691,
+	-1000, -1000, 24477, -1000, -1000, 7625, -1000, 33691, 250, 33691,
+	-1000, 22850, 23935, 9275, -31, -1000, 9275, 1360, -1000, -15,
+	-39, 11465, 565, -1000, -1000, -1000, 315, 15260, 1190, 565,
+	40, -1000, -1000, -1000, 1416, -1000, 1416, 1416, 1416, 1416,
+	410, 410, 410, 410, -1000, -1000, -1000, -1000, -1000, 1452,
+	1431, -1000, 1416, 1416, 1416, 1416, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1523, 1523, 1523, 1513, 1513, 316, -1000,
-	13828, 221, 33343, 1732, 911, 128, 347, 1560, 1089, 1089,
-	1089, 347, -1000, 1314, 1306, -1000, -379, 1438, -1000, -1000,
-	1791, -1000, -1000, 730, 738, 734, 487, 33343, 116, 253,
-	-1000, 313, -1000, 33343, 1089, 690, 484, 1089, -1000, 1089,
-	-1000, -1000, -1000, -1000, -1000, 1089, 1437, -1000, 1479, 837,
-	725, 723, 705, 1437, -1000, -1000, -122, 1437, -1000, 1437,
-	-1000, 1437, -1000, 1437, -1000, 1437, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 605, 33343, 116, 689, -1000, 371,
-	-1000, -1000, 689, 689, -1000, -1000, -1000, -1000, 1075, 1067,
+	-1000, -1000, -1000, 1429, 1429, 1429, 1417, 1417, 299, -1000,
+	14176, 237, 33691, 1685, 923, 161, 326, 1497, 1105, 1105,
+	1105, 326, -1000, 1262, 1216, -1000, -373, 1385, -1000, -1000,
+	1759, -1000, -1000, 671, 744, 727, 554, 33691, 144, 233,
+	-1000, 292, -1000, 33691, 1105, 710, 483, 1105, -1000, 1105,
+	-1000, -1000, -1000, -1000, -1000, 1105, 1383, -1000, 1338, 767,
+	725, 753, 723, 1383, -1000, -1000, -114, 1383, -1000, 1383,
+	-1000, 1383, -1000, 1383, -1000, 1383, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 577, 33691, 144, 649, -1000, 331,
+	-1000, -1000, 649, 649, -1000, -1000, -1000, -1000, 1031, 1028,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -364, 33343, -1000,
-	127, 611, 230, 268, 192, 33343, 132, 1765, 190, 178,
-	33343, 33343, 346, 1575, 33343, 1750, 33343, -1000, -1000, -1000,
-	-1000, 912, 33343, -1000, -1000, 684, 684, -1000, -1000, 33343,
-	684, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 684,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -363, 33691, -1000,
+	143, 638, 220, 249, 204, 33691, 133, 1696, 171, 186,
+	33691, 33691, 322, 1511, 33691, 1684, 33691, -1000, -1000, -1000,
+	-1000, 698, 33691, -1000, -1000, 745, 745, -1000, -1000, 33691,
+	745, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 745,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 33343, 33343, -1000,
-	-1000, -1000, -1000, -1000, 210, -42, 274, -1000, -1000, -1000,
-	-1000, -1000, 1771, -1000, 912, 672, 645, -1000, -1000, -1000,
-	884, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 857, 14370,
-	14370, 14370, 1238, 378, 1318, 940, 847, 783, 783, 707,
-	707, 523, 523, 523, 523, 523, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1402, -1000, 933, 1104, 1163, -1000, 1402,
-	1402, 1150, 13286, -1000, -1000, 698, -1000, 13828, 1163, -1000,
-	-1000, 1163, 1420, 1416, 1824, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 33691, 33691, -1000,
+	-1000, -1000, -1000, -1000, 150, -19, 274, -1000, -1000, -1000,
+	-1000, -1000, 1709, -1000, 698, 700, 694, -1000, -1000, -1000,
+	850, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 808, 14718,
+	14718, 14718, 1925, 376, 2276, 936, 1418, 782, 782, 795,
+	795, 569, 569, 569, 569, 569, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1318, -1000, 1140, 891, 1104, -1000, 1318,
+	1318, 1054, 13634, -1000, -1000, 663, -1000, 14176, 1104, -1000,
+	-1000, 1104, 1380, 1379, 1783, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 843, 1163, 13286, 13286,
-	1398, 1489, 467, -1000, 1402, 1163, 1163, 1402, 1402, 8377,
-	1163, -1000, 33343, -1000, -299, -1000, -61, 501, 1489, -1000,
-	23044, 1163, 1321, -1000, -1000, -1000, -1000, -1000, 20334, 1486,
-	1790, -1000, -1000, 1489, 1418, -1000, -1000, 292, 8, 19792,
-	-1000, -1000, 912, 912, 13828, -1000, -1000, -1000, -1000, -1000,
-	-1000, 466, 1815, 273, 1489, -1000, 1450, 1702, -1000, -1000,
-	-1000, 1757, 17082, 33343, 1487, 1444, -1000, 465, -1000, 1369,
-	-26, -53, -1000, -1000, -1000, -1000, 912, -1000, 1288, 264,
-	352, -1000, 335, -1000, -1000, -1000, -1000, 1679, 14, -1000,
-	-1000, -1000, 292, 292, -1000, -1000, -1000, -1000, -1000, -1000,
-	1066, 1066, -1000, -1000, -1000, -1000, -1000, 892, -1000, -1000,
-	-1000, 890, -1000, -1000, 1216, 1587, 221, -1000, -1000, 591,
-	1043, 1684, 33343, -1000, -1000, 1234, 127, 33343, 640, 1574,
-	-1000, 1560, 1560, 1560, 33343, -1000, -1000, -1000, -1000, -368,
-	46, 267, -1000, -1000, -1000, 2838, 33343, 1412, -1000, 113,
-	-1000, 1211, 1630, 33343, -1000, 1409, 1522, 1089, 1089, -1000,
-	-1000, -1000, 33343, 1489, -1000, -1000, -1000, -1000, 411, 1716,
-	1715, 116, 113, 524, 1089, -1000, -1000, -1000, -1000, -1000,
-	-371, 1406, 383, 118, 226, 33343, 33343, 33343, 33343, 33343,
-	443, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 170,
-	369, -1000, 33343, 33343, 444, -1000, -1000, -1000, 691, -1000,
-	-1000, 691, -1000, -1000, -1000, -1000, -1000, 1703, 33343, -49,
-	-338, -1000, -335, -1000, -1000, -1000, -1000, 977, 365, 1318,
-	14370, 14370, 13286, -116, 257, 257, 689, -1000, -1000, -1000,
-	13828, 13828, 1276, 617, -1000, 13828, 769, -1000, -1000, 13828,
-	13828, 13828, 1163, 219, -1000, 1402, 1402, 13286, 8377, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 397,
-	396, 380, 33343, -1000, -1000, 1772, -1000, 1627, 1625, 1811,
-	1815, -1000, 22502, 1790, -1000, -1000, 33343, -291, -1000, 1662,
-	1651, -1000, -1000, -1000, -1000, 6727, 1775, 13828, 1573, 33343,
-	1489, -1000, 16539, 33343, 33343, 22502, 22502, 22502, 22502, 22502,
-	-1000, 1605, 1604, -1000, 1595, 1592, 1596, 33343, -1000, 1400,
-	1163, 1797, 17082, 18708, 1241, 22502, -1000, -1000, 22502, 33343,
-	6177, -1000, -1000, -54, -66, -1000, -1000, -1000, -1000, 271,
-	-1000, -1000, 887, 1755, 1673, -1000, -1000, -1000, -1000, 1392,
-	-1000, 1390, 1350, 1388, 94, -1000, 1551, 1698, 591, 591,
-	-1000, 888, -1000, 1089, -1000, -1000, 381, -1000, 1735, 33343,
-	1572, 1571, 1570, -1000, -378, 883, 1521, 1550, 13828, 1520,
-	1789, 1326, 33343, -1000, -1000, 33343, -1000, -1000, 358, -1000,
-	221, 33343, -1000, -1000, -1000, 253, 33343, -1000, 33809, 113,
-	-1000, -1000, -1000, -1000, -1000, -1000, 33343, 142, -1000, 1516,
-	1007, -1000, -1000, 1494, -1000, -1000, -1000, -1000, 119, 229,
-	-1000, 33343, 426, 1587, 33343, -1000, -1000, -1000, 684, 684,
-	-1000, -1000, 1697, -1000, 1089, 14370, 14370, -1000, 522, -1000,
-	1489, 1163, 1507, 1507, -1000, 1507, 1513, -1000, 1507, 85,
-	1507, 81, 1507, -1000, -1000, 1163, 1163, 780, 854, -107,
-	-1000, 912, 13828, 1249, 1227, 1140, -1000, 1505, 1504, -1000,
-	-1000, 1163, -1000, 1489, 1489, 1489, 1364, 33343, -1000, -1000,
-	-1000, -1000, 1815, 1810, 1348, -1000, -1000, 8, 325, -1000,
-	1646, 1651, -1000, 1788, 1657, 1786, -1000, -1000, -1000, 912,
-	-1000, 1725, 1446, -1000, 590, 1282, -1000, -1000, 12744, 1367,
-	1624, 463, 1364, 1475, 1702, 1557, 1568, 1527, -1000, -1000,
-	-1000, -1000, 1603, -1000, 1588, -1000, -1000, 1492, -1000, -1000,
-	1396, 259, 22502, 1335, 1335, -1000, 458, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 785, 4190, 1839, -1000, 1028, -1000,
-	1018, -1000, 675, 660, -1000, 33343, 1501, -1000, 292, 1027,
-	292, 879, -1000, 877, -1000, -1000, -232, -1000, -1000, 1498,
-	1581, -1000, -1000, 33343, -1000, -1000, 33343, 33343, 33343, 1500,
-	1784, -1000, 13828, 1499, 588, 1190, 33343, 33343, -1000, -1000,
-	242, 1489, -1000, 1341, 1313, -1000, -189, -1000, 13828, -1000,
-	-1000, -1000, 973, 973, -1000, 1492, -1000, -1000, -1000, 1203,
-	-1000, -1000, -126, 33343, 33343, 33343, 33343, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 522, 14370, -1000, -1000,
-	292, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	13828, -1000, 13828, -1000, 1775, 1023, 912, 13828, 13828, -1000,
-	875, 852, -1000, 19250, 21960, 21960, 18708, -1000, 1810, 1806,
-	1783, 1642, 1648, 1648, 1646, -1000, 1781, 1778, -1000, 1009,
-	1777, 1005, 653, -1000, 33343, 13828, 1489, -1000, 277, 33343,
-	1489, 33343, -1000, 1802, -1000, -1000, 13828, 1497, -1000, 13828,
-	-1000, -1000, -1000, -1000, -1000, 1815, 1335, -1000, -1000, 535,
-	-1000, 13828, -1000, -1000, -1000, 404, -1000, -1000, -1000, -1000,
-	-1000, 1493, 13828, -1000, -1000, -1000, 1193, 1186, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1492, -1000, -1000, -1000,
-	-1000, 253, -376, 1154, 33343, 826, -1000, 1250, 1326, 421,
-	-1000, 13828, 303, -1000, 253, -1000, -194, -196, 1140, -1000,
-	-1000, 1754, -1000, -1000, 8377, -1000, -1000, 1491, 1558, -1000,
-	328, -1000, 1140, 1140, 1163, -1000, 1140, 1140, 1184, 1165,
-	1237, -1000, -1000, -1000, 1237, 1237, 501, 1806, -1000, 13828,
-	13828, 1640, 832, -1000, -1000, -1000, -1000, 986, 985, -1000,
-	970, -1000, 1838, -1000, 912, -1000, 1489, -1000, 447, 1282,
-	-1000, 1775, 912, 33343, 912, 1802, -1000, 1140, -1000, 1489,
-	33343, 1079, -1000, -1000, 1752, 1218, 44, -1000, 1202, -1000,
-	-1000, -1000, -1000, 12202, -1000, -1000, -1000, -1000, -1000, -1000,
-	280, 1323, -1000, 573, 33343, 33343, 1163, 234, -135, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 21418, -1000, -1000, -1000,
-	-1000, -1000, 912, 1321, -1000, 795, -1000, -1000, -1000, -1000,
-	-1000, 33343, 1282, 33343, -1000, 1196, 1775, -1000, 1181, -1000,
-	280, -1000, 1485, 1550, -1000, 1163, -124, 8377, 5627, 1151,
-	-1000, -1000, 1615, -119, -143, -1000, -1000, -1000, -1000, 1259,
-	-1000, -1000, -1000, 1566, 726, -124, 13828, 1484, -1000, -1000,
-	-48, -1000, -1000, -1000, -1000, -1000, 1494, -1000, 1610, -1000,
-	1564, -1000, 1820, -1000, -1000, -1000, 680, 964, -1000, -1000,
-	-1000, -1000, 790, 33343, 315, -1000, -1000, -126, -129, -1000,
-	1822, 604, 604, -1000, -1000, -384, 1146, 138, -1000, -137,
-	-1000, -1000, -1000, 312, 861, -1000, -1000, 817, -384, -1000,
-	-174, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 838, 1104, 13634, 13634,
+	1373, 1401, 457, -1000, 1318, 1104, 1104, 1318, 1318, 8725,
+	1104, -1000, 33691, -1000, -295, -1000, -47, 471, 1401, -1000,
+	23392, 1104, 1252, -1000, -1000, -1000, -1000, -1000, 20682, 1335,
+	1640, -1000, -1000, 1401, 1347, -1000, -1000, 410, 27, 20140,
+	-1000, -1000, 698, 698, 14176, -1000, -1000, -1000, -1000, -1000,
+	-1000, 452, 1772, 271, 1401, -1000, 1250, 1567, -1000, -1000,
+	-1000, 1702, 17430, 33691, 1393, 1213, -1000, 443, -1000, 1360,
+	-31, -42, -1000, -1000, -1000, -1000, 698, -1000, 1178, 251,
+	269, -1000, 313, -1000, -1000, -1000, -1000, 1626, 29, -1000,
+	-1000, -1000, 410, 410, -1000, -1000, -1000, -1000, -1000, -1000,
+	1024, 1024, -1000, -1000, -1000, -1000, -1000, 920, -1000, -1000,
+	-1000, 914, -1000, -1000, 1113, 1519, 237, -1000, -1000, 628,
+	1018, 1628, 33691, -1000, -1000, 1161, 143, 33691, 653, 1510,
+	-1000, 1497, 1497, 1497, 33691, -1000, -1000, -1000, -1000, -365,
+	51, 286, -1000, -1000, -1000, 2466, 33691, 1341, -1000, 131,
+	-1000, 1149, 1585, 33691, -1000, 1325, 1427, 1105, 1105, -1000,
+	-1000, -1000, 33691, 1401, -1000, -1000, -1000, -1000, 357, 1657,
+	1652, 144, 131, 565, 1105, -1000, -1000, -1000, -1000, -1000,
+	-366, 1323, 345, 134, 253, 33691, 33691, 33691, 33691, 33691,
+	404, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 183,
+	330, -1000, 33691, 33691, 450, -1000, -1000, -1000, 726, -1000,
+	-1000, 726, -1000, -1000, -1000, -1000, -1000, 1648, 33691, -26,
+	-333, -1000, -329, -1000, -1000, -1000, -1000, 1469, 373, 2276,
+	14718, 14718, 13634, -106, 941, 941, 649, -1000, -1000, -1000,
+	14176, 14176, 1291, 652, -1000, 14176, 685, -1000, -1000, 14176,
+	14176, 14176, 1104, 222, -101, 1318, 1318, 13634, 8725, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 349,
+	348, 347, 33691, -1000, -1000, 1741, -1000, 1575, 1574, 1776,
+	1772, -1000, 22850, 1640, -1000, -1000, 33691, -290, -1000, 1610,
+	1604, -1000, -1000, -1000, -1000, 7075, 1716, 14176, 1509, 33691,
+	1401, -1000, 16887, 33691, 33691, 22850, 22850, 22850, 22850, 22850,
+	-1000, 1537, 1531, -1000, 1534, 1527, 1543, 33691, -1000, 1316,
+	1104, 1722, 17430, 19056, 1376, 22850, -1000, -1000, 22850, 33691,
+	6525, -1000, -1000, -29, -25, -1000, -1000, -1000, -1000, 315,
+	-1000, -1000, 1437, 1701, 1624, -1000, -1000, -1000, -1000, 1313,
+	-1000, 1308, 1345, 1306, 109, -1000, 1467, 1646, 628, 628,
+	-1000, 912, -1000, 1105, -1000, -1000, 339, -1000, 1674, 33691,
+	1508, 1506, 1504, -1000, -379, 910, 1425, 1423, 14176, 1421,
+	1757, 1271, 33691, -1000, -1000, 33691, -1000, -1000, 353, -1000,
+	237, 33691, -1000, -1000, -1000, 233, 33691, -1000, 5568, 131,
+	-1000, -1000, -1000, -1000, -1000, -1000, 33691, 160, -1000, 1420,
+	980, -1000, -1000, 1484, -1000, -1000, -1000, -1000, 136, 219,
+	-1000, 33691, 449, 1519, 33691, -1000, -1000, -1000, 745, 745,
+	-1000, -1000, 1643, -1000, 1105, 14718, 14718, -1000, 536, -1000,
+	1401, 1104, 1416, 1416, -1000, 1416, 1417, -1000, 1416, 93,
+	1416, 88, 1416, -1000, -1000, 1104, 1104, 887, 867, -92,
+	-1000, 698, 14176, 1134, 977, 1074, -1000, 1415, 1414, -1000,
+	1401, -1000, -1000, 1104, -1000, 1401, 1401, 1401, 1300, 33691,
+	-1000, -1000, -1000, -1000, 1772, 1744, 1283, -1000, -1000, 27,
+	343, -1000, 1599, 1604, -1000, 1756, 1606, 1755, -1000, -1000,
+	-1000, 698, -1000, 1661, 1225, -1000, 624, 1257, -1000, -1000,
+	13092, 1303, 1572, 441, 1300, 1356, 1567, 1478, 1503, 1861,
+	-1000, -1000, -1000, -1000, 1530, -1000, 1523, -1000, -1000, 1403,
+	-1000, -1000, 1196, 250, 22850, 1311, 1311, -1000, 425, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 759, 5439, 1811, -1000,
+	1016, -1000, 1036, -1000, 709, 708, -1000, 33691, 1412, -1000,
+	410, 1015, 410, 890, -1000, 871, -1000, -1000, -232, -1000,
+	-1000, 1426, 1480, -1000, -1000, 33691, -1000, -1000, 33691, 33691,
+	33691, 1411, 1754, -1000, 14176, 1409, 621, 1099, 33691, 33691,
+	-1000, -1000, 226, 1401, -1000, 1297, 1293, -1000, -136, -1000,
+	14176, -1000, -1000, -1000, 886, 886, -1000, 1403, -1000, -1000,
+	-1000, 1146, -1000, -1000, -119, 33691, 33691, 33691, 33691, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 536, 14718,
+	-1000, -1000, 410, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 14176, -1000, 14176, -1000, 1716, 1006, 698, 14176,
+	14176, -1000, 870, 858, 248, -1000, 19598, 22308, 22308, 19056,
+	-1000, 1744, 1729, 1752, 1595, 1598, 1598, 1599, -1000, 1751,
+	1745, -1000, 1004, 1743, 1003, 706, -1000, 33691, 14176, 1401,
+	-1000, 294, 33691, 1401, 33691, -1000, 1723, -1000, -1000, 14176,
+	1407, -1000, 14176, -1000, -1000, -1000, -1000, -1000, 1772, 1311,
+	-1000, -1000, 582, -1000, 14176, -1000, -1000, -1000, 235, -1000,
+	-1000, -1000, -1000, -1000, 1404, 14176, -1000, -1000, -1000, 1135,
+	1126, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1403,
+	-1000, -1000, -1000, -1000, 233, -370, 1082, 33691, 816, -1000,
+	1288, 1271, 411, -1000, 14176, 284, -1000, 233, -1000, -191,
+	-192, 1074, -1000, -1000, 1699, -1000, -1000, 8725, -1000, -1000,
+	1402, 1485, -1000, 304, -1000, 1074, 1074, 1104, -1000, 1074,
+	1074, 1116, 1114, 1723, 1734, 1276, -1000, -1000, -1000, 1276,
+	1276, 471, 1729, -1000, 14176, 14176, 1590, 830, -1000, -1000,
+	-1000, -1000, 987, 970, -1000, 960, -1000, 1808, -1000, 698,
+	-1000, 1401, -1000, 408, 1257, -1000, 1716, 698, 33691, 698,
+	1723, -1000, 1074, -1000, 1401, 33691, 1005, -1000, -1000, 1697,
+	1255, 50, -1000, 1232, -1000, -1000, -1000, -1000, 12550, -1000,
+	-1000, -1000, -1000, -1000, -1000, 298, 1269, -1000, 614, 33691,
+	33691, 1104, 243, -128, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -253, 14176, 21766, -1000, -1000, -1000, -1000, -1000, 698,
+	1252, -1000, 803, -1000, -1000, -1000, -1000, -1000, 33691, 1257,
+	33691, -1000, 1208, 1716, -1000, 1186, -1000, 298, -1000, 1399,
+	1423, -1000, 1104, -117, 8725, 5975, 1174, -1000, -1000, 1544,
+	-111, -131, 1104, 189, -1000, -1000, 1252, -1000, -1000, -1000,
+	-1000, 1228, -1000, -1000, -1000, 1501, 771, -117, 14176, 1296,
+	-1000, -1000, -46, -1000, -1000, -1000, -1000, -1000, 1484, -1000,
+	1542, -1000, -1000, -1000, 135, -195, -194, -241, -1000, -1000,
+	1500, -1000, 1779, -1000, -1000, -1000, 757, 893, -1000, -1000,
+	-1000, -1000, 990, 33691, 276, -1000, -1000, -119, -126, 657,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1807, 501, 501, -1000,
+	-1000, -381, 1122, 157, -1000, -135, 135, -1000, -1000, -1000,
+	296, 861, -1000, -1000, 777, -381, -1000, -132, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2179, 2178, 11, 1, 2173, 13, 77, 152, 20,
-	182, 74, 2171, 2170, 2169, 2167, 200, 199, 198, 2165,
-	2145, 2144, 2141, 2140, 2135, 2132, 2131, 2130, 2127, 183,
-	144, 149, 2126, 2125, 2124, 90, 155, 66, 71, 159,
-	2123, 2122, 56, 2119, 2118, 2117, 179, 178, 679, 2114,
-	177, 97, 24, 2113, 2112, 2110, 2109, 2106, 2104, 2102,
-	2101, 2100, 2099, 2097, 2096, 2095, 2090, 234, 2089, 2088,
-	7, 2087, 63, 2086, 2084, 2083, 102, 2082, 2081, 2080,
-	2079, 2078, 2075, 121, 2074, 2073, 2071, 2070, 156, 2069,
-	2068, 127, 80, 94, 2066, 2065, 75, 139, 2064, 98,
-	2063, 2056, 2051, 132, 2048, 233, 2047, 62, 45, 2037,
-	47, 70, 40, 39, 2036, 2033, 2031, 60, 89, 2030,
-	76, 49, 2029, 84, 78, 2028, 43, 2027, 2026, 96,
-	2025, 2024, 2022, 67, 2016, 2014, 3460, 2013, 68, 116,
-	23, 34, 2012, 2007, 2006, 2005, 2003, 32, 2002, 2001,
-	2000, 119, 18, 1997, 21, 12, 31, 122, 1996, 37,
-	57, 1993, 115, 1992, 1991, 35, 16, 19, 1990, 17,
-	110, 129, 28, 79, 112, 1989, 1986, 36, 50, 1981,
-	1978, 1977, 1976, 1975, 1974, 42, 1973, 33, 1971, 185,
-	1970, 2, 27, 55, 41, 154, 44, 30, 1969, 163,
-	1968, 29, 140, 101, 136, 1966, 1965, 1963, 146, 225,
-	1962, 1959, 52, 145, 120, 128, 1956, 220, 1955, 1951,
-	73, 1135, 1701, 15, 138, 1949, 1947, 2644, 111, 113,
-	38, 1942, 157, 1941, 1939, 1936, 160, 141, 82, 904,
-	92, 1934, 1933, 1932, 1931, 1928, 1927, 1925, 1923, 270,
-	161, 86, 93, 133, 46, 1921, 1920, 1918, 100, 65,
-	1917, 137, 134, 109, 143, 1915, 150, 125, 123, 1914,
-	91, 1913, 1912, 1911, 1910, 72, 1909, 1908, 1907, 1906,
-	131, 130, 99, 64, 1905, 58, 95, 135, 124, 26,
-	1904, 22, 1903, 1901, 158, 8, 1900, 4, 0, 1899,
-	6, 142, 230, 126, 1894, 1893, 3, 1882, 5, 1881,
-	1877, 117, 1874, 1872, 1865, 10, 25, 9, 1861, 1860,
-	1858, 3121, 2740, 104, 1857, 153,
-}
-
-//line sql.y:6117
+	0, 2137, 2135, 15, 1, 2134, 22, 76, 156, 14,
+	170, 75, 2133, 2130, 2129, 2128, 190, 189, 185, 2126,
+	2125, 2124, 2123, 2121, 2120, 2119, 2116, 2114, 2112, 187,
+	144, 148, 2110, 2109, 2108, 93, 146, 70, 67, 167,
+	2107, 2106, 63, 2105, 2104, 2098, 161, 154, 707, 2097,
+	153, 95, 18, 2092, 2091, 2090, 2089, 2088, 2087, 2086,
+	2085, 2084, 2083, 2081, 2079, 2067, 2066, 229, 2065, 2064,
+	8, 2063, 60, 2061, 2060, 2059, 101, 2058, 2057, 2056,
+	2055, 2053, 2049, 123, 2045, 2042, 2041, 2038, 159, 2037,
+	2036, 132, 91, 87, 2034, 2033, 79, 155, 2032, 100,
+	2031, 2030, 2029, 134, 2028, 2225, 2027, 45, 39, 2026,
+	57, 85, 30, 38, 2024, 2023, 2022, 44, 58, 2021,
+	82, 32, 2020, 80, 77, 2019, 42, 2018, 2015, 97,
+	2014, 2013, 2012, 74, 2010, 2009, 3538, 2008, 72, 117,
+	25, 35, 2004, 2003, 2002, 2001, 2000, 28, 1997, 1996,
+	1994, 1993, 1991, 1990, 2, 1986, 1983, 122, 19, 1981,
+	24, 26, 31, 110, 1980, 41, 65, 1979, 121, 1978,
+	1977, 47, 21, 34, 1976, 83, 104, 137, 16, 78,
+	116, 1975, 1974, 40, 52, 1973, 1972, 1970, 1969, 1968,
+	1966, 46, 1963, 37, 1956, 175, 1955, 6, 27, 64,
+	49, 128, 50, 29, 1954, 157, 1953, 36, 140, 111,
+	136, 1950, 1948, 1942, 147, 224, 1941, 1940, 62, 143,
+	124, 126, 1937, 222, 1934, 1933, 73, 1335, 1672, 9,
+	139, 1920, 1919, 2786, 115, 119, 33, 1916, 127, 1915,
+	1914, 1913, 162, 142, 98, 988, 92, 1912, 1911, 1910,
+	1908, 1907, 1904, 1903, 1902, 90, 165, 43, 89, 152,
+	55, 1900, 1898, 1897, 105, 68, 1893, 138, 131, 109,
+	158, 1892, 160, 129, 163, 1879, 86, 1874, 1862, 1861,
+	1859, 71, 1858, 1857, 1852, 1851, 130, 125, 88, 56,
+	1850, 66, 99, 133, 135, 20, 1848, 13, 1846, 1845,
+	164, 12, 1844, 3, 0, 1842, 5, 145, 220, 141,
+	1841, 1840, 4, 1839, 7, 1838, 1836, 120, 1835, 1834,
+	1832, 11, 17, 10, 1831, 1830, 1829, 3558, 2756, 102,
+	1826, 166,
+}
+
+//line sql.y:6215
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -5143,6 +5171,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitTypeUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -5268,6 +5311,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -5459,85 +5507,85 @@ func (st *yySymType) withUnion() *With {
 }
 
 var yyR1 = [...]int{
-	0, 319, 320, 320, 5, 5, 5, 5, 5, 5,
+	0, 325, 326, 326, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 298, 298, 298, 301, 301,
+	5, 5, 5, 5, 5, 304, 304, 304, 307, 307,
 	27, 62, 29, 29, 28, 28, 31, 31, 30, 6,
 	6, 6, 7, 7, 7, 7, 7, 7, 7, 7,
 	8, 8, 8, 8, 8, 9, 9, 9, 9, 11,
 	11, 11, 11, 11, 14, 15, 10, 10, 16, 16,
-	74, 74, 17, 18, 18, 18, 18, 323, 323, 131,
-	131, 129, 129, 130, 130, 194, 194, 19, 20, 20,
-	204, 204, 203, 203, 203, 205, 205, 205, 205, 239,
-	239, 21, 21, 21, 21, 21, 89, 89, 300, 300,
-	299, 297, 297, 296, 296, 295, 33, 34, 43, 43,
-	43, 43, 44, 45, 302, 302, 271, 49, 49, 48,
-	48, 48, 48, 50, 50, 47, 47, 46, 46, 273,
-	273, 260, 260, 272, 272, 272, 272, 272, 272, 272,
-	259, 259, 100, 100, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 314, 314, 176, 176, 176,
-	176, 176, 176, 109, 109, 117, 117, 117, 117, 107,
+	74, 74, 17, 18, 18, 18, 18, 329, 329, 131,
+	131, 129, 129, 130, 130, 200, 200, 19, 20, 20,
+	210, 210, 209, 209, 209, 211, 211, 211, 211, 245,
+	245, 21, 21, 21, 21, 21, 89, 89, 306, 306,
+	305, 303, 303, 302, 302, 301, 33, 34, 43, 43,
+	43, 43, 44, 45, 308, 308, 277, 49, 49, 48,
+	48, 48, 48, 50, 50, 47, 47, 46, 46, 279,
+	279, 266, 266, 278, 278, 278, 278, 278, 278, 278,
+	265, 265, 100, 100, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 320, 320, 182, 182, 182,
+	182, 182, 182, 109, 109, 117, 117, 117, 117, 107,
 	107, 108, 106, 106, 106, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 318, 318, 318,
-	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
-	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
-	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
-	318, 318, 318, 318, 318, 318, 318, 318, 116, 116,
-	113, 113, 113, 114, 114, 114, 315, 315, 315, 315,
-	242, 242, 242, 242, 245, 245, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 244, 244, 244, 244, 244,
-	246, 246, 246, 246, 246, 247, 247, 247, 247, 247,
-	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
-	248, 248, 248, 248, 248, 248, 248, 248, 258, 258,
-	249, 249, 253, 253, 254, 254, 254, 255, 255, 255,
-	256, 256, 251, 251, 251, 251, 251, 251, 252, 252,
-	252, 261, 285, 285, 284, 284, 282, 282, 282, 282,
-	270, 270, 279, 279, 279, 279, 279, 269, 269, 265,
-	265, 265, 266, 266, 267, 267, 264, 264, 268, 268,
-	281, 281, 280, 262, 262, 263, 263, 287, 316, 316,
-	316, 316, 317, 317, 288, 307, 308, 306, 306, 306,
-	306, 306, 72, 72, 72, 216, 216, 216, 277, 277,
-	276, 276, 276, 278, 278, 275, 275, 275, 275, 275,
-	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
-	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
-	275, 275, 275, 211, 211, 211, 305, 305, 305, 305,
-	305, 305, 304, 304, 304, 274, 274, 274, 303, 303,
-	51, 51, 160, 160, 40, 40, 40, 40, 40, 40,
+	112, 112, 112, 112, 112, 112, 112, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 116, 116,
+	113, 113, 113, 114, 114, 114, 321, 321, 321, 321,
+	248, 248, 248, 248, 251, 251, 249, 249, 249, 249,
+	249, 249, 249, 249, 249, 250, 250, 250, 250, 250,
+	252, 252, 252, 252, 252, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	254, 254, 254, 254, 254, 254, 254, 254, 264, 264,
+	255, 255, 259, 259, 260, 260, 260, 261, 261, 261,
+	262, 262, 257, 257, 257, 257, 257, 257, 258, 258,
+	258, 267, 291, 291, 290, 290, 288, 288, 288, 288,
+	276, 276, 285, 285, 285, 285, 285, 275, 275, 271,
+	271, 271, 272, 272, 273, 273, 270, 270, 274, 274,
+	287, 287, 286, 268, 268, 269, 269, 293, 322, 322,
+	322, 322, 323, 323, 294, 313, 314, 312, 312, 312,
+	312, 312, 72, 72, 72, 222, 222, 222, 283, 283,
+	282, 282, 282, 284, 284, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 217, 217, 217, 311, 311, 311, 311,
+	311, 311, 310, 310, 310, 280, 280, 280, 309, 309,
+	51, 51, 166, 166, 40, 40, 40, 40, 40, 40,
 	39, 39, 39, 35, 35, 35, 35, 35, 35, 35,
 	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
 	35, 35, 35, 35, 35, 35, 41, 41, 36, 36,
 	36, 36, 36, 36, 36, 36, 36, 22, 22, 22,
 	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
 	22, 22, 22, 22, 22, 22, 22, 76, 76, 77,
-	77, 77, 77, 78, 78, 78, 290, 290, 52, 52,
-	3, 3, 1, 1, 2, 2, 4, 4, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	257, 257, 257, 289, 289, 291, 293, 293, 293, 293,
-	292, 85, 85, 23, 32, 32, 24, 24, 24, 24,
+	77, 77, 77, 78, 78, 78, 296, 296, 52, 52,
+	3, 3, 1, 1, 2, 2, 4, 4, 300, 300,
+	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	263, 263, 263, 295, 295, 297, 299, 299, 299, 299,
+	298, 85, 85, 23, 32, 32, 24, 24, 24, 24,
 	25, 25, 53, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 309, 309, 210, 210, 218, 218, 209, 209, 232,
-	232, 232, 212, 212, 212, 213, 213, 313, 313, 313,
-	55, 55, 55, 57, 57, 58, 59, 59, 234, 234,
-	235, 235, 60, 61, 73, 73, 73, 73, 73, 73,
+	54, 315, 315, 216, 216, 224, 224, 215, 215, 238,
+	238, 238, 218, 218, 218, 219, 219, 319, 319, 319,
+	55, 55, 55, 57, 57, 58, 59, 59, 240, 240,
+	241, 241, 60, 61, 73, 73, 73, 73, 73, 73,
 	75, 75, 75, 13, 13, 13, 13, 69, 69, 69,
-	12, 12, 56, 56, 63, 310, 310, 311, 312, 312,
-	312, 312, 64, 66, 26, 26, 26, 26, 26, 26,
+	12, 12, 56, 56, 63, 316, 316, 317, 318, 318,
+	318, 318, 64, 66, 26, 26, 26, 26, 26, 26,
 	95, 95, 83, 83, 83, 83, 83, 83, 83, 83,
-	83, 83, 83, 83, 90, 90, 90, 84, 84, 324,
+	83, 83, 83, 83, 90, 90, 90, 84, 84, 330,
 	67, 68, 68, 88, 88, 88, 81, 81, 81, 87,
 	87, 87, 92, 92, 94, 94, 94, 94, 94, 96,
 	96, 96, 96, 96, 96, 96, 91, 91, 93, 93,
-	93, 93, 225, 225, 225, 224, 224, 120, 120, 122,
-	121, 121, 123, 123, 124, 124, 124, 158, 139, 139,
-	192, 192, 191, 191, 193, 193, 193, 193, 195, 195,
+	93, 93, 231, 231, 231, 230, 230, 120, 120, 122,
+	121, 121, 123, 123, 124, 124, 124, 164, 139, 139,
+	198, 198, 197, 197, 199, 199, 199, 199, 201, 201,
 	125, 125, 125, 125, 126, 126, 127, 127, 128, 128,
-	233, 233, 230, 230, 230, 229, 229, 132, 132, 132,
+	239, 239, 236, 236, 236, 235, 235, 132, 132, 132,
 	134, 133, 133, 133, 133, 135, 135, 137, 137, 136,
 	136, 138, 140, 140, 140, 140, 140, 141, 141, 105,
 	105, 105, 105, 105, 105, 115, 115, 115, 115, 118,
@@ -5545,78 +5593,80 @@ var yyR1 = [...]int{
 	110, 110, 110, 110, 110, 110, 110, 110, 110, 110,
 	110, 110, 110, 111, 111, 111, 111, 111, 111, 111,
 	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 207, 207, 142, 142,
-	150, 150, 150, 150, 143, 143, 143, 143, 143, 143,
-	143, 151, 151, 151, 157, 152, 152, 148, 148, 148,
-	148, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 79, 79, 79, 79, 79, 79, 79, 79,
-	79, 325, 325, 250, 250, 250, 149, 149, 149, 149,
-	149, 86, 86, 86, 86, 86, 238, 238, 238, 241,
-	241, 241, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 240, 240, 163, 163, 82,
-	82, 161, 161, 162, 164, 164, 159, 159, 159, 145,
-	145, 145, 165, 165, 166, 166, 167, 167, 169, 168,
-	168, 170, 171, 171, 171, 172, 172, 173, 173, 173,
-	42, 42, 42, 42, 42, 37, 37, 37, 37, 38,
-	38, 38, 97, 97, 97, 97, 99, 99, 98, 98,
-	70, 70, 71, 71, 71, 103, 103, 104, 104, 104,
-	101, 101, 102, 102, 189, 189, 174, 174, 174, 181,
-	181, 181, 177, 177, 179, 179, 179, 180, 180, 180,
-	178, 186, 186, 188, 188, 187, 187, 183, 183, 184,
-	184, 185, 185, 185, 182, 182, 144, 144, 144, 144,
-	144, 190, 190, 190, 190, 196, 196, 154, 154, 156,
-	156, 155, 119, 197, 197, 201, 198, 198, 202, 202,
-	202, 202, 202, 199, 199, 200, 200, 226, 226, 226,
-	206, 206, 217, 217, 214, 214, 215, 215, 208, 208,
-	219, 219, 219, 65, 153, 153, 286, 286, 283, 222,
-	222, 223, 223, 227, 227, 231, 231, 228, 228, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 321, 322, 236, 237, 237, 237,
+	111, 111, 111, 111, 111, 111, 213, 213, 142, 142,
+	156, 156, 156, 156, 143, 143, 143, 143, 143, 143,
+	143, 157, 157, 157, 163, 158, 158, 148, 148, 148,
+	148, 148, 150, 151, 151, 152, 152, 152, 153, 153,
+	154, 154, 154, 154, 154, 155, 155, 146, 146, 146,
+	146, 146, 146, 146, 146, 146, 146, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 80, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 80, 80, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 331, 331, 256,
+	256, 256, 149, 149, 149, 149, 149, 86, 86, 86,
+	86, 86, 244, 244, 244, 247, 247, 247, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 169, 169, 82, 82, 167, 167, 168,
+	170, 170, 165, 165, 165, 145, 145, 145, 171, 171,
+	172, 172, 173, 173, 175, 174, 174, 176, 177, 177,
+	177, 178, 178, 179, 179, 179, 42, 42, 42, 42,
+	42, 37, 37, 37, 37, 38, 38, 38, 97, 97,
+	97, 97, 99, 99, 98, 98, 70, 70, 71, 71,
+	71, 103, 103, 104, 104, 104, 101, 101, 102, 102,
+	195, 195, 180, 180, 180, 187, 187, 187, 183, 183,
+	185, 185, 185, 186, 186, 186, 184, 192, 192, 194,
+	194, 193, 193, 189, 189, 190, 190, 191, 191, 191,
+	188, 188, 144, 144, 144, 144, 144, 196, 196, 196,
+	196, 202, 202, 160, 160, 162, 162, 161, 119, 203,
+	203, 207, 204, 204, 208, 208, 208, 208, 208, 205,
+	205, 206, 206, 232, 232, 232, 212, 212, 223, 223,
+	220, 220, 221, 221, 214, 214, 225, 225, 225, 65,
+	159, 159, 292, 292, 289, 228, 228, 229, 229, 233,
+	233, 237, 237, 234, 234, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 327,
+	328, 242, 243, 243, 243,
 }
 
 var yyR2 = [...]int{
@@ -5709,30 +5759,33 @@ var yyR2 = [...]int{
 	6, 2, 2, 3, 3, 3, 0, 3, 1, 1,
 	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 3, 4, 5, 5,
-	6, 4, 4, 8, 6, 8, 6, 8, 5, 4,
-	2, 2, 1, 2, 2, 2, 8, 8, 6, 5,
+	5, 6, 6, 0, 3, 0, 2, 5, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 4, 4, 8,
+	6, 8, 6, 8, 5, 4, 2, 2, 1, 2,
+	2, 2, 8, 8, 6, 5, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	2, 3, 4, 4, 4, 4, 4, 0, 3, 4,
+	7, 3, 1, 1, 1, 0, 5, 5, 2, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	2, 1, 1, 0, 1, 0, 2, 1, 2, 4,
+	0, 2, 1, 3, 5, 1, 2, 2, 0, 3,
+	0, 2, 0, 1, 3, 1, 3, 2, 0, 1,
+	1, 0, 1, 2, 4, 4, 0, 2, 2, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 0, 3,
+	3, 3, 0, 3, 1, 1, 0, 4, 0, 1,
+	1, 0, 3, 1, 3, 2, 1, 1, 0, 1,
+	2, 4, 9, 3, 5, 0, 3, 3, 0, 1,
+	0, 2, 2, 0, 2, 2, 2, 0, 2, 1,
+	2, 3, 3, 0, 2, 1, 2, 3, 4, 3,
+	0, 1, 2, 1, 5, 4, 4, 1, 3, 3,
+	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
+	3, 3, 1, 3, 3, 3, 3, 3, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	0, 2, 0, 3, 0, 1, 0, 1, 1, 5,
+	0, 1, 0, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 0, 2, 3, 4, 4, 4, 4,
-	4, 0, 3, 4, 7, 3, 1, 1, 1, 0,
-	5, 5, 2, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 2, 1, 1, 0, 1, 0,
-	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
-	2, 2, 0, 3, 0, 2, 0, 1, 3, 1,
-	3, 2, 0, 1, 1, 0, 1, 2, 4, 4,
-	0, 2, 2, 1, 1, 3, 3, 3, 3, 3,
-	3, 3, 0, 3, 3, 3, 0, 3, 1, 1,
-	0, 4, 0, 1, 1, 0, 3, 1, 3, 2,
-	1, 1, 0, 1, 2, 4, 9, 3, 5, 0,
-	3, 3, 0, 1, 0, 2, 2, 0, 2, 2,
-	2, 0, 2, 1, 2, 3, 3, 0, 2, 1,
-	2, 3, 4, 3, 0, 1, 2, 1, 5, 4,
-	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 0, 2, 0, 3, 0, 1,
-	0, 1, 1, 5, 0, 1, 0, 1, 2, 1,
-	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5776,263 +5829,265 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -319, -5, -9, -14, -15, -16, -17, -18, -19,
+	-1000, -325, -5, -9, -14, -15, -16, -17, -18, -19,
 	-20, -21, -22, -23, -24, -25, -53, -54, -55, -57,
 	-58, -59, -60, -61, -12, -56, -26, -27, -62, -63,
 	-64, -65, -66, -7, -6, -11, 7, 8, -74, -28,
 	30, -33, -43, 203, -44, -34, 204, -45, 206, 205,
 	241, 207, 234, 72, 281, 282, 284, 285, 286, 287,
 	-75, 239, 240, 209, 34, 43, 31, 32, 35, 212,
-	-8, -29, 6, -321, 9, 407, 236, 235, 26, -10,
-	421, 83, -320, 555, -189, -174, 20, 31, 27, -173,
-	-169, -88, -174, 18, 16, 5, -67, -324, -67, -67,
-	10, 11, -67, -271, -273, 83, 140, 83, -67, -49,
-	-48, -47, -46, -50, 29, -40, -41, -294, -39, -36,
+	-8, -29, 6, -327, 9, 407, 236, 235, 26, -10,
+	422, 83, -326, 555, -195, -180, 20, 31, 27, -179,
+	-175, -88, -180, 18, 16, 5, -67, -330, -67, -67,
+	10, 11, -67, -277, -279, 83, 140, 83, -67, -49,
+	-48, -47, -46, -50, 29, -40, -41, -300, -39, -36,
 	208, 205, 249, 107, 108, 241, 242, 243, 207, 225,
-	240, 244, 239, 258, -35, 78, 31, 421, 424, -278,
-	204, 210, 211, 408, 110, 109, 73, 206, -275, 333,
+	240, 244, 239, 258, -35, 78, 31, 422, 425, -284,
+	204, 210, 211, 408, 110, 109, 73, 206, -281, 333,
 	525, -50, 527, 99, 101, 526, 42, 215, 528, 529,
 	530, 531, 224, 532, 533, 534, 535, 541, 542, 543,
-	544, 111, 5, -67, -231, -227, -298, -221, 86, 87,
-	88, 418, 227, 452, 453, 274, 78, 39, 330, 333,
-	525, 281, 295, 289, 316, 308, 419, 454, 277, 228,
+	544, 111, 5, -67, -237, -233, -304, -227, 86, 87,
+	88, 419, 227, 452, 453, 274, 78, 39, 330, 333,
+	525, 281, 295, 289, 316, 308, 420, 454, 277, 228,
 	262, 522, 306, 116, 527, 280, 455, 242, 338, 339,
 	340, 99, 284, 375, 540, 279, 456, 538, 101, 526,
-	77, 49, 42, 237, 304, 300, 528, 263, 457, 429,
+	77, 49, 42, 237, 304, 300, 528, 263, 457, 430,
 	256, 110, 107, 547, 34, 298, 48, 28, 537, 109,
 	47, 529, 131, 458, 530, 342, 321, 516, 46, 343,
-	243, 459, 81, 423, 524, 344, 299, 345, 273, 536,
+	243, 459, 81, 424, 524, 344, 299, 345, 273, 536,
 	209, 460, 508, 346, 347, 517, 461, 322, 326, 462,
 	367, 348, 554, 50, 463, 464, 518, 108, 465, 76,
 	531, 293, 294, 466, 271, 226, 369, 320, 224, 33,
-	349, 420, 275, 56, 250, 370, 44, 324, 551, 43,
+	349, 421, 275, 56, 250, 370, 44, 324, 551, 43,
 	512, 467, 515, 319, 315, 51, 468, 469, 470, 471,
-	532, 318, 292, 314, 546, 441, 268, 533, 60, 211,
+	532, 318, 292, 314, 546, 442, 268, 533, 60, 211,
 	328, 327, 329, 257, 366, 311, 472, 473, 474, 231,
 	79, 475, 301, 19, 476, 477, 350, 264, 478, 54,
 	479, 480, 373, 240, 481, 52, 534, 37, 245, 548,
 	535, 482, 483, 484, 485, 486, 352, 487, 351, 323,
-	325, 252, 353, 422, 488, 297, 244, 539, 489, 232,
+	325, 252, 353, 423, 488, 297, 244, 539, 489, 232,
 	523, 246, 249, 239, 374, 233, 490, 491, 492, 493,
 	494, 278, 495, 496, 285, 541, 41, 497, 498, 499,
 	500, 272, 267, 368, 377, 59, 80, 335, 501, 521,
 	291, 265, 502, 282, 53, 542, 543, 544, 259, 545,
 	7, 552, 553, 355, 111, 269, 270, 45, 312, 251,
 	503, 504, 302, 303, 317, 290, 313, 283, 509, 253,
-	356, 241, 505, 376, 266, 331, 336, 426, 258, 357,
-	520, 425, 310, 307, 260, 506, 358, 216, 254, 255,
+	356, 241, 505, 376, 266, 331, 336, 427, 258, 357,
+	520, 426, 310, 307, 260, 506, 358, 216, 254, 255,
 	507, 510, 359, 360, 276, 361, 362, 363, 364, 365,
-	261, 424, 288, 305, 337, 388, 389, 390, 391, 392,
+	261, 425, 288, 305, 337, 388, 389, 390, 391, 392,
 	393, 394, 395, 396, 397, 398, 399, 400, 401, 402,
-	403, 404, 405, 416, 214, -67, 214, -136, -227, 214,
-	-199, 339, -218, 341, 354, 349, 359, 347, -210, 350,
-	352, 252, -313, 367, 214, 356, 203, 157, 342, 351,
-	360, 361, 276, 362, 365, 261, -309, -298, 530, 545,
-	116, 309, 346, 344, 368, 512, 364, 363, -227, 283,
-	-234, 288, -222, -298, -221, 286, -136, -73, 508, 207,
-	-236, -236, -90, 512, 514, -152, -105, 124, -115, -118,
-	-110, -111, -146, -147, -148, -149, -116, -159, 146, 147,
-	154, 125, -157, -119, 24, 417, 409, 408, 157, 29,
+	403, 404, 405, 416, 214, -67, 214, -136, -233, 214,
+	-205, 339, -224, 341, 354, 349, 359, 347, -216, 350,
+	352, 252, -319, 367, 214, 356, 203, 157, 342, 351,
+	360, 361, 276, 362, 365, 261, -315, -304, 530, 545,
+	116, 309, 346, 344, 368, 512, 364, 363, -233, 283,
+	-240, 288, -228, -304, -227, 286, -136, -73, 508, 207,
+	-242, -242, -90, 512, 514, -158, -105, 124, -115, -118,
+	-110, -111, -146, -147, -148, -149, -116, -165, 146, 147,
+	154, 125, -163, -119, 24, 418, 409, 408, 157, 29,
 	199, 66, 67, 411, 412, 127, 55, 384, 385, -117,
-	380, 386, 381, 414, 415, 102, 416, -222, -227, 219,
-	379, 213, 152, 407, -112, -108, -6, -155, 378, 382,
-	383, 387, -298, -221, -114, -113, -142, 89, 95, 100,
-	96, -318, 103, -321, 90, 91, 92, 93, 94, 104,
+	380, 386, 381, 414, 415, 102, 416, -228, -233, 219,
+	379, 213, 152, 407, -112, -108, -6, -161, 378, 382,
+	383, 387, -304, -227, -114, -113, -142, 89, 95, 100,
+	96, -324, 103, -327, 90, 91, 92, 93, 94, 104,
 	105, 158, 159, 160, 161, 162, 163, 164, 165, 166,
 	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
 	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
 	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 42, 355, 355, -136, -67, -167, -88, -169,
-	-8, -6, -321, 6, -67, -6, -7, -11, -29, -31,
-	448, -30, -227, -174, -189, 10, 142, 40, 48, -172,
-	-173, -10, -6, -105, 17, 21, 22, -93, 148, -105,
-	-227, -68, -93, -208, 218, -67, -67, -198, -239, 283,
-	-202, 368, 367, -223, -200, -222, -220, -199, 366, 208,
-	427, 123, 23, 25, 126, 157, 17, 127, 35, 210,
-	309, 225, 156, 221, 408, 203, 70, 428, 380, 381,
-	378, 384, 410, 411, 379, 341, 29, 11, 430, 26,
+	197, 198, 42, 355, 355, -136, -67, -173, -88, -175,
+	-8, -6, -327, 6, -67, -6, -7, -11, -29, -31,
+	448, -30, -233, -180, -195, 10, 142, 40, 48, -178,
+	-179, -10, -6, -105, 17, 21, 22, -93, 148, -105,
+	-233, -68, -93, -214, 218, -67, -67, -204, -245, 283,
+	-208, 368, 367, -229, -206, -228, -226, -205, 366, 208,
+	428, 123, 23, 25, 126, 157, 17, 127, 35, 210,
+	309, 225, 156, 221, 408, 203, 70, 429, 380, 381,
+	378, 384, 410, 411, 379, 341, 29, 11, 431, 26,
 	235, 22, 36, 150, 205, 130, 238, 24, 236, 102,
-	105, 433, 20, 73, 230, 12, 223, 38, 14, 434,
-	435, 15, 219, 218, 142, 215, 68, 9, 199, 27,
-	139, 64, 436, 118, 437, 438, 439, 440, 66, 140,
-	18, 549, 382, 383, 31, 513, 417, 247, 152, 71,
-	57, 514, 124, 442, 443, 103, 444, 106, 74, 519,
-	120, 16, 69, 40, 445, 248, 446, 220, 550, 447,
+	105, 434, 20, 73, 230, 12, 223, 38, 14, 435,
+	436, 15, 219, 218, 142, 215, 68, 9, 199, 27,
+	139, 64, 437, 118, 438, 439, 440, 441, 66, 140,
+	18, 549, 382, 383, 31, 513, 418, 247, 152, 71,
+	57, 514, 124, 443, 444, 103, 445, 106, 74, 519,
+	120, 16, 69, 40, 417, 248, 446, 220, 550, 447,
 	371, 448, 141, 206, 407, 67, 449, 213, 354, 6,
 	413, 30, 234, 222, 113, 65, 450, 214, 129, 414,
 	415, 217, 104, 5, 117, 32, 10, 72, 75, 385,
-	386, 387, 55, 112, 421, 128, 13, 451, 372, 122,
-	116, -272, 140, -259, -263, -222, 229, -288, 225, -136,
-	-281, -280, -222, -89, -217, 215, 223, 222, 117, -302,
-	120, 269, 379, 213, -46, -47, -199, 156, -76, 246,
-	248, 84, 84, -263, -262, -261, -303, 248, 229, -287,
-	-279, 221, 230, -269, 222, 223, -264, 215, 118, -303,
-	-264, 220, 230, 248, 248, 111, 248, 111, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 245, -270, 132,
-	-270, 425, 425, -275, -303, -303, -303, 217, 33, 33,
-	-219, -264, 217, 23, -270, -270, -199, 156, -270, -270,
-	-270, -270, 256, 256, -270, -270, -270, -270, -270, -270,
-	-270, -270, -270, -270, -270, -270, -270, -270, -270, 214,
-	-302, -97, 365, 276, 78, -48, 258, -32, -136, -217,
-	215, 216, -302, -136, 200, -136, -212, 140, 13, -212,
-	-209, 355, 353, 340, 345, -212, -212, -212, -212, 259,
-	338, -265, 215, 33, 226, 355, 259, 338, 259, 260,
-	259, 260, 348, 358, 259, -232, 12, 142, 379, 343,
-	347, 252, 214, 253, 216, 357, -298, 515, 260, -232,
-	90, -213, 140, 355, 255, -212, -237, -321, -223, 309,
-	-237, -237, 30, 87, 217, -222, -69, -222, 90, -13,
+	386, 387, 55, 112, 422, 128, 13, 451, 372, 122,
+	116, -278, 140, -265, -269, -228, 229, -294, 225, -136,
+	-287, -286, -228, -89, -223, 215, 223, 222, 117, -308,
+	120, 269, 379, 213, -46, -47, -205, 156, -76, 246,
+	248, 84, 84, -269, -268, -267, -309, 248, 229, -293,
+	-285, 221, 230, -275, 222, 223, -270, 215, 118, -309,
+	-270, 220, 230, 248, 248, 111, 248, 111, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 245, -276, 132,
+	-276, 426, 426, -281, -309, -309, -309, 217, 33, 33,
+	-225, -270, 217, 23, -276, -276, -205, 156, -276, -276,
+	-276, -276, 256, 256, -276, -276, -276, -276, -276, -276,
+	-276, -276, -276, -276, -276, -276, -276, -276, -276, 214,
+	-308, -97, 365, 276, 78, -48, 258, -32, -136, -223,
+	215, 216, -308, -136, 200, -136, -218, 140, 13, -218,
+	-215, 355, 353, 340, 345, -218, -218, -218, -218, 259,
+	338, -271, 215, 33, 226, 355, 259, 338, 259, 260,
+	259, 260, 348, 358, 259, -238, 12, 142, 379, 343,
+	347, 252, 214, 253, 216, 357, -304, 515, 260, -238,
+	90, -219, 140, 355, 255, -218, -243, -327, -229, 309,
+	-243, -243, 30, 87, 217, -228, -69, -228, 90, -13,
 	-9, -17, -16, -18, 132, -95, 355, -83, 157, 530,
 	516, 517, 518, 515, 352, 523, 521, 519, 259, 520,
 	84, 120, 122, 123, -105, 139, -143, 132, 133, 134,
 	135, 136, 137, 138, 142, 124, 126, 140, 141, 121,
 	143, 144, 145, 146, 147, 148, 149, 151, 150, 152,
-	153, 156, 201, 202, -111, -111, -111, -111, -157, -321,
-	-321, -321, -111, -207, -321, -111, -321, -321, -321, -321,
-	-163, -105, -321, -325, -321, -325, -325, -250, -321, -250,
-	-321, -321, -321, -321, -321, 200, -321, -321, -321, -321,
-	-321, -250, -250, -250, -250, 100, 95, 89, -159, 96,
-	90, -222, -227, -6, -7, -152, -236, -310, -311, -139,
-	-136, -321, 276, -172, -10, -6, -167, -173, -169, -6,
+	153, 156, 201, 202, -111, -111, -111, -111, -163, -327,
+	-327, -327, -111, -213, -327, -111, -327, -327, -327, -327,
+	-169, -105, -327, -331, -327, -331, -331, -256, -327, -256,
+	-327, -327, -327, -327, -327, 200, -327, -327, -327, -327,
+	-327, -256, -256, -256, -256, 100, 95, 89, -165, 96,
+	90, -228, -233, -6, -7, -158, -242, -316, -317, -139,
+	-136, -327, 276, -178, -10, -6, -173, -179, -175, -6,
 	-67, -81, -94, 61, 62, -96, 22, 36, 65, 63,
-	21, -322, 85, -322, -189, -322, 84, -31, -192, 83,
-	59, 41, 90, 90, 84, 19, -168, -170, -105, 12,
-	-225, -224, 23, -222, 90, 200, 98, 12, -137, 27,
-	-136, -208, -208, 84, 283, -202, -239, -204, -203, 369,
-	371, 132, -226, -222, 90, 29, 85, 84, -136, -242,
-	-245, -247, -246, -248, -243, -244, 306, 307, 157, 310,
+	21, -328, 85, -328, -195, -328, 84, -31, -198, 83,
+	59, 41, 90, 90, 84, 19, -174, -176, -105, 12,
+	-231, -230, 23, -228, 90, 200, 98, 12, -137, 27,
+	-136, -214, -214, 84, 283, -208, -245, -210, -209, 369,
+	371, 132, -232, -228, 90, 29, 85, 84, -136, -248,
+	-251, -253, -252, -254, -249, -250, 306, 307, 157, 310,
 	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
 	30, 237, 302, 303, 304, 305, 322, 323, 324, 325,
-	326, 327, 328, 329, 289, 308, 419, 290, 291, 292,
-	293, 294, 295, 297, 298, 299, 300, 301, -301, -298,
-	83, 85, 84, -249, 83, -97, 214, -298, 215, 215,
-	215, -67, 407, -270, -270, 245, 17, -39, -36, -294,
-	16, -35, -36, 208, 107, 108, 205, 83, -259, 83,
-	-268, -301, -298, 83, 118, 220, 117, -267, -264, -267,
-	-268, -298, -159, -298, 118, 118, -195, -222, -195, -195,
-	21, -195, 21, -195, 21, 92, -222, -195, 21, -195,
-	21, -195, 21, -195, 21, -195, 21, 29, 76, 77,
-	29, 79, 80, 81, -159, -159, -259, -199, -136, -298,
-	92, 92, -270, -270, 92, 90, 90, 90, -270, -270,
-	92, 90, -229, -227, 90, -304, 231, 273, 275, 92,
-	92, 92, 92, 29, 90, -305, 29, 537, 536, 538,
-	539, 540, 92, 29, 92, 29, 92, -222, 83, -136,
+	326, 327, 328, 329, 289, 308, 420, 290, 291, 292,
+	293, 294, 295, 297, 298, 299, 300, 301, -307, -304,
+	83, 85, 84, -255, 83, -97, 214, -304, 215, 215,
+	215, -67, 407, -276, -276, 245, 17, -39, -36, -300,
+	16, -35, -36, 208, 107, 108, 205, 83, -265, 83,
+	-274, -307, -304, 83, 118, 220, 117, -273, -270, -273,
+	-274, -304, -165, -304, 118, 118, -201, -228, -201, -201,
+	21, -201, 21, -201, 21, 92, -228, -201, 21, -201,
+	21, -201, 21, -201, 21, -201, 21, 29, 76, 77,
+	29, 79, 80, 81, -165, -165, -265, -205, -136, -304,
+	92, 92, -276, -276, 92, 90, 90, 90, -276, -276,
+	92, 90, -235, -233, 90, -310, 231, 273, 275, 92,
+	92, 92, 92, 29, 90, -311, 29, 537, 536, 538,
+	539, 540, 92, 29, 92, 29, 92, -228, 83, -136,
 	-103, 263, 203, 205, 208, 74, 90, 277, 132, 42,
-	84, 217, 214, -298, -214, 219, -214, -228, -227, -220,
-	90, -105, -266, 12, 142, -232, -232, -212, -136, -266,
-	-232, -212, -136, -212, -212, -212, -212, -232, -232, -232,
-	-212, -227, -227, -136, -136, -136, -136, -136, -136, -136,
-	-237, -237, -237, -213, -212, 515, 90, 74, -235, 286,
-	320, 509, 510, 511, 84, 421, -129, -136, 515, 515,
-	515, 515, 515, 515, -105, -105, -105, -105, -150, 103,
-	124, 104, 105, -118, -151, -155, -157, 97, 142, 126,
+	84, 217, 214, -304, -220, 219, -220, -234, -233, -226,
+	90, -105, -272, 12, 142, -238, -238, -218, -136, -272,
+	-238, -218, -136, -218, -218, -218, -218, -238, -238, -238,
+	-218, -233, -233, -136, -136, -136, -136, -136, -136, -136,
+	-243, -243, -243, -219, -218, 515, 90, 74, -241, 286,
+	320, 509, 510, 511, 84, 422, -129, -136, 515, 515,
+	515, 515, 515, 515, -105, -105, -105, -105, -156, 103,
+	124, 104, 105, -118, -157, -161, -163, 97, 142, 126,
 	140, 141, -110, -111, -110, -110, -110, -110, -110, -110,
-	-110, -110, -110, -110, -110, -110, -110, -238, -298, 90,
-	157, 90, 90, -91, -93, -105, -105, -298, -222, -91,
-	-91, -105, -87, 22, 36, -161, -162, 128, -159, -322,
-	-322, 92, -222, -222, -80, -79, 389, 390, 391, 392,
+	-110, -110, -110, -110, -110, -110, -110, -244, -304, 90,
+	157, 90, 90, -91, -93, -105, -105, -304, -228, -91,
+	-91, -105, -87, 22, 36, -167, -168, 128, -165, -328,
+	-328, 92, -228, -228, -80, -79, 389, 390, 391, 392,
 	394, 395, 396, 399, 400, 404, 405, 388, 406, 393,
 	398, 401, 402, 403, 397, 305, -105, -92, 22, 36,
-	-91, -223, -228, -220, -91, -92, -92, -91, -91, 200,
-	-189, -322, 84, -312, 371, 372, 513, -230, 248, -229,
-	23, -153, -152, 90, -172, -172, 61, 62, 57, -91,
-	-96, -322, -30, 23, -191, -222, 60, 90, -251, -199,
-	330, 331, -105, -105, 84, -171, 25, 26, -136, -224,
-	148, -228, -136, -194, 248, -136, -121, -123, -124, -125,
-	-139, -158, -321, 12, -129, -130, -138, -227, -202, -204,
-	84, 370, 372, 373, 74, 106, -105, -252, 156, -277,
-	-276, -275, -259, -261, -262, -263, 85, -252, -255, 336,
-	335, -249, -249, -249, -249, -249, -251, -251, -251, -251,
-	83, 83, -249, -249, -249, -249, -253, 83, -253, -253,
-	-254, 83, -254, -288, -105, -285, -284, -282, -283, 224,
-	99, 421, 75, -280, -171, 92, -103, -215, 219, -286,
-	-283, -298, -298, -298, -215, -298, 90, -298, 90, -77,
-	-52, -1, 549, 550, 551, 84, 17, -260, -259, -51,
-	273, -291, -292, 248, -287, -281, -268, 118, -267, -268,
-	-268, -298, 84, 27, 111, 111, 111, 111, 421, 205,
-	30, -259, -51, -238, 217, -238, -238, 90, 90, -211,
+	-91, -229, -234, -226, -91, -92, -92, -91, -91, 200,
+	-195, -328, 84, -318, 371, 372, 513, -236, 248, -235,
+	23, -159, -158, 90, -178, -178, 61, 62, 57, -91,
+	-96, -328, -30, 23, -197, -228, 60, 90, -257, -205,
+	330, 331, -105, -105, 84, -177, 25, 26, -136, -230,
+	148, -234, -136, -200, 248, -136, -121, -123, -124, -125,
+	-139, -164, -327, 12, -129, -130, -138, -233, -208, -210,
+	84, 370, 372, 373, 74, 106, -105, -258, 156, -283,
+	-282, -281, -265, -267, -268, -269, 85, -258, -261, 336,
+	335, -255, -255, -255, -255, -255, -257, -257, -257, -257,
+	83, 83, -255, -255, -255, -255, -259, 83, -259, -259,
+	-260, 83, -260, -294, -105, -291, -290, -288, -289, 224,
+	99, 422, 75, -286, -177, 92, -103, -221, 219, -292,
+	-289, -304, -304, -304, -221, -304, 90, -304, 90, -77,
+	-52, -1, 549, 550, 551, 84, 17, -266, -265, -51,
+	273, -297, -298, 248, -293, -287, -274, 118, -273, -274,
+	-274, -304, 84, 27, 111, 111, 111, 111, 422, 205,
+	30, -265, -51, -244, 217, -244, -244, 90, 90, -217,
 	545, -129, -99, 265, 132, 254, 254, 214, 214, 267,
 	-136, 278, 280, 279, 277, 21, 266, 268, 270, 256,
-	-136, -136, -214, 74, -131, -136, 24, -227, -136, -212,
-	-212, -136, -212, -212, -136, -222, -83, 371, 84, 421,
-	20, -84, 20, 103, 104, 105, -151, -110, -111, -110,
-	123, 238, 84, -322, 23, 84, 75, -322, -322, -322,
-	84, 12, -91, -164, -162, 130, -105, -322, -322, 84,
-	84, 12, -241, 23, -322, -91, -91, -321, 200, -322,
-	-322, -322, -322, -322, -223, -311, 512, 372, -140, 72,
-	218, 73, -321, -229, -322, -145, -222, 92, 96, -174,
-	-120, -122, 12, -96, -157, 85, 84, -251, -178, -183,
-	-209, -222, 90, 157, -170, 200, -141, 13, -144, 30,
-	55, -9, -321, -321, 30, 84, -132, -134, -133, -135,
-	64, 68, 70, 65, 66, 67, 71, -233, 23, -121,
-	-7, -6, -321, -136, -129, -323, 12, 75, -323, 84,
-	200, -203, -205, 374, 371, 377, -298, 90, -76, 84,
-	-275, -263, -175, -100, 38, -256, 337, -251, -251, -258,
-	90, -258, 92, 92, 85, -42, -37, -38, 31, 78,
-	-282, -270, 90, 37, -222, 85, -99, -136, 124, 74,
-	-286, -286, -286, -227, -2, 548, 554, 118, 83, 340,
-	16, -191, 84, 85, -160, 274, 85, -293, 55, -222,
-	85, 83, -268, -268, -222, -321, 214, 29, 29, -51,
-	-160, -252, -298, 547, 546, 85, 216, 272, -104, 384,
+	-136, -136, -220, 74, -131, -136, 24, -233, -136, -218,
+	-218, -136, -218, -218, -136, -228, -83, 371, 84, 422,
+	20, -84, 20, 103, 104, 105, -157, -110, -111, -110,
+	123, 238, 84, -328, 23, 84, 75, -328, -328, -328,
+	84, 12, -91, -170, -168, 130, -105, -328, -328, 84,
+	84, 12, -247, 23, -328, -91, -91, -327, 200, -328,
+	-328, -328, -328, -328, -229, -317, 512, 372, -140, 72,
+	218, 73, -327, -235, -328, -145, -228, 92, 96, -180,
+	-120, -122, 12, -96, -163, 85, 84, -257, -184, -189,
+	-215, -228, 90, 157, -176, 200, -141, 13, -144, 30,
+	55, -9, -327, -327, 30, 84, -132, -134, -133, -135,
+	64, 68, 70, 65, 66, 67, 71, -239, 23, -121,
+	-7, -6, -327, -136, -129, -329, 12, 75, -329, 84,
+	200, -209, -211, 374, 371, 377, -304, 90, -76, 84,
+	-281, -269, -181, -100, 38, -262, 337, -257, -257, -264,
+	90, -264, 92, 92, 85, -42, -37, -38, 31, 78,
+	-288, -276, 90, 37, -228, 85, -99, -136, 124, 74,
+	-292, -292, -292, -233, -2, 548, 554, 118, 83, 340,
+	16, -197, 84, 85, -166, 274, 85, -299, 55, -228,
+	85, 83, -274, -274, -228, -327, 214, 29, 29, -51,
+	-166, -258, -304, 547, 546, 85, 216, 272, -104, 384,
 	-101, 90, 86, -136, -136, -136, -136, -136, 208, 205,
-	257, 217, -129, -136, 84, -72, 233, 228, -232, -232,
+	257, 217, -129, -136, 84, -72, 233, 228, -238, -238,
 	31, -136, 371, 524, 522, 123, 238, -118, -111, -93,
-	418, -240, 157, 306, 237, 304, 300, 320, 311, 335,
-	302, 336, 299, 298, 297, -240, -238, -105, -105, -167,
-	131, -105, 129, -105, -105, -105, -322, 157, 306, -322,
-	-322, -92, -223, 215, 215, 215, -195, -206, 20, 12,
-	55, 55, -120, -141, -121, -96, -222, -181, 508, -186,
-	44, -184, -185, 45, -182, 46, 54, 148, -172, -105,
-	-196, 74, -197, -201, -159, -154, -156, -155, -321, -190,
-	-322, -222, -195, -197, -123, -124, -124, -123, -124, 64,
-	64, 64, 69, 64, 69, 64, -133, -227, -322, -322,
-	-7, -230, 75, -121, -121, -138, -227, 148, 371, 375,
-	376, -275, -317, 103, 124, 29, 74, 333, 99, -315,
-	156, -316, 220, 117, 118, 232, 23, 39, 85, 84,
-	85, 84, 85, 84, -216, 458, 124, -38, -37, -270,
-	-270, 92, -298, 216, 24, -136, 74, 74, 74, -78,
-	552, 92, 83, -3, 78, -105, 83, 17, -259, -159,
-	250, 142, -285, -191, -289, -291, -136, -107, -321, -106,
-	-108, -112, 146, 147, -160, -136, -98, 263, 271, 83,
-	-102, 87, -300, 75, 254, 333, 254, -136, -72, -42,
-	-136, -212, -212, 31, -298, -118, -111, -321, -322, -249,
-	-249, -249, -254, -249, 294, -249, 294, -249, -322, -322,
-	84, -322, 20, -322, -82, 413, -105, 84, 84, -322,
-	83, 83, -322, -321, -321, -321, -322, -136, -141, -165,
-	14, -178, 49, 312, -188, -187, 53, 45, -185, 17,
-	47, 17, 28, -196, 84, 132, 84, -322, -322, 84,
-	55, 200, -322, -141, -127, -126, 74, 75, -128, 74,
-	-126, 64, 64, -192, -322, -194, -121, -141, -141, 200,
-	103, -321, -109, -117, -107, 10, 90, 90, -298, 118,
-	118, -136, 83, -251, 90, -251, 92, 92, 458, 29,
-	79, 80, 81, 29, 76, 77, -136, -136, -136, -136,
-	-290, 83, 17, -105, 83, 132, 85, -191, -191, 251,
-	-155, -321, 85, -322, 84, -257, 421, 424, -105, -113,
-	-113, -192, 85, -297, 421, -299, -222, -222, -222, -222,
-	-110, -251, -105, -105, -172, 90, -105, -105, 92, 92,
-	-193, -322, -222, 220, -193, -193, -230, -165, -166, 15,
-	17, -179, 51, -177, 50, -177, -187, 17, 17, 90,
-	17, 90, 118, -201, -105, -156, 55, -9, -222, -154,
-	-222, -167, -105, 83, -105, -141, -141, -105, -147, 416,
-	83, -105, 85, 85, -192, -289, -52, 85, -191, 92,
-	85, -155, -85, -321, 247, -316, -291, 425, 425, -322,
-	23, -296, -295, -223, 83, 75, -86, 142, 421, -322,
-	-322, -322, -322, -322, 85, 85, 84, -322, -322, -322,
-	-140, -166, -105, -152, -180, 52, 74, 106, 90, 90,
-	90, 10, -154, 200, -172, -191, -167, -322, -191, 85,
-	23, 85, 554, 118, 85, 247, -9, 84, 132, -191,
-	-136, -322, 419, 71, 422, -222, 220, 74, 106, -197,
-	-222, 85, -172, 85, -176, -9, 83, -3, -322, -70,
-	421, -295, -274, -223, 90, 92, 85, 60, 420, 423,
-	-307, -308, 74, -317, -314, 103, 124, 99, -315, 112,
-	113, -70, -105, 83, -71, 262, 512, -300, 60, -308,
-	74, 11, 10, 103, 90, 85, -191, 225, -297, 421,
-	-306, 233, 228, 231, 30, -306, -4, 553, 85, 264,
-	422, 227, 29, 103, 92, -4, 423,
+	419, -246, 157, 306, 237, 304, 300, 320, 311, 335,
+	302, 336, 299, 298, 297, -246, -244, -105, -105, -173,
+	131, -105, 129, -105, -105, -105, -328, 157, 306, -150,
+	417, -328, -328, -92, -229, 215, 215, 215, -201, -212,
+	20, 12, 55, 55, -120, -141, -121, -96, -228, -187,
+	508, -192, 44, -190, -191, 45, -188, 46, 54, 148,
+	-178, -105, -202, 74, -203, -207, -165, -160, -162, -161,
+	-327, -196, -328, -228, -201, -203, -123, -124, -124, -123,
+	-124, 64, 64, 64, 69, 64, 69, 64, -133, -233,
+	-328, -328, -7, -236, 75, -121, -121, -138, -233, 148,
+	371, 375, 376, -281, -323, 103, 124, 29, 74, 333,
+	99, -321, 156, -322, 220, 117, 118, 232, 23, 39,
+	85, 84, 85, 84, 85, 84, -222, 458, 124, -38,
+	-37, -276, -276, 92, -304, 216, 24, -136, 74, 74,
+	74, -78, 552, 92, 83, -3, 78, -105, 83, 17,
+	-265, -165, 250, 142, -291, -197, -295, -297, -136, -107,
+	-327, -106, -108, -112, 146, 147, -166, -136, -98, 263,
+	271, 83, -102, 87, -306, 75, 254, 333, 254, -136,
+	-72, -42, -136, -218, -218, 31, -304, -118, -111, -327,
+	-328, -255, -255, -255, -260, -255, 294, -255, 294, -255,
+	-328, -328, 84, -328, 20, -328, -82, 413, -105, 84,
+	84, -328, 83, 83, -327, -328, -327, -327, -327, -328,
+	-136, -141, -171, 14, -184, 49, 312, -194, -193, 53,
+	45, -191, 17, 47, 17, 28, -202, 84, 132, 84,
+	-328, -328, 84, 55, 200, -328, -141, -127, -126, 74,
+	75, -128, 74, -126, 64, 64, -198, -328, -200, -121,
+	-141, -141, 200, 103, -327, -109, -117, -107, 10, 90,
+	90, -304, 118, 118, -136, 83, -257, 90, -257, 92,
+	92, 458, 29, 79, 80, 81, 29, 76, 77, -136,
+	-136, -136, -136, -296, 83, 17, -105, 83, 132, 85,
+	-197, -197, 251, -161, -327, 85, -328, 84, -263, 422,
+	425, -105, -113, -113, -198, 85, -303, 422, -305, -228,
+	-228, -228, -228, -110, -257, -105, -105, -178, 90, -105,
+	-105, 92, 92, -151, 248, -199, -328, -228, 220, -199,
+	-199, -236, -171, -172, 15, 17, -185, 51, -183, 50,
+	-183, -193, 17, 17, 90, 17, 90, 118, -207, -105,
+	-162, 55, -9, -228, -160, -228, -173, -105, 83, -105,
+	-141, -141, -105, -147, 416, 83, -105, 85, 85, -198,
+	-295, -52, 85, -197, 92, 85, -161, -85, -327, 247,
+	-322, -297, 426, 426, -328, 23, -302, -301, -229, 83,
+	75, -86, 142, 422, -328, -328, -328, -328, -328, 85,
+	85, -173, 17, 84, -328, -328, -328, -140, -172, -105,
+	-158, -186, 52, 74, 106, 90, 90, 90, 10, -160,
+	200, -178, -197, -173, -328, -197, 85, 23, 85, 554,
+	118, 85, 247, -9, 84, 132, -197, -136, -328, 420,
+	71, 423, -152, -153, 427, 550, -158, -228, 220, 74,
+	106, -203, -228, 85, -178, 85, -182, -9, 83, -3,
+	-328, -70, 422, -301, -280, -229, 90, 92, 85, 60,
+	421, 424, -328, -154, 126, 86, 505, -155, 92, 96,
+	-313, -314, 74, -323, -320, 103, 124, 99, -321, 112,
+	113, -70, -105, 83, -71, 262, 512, -306, 60, -154,
+	427, 485, 460, 485, 460, -314, 74, 11, 10, 103,
+	90, 85, -197, 225, -303, 422, 123, -312, 233, 228,
+	231, 30, -312, -4, 553, 85, 264, 423, -154, 227,
+	29, 103, 92, -4, 424,
 }
 
 var yyDef = [...]int{
@@ -6040,107 +6095,107 @@ var yyDef = [...]int{
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 65, 67, 68, 709, 709, 709, 0,
-	709, 0, 0, 709, -2, -2, 709, 1125, 0, 709,
+	709, 0, 0, 709, -2, -2, 709, 1141, 0, 709,
 	0, 0, -2, 641, 643, 0, 645, -2, 0, 0,
-	654, 1575, 1575, 704, 0, 0, 0, 0, 0, 709,
-	986, 45, 709, 0, 80, 81, 660, 661, 662, 60,
-	0, 1573, 1, 3, 66, 70, 0, 0, 0, 53,
-	995, 0, 73, 0, 0, 713, 0, 711, 0, 1108,
+	654, 1591, 1591, 704, 0, 0, 0, 0, 0, 709,
+	1002, 45, 709, 0, 80, 81, 660, 661, 662, 60,
+	0, 1589, 1, 3, 66, 70, 0, 0, 0, 53,
+	1011, 0, 73, 0, 0, 713, 0, 711, 0, 1124,
 	709, 709, 0, 111, 112, 0, 0, 0, -2, 115,
 	-2, 139, 140, 0, 144, 507, 447, 490, 445, 476,
 	-2, 438, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 450, 340, 340, 0, 0, -2,
-	438, 438, 438, 0, 0, 0, 473, 1110, 393, 340,
+	438, 438, 438, 0, 0, 0, 473, 1126, 393, 340,
 	340, 0, 340, 340, 340, 340, 0, 0, 340, 340,
 	340, 340, 340, 340, 340, 340, 340, 340, 340, 340,
-	340, 340, 340, 1012, 143, 1126, 1123, 1124, 35, 36,
-	37, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
-	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
-	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
-	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
-	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318,
-	1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328,
-	1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
-	1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348,
-	1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358,
-	1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
-	1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378,
-	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
-	1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
-	1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
-	1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418,
-	1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428,
-	1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438,
-	1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448,
-	1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458,
-	1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468,
-	1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478,
-	1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488,
-	1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498,
-	1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1507, 1508,
-	1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516, 1517, 1518,
-	1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526, 1527, 1528,
-	1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538,
-	1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546, 1547, 1548,
-	1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558,
-	1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568,
-	1569, 1570, 1571, 1572, 0, 1102, 0, 571, 799, 0,
+	340, 340, 340, 1028, 143, 1142, 1139, 1140, 35, 36,
+	37, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
+	1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304,
+	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354,
+	1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364,
+	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374,
+	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
+	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
+	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
+	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
+	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434,
+	1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444,
+	1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454,
+	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464,
+	1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474,
+	1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484,
+	1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494,
+	1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504,
+	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514,
+	1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524,
+	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
+	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544,
+	1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554,
+	1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
+	1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574,
+	1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584,
+	1585, 1586, 1587, 1588, 0, 1118, 0, 571, 799, 0,
 	632, 632, 0, 632, 632, 632, 632, 0, 0, 0,
 	583, 0, 0, 0, 0, 629, 0, 0, 602, 604,
-	0, 629, 0, 635, 0, 616, 632, 1576, 1576, 1576,
-	1093, 0, 626, 624, 638, 639, 621, 622, 640, 644,
-	0, 649, 652, 1119, 1120, 0, 667, 44, 1351, 659,
+	0, 629, 0, 635, 0, 616, 632, 1592, 1592, 1592,
+	1109, 0, 626, 624, 638, 639, 621, 622, 640, 644,
+	0, 649, 652, 1135, 1136, 0, 667, 44, 1367, 659,
 	672, 673, 0, 705, 706, 40, 885, 0, 814, 818,
 	829, 842, 843, 844, 845, 846, 848, 849, 0, 0,
 	0, 0, 854, 855, 0, 0, 0, 0, 0, 866,
-	0, 0, 0, 0, 0, 967, 0, 931, 931, 902,
-	931, 933, 933, 0, 0, 0, 1572, 976, 0, 0,
-	0, 0, 0, 0, 248, 249, 884, 1082, 933, 933,
-	933, 933, -2, -2, 195, 196, 197, 198, 199, 200,
+	0, 0, 0, 0, 0, 983, 0, 947, 947, 918,
+	947, 949, 949, 0, 0, 0, 1588, 992, 0, 0,
+	0, 0, 0, 0, 248, 249, 884, 1098, 949, 949,
+	949, 949, -2, -2, 195, 196, 197, 198, 199, 200,
 	201, 0, 191, 0, 253, 254, 250, 251, 252, 868,
 	869, 207, 208, 209, 210, 211, 212, 213, 214, 215,
 	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
 	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
 	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
-	246, 247, 1575, 0, 682, 0, 0, 995, 0, 987,
-	986, 58, 0, 709, -2, 0, 0, 0, 0, 42,
-	0, 47, 760, 72, 71, 1034, 0, 0, 0, 54,
-	996, 62, 64, 997, 0, 714, 715, 0, 738, 742,
-	0, 710, 0, 0, 1109, 1108, 1108, 97, 0, 1516,
-	1086, -2, -2, 0, 0, 1121, 1122, 1095, -2, 1129,
-	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
-	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
-	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
-	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
-	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
-	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
-	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
-	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
-	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
-	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
-	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
-	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
-	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
+	246, 247, 1591, 0, 682, 0, 0, 1011, 0, 1003,
+	1002, 58, 0, 709, -2, 0, 0, 0, 0, 42,
+	0, 47, 760, 72, 71, 1050, 0, 0, 0, 54,
+	1012, 62, 64, 1013, 0, 714, 715, 0, 738, 742,
+	0, 710, 0, 0, 1125, 1124, 1124, 97, 0, 1532,
+	1102, -2, -2, 0, 0, 1137, 1138, 1111, -2, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
+	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
+	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
 	-2, 0, 0, 153, 154, 0, 38, 366, 0, 149,
-	0, 360, 310, 1012, 0, 0, 0, 0, 0, 709,
-	0, 1103, 134, 135, 141, 142, 340, 340, 487, 0,
+	0, 360, 310, 1028, 0, 0, 0, 0, 0, 709,
+	0, 1119, 134, 135, 141, 142, 340, 340, 487, 0,
 	0, 143, 143, 454, 455, 456, 0, 0, -2, 364,
 	0, 439, 0, 0, 354, 354, 358, 356, 357, 0,
 	0, 0, 0, 0, 0, 467, 0, 468, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 549, 0, 341,
 	0, 485, 486, 394, 0, 0, 0, 0, 465, 466,
-	0, 0, 1111, 1112, 0, 0, 340, 340, 0, 0,
+	0, 0, 1127, 1128, 0, 0, 340, 340, 0, 0,
 	0, 0, 340, 340, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	133, 1025, 0, 0, 0, -2, 0, 563, 0, 0,
-	0, 1104, 1104, 570, 0, 572, 573, 0, 0, 574,
+	133, 1041, 0, 0, 0, -2, 0, 563, 0, 0,
+	0, 1120, 1120, 570, 0, 572, 573, 0, 0, 574,
 	0, 629, 629, 627, 628, 576, 577, 578, 579, 632,
 	0, 0, 349, 350, 351, 629, 632, 0, 632, 632,
 	632, 632, 629, 629, 629, 632, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1576, 1576, 1576, 635, 632,
-	0, 612, 0, 613, 614, 617, 618, 1577, 1578, 1140,
-	619, 620, 1094, 642, 650, 653, 670, 668, 669, 671,
+	0, 0, 0, 0, 0, 1592, 1592, 1592, 635, 632,
+	0, 612, 0, 613, 614, 617, 618, 1593, 1594, 1156,
+	619, 620, 1110, 642, 650, 653, 670, 668, 669, 671,
 	663, 664, 665, 666, 0, 684, 685, 690, 0, 0,
 	0, 0, 696, 697, 698, 0, 0, 701, 702, 703,
 	0, 0, 0, 0, 812, 0, 0, 874, 875, 876,
@@ -6148,24 +6203,24 @@ var yyDef = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 850, 851, 852, 853, 856, 0,
 	0, 0, 861, 862, 0, 0, 0, 0, 0, 719,
-	0, 968, 0, 900, 0, 901, 903, 904, 0, 905,
+	0, 984, 0, 916, 0, 917, 919, 920, 0, 921,
 	0, 0, 0, 0, 722, 0, 0, 722, 722, 0,
 	0, 185, 186, 187, 188, 202, 203, 204, 205, 206,
-	255, 976, 0, 884, 0, 0, 41, 674, 675, 0,
-	782, 1114, 0, 52, 61, 63, 995, 56, 995, 0,
+	255, 992, 0, 884, 0, 0, 41, 674, 675, 0,
+	782, 1130, 0, 52, 61, 63, 1011, 56, 1011, 0,
 	724, 0, 0, -2, -2, 725, 731, 732, 733, 734,
-	735, 49, 1574, 50, 0, 69, 0, 43, 0, 0,
-	0, 0, 322, 1037, 0, 0, 988, 989, 992, 0,
+	735, 49, 1590, 50, 0, 69, 0, 43, 0, 0,
+	0, 0, 322, 1053, 0, 0, 1004, 1005, 1008, 0,
 	739, 743, 0, 745, 746, 0, 712, 0, 95, 0,
-	798, 0, 0, 0, 1516, 1092, 0, 99, 100, 0,
-	0, 0, 328, 1097, 1098, 1099, -2, 347, 0, 328,
+	798, 0, 0, 0, 1532, 1108, 0, 99, 100, 0,
+	0, 0, 328, 1113, 1114, 1115, -2, 347, 0, 328,
 	317, 261, 262, 263, 310, 265, 310, 310, 310, 310,
 	322, 322, 322, 322, 293, 294, 295, 296, 297, 0,
 	0, 280, 310, 310, 310, 310, 300, 301, 302, 303,
 	304, 305, 306, 307, 266, 267, 268, 269, 270, 271,
 	272, 273, 274, 312, 312, 312, 314, 314, 0, 39,
-	0, 332, 0, 992, 0, 1025, 1106, 1116, 0, 0,
-	0, 1106, 117, 0, 0, 488, 518, 448, 477, 489,
+	0, 332, 0, 1008, 0, 1041, 1122, 1132, 0, 0,
+	0, 1122, 117, 0, 0, 488, 518, 448, 477, 489,
 	0, 451, 452, -2, 0, 0, 438, 0, 440, 0,
 	348, 0, -2, 0, 358, 0, 354, 358, 355, 358,
 	346, 359, 469, 470, 471, 0, 529, 768, 0, 0,
@@ -6176,8 +6231,8 @@ var yyDef = [...]int{
 	405, 406, 407, 785, 786, 408, 432, 433, 434, 409,
 	410, 411, 412, 413, 414, 415, 426, 427, 428, 429,
 	430, 431, 416, 417, 418, 419, 420, 423, 0, 127,
-	1016, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1104, 0, 0, 0, 0, 800, 1127, 1128,
+	1032, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1120, 0, 0, 0, 0, 800, 1143, 1144,
 	633, 634, 0, 352, 353, 632, 632, 580, 603, 0,
 	632, 584, 605, 585, 587, 586, 588, 607, 608, 632,
 	591, 630, 631, 592, 593, 594, 595, 596, 597, 598,
@@ -6186,108 +6241,111 @@ var yyDef = [...]int{
 	694, 695, 707, 700, 886, 809, 810, 811, 813, 815,
 	0, 870, 872, 817, 819, 881, 882, 883, 0, 0,
 	0, 0, 0, 823, 827, 830, 831, 832, 833, 834,
-	835, 836, 837, 838, 839, 840, 841, 847, 946, 947,
-	948, 864, 865, 0, 736, 0, 0, 0, 863, 0,
-	0, 0, 0, 720, 721, 974, 971, 0, 0, 932,
-	934, 0, 0, 0, 0, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 949, 0, 0, 0,
-	723, 977, 0, -2, 0, 0, 0, 0, 0, 0,
-	0, 1081, 0, 677, 678, 680, 0, 802, 0, 783,
-	0, 0, 1115, 683, 55, 57, 729, 730, 0, 747,
-	726, 51, 46, 0, 0, 762, 1035, 322, 1057, 0,
-	326, 327, 998, 999, 0, 991, 993, 994, 74, 744,
+	835, 836, 837, 838, 839, 840, 841, 847, 962, 963,
+	964, 864, 865, 0, 736, 0, 0, 0, 863, 0,
+	0, 0, 0, 720, 721, 990, 987, 0, 0, 948,
+	950, 0, 0, 0, 0, 926, 927, 928, 929, 930,
+	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
+	941, 942, 943, 944, 945, 946, 965, 0, 0, 0,
+	723, 993, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 1097, 0, 677, 678, 680, 0, 802, 0, 783,
+	0, 0, 1131, 683, 55, 57, 729, 730, 0, 747,
+	726, 51, 46, 0, 0, 762, 1051, 322, 1073, 0,
+	326, 327, 1014, 1015, 0, 1007, 1009, 1010, 74, 744,
 	740, 0, 807, 0, 0, 797, 0, 750, 752, 753,
-	754, 780, 0, 0, 0, 0, 93, 799, 1087, 98,
-	0, 0, 103, 104, 1088, 1089, 1090, 1091, 0, 507,
+	754, 780, 0, 0, 0, 0, 93, 799, 1103, 98,
+	0, 0, 103, 104, 1104, 1105, 1106, 1107, 0, 507,
 	-2, 390, 155, 157, 158, 159, 150, -2, 320, 318,
 	319, 264, 322, 322, 287, 288, 289, 290, 291, 292,
 	0, 0, 281, 282, 283, 284, 275, 0, 276, 277,
-	278, 0, 279, 365, 0, 1000, 333, 334, 336, 340,
-	0, 0, 0, 361, 362, 0, 1016, 0, 0, 0,
-	1117, 1116, 1116, 1116, 0, 145, 146, 147, 148, 524,
+	278, 0, 279, 365, 0, 1016, 333, 334, 336, 340,
+	0, 0, 0, 361, 362, 0, 1032, 0, 0, 0,
+	1133, 1132, 1132, 1132, 0, 145, 146, 147, 148, 524,
 	0, 0, 519, 522, 523, 143, 0, 0, 151, 442,
 	441, 0, 556, 0, 363, 0, 0, 358, 358, 343,
 	344, 472, 0, 0, 531, 532, 533, 534, 0, 0,
 	0, 440, 442, 328, 0, 397, 398, 403, 404, 421,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 502, 503, 504, 505, 506, 1013, 1014, 1015, 0,
-	0, 564, 0, 0, 382, 89, 1105, 569, 629, 590,
+	0, 502, 503, 504, 505, 506, 1029, 1030, 1031, 0,
+	0, 564, 0, 0, 382, 89, 1121, 569, 629, 590,
 	606, 629, 582, 589, 615, 647, 691, 0, 0, 0,
 	0, 699, 0, 816, 871, 873, 820, 0, 824, 828,
-	0, 0, 0, 0, 0, 0, 0, 867, 891, 892,
-	0, 0, 986, 0, 972, 0, 0, 899, 935, 0,
-	0, 0, 0, 0, 887, 0, 0, 722, 0, 936,
-	937, 938, 939, 940, 977, 676, 679, 681, 758, 0,
-	0, 0, 0, 784, 1113, 0, 979, 0, 0, 747,
-	807, 748, 0, 727, 48, 761, 0, 1039, 1038, 1051,
-	1064, 323, 324, 325, 990, 0, 995, 0, 1075, 0,
-	0, 1067, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 867, 907, 908,
+	0, 0, 1002, 0, 988, 0, 0, 915, 951, 0,
+	0, 0, 0, 0, 887, 0, 0, 722, 0, 952,
+	953, 954, 955, 956, 993, 676, 679, 681, 758, 0,
+	0, 0, 0, 784, 1129, 0, 995, 0, 0, 747,
+	807, 748, 0, 727, 48, 761, 0, 1055, 1054, 1067,
+	1080, 323, 324, 325, 1006, 0, 1011, 0, 1091, 0,
+	0, 1083, 0, 0, 0, 0, 0, 0, 0, 0,
 	787, 0, 0, 790, 0, 0, 0, 0, 781, 0,
 	0, 0, 0, -2, 0, 0, 87, 88, 0, 0,
 	0, 101, 102, 0, 0, 108, 329, 330, 136, 143,
 	392, 156, 372, 0, 0, 260, 321, 285, 286, 0,
-	308, 0, 0, 0, 385, 113, 1004, 1003, 340, 340,
-	335, 0, 338, 0, 1118, 311, 0, 126, 0, 0,
+	308, 0, 0, 0, 385, 113, 1020, 1019, 340, 340,
+	335, 0, 338, 0, 1134, 311, 0, 126, 0, 0,
 	0, 0, 0, 132, 513, 0, 0, 520, 0, 0,
 	0, 446, 0, 457, 458, 0, 528, 555, 0, 560,
 	332, 0, 342, 345, 769, 0, 0, 459, 0, 442,
-	463, 464, 475, 424, 425, 422, 0, 0, 1026, 1027,
-	1032, 1030, 1031, 118, 495, 497, 496, 500, 0, 0,
-	493, 0, 382, 1000, 0, 568, 383, 384, 632, 632,
+	463, 464, 475, 424, 425, 422, 0, 0, 1042, 1043,
+	1048, 1046, 1047, 118, 495, 497, 496, 500, 0, 0,
+	493, 0, 382, 1016, 0, 568, 383, 384, 632, 632,
 	686, 92, 0, 689, 0, 0, 0, 821, 825, 737,
-	0, 0, 310, 310, 954, 310, 314, 957, 310, 959,
-	310, 962, 310, 965, 966, 0, 0, 0, 0, 969,
-	898, 975, 0, 0, 0, 0, 909, 0, 0, 888,
-	889, 0, 978, 0, 0, 0, 0, 0, 1100, 1101,
-	980, 981, 807, 982, 749, 728, 763, 1057, 0, 1050,
-	0, -2, 1059, 0, 0, 0, 1065, 741, 75, 808,
-	78, 0, 1075, 1083, 0, 1066, 1077, 1079, 0, 0,
-	0, 1071, 0, 807, 751, 776, 778, 0, 773, 788,
-	789, 791, 0, 793, 0, 795, 796, 760, 756, 757,
-	0, 95, 0, 807, 807, 94, 0, 801, 105, 106,
-	107, 391, 160, 165, 0, 0, 0, 170, 0, 172,
-	0, 373, 0, 257, 259, 0, 0, 163, 322, 0,
-	322, 0, 315, 0, 374, 386, 0, 1001, 1002, 0,
-	0, 337, 339, 0, 1107, 128, 0, 0, 0, 516,
-	0, 525, 0, 0, 0, 0, 0, 0, 152, 443,
-	0, 0, 331, 0, 0, 553, 550, 460, 0, 189,
-	190, 192, 0, 0, 462, 760, 1017, 1018, 1019, 0,
-	1029, 1033, 121, 0, 0, 0, 0, 565, 566, 567,
-	90, 575, 581, 688, 708, 822, 826, 0, 858, 952,
-	322, 955, 956, 958, 960, 961, 963, 964, 859, 860,
-	0, 894, 0, 896, 995, 0, 973, 0, 0, 908,
-	0, 0, 890, 0, 0, 0, 782, 59, 982, 984,
-	0, 1044, 1042, 1042, 1052, 1053, 0, 0, 1060, 0,
-	0, 0, 0, 79, 0, 0, 0, 1080, 0, 0,
-	0, 0, 96, 986, 770, 777, 0, 0, 771, 0,
-	772, 792, 794, 755, -2, 807, 807, 85, 86, 0,
-	166, 0, 168, 183, 184, 0, 171, 173, 174, 256,
-	258, 0, 0, 298, 309, 299, 0, 0, 387, 1005,
-	1006, 1007, 1008, 1009, 1010, 1011, 760, 129, 130, 131,
-	508, 0, 518, 0, 0, 0, 511, 0, 449, 0,
-	559, 0, 0, 530, 0, 538, 0, 0, 0, 193,
-	194, 0, 1028, 494, 0, 119, 120, 0, 0, 499,
-	941, 953, 0, 0, 0, 970, 0, 0, 0, 0,
-	0, 804, 764, 765, 0, 0, 802, 984, 77, 0,
-	0, 1047, 0, 1040, 1043, 1041, 1054, 0, 0, 1061,
-	0, 1063, 0, 1084, 1085, 1078, 0, 1070, 1073, 1069,
-	1072, 995, 774, 0, 779, 986, 84, 0, 169, 0,
-	0, 0, 313, 316, 0, 0, 0, 509, 0, 521,
-	512, 557, 558, 0, 561, 367, 554, 551, 552, 461,
-	0, 122, 123, 0, 0, 0, 0, 0, 0, 893,
-	895, 897, 906, 907, 950, 951, 0, 803, 805, 806,
-	759, 76, 985, 983, 1036, 0, 1045, 1046, 1055, 1056,
-	1062, 0, 1068, 0, 82, 0, 995, 167, 0, 177,
-	0, 517, 0, 520, 510, 0, 1020, 0, 0, 0,
-	501, 857, 0, 0, 0, 766, 767, 1048, 1049, 1076,
-	1074, 775, 83, 368, 372, 1020, 0, 0, 562, 491,
-	1022, 124, 125, 435, 436, 437, 118, 942, 0, 945,
+	0, 0, 310, 310, 970, 310, 314, 973, 310, 975,
+	310, 978, 310, 981, 982, 0, 0, 0, 0, 985,
+	914, 991, 0, 0, 0, 0, 925, 0, 0, 890,
+	0, 888, 889, 0, 994, 0, 0, 0, 0, 0,
+	1116, 1117, 996, 997, 807, 998, 749, 728, 763, 1073,
+	0, 1066, 0, -2, 1075, 0, 0, 0, 1081, 741,
+	75, 808, 78, 0, 1091, 1099, 0, 1082, 1093, 1095,
+	0, 0, 0, 1087, 0, 807, 751, 776, 778, 0,
+	773, 788, 789, 791, 0, 793, 0, 795, 796, 760,
+	756, 757, 0, 95, 0, 807, 807, 94, 0, 801,
+	105, 106, 107, 391, 160, 165, 0, 0, 0, 170,
+	0, 172, 0, 373, 0, 257, 259, 0, 0, 163,
+	322, 0, 322, 0, 315, 0, 374, 386, 0, 1017,
+	1018, 0, 0, 337, 339, 0, 1123, 128, 0, 0,
+	0, 516, 0, 525, 0, 0, 0, 0, 0, 0,
+	152, 443, 0, 0, 331, 0, 0, 553, 550, 460,
+	0, 189, 190, 192, 0, 0, 462, 760, 1033, 1034,
+	1035, 0, 1045, 1049, 121, 0, 0, 0, 0, 565,
+	566, 567, 90, 575, 581, 688, 708, 822, 826, 0,
+	858, 968, 322, 971, 972, 974, 976, 977, 979, 980,
+	859, 860, 0, 910, 0, 912, 1011, 0, 989, 0,
+	0, 924, 0, 0, 893, 891, 0, 0, 0, 782,
+	59, 998, 1000, 0, 1060, 1058, 1058, 1068, 1069, 0,
+	0, 1076, 0, 0, 0, 0, 79, 0, 0, 0,
+	1096, 0, 0, 0, 0, 96, 1002, 770, 777, 0,
+	0, 771, 0, 772, 792, 794, 755, -2, 807, 807,
+	85, 86, 0, 166, 0, 168, 183, 184, 0, 171,
+	173, 174, 256, 258, 0, 0, 298, 309, 299, 0,
+	0, 387, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 760,
+	129, 130, 131, 508, 0, 518, 0, 0, 0, 511,
+	0, 449, 0, 559, 0, 0, 530, 0, 538, 0,
+	0, 0, 193, 194, 0, 1044, 494, 0, 119, 120,
+	0, 0, 499, 957, 969, 0, 0, 0, 986, 0,
+	0, 0, 0, 1002, 0, 0, 804, 764, 765, 0,
+	0, 802, 1000, 77, 0, 0, 1063, 0, 1056, 1059,
+	1057, 1070, 0, 0, 1077, 0, 1079, 0, 1100, 1101,
+	1094, 0, 1086, 1089, 1085, 1088, 1011, 774, 0, 779,
+	1002, 84, 0, 169, 0, 0, 0, 313, 316, 0,
+	0, 0, 509, 0, 521, 512, 557, 558, 0, 561,
+	367, 554, 551, 552, 461, 0, 122, 123, 0, 0,
+	0, 0, 0, 0, 909, 911, 913, 922, 923, 966,
+	967, 895, 0, 0, 803, 805, 806, 759, 76, 1001,
+	999, 1052, 0, 1061, 1062, 1071, 1072, 1078, 0, 1084,
+	0, 82, 0, 1011, 167, 0, 177, 0, 517, 0,
+	520, 510, 0, 1036, 0, 0, 0, 501, 857, 0,
+	0, 0, 0, 0, 898, 899, 894, 766, 767, 1064,
+	1065, 1092, 1090, 775, 83, 368, 372, 1036, 0, 0,
+	562, 491, 1038, 124, 125, 435, 436, 437, 118, 958,
+	0, 961, 892, 896, 0, 0, 0, 0, 905, 906,
 	369, 370, 0, 161, 178, 179, 0, 0, 182, 175,
-	176, 114, 0, 0, 0, 1023, 1024, 121, 943, 371,
-	0, 0, 0, 180, 181, 526, 0, 0, 498, 0,
-	375, 377, 378, 0, 0, 376, 514, 0, 526, 1021,
-	0, 379, 380, 381, 527, 515, 944,
+	176, 114, 0, 0, 0, 1039, 1040, 121, 959, 0,
+	900, 901, 902, 903, 904, 371, 0, 0, 0, 180,
+	181, 526, 0, 0, 498, 0, 0, 375, 377, 378,
+	0, 0, 376, 514, 0, 526, 1037, 0, 897, 379,
+	380, 381, 527, 515, 960,
 }
 
 var yyTok1 = [...]int{
@@ -6742,68 +6800,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:469
+//line sql.y:482
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:474
+//line sql.y:487
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:475
+//line sql.y:488
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:479
+//line sql.y:492
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:512
+//line sql.y:525
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:518
+//line sql.y:531
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:522
+//line sql.y:535
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:526
+//line sql.y:539
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:531
+//line sql.y:544
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:535
+//line sql.y:548
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:541
+//line sql.y:554
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6811,7 +6869,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:547
+//line sql.y:560
 		{
 			yyLOCAL = &Load{}
 		}
@@ -6819,23 +6877,23 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:553
+//line sql.y:566
 		{
-			yyLOCAL = &With{ctes: yyDollar[2].ctesUnion(), Recursive: false}
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion(), Recursive: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:557
+//line sql.y:570
 		{
-			yyLOCAL = &With{ctes: yyDollar[3].ctesUnion(), Recursive: true}
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:562
+//line sql.y:575
 		{
 			yyLOCAL = nil
 		}
@@ -6843,14 +6901,14 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:566
+//line sql.y:579
 		{
 			yyLOCAL = yyDollar[1].withUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:572
+//line sql.y:585
 		{
 			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
@@ -6858,7 +6916,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*CommonTableExpr
-//line sql.y:576
+//line sql.y:589
 		{
 			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
 		}
@@ -6866,7 +6924,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *CommonTableExpr
-//line sql.y:582
+//line sql.y:595
 		{
 			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
 		}
@@ -6874,7 +6932,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:588
+//line sql.y:601
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -6882,7 +6940,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:592
+//line sql.y:605
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -6890,7 +6948,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:596
+//line sql.y:609
 		{
 			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[2].selStmtUnion()
@@ -6899,7 +6957,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:619
+//line sql.y:632
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6909,7 +6967,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:625
+//line sql.y:638
 		{
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -6918,7 +6976,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:630
+//line sql.y:643
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6928,7 +6986,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:636
+//line sql.y:649
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6939,7 +6997,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:643
+//line sql.y:656
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6949,7 +7007,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:649
+//line sql.y:662
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6959,14 +7017,14 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:656
+//line sql.y:669
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 		}
 	case 59:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:660
+//line sql.y:673
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -6974,7 +7032,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:666
+//line sql.y:679
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6982,7 +7040,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:670
+//line sql.y:683
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6990,7 +7048,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:674
+//line sql.y:687
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6998,7 +7056,7 @@ yydefault:
 	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:678
+//line sql.y:691
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -7006,7 +7064,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:682
+//line sql.y:695
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -7014,7 +7072,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:688
+//line sql.y:701
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -7022,7 +7080,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:692
+//line sql.y:705
 		{
 			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -7031,7 +7089,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:697
+//line sql.y:710
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -7039,7 +7097,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:701
+//line sql.y:714
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -7047,7 +7105,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:707
+//line sql.y:720
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -7055,7 +7113,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:711
+//line sql.y:724
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -7064,7 +7122,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:716
+//line sql.y:729
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
@@ -7074,7 +7132,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:722
+//line sql.y:735
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
@@ -7084,7 +7142,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:728
+//line sql.y:741
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -7093,7 +7151,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:735
+//line sql.y:748
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
@@ -7101,7 +7159,7 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:741
+//line sql.y:754
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
@@ -7109,7 +7167,7 @@ yydefault:
 	case 76:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:749
+//line sql.y:762
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
 		}
@@ -7117,7 +7175,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:753
+//line sql.y:766
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
@@ -7125,7 +7183,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:761
+//line sql.y:774
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -7141,7 +7199,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:773
+//line sql.y:786
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -7155,7 +7213,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:785
+//line sql.y:798
 		{
 			yyLOCAL = InsertAct
 		}
@@ -7163,7 +7221,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:789
+//line sql.y:802
 		{
 			yyLOCAL = ReplaceAct
 		}
@@ -7171,7 +7229,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:808
 		{
 			yyLOCAL = &Update{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: yyDollar[5].tableExprsUnion(), Exprs: yyDollar[7].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion()), OrderBy: yyDollar[9].orderByUnion(), Limit: yyDollar[10].limitUnion()}
 		}
@@ -7179,7 +7237,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:801
+//line sql.y:814
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName, As: yyDollar[7].tableIdent}}, Partitions: yyDollar[8].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion()), OrderBy: yyDollar[10].orderByUnion(), Limit: yyDollar[11].limitUnion()}
 		}
@@ -7187,7 +7245,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:805
+//line sql.y:818
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[6].tableNamesUnion(), TableExprs: yyDollar[8].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion())}
 		}
@@ -7195,7 +7253,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:809
+//line sql.y:822
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
@@ -7203,32 +7261,32 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:813
+//line sql.y:826
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:818
+//line sql.y:831
 		{
 		}
 	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:819
+//line sql.y:832
 		{
 		}
 	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:823
+//line sql.y:836
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:827
+//line sql.y:840
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
@@ -7236,14 +7294,14 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:833
+//line sql.y:846
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:837
+//line sql.y:850
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -7251,14 +7309,14 @@ yydefault:
 	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:843
+//line sql.y:856
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:847
+//line sql.y:860
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -7266,7 +7324,7 @@ yydefault:
 	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:852
+//line sql.y:865
 		{
 			yyLOCAL = nil
 		}
@@ -7274,7 +7332,7 @@ yydefault:
 	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:856
+//line sql.y:869
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
@@ -7282,7 +7340,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:862
+//line sql.y:875
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
@@ -7290,7 +7348,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:868
+//line sql.y:881
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
@@ -7298,7 +7356,7 @@ yydefault:
 	case 99:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:872
+//line sql.y:885
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
@@ -7306,14 +7364,14 @@ yydefault:
 	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:878
+//line sql.y:891
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:882
+//line sql.y:895
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
@@ -7321,7 +7379,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:888
+//line sql.y:901
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
@@ -7329,7 +7387,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:892
+//line sql.y:905
 		{
 			yyLOCAL = ReadWrite
 		}
@@ -7337,7 +7395,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:896
+//line sql.y:909
 		{
 			yyLOCAL = ReadOnly
 		}
@@ -7345,7 +7403,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:902
+//line sql.y:915
 		{
 			yyLOCAL = RepeatableRead
 		}
@@ -7353,7 +7411,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:906
+//line sql.y:919
 		{
 			yyLOCAL = ReadCommitted
 		}
@@ -7361,7 +7419,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:910
+//line sql.y:923
 		{
 			yyLOCAL = ReadUncommitted
 		}
@@ -7369,7 +7427,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:914
+//line sql.y:927
 		{
 			yyLOCAL = Serializable
 		}
@@ -7377,7 +7435,7 @@ yydefault:
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:920
+//line sql.y:933
 		{
 			yyLOCAL = SessionScope
 		}
@@ -7385,7 +7443,7 @@ yydefault:
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:924
+//line sql.y:937
 		{
 			yyLOCAL = GlobalScope
 		}
@@ -7393,7 +7451,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:930
+//line sql.y:943
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
@@ -7403,7 +7461,7 @@ yydefault:
 	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:936
+//line sql.y:949
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -7414,7 +7472,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:943
+//line sql.y:956
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -7427,7 +7485,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:952
+//line sql.y:965
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].definerUnion(), Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
@@ -7435,7 +7493,7 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:956
+//line sql.y:969
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
@@ -7445,7 +7503,7 @@ yydefault:
 	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:963
+//line sql.y:976
 		{
 			yyLOCAL = false
 		}
@@ -7453,33 +7511,33 @@ yydefault:
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:967
+//line sql.y:980
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:972
+//line sql.y:985
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:976
+//line sql.y:989
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:982
+//line sql.y:995
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 121:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:987
+//line sql.y:1000
 		{
 			var v []VindexParam
 			yyLOCAL = v
@@ -7488,7 +7546,7 @@ yydefault:
 	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:992
+//line sql.y:1005
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
@@ -7496,7 +7554,7 @@ yydefault:
 	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:998
+//line sql.y:1011
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
@@ -7504,21 +7562,21 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1003
+//line sql.y:1016
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1009
+//line sql.y:1022
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 126:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:1015
+//line sql.y:1028
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -7527,7 +7585,7 @@ yydefault:
 	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1022
+//line sql.y:1035
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
@@ -7536,7 +7594,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1029
+//line sql.y:1042
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7545,7 +7603,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1034
+//line sql.y:1047
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7554,7 +7612,7 @@ yydefault:
 	case 130:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1039
+//line sql.y:1052
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7563,7 +7621,7 @@ yydefault:
 	case 131:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1044
+//line sql.y:1057
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7572,7 +7630,7 @@ yydefault:
 	case 132:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:1051
+//line sql.y:1064
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -7581,7 +7639,7 @@ yydefault:
 	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:1058
+//line sql.y:1071
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
@@ -7590,7 +7648,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1069
+//line sql.y:1082
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
@@ -7600,7 +7658,7 @@ yydefault:
 	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1076
+//line sql.y:1089
 		{
 			yyLOCAL = nil
 		}
@@ -7608,7 +7666,7 @@ yydefault:
 	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1080
+//line sql.y:1093
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
@@ -7616,7 +7674,7 @@ yydefault:
 	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1086
+//line sql.y:1099
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
@@ -7624,21 +7682,21 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1090
+//line sql.y:1103
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1094
+//line sql.y:1107
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 142:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1098
+//line sql.y:1111
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
@@ -7646,7 +7704,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1104
+//line sql.y:1117
 		{
 			yyLOCAL = false
 		}
@@ -7654,39 +7712,39 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1108
+//line sql.y:1121
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1114
+//line sql.y:1127
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1118
+//line sql.y:1131
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1124
+//line sql.y:1137
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1128
+//line sql.y:1141
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1135
+//line sql.y:1148
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
@@ -7694,7 +7752,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1139
+//line sql.y:1152
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
@@ -7702,14 +7760,14 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:1145
+//line sql.y:1158
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1149
+//line sql.y:1162
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
@@ -7717,7 +7775,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1155
+//line sql.y:1168
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
@@ -7726,7 +7784,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1160
+//line sql.y:1173
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
@@ -7734,39 +7792,39 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1165
+//line sql.y:1178
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
 	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1169
+//line sql.y:1182
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
 	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1174
+//line sql.y:1187
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
 	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1178
+//line sql.y:1191
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1195
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 160:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1193
+//line sql.y:1206
 		{
 			yyDollar[2].columnType.Options = yyDollar[4].columnTypeOptionsUnion()
 			if yyDollar[2].columnType.Options.Collate == "" {
@@ -7779,7 +7837,7 @@ yydefault:
 	case 161:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1202
+//line sql.y:1215
 		{
 			yyDollar[2].columnType.Options = yyDollar[9].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[7].exprUnion()
@@ -7790,20 +7848,20 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1211
+//line sql.y:1224
 		{
 			yyVAL.str = ""
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1215
+//line sql.y:1228
 		{
 			yyVAL.str = ""
 		}
 	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1224
+//line sql.y:1237
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
@@ -7811,7 +7869,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1228
+//line sql.y:1241
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7821,7 +7879,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1234
+//line sql.y:1247
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7831,7 +7889,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1240
+//line sql.y:1253
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7840,7 +7898,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1245
+//line sql.y:1258
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7849,7 +7907,7 @@ yydefault:
 	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1250
+//line sql.y:1263
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7858,7 +7916,7 @@ yydefault:
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1255
+//line sql.y:1268
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7867,7 +7925,7 @@ yydefault:
 	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1260
+//line sql.y:1273
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7876,7 +7934,7 @@ yydefault:
 	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1265
+//line sql.y:1278
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7884,14 +7942,14 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1270
+//line sql.y:1283
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = encodeSQLString(yyDollar[3].str)
 		}
 	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1274
+//line sql.y:1287
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = string(yyDollar[3].colIdent.String())
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7900,7 +7958,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1281
+//line sql.y:1294
 		{
 			yyLOCAL = VirtualStorage
 		}
@@ -7908,7 +7966,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1285
+//line sql.y:1298
 		{
 			yyLOCAL = StoredStorage
 		}
@@ -7916,7 +7974,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1290
+//line sql.y:1303
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
@@ -7924,7 +7982,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1294
+//line sql.y:1307
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7933,7 +7991,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1299
+//line sql.y:1312
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7943,7 +8001,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1305
+//line sql.y:1318
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7953,7 +8011,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1311
+//line sql.y:1324
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7962,7 +8020,7 @@ yydefault:
 	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1316
+//line sql.y:1329
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7971,7 +8029,7 @@ yydefault:
 	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1323
+//line sql.y:1336
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -7979,7 +8037,7 @@ yydefault:
 	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1330
+//line sql.y:1343
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7987,7 +8045,7 @@ yydefault:
 	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1334
+//line sql.y:1347
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7995,7 +8053,7 @@ yydefault:
 	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1338
+//line sql.y:1351
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -8003,7 +8061,7 @@ yydefault:
 	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1342
+//line sql.y:1355
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -8011,7 +8069,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1353
+//line sql.y:1366
 		{
 			yyLOCAL = &NullVal{}
 		}
@@ -8019,7 +8077,7 @@ yydefault:
 	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1360
+//line sql.y:1373
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
@@ -8027,7 +8085,7 @@ yydefault:
 	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1364
+//line sql.y:1377
 		{
 			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
@@ -8035,7 +8093,7 @@ yydefault:
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1370
+//line sql.y:1383
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -8043,7 +8101,7 @@ yydefault:
 	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1374
+//line sql.y:1387
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -8051,7 +8109,7 @@ yydefault:
 	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1378
+//line sql.y:1391
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
@@ -8059,7 +8117,7 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1382
+//line sql.y:1395
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
@@ -8067,7 +8125,7 @@ yydefault:
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1386
+//line sql.y:1399
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
@@ -8075,7 +8133,7 @@ yydefault:
 	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1390
+//line sql.y:1403
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
@@ -8083,7 +8141,7 @@ yydefault:
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1394
+//line sql.y:1407
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
@@ -8092,7 +8150,7 @@ yydefault:
 	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1399
+//line sql.y:1412
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewBitLiteral(yyDollar[2].str)}
 		}
@@ -8100,7 +8158,7 @@ yydefault:
 	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1403
+//line sql.y:1416
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexNumLiteral(yyDollar[2].str)}
 		}
@@ -8108,7 +8166,7 @@ yydefault:
 	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1407
+//line sql.y:1420
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexLiteral(yyDollar[2].str)}
 		}
@@ -8116,7 +8174,7 @@ yydefault:
 	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1411
+//line sql.y:1424
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: yyDollar[2].colNameUnion()}
 		}
@@ -8124,7 +8182,7 @@ yydefault:
 	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1415
+//line sql.y:1428
 		{
 			bindVariable(yylex, yyDollar[2].str[1:])
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewArgument(yyDollar[2].str[1:])}
@@ -8132,254 +8190,254 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1422
+//line sql.y:1435
 		{
 			yyVAL.str = Armscii8Str
 		}
 	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1426
+//line sql.y:1439
 		{
 			yyVAL.str = ASCIIStr
 		}
 	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1430
+//line sql.y:1443
 		{
 			yyVAL.str = Big5Str
 		}
 	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1434
+//line sql.y:1447
 		{
 			yyVAL.str = UBinaryStr
 		}
 	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1438
+//line sql.y:1451
 		{
 			yyVAL.str = Cp1250Str
 		}
 	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1442
+//line sql.y:1455
 		{
 			yyVAL.str = Cp1251Str
 		}
 	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1446
+//line sql.y:1459
 		{
 			yyVAL.str = Cp1256Str
 		}
 	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1450
+//line sql.y:1463
 		{
 			yyVAL.str = Cp1257Str
 		}
 	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1454
+//line sql.y:1467
 		{
 			yyVAL.str = Cp850Str
 		}
 	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1458
+//line sql.y:1471
 		{
 			yyVAL.str = Cp852Str
 		}
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1462
+//line sql.y:1475
 		{
 			yyVAL.str = Cp866Str
 		}
 	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1466
+//line sql.y:1479
 		{
 			yyVAL.str = Cp932Str
 		}
 	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1470
+//line sql.y:1483
 		{
 			yyVAL.str = Dec8Str
 		}
 	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1487
 		{
 			yyVAL.str = EucjpmsStr
 		}
 	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1491
 		{
 			yyVAL.str = EuckrStr
 		}
 	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1482
+//line sql.y:1495
 		{
 			yyVAL.str = Gb18030Str
 		}
 	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1486
+//line sql.y:1499
 		{
 			yyVAL.str = Gb2312Str
 		}
 	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1490
+//line sql.y:1503
 		{
 			yyVAL.str = GbkStr
 		}
 	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1494
+//line sql.y:1507
 		{
 			yyVAL.str = Geostd8Str
 		}
 	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1498
+//line sql.y:1511
 		{
 			yyVAL.str = GreekStr
 		}
 	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1502
+//line sql.y:1515
 		{
 			yyVAL.str = HebrewStr
 		}
 	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1519
 		{
 			yyVAL.str = Hp8Str
 		}
 	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1510
+//line sql.y:1523
 		{
 			yyVAL.str = Keybcs2Str
 		}
 	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1514
+//line sql.y:1527
 		{
 			yyVAL.str = Koi8rStr
 		}
 	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1518
+//line sql.y:1531
 		{
 			yyVAL.str = Koi8uStr
 		}
 	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1535
 		{
 			yyVAL.str = Latin1Str
 		}
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1526
+//line sql.y:1539
 		{
 			yyVAL.str = Latin2Str
 		}
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1530
+//line sql.y:1543
 		{
 			yyVAL.str = Latin5Str
 		}
 	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1534
+//line sql.y:1547
 		{
 			yyVAL.str = Latin7Str
 		}
 	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1538
+//line sql.y:1551
 		{
 			yyVAL.str = MacceStr
 		}
 	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1542
+//line sql.y:1555
 		{
 			yyVAL.str = MacromanStr
 		}
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1546
+//line sql.y:1559
 		{
 			yyVAL.str = SjisStr
 		}
 	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1550
+//line sql.y:1563
 		{
 			yyVAL.str = Swe7Str
 		}
 	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1554
+//line sql.y:1567
 		{
 			yyVAL.str = Tis620Str
 		}
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1558
+//line sql.y:1571
 		{
 			yyVAL.str = Ucs2Str
 		}
 	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1562
+//line sql.y:1575
 		{
 			yyVAL.str = UjisStr
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1566
+//line sql.y:1579
 		{
 			yyVAL.str = Utf16Str
 		}
 	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1570
+//line sql.y:1583
 		{
 			yyVAL.str = Utf16leStr
 		}
 	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1587
 		{
 			yyVAL.str = Utf32Str
 		}
 	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1591
 		{
 			yyVAL.str = Utf8Str
 		}
 	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1582
+//line sql.y:1595
 		{
 			yyVAL.str = Utf8mb4Str
 		}
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1592
+//line sql.y:1605
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
@@ -8387,7 +8445,7 @@ yydefault:
 	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1596
+//line sql.y:1609
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
@@ -8395,7 +8453,7 @@ yydefault:
 	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1600
+//line sql.y:1613
 		{
 			yyLOCAL = NewDecimalLiteral(yyDollar[1].str)
 		}
@@ -8403,7 +8461,7 @@ yydefault:
 	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1607
+//line sql.y:1620
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
@@ -8411,7 +8469,7 @@ yydefault:
 	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1611
+//line sql.y:1624
 		{
 			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
 		}
@@ -8419,7 +8477,7 @@ yydefault:
 	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1615
+//line sql.y:1628
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -8427,7 +8485,7 @@ yydefault:
 	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1621
+//line sql.y:1634
 		{
 			yyLOCAL = colKeyPrimary
 		}
@@ -8435,7 +8493,7 @@ yydefault:
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1625
+//line sql.y:1638
 		{
 			yyLOCAL = colKeyUnique
 		}
@@ -8443,7 +8501,7 @@ yydefault:
 	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1629
+//line sql.y:1642
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
@@ -8451,14 +8509,14 @@ yydefault:
 	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1633
+//line sql.y:1646
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
 	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1639
+//line sql.y:1652
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
@@ -8466,74 +8524,74 @@ yydefault:
 		}
 	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1650
+//line sql.y:1663
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
 	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1668
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1661
+//line sql.y:1674
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1665
+//line sql.y:1678
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1669
+//line sql.y:1682
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1673
+//line sql.y:1686
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1677
+//line sql.y:1690
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1681
+//line sql.y:1694
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1685
+//line sql.y:1698
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1689
+//line sql.y:1702
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1693
+//line sql.y:1706
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1699
+//line sql.y:1712
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8541,7 +8599,7 @@ yydefault:
 		}
 	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1705
+//line sql.y:1718
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8549,7 +8607,7 @@ yydefault:
 		}
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1711
+//line sql.y:1724
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8557,7 +8615,7 @@ yydefault:
 		}
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1717
+//line sql.y:1730
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8565,7 +8623,7 @@ yydefault:
 		}
 	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1723
+//line sql.y:1736
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8573,189 +8631,189 @@ yydefault:
 		}
 	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1744
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1735
+//line sql.y:1748
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1739
+//line sql.y:1752
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1743
+//line sql.y:1756
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1747
+//line sql.y:1760
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1753
+//line sql.y:1766
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+//line sql.y:1770
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1761
+//line sql.y:1774
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1765
+//line sql.y:1778
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1769
+//line sql.y:1782
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1773
+//line sql.y:1786
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1777
+//line sql.y:1790
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1781
+//line sql.y:1794
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1785
+//line sql.y:1798
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1789
+//line sql.y:1802
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1793
+//line sql.y:1806
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1797
+//line sql.y:1810
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1801
+//line sql.y:1814
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 298:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1805
+//line sql.y:1818
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 299:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1810
+//line sql.y:1823
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1816
+//line sql.y:1829
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1820
+//line sql.y:1833
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1824
+//line sql.y:1837
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1828
+//line sql.y:1841
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1832
+//line sql.y:1845
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1836
+//line sql.y:1849
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1840
+//line sql.y:1853
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1844
+//line sql.y:1857
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1863
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
 	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1855
+//line sql.y:1868
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
 	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1860
+//line sql.y:1873
 		{
 			yyLOCAL = nil
 		}
@@ -8763,20 +8821,20 @@ yydefault:
 	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1864
+//line sql.y:1877
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1869
+//line sql.y:1882
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 313:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1873
+//line sql.y:1886
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8785,13 +8843,13 @@ yydefault:
 		}
 	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1881
+//line sql.y:1894
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1898
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8799,7 +8857,7 @@ yydefault:
 		}
 	case 316:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1891
+//line sql.y:1904
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8809,7 +8867,7 @@ yydefault:
 	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1899
+//line sql.y:1912
 		{
 			yyLOCAL = false
 		}
@@ -8817,7 +8875,7 @@ yydefault:
 	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1903
+//line sql.y:1916
 		{
 			yyLOCAL = true
 		}
@@ -8825,7 +8883,7 @@ yydefault:
 	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1907
+//line sql.y:1920
 		{
 			yyLOCAL = false
 		}
@@ -8833,7 +8891,7 @@ yydefault:
 	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1912
+//line sql.y:1925
 		{
 			yyLOCAL = false
 		}
@@ -8841,71 +8899,71 @@ yydefault:
 	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1916
+//line sql.y:1929
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1921
+//line sql.y:1934
 		{
 			yyVAL.str = ""
 		}
 	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1925
+//line sql.y:1938
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1929
+//line sql.y:1942
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1933
+//line sql.y:1946
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1937
+//line sql.y:1950
 		{
 			// ASCII: Shorthand for CHARACTER SET latin1.
 			yyVAL.str = "latin1"
 		}
 	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1942
+//line sql.y:1955
 		{
 			// UNICODE: Shorthand for CHARACTER SET ucs2.
 			yyVAL.str = "ucs2"
 		}
 	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1948
+//line sql.y:1961
 		{
 			yyVAL.str = ""
 		}
 	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1952
+//line sql.y:1965
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1956
+//line sql.y:1969
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 331:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1963
+//line sql.y:1976
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
@@ -8913,7 +8971,7 @@ yydefault:
 	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1968
+//line sql.y:1981
 		{
 			yyLOCAL = nil
 		}
@@ -8921,7 +8979,7 @@ yydefault:
 	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1972
+//line sql.y:1985
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
@@ -8929,14 +8987,14 @@ yydefault:
 	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1978
+//line sql.y:1991
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1982
+//line sql.y:1995
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
@@ -8944,7 +9002,7 @@ yydefault:
 	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1988
+//line sql.y:2001
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
@@ -8952,7 +9010,7 @@ yydefault:
 	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1992
+//line sql.y:2005
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
@@ -8961,7 +9019,7 @@ yydefault:
 	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1997
+//line sql.y:2010
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -8969,27 +9027,27 @@ yydefault:
 	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2001
+//line sql.y:2014
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
 	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2007
+//line sql.y:2020
 		{
 			yyVAL.str = ""
 		}
 	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2011
+//line sql.y:2024
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2017
+//line sql.y:2030
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
@@ -8997,7 +9055,7 @@ yydefault:
 	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2021
+//line sql.y:2034
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
@@ -9005,7 +9063,7 @@ yydefault:
 	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2025
+//line sql.y:2038
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
@@ -9013,7 +9071,7 @@ yydefault:
 	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2029
+//line sql.y:2042
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
@@ -9021,100 +9079,100 @@ yydefault:
 	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2033
+//line sql.y:2046
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2038
+//line sql.y:2051
 		{
 			yyVAL.str = ""
 		}
 	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2042
+//line sql.y:2055
 		{
 			yyVAL.str = yyDollar[2].str
 		}
 	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2061
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2052
+//line sql.y:2065
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2056
+//line sql.y:2069
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2063
+//line sql.y:2076
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2067
+//line sql.y:2080
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2072
+//line sql.y:2085
 		{
 			yyVAL.str = "key"
 		}
 	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2076
+//line sql.y:2089
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2082
+//line sql.y:2095
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2099
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2091
+//line sql.y:2104
 		{
 			yyVAL.str = ""
 		}
 	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2108
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:2101
+//line sql.y:2114
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2105
+//line sql.y:2118
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
@@ -9122,7 +9180,7 @@ yydefault:
 	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:2111
+//line sql.y:2124
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
@@ -9130,7 +9188,7 @@ yydefault:
 	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2117
+//line sql.y:2130
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -9138,7 +9196,7 @@ yydefault:
 	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2121
+//line sql.y:2134
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -9146,7 +9204,7 @@ yydefault:
 	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2127
+//line sql.y:2140
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -9154,7 +9212,7 @@ yydefault:
 	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2131
+//line sql.y:2144
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -9162,7 +9220,7 @@ yydefault:
 	case 367:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2137
+//line sql.y:2150
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
@@ -9170,7 +9228,7 @@ yydefault:
 	case 368:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2143
+//line sql.y:2156
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
@@ -9178,7 +9236,7 @@ yydefault:
 	case 369:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2147
+//line sql.y:2160
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -9186,7 +9244,7 @@ yydefault:
 	case 370:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2151
+//line sql.y:2164
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -9194,7 +9252,7 @@ yydefault:
 	case 371:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2155
+//line sql.y:2168
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
@@ -9202,7 +9260,7 @@ yydefault:
 	case 372:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2160
+//line sql.y:2173
 		{
 			yyLOCAL = nil
 		}
@@ -9210,7 +9268,7 @@ yydefault:
 	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2164
+//line sql.y:2177
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
@@ -9218,7 +9276,7 @@ yydefault:
 	case 374:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2170
+//line sql.y:2183
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
@@ -9226,7 +9284,7 @@ yydefault:
 	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2176
+//line sql.y:2189
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -9234,7 +9292,7 @@ yydefault:
 	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2182
+//line sql.y:2195
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -9242,7 +9300,7 @@ yydefault:
 	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2188
+//line sql.y:2201
 		{
 			yyLOCAL = Restrict
 		}
@@ -9250,7 +9308,7 @@ yydefault:
 	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2192
+//line sql.y:2205
 		{
 			yyLOCAL = Cascade
 		}
@@ -9258,7 +9316,7 @@ yydefault:
 	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2196
+//line sql.y:2209
 		{
 			yyLOCAL = NoAction
 		}
@@ -9266,7 +9324,7 @@ yydefault:
 	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2200
+//line sql.y:2213
 		{
 			yyLOCAL = SetDefault
 		}
@@ -9274,33 +9332,33 @@ yydefault:
 	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2204
+//line sql.y:2217
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
 	case 382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2209
+//line sql.y:2222
 		{
 			yyVAL.str = ""
 		}
 	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2213
+//line sql.y:2226
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2217
+//line sql.y:2230
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2222
+//line sql.y:2235
 		{
 			yyLOCAL = true
 		}
@@ -9308,7 +9366,7 @@ yydefault:
 	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2226
+//line sql.y:2239
 		{
 			yyLOCAL = true
 		}
@@ -9316,7 +9374,7 @@ yydefault:
 	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2230
+//line sql.y:2243
 		{
 			yyLOCAL = false
 		}
@@ -9324,7 +9382,7 @@ yydefault:
 	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2235
+//line sql.y:2248
 		{
 			yyLOCAL = nil
 		}
@@ -9332,7 +9390,7 @@ yydefault:
 	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2239
+//line sql.y:2252
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -9340,21 +9398,21 @@ yydefault:
 	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2245
+//line sql.y:2258
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2249
+//line sql.y:2262
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
 	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2253
+//line sql.y:2266
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -9362,14 +9420,14 @@ yydefault:
 	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2259
+//line sql.y:2272
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2263
+//line sql.y:2276
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -9377,7 +9435,7 @@ yydefault:
 	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2269
+//line sql.y:2282
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9385,7 +9443,7 @@ yydefault:
 	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2273
+//line sql.y:2286
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9393,7 +9451,7 @@ yydefault:
 	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2277
+//line sql.y:2290
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
@@ -9401,7 +9459,7 @@ yydefault:
 	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2281
+//line sql.y:2294
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
@@ -9409,7 +9467,7 @@ yydefault:
 	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2285
+//line sql.y:2298
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9417,7 +9475,7 @@ yydefault:
 	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2289
+//line sql.y:2302
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9425,7 +9483,7 @@ yydefault:
 	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2293
+//line sql.y:2306
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9433,7 +9491,7 @@ yydefault:
 	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2297
+//line sql.y:2310
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9441,7 +9499,7 @@ yydefault:
 	case 403:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2301
+//line sql.y:2314
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -9449,7 +9507,7 @@ yydefault:
 	case 404:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2305
+//line sql.y:2318
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -9457,7 +9515,7 @@ yydefault:
 	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2309
+//line sql.y:2322
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9465,7 +9523,7 @@ yydefault:
 	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2313
+//line sql.y:2326
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9473,7 +9531,7 @@ yydefault:
 	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2317
+//line sql.y:2330
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
@@ -9481,7 +9539,7 @@ yydefault:
 	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2321
+//line sql.y:2334
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9489,7 +9547,7 @@ yydefault:
 	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2325
+//line sql.y:2338
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9497,7 +9555,7 @@ yydefault:
 	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2329
+//line sql.y:2342
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9505,7 +9563,7 @@ yydefault:
 	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2333
+//line sql.y:2346
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9513,7 +9571,7 @@ yydefault:
 	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2337
+//line sql.y:2350
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9521,7 +9579,7 @@ yydefault:
 	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2341
+//line sql.y:2354
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9529,7 +9587,7 @@ yydefault:
 	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2345
+//line sql.y:2358
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9537,7 +9595,7 @@ yydefault:
 	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2349
+//line sql.y:2362
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9545,7 +9603,7 @@ yydefault:
 	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2353
+//line sql.y:2366
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9553,7 +9611,7 @@ yydefault:
 	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2357
+//line sql.y:2370
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9561,7 +9619,7 @@ yydefault:
 	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2361
+//line sql.y:2374
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9569,7 +9627,7 @@ yydefault:
 	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2365
+//line sql.y:2378
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9577,7 +9635,7 @@ yydefault:
 	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2369
+//line sql.y:2382
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9585,7 +9643,7 @@ yydefault:
 	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2373
+//line sql.y:2386
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
@@ -9593,57 +9651,57 @@ yydefault:
 	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2377
+//line sql.y:2390
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2382
+//line sql.y:2395
 		{
 			yyVAL.str = ""
 		}
 	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2386
+//line sql.y:2399
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2390
+//line sql.y:2403
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2409
+//line sql.y:2422
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2413
+//line sql.y:2426
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2417
+//line sql.y:2430
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2422
+//line sql.y:2435
 		{
 			yyVAL.str = ""
 		}
 	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2428
+//line sql.y:2441
 		{
 			yyLOCAL = false
 		}
@@ -9651,7 +9709,7 @@ yydefault:
 	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2432
+//line sql.y:2445
 		{
 			yyLOCAL = true
 		}
@@ -9659,7 +9717,7 @@ yydefault:
 	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2437
+//line sql.y:2450
 		{
 			yyLOCAL = nil
 		}
@@ -9667,7 +9725,7 @@ yydefault:
 	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2441
+//line sql.y:2454
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
@@ -9675,7 +9733,7 @@ yydefault:
 	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2446
+//line sql.y:2459
 		{
 			yyLOCAL = nil
 		}
@@ -9683,14 +9741,14 @@ yydefault:
 	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2450
+//line sql.y:2463
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 446:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2454
+//line sql.y:2467
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
@@ -9698,14 +9756,14 @@ yydefault:
 	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2458
+//line sql.y:2471
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2462
+//line sql.y:2475
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
@@ -9713,7 +9771,7 @@ yydefault:
 	case 449:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2466
+//line sql.y:2479
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
@@ -9721,21 +9779,21 @@ yydefault:
 	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2472
+//line sql.y:2485
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2476
+//line sql.y:2489
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
 	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2480
+//line sql.y:2493
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -9743,7 +9801,7 @@ yydefault:
 	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2486
+//line sql.y:2499
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -9751,7 +9809,7 @@ yydefault:
 	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2490
+//line sql.y:2503
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -9759,7 +9817,7 @@ yydefault:
 	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2494
+//line sql.y:2507
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -9767,7 +9825,7 @@ yydefault:
 	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2498
+//line sql.y:2511
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
@@ -9775,7 +9833,7 @@ yydefault:
 	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2502
+//line sql.y:2515
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
@@ -9783,7 +9841,7 @@ yydefault:
 	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2506
+//line sql.y:2519
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -9791,7 +9849,7 @@ yydefault:
 	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2510
+//line sql.y:2523
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
@@ -9799,7 +9857,7 @@ yydefault:
 	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2514
+//line sql.y:2527
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
@@ -9807,7 +9865,7 @@ yydefault:
 	case 461:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2518
+//line sql.y:2531
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[7].exprUnion()}
 		}
@@ -9815,7 +9873,7 @@ yydefault:
 	case 462:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2522
+//line sql.y:2535
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
@@ -9823,7 +9881,7 @@ yydefault:
 	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2526
+//line sql.y:2539
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -9831,7 +9889,7 @@ yydefault:
 	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2530
+//line sql.y:2543
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
@@ -9839,7 +9897,7 @@ yydefault:
 	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2534
+//line sql.y:2547
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
@@ -9847,7 +9905,7 @@ yydefault:
 	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2538
+//line sql.y:2551
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
@@ -9855,7 +9913,7 @@ yydefault:
 	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2542
+//line sql.y:2555
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
@@ -9863,7 +9921,7 @@ yydefault:
 	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2546
+//line sql.y:2559
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
@@ -9871,7 +9929,7 @@ yydefault:
 	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2550
+//line sql.y:2563
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
@@ -9879,7 +9937,7 @@ yydefault:
 	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2554
+//line sql.y:2567
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
@@ -9887,7 +9945,7 @@ yydefault:
 	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2558
+//line sql.y:2571
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
@@ -9895,7 +9953,7 @@ yydefault:
 	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2562
+//line sql.y:2575
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
@@ -9903,7 +9961,7 @@ yydefault:
 	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2566
+//line sql.y:2579
 		{
 			yyLOCAL = &Force{}
 		}
@@ -9911,7 +9969,7 @@ yydefault:
 	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2570
+//line sql.y:2583
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
@@ -9919,7 +9977,7 @@ yydefault:
 	case 475:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2574
+//line sql.y:2587
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
@@ -9927,14 +9985,14 @@ yydefault:
 	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2580
+//line sql.y:2593
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2584
+//line sql.y:2597
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -9942,7 +10000,7 @@ yydefault:
 	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2590
+//line sql.y:2603
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9950,7 +10008,7 @@ yydefault:
 	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2594
+//line sql.y:2607
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9958,7 +10016,7 @@ yydefault:
 	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2598
+//line sql.y:2611
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9966,7 +10024,7 @@ yydefault:
 	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2602
+//line sql.y:2615
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
@@ -9974,7 +10032,7 @@ yydefault:
 	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2606
+//line sql.y:2619
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
@@ -9982,7 +10040,7 @@ yydefault:
 	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2610
+//line sql.y:2623
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
@@ -9990,7 +10048,7 @@ yydefault:
 	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2614
+//line sql.y:2627
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
@@ -9998,7 +10056,7 @@ yydefault:
 	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2618
+//line sql.y:2631
 		{
 			yyLOCAL = &Validation{With: true}
 		}
@@ -10006,7 +10064,7 @@ yydefault:
 	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2622
+//line sql.y:2635
 		{
 			yyLOCAL = &Validation{With: false}
 		}
@@ -10014,7 +10072,7 @@ yydefault:
 	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2628
+//line sql.y:2641
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10025,7 +10083,7 @@ yydefault:
 	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2635
+//line sql.y:2648
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10036,7 +10094,7 @@ yydefault:
 	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2642
+//line sql.y:2655
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10047,7 +10105,7 @@ yydefault:
 	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2649
+//line sql.y:2662
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
@@ -10057,7 +10115,7 @@ yydefault:
 	case 491:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2655
+//line sql.y:2668
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), Algorithm: yyDollar[3].str, Definer: yyDollar[4].definerUnion(), Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
@@ -10065,7 +10123,7 @@ yydefault:
 	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2659
+//line sql.y:2672
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10076,7 +10134,7 @@ yydefault:
 	case 493:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2666
+//line sql.y:2679
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10087,7 +10145,7 @@ yydefault:
 	case 494:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2673
+//line sql.y:2686
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -10103,7 +10161,7 @@ yydefault:
 	case 495:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2685
+//line sql.y:2698
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -10117,7 +10175,7 @@ yydefault:
 	case 496:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2695
+//line sql.y:2708
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -10125,7 +10183,7 @@ yydefault:
 	case 497:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2699
+//line sql.y:2712
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -10133,7 +10191,7 @@ yydefault:
 	case 498:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2703
+//line sql.y:2716
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -10150,7 +10208,7 @@ yydefault:
 	case 499:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2716
+//line sql.y:2729
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -10164,7 +10222,7 @@ yydefault:
 	case 500:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2726
+//line sql.y:2739
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -10172,7 +10230,7 @@ yydefault:
 	case 501:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2730
+//line sql.y:2743
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -10187,7 +10245,7 @@ yydefault:
 	case 502:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2741
+//line sql.y:2754
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -10198,7 +10256,7 @@ yydefault:
 	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2748
+//line sql.y:2761
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CleanupMigrationType,
@@ -10209,7 +10267,7 @@ yydefault:
 	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2755
+//line sql.y:2768
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -10220,7 +10278,7 @@ yydefault:
 	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2762
+//line sql.y:2775
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -10231,7 +10289,7 @@ yydefault:
 	case 506:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2769
+//line sql.y:2782
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
@@ -10241,7 +10299,7 @@ yydefault:
 	case 507:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2776
+//line sql.y:2789
 		{
 			yyLOCAL = nil
 		}
@@ -10249,7 +10307,7 @@ yydefault:
 	case 508:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2780
+//line sql.y:2793
 		{
 			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].integerUnion()
 			yyDollar[3].partitionOptionUnion().SubPartition = yyDollar[5].subPartitionUnion()
@@ -10260,7 +10318,7 @@ yydefault:
 	case 509:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2789
+//line sql.y:2802
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear: yyDollar[1].booleanUnion(),
@@ -10272,7 +10330,7 @@ yydefault:
 	case 510:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2797
+//line sql.y:2810
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear:     yyDollar[1].booleanUnion(),
@@ -10285,7 +10343,7 @@ yydefault:
 	case 511:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2806
+//line sql.y:2819
 		{
 			yyLOCAL = &PartitionOption{
 				Type: yyDollar[1].partitionByTypeUnion(),
@@ -10296,7 +10354,7 @@ yydefault:
 	case 512:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2813
+//line sql.y:2826
 		{
 			yyLOCAL = &PartitionOption{
 				Type:    yyDollar[1].partitionByTypeUnion(),
@@ -10307,7 +10365,7 @@ yydefault:
 	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2821
+//line sql.y:2834
 		{
 			yyLOCAL = nil
 		}
@@ -10315,7 +10373,7 @@ yydefault:
 	case 514:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2825
+//line sql.y:2838
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10328,7 +10386,7 @@ yydefault:
 	case 515:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2834
+//line sql.y:2847
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10342,7 +10400,7 @@ yydefault:
 	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2845
+//line sql.y:2858
 		{
 			yyLOCAL = nil
 		}
@@ -10350,7 +10408,7 @@ yydefault:
 	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2849
+//line sql.y:2862
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
@@ -10358,7 +10416,7 @@ yydefault:
 	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2854
+//line sql.y:2867
 		{
 			yyLOCAL = false
 		}
@@ -10366,7 +10424,7 @@ yydefault:
 	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2858
+//line sql.y:2871
 		{
 			yyLOCAL = true
 		}
@@ -10374,7 +10432,7 @@ yydefault:
 	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2863
+//line sql.y:2876
 		{
 			yyLOCAL = 0
 		}
@@ -10382,7 +10440,7 @@ yydefault:
 	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2867
+//line sql.y:2880
 		{
 			yyLOCAL = convertStringToInt(yyDollar[3].str)
 		}
@@ -10390,7 +10448,7 @@ yydefault:
 	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2873
+//line sql.y:2886
 		{
 			yyLOCAL = RangeType
 		}
@@ -10398,7 +10456,7 @@ yydefault:
 	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2877
+//line sql.y:2890
 		{
 			yyLOCAL = ListType
 		}
@@ -10406,7 +10464,7 @@ yydefault:
 	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2882
+//line sql.y:2895
 		{
 			yyLOCAL = -1
 		}
@@ -10414,7 +10472,7 @@ yydefault:
 	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2886
+//line sql.y:2899
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
@@ -10422,7 +10480,7 @@ yydefault:
 	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2891
+//line sql.y:2904
 		{
 			yyLOCAL = -1
 		}
@@ -10430,7 +10488,7 @@ yydefault:
 	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2895
+//line sql.y:2908
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
@@ -10438,7 +10496,7 @@ yydefault:
 	case 528:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2901
+//line sql.y:2914
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
@@ -10446,7 +10504,7 @@ yydefault:
 	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2905
+//line sql.y:2918
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10454,7 +10512,7 @@ yydefault:
 	case 530:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2909
+//line sql.y:2922
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
@@ -10462,7 +10520,7 @@ yydefault:
 	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2913
+//line sql.y:2926
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10470,7 +10528,7 @@ yydefault:
 	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2917
+//line sql.y:2930
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
@@ -10478,7 +10536,7 @@ yydefault:
 	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2921
+//line sql.y:2934
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10486,7 +10544,7 @@ yydefault:
 	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2925
+//line sql.y:2938
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
@@ -10494,7 +10552,7 @@ yydefault:
 	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2929
+//line sql.y:2942
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10502,7 +10560,7 @@ yydefault:
 	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2933
+//line sql.y:2946
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
@@ -10510,7 +10568,7 @@ yydefault:
 	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2937
+//line sql.y:2950
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -10518,7 +10576,7 @@ yydefault:
 	case 538:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2941
+//line sql.y:2954
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
@@ -10526,7 +10584,7 @@ yydefault:
 	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2945
+//line sql.y:2958
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10534,7 +10592,7 @@ yydefault:
 	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2949
+//line sql.y:2962
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
@@ -10542,7 +10600,7 @@ yydefault:
 	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2953
+//line sql.y:2966
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10550,7 +10608,7 @@ yydefault:
 	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2957
+//line sql.y:2970
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
@@ -10558,7 +10616,7 @@ yydefault:
 	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2961
+//line sql.y:2974
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10566,7 +10624,7 @@ yydefault:
 	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2965
+//line sql.y:2978
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
@@ -10574,7 +10632,7 @@ yydefault:
 	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2969
+//line sql.y:2982
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10582,7 +10640,7 @@ yydefault:
 	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2973
+//line sql.y:2986
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
@@ -10590,7 +10648,7 @@ yydefault:
 	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2977
+//line sql.y:2990
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10598,7 +10656,7 @@ yydefault:
 	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2981
+//line sql.y:2994
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
@@ -10606,7 +10664,7 @@ yydefault:
 	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2985
+//line sql.y:2998
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
@@ -10614,7 +10672,7 @@ yydefault:
 	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2990
+//line sql.y:3003
 		{
 			yyLOCAL = false
 		}
@@ -10622,7 +10680,7 @@ yydefault:
 	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2994
+//line sql.y:3007
 		{
 			yyLOCAL = false
 		}
@@ -10630,7 +10688,7 @@ yydefault:
 	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2998
+//line sql.y:3011
 		{
 			yyLOCAL = true
 		}
@@ -10638,28 +10696,28 @@ yydefault:
 	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:3005
+//line sql.y:3018
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3009
+//line sql.y:3022
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
 	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3015
+//line sql.y:3028
 		{
 			yyVAL.partDefUnion().ValueRange = yyDollar[2].partitionValueRangeUnion()
 		}
 	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3020
+//line sql.y:3033
 		{
 			yyLOCAL = nil
 		}
@@ -10667,7 +10725,7 @@ yydefault:
 	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3024
+//line sql.y:3037
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  LessThanType,
@@ -10678,7 +10736,7 @@ yydefault:
 	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3031
+//line sql.y:3044
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:     LessThanType,
@@ -10689,7 +10747,7 @@ yydefault:
 	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3038
+//line sql.y:3051
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  InType,
@@ -10700,27 +10758,27 @@ yydefault:
 	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:3047
+//line sql.y:3060
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3053
+//line sql.y:3066
 		{
 			yyVAL.str = ""
 		}
 	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3057
+//line sql.y:3070
 		{
 			yyVAL.str = ""
 		}
 	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3063
+//line sql.y:3076
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
@@ -10728,14 +10786,14 @@ yydefault:
 	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:3069
+//line sql.y:3082
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
 	case 565:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3073
+//line sql.y:3086
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
@@ -10743,7 +10801,7 @@ yydefault:
 	case 566:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3079
+//line sql.y:3092
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
@@ -10751,7 +10809,7 @@ yydefault:
 	case 567:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3083
+//line sql.y:3096
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -10764,7 +10822,7 @@ yydefault:
 	case 568:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3092
+//line sql.y:3105
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), Comments: Comments(yyDollar[2].strs), IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -10772,7 +10830,7 @@ yydefault:
 	case 569:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3096
+//line sql.y:3109
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -10780,7 +10838,7 @@ yydefault:
 	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3102
+//line sql.y:3115
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
@@ -10788,7 +10846,7 @@ yydefault:
 	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3106
+//line sql.y:3119
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
@@ -10796,7 +10854,7 @@ yydefault:
 	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3111
+//line sql.y:3124
 		{
 			yyLOCAL = &OtherRead{}
 		}
@@ -10804,7 +10862,7 @@ yydefault:
 	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3117
+//line sql.y:3130
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10812,7 +10870,7 @@ yydefault:
 	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3121
+//line sql.y:3134
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10820,7 +10878,7 @@ yydefault:
 	case 575:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3125
+//line sql.y:3138
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -10828,7 +10886,7 @@ yydefault:
 	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3129
+//line sql.y:3142
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10836,7 +10894,7 @@ yydefault:
 	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3133
+//line sql.y:3146
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10844,7 +10902,7 @@ yydefault:
 	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3137
+//line sql.y:3150
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10852,7 +10910,7 @@ yydefault:
 	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3141
+//line sql.y:3154
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10860,7 +10918,7 @@ yydefault:
 	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3145
+//line sql.y:3158
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10868,7 +10926,7 @@ yydefault:
 	case 581:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3149
+//line sql.y:3162
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -10876,7 +10934,7 @@ yydefault:
 	case 582:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3153
+//line sql.y:3166
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10884,7 +10942,7 @@ yydefault:
 	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3157
+//line sql.y:3170
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
@@ -10892,7 +10950,7 @@ yydefault:
 	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3161
+//line sql.y:3174
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10900,7 +10958,7 @@ yydefault:
 	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3165
+//line sql.y:3178
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10908,7 +10966,7 @@ yydefault:
 	case 586:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3169
+//line sql.y:3182
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10916,7 +10974,7 @@ yydefault:
 	case 587:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3173
+//line sql.y:3186
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10924,7 +10982,7 @@ yydefault:
 	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3177
+//line sql.y:3190
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10932,7 +10990,7 @@ yydefault:
 	case 589:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3181
+//line sql.y:3194
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10940,7 +10998,7 @@ yydefault:
 	case 590:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3185
+//line sql.y:3198
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10948,7 +11006,7 @@ yydefault:
 	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3189
+//line sql.y:3202
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10956,7 +11014,7 @@ yydefault:
 	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3193
+//line sql.y:3206
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
@@ -10964,7 +11022,7 @@ yydefault:
 	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3197
+//line sql.y:3210
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
@@ -10972,7 +11030,7 @@ yydefault:
 	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3201
+//line sql.y:3214
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
@@ -10980,7 +11038,7 @@ yydefault:
 	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3205
+//line sql.y:3218
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
@@ -10988,7 +11046,7 @@ yydefault:
 	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3209
+//line sql.y:3222
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
@@ -10996,7 +11054,7 @@ yydefault:
 	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3213
+//line sql.y:3226
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
@@ -11004,7 +11062,7 @@ yydefault:
 	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3217
+//line sql.y:3230
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
@@ -11012,7 +11070,7 @@ yydefault:
 	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3221
+//line sql.y:3234
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -11020,7 +11078,7 @@ yydefault:
 	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3225
+//line sql.y:3238
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
@@ -11028,7 +11086,7 @@ yydefault:
 	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3229
+//line sql.y:3242
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -11036,7 +11094,7 @@ yydefault:
 	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3233
+//line sql.y:3246
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -11044,7 +11102,7 @@ yydefault:
 	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3237
+//line sql.y:3250
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -11052,7 +11110,7 @@ yydefault:
 	case 604:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3241
+//line sql.y:3254
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -11060,7 +11118,7 @@ yydefault:
 	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3245
+//line sql.y:3258
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -11068,7 +11126,7 @@ yydefault:
 	case 606:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3249
+//line sql.y:3262
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -11076,7 +11134,7 @@ yydefault:
 	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3253
+//line sql.y:3266
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -11084,7 +11142,7 @@ yydefault:
 	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3257
+//line sql.y:3270
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -11092,7 +11150,7 @@ yydefault:
 	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3261
+//line sql.y:3274
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
@@ -11101,7 +11159,7 @@ yydefault:
 	case 610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3266
+//line sql.y:3279
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
@@ -11109,7 +11167,7 @@ yydefault:
 	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3270
+//line sql.y:3283
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
@@ -11117,7 +11175,7 @@ yydefault:
 	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3274
+//line sql.y:3287
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
@@ -11126,7 +11184,7 @@ yydefault:
 	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3279
+//line sql.y:3292
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -11134,7 +11192,7 @@ yydefault:
 	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3283
+//line sql.y:3296
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -11142,7 +11200,7 @@ yydefault:
 	case 615:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3287
+//line sql.y:3300
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
@@ -11150,7 +11208,7 @@ yydefault:
 	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3291
+//line sql.y:3304
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
@@ -11158,7 +11216,7 @@ yydefault:
 	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3296
+//line sql.y:3309
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -11169,7 +11227,7 @@ yydefault:
 	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3310
+//line sql.y:3323
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
@@ -11177,7 +11235,7 @@ yydefault:
 	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3314
+//line sql.y:3327
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -11185,39 +11243,39 @@ yydefault:
 	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3318
+//line sql.y:3331
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
 	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3324
+//line sql.y:3337
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3328
+//line sql.y:3341
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3334
+//line sql.y:3347
 		{
 			yyVAL.str = ""
 		}
 	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3338
+//line sql.y:3351
 		{
 			yyVAL.str = "extended "
 		}
 	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3344
+//line sql.y:3357
 		{
 			yyLOCAL = false
 		}
@@ -11225,45 +11283,45 @@ yydefault:
 	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3348
+//line sql.y:3361
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3354
+//line sql.y:3367
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3358
+//line sql.y:3371
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3364
+//line sql.y:3377
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
 	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3368
+//line sql.y:3381
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3372
+//line sql.y:3385
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 632:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3378
+//line sql.y:3391
 		{
 			yyLOCAL = nil
 		}
@@ -11271,7 +11329,7 @@ yydefault:
 	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3382
+//line sql.y:3395
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
@@ -11279,7 +11337,7 @@ yydefault:
 	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3386
+//line sql.y:3399
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
@@ -11287,7 +11345,7 @@ yydefault:
 	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3392
+//line sql.y:3405
 		{
 			yyLOCAL = nil
 		}
@@ -11295,33 +11353,33 @@ yydefault:
 	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3396
+//line sql.y:3409
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
 	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3402
+//line sql.y:3415
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3406
+//line sql.y:3419
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3410
+//line sql.y:3423
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3416
+//line sql.y:3429
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
@@ -11329,7 +11387,7 @@ yydefault:
 	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3420
+//line sql.y:3433
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
@@ -11337,7 +11395,7 @@ yydefault:
 	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3424
+//line sql.y:3437
 		{
 			yyLOCAL = &Use{DBName: NewTableIdent(yyDollar[2].tableIdent.String() + "@" + string(yyDollar[3].str))}
 		}
@@ -11345,7 +11403,7 @@ yydefault:
 	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3430
+//line sql.y:3443
 		{
 			yyLOCAL = &Begin{}
 		}
@@ -11353,7 +11411,7 @@ yydefault:
 	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3434
+//line sql.y:3447
 		{
 			yyLOCAL = &Begin{}
 		}
@@ -11361,7 +11419,7 @@ yydefault:
 	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3440
+//line sql.y:3453
 		{
 			yyLOCAL = &Commit{}
 		}
@@ -11369,7 +11427,7 @@ yydefault:
 	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3446
+//line sql.y:3459
 		{
 			yyLOCAL = &Rollback{}
 		}
@@ -11377,39 +11435,39 @@ yydefault:
 	case 647:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3450
+//line sql.y:3463
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3455
+//line sql.y:3468
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3457
+//line sql.y:3470
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 650:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3460
+//line sql.y:3473
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3462
+//line sql.y:3475
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3467
+//line sql.y:3480
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
@@ -11417,7 +11475,7 @@ yydefault:
 	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3473
+//line sql.y:3486
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
@@ -11425,7 +11483,7 @@ yydefault:
 	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3478
+//line sql.y:3491
 		{
 			yyLOCAL = EmptyType
 		}
@@ -11433,7 +11491,7 @@ yydefault:
 	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3482
+//line sql.y:3495
 		{
 			yyLOCAL = JSONType
 		}
@@ -11441,7 +11499,7 @@ yydefault:
 	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3486
+//line sql.y:3499
 		{
 			yyLOCAL = TreeType
 		}
@@ -11449,7 +11507,7 @@ yydefault:
 	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3490
+//line sql.y:3503
 		{
 			yyLOCAL = VitessType
 		}
@@ -11457,7 +11515,7 @@ yydefault:
 	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3494
+//line sql.y:3507
 		{
 			yyLOCAL = TraditionalType
 		}
@@ -11465,33 +11523,33 @@ yydefault:
 	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3498
+//line sql.y:3511
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
 	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3504
+//line sql.y:3517
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3508
+//line sql.y:3521
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3512
+//line sql.y:3525
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3518
+//line sql.y:3531
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -11499,7 +11557,7 @@ yydefault:
 	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3522
+//line sql.y:3535
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -11507,7 +11565,7 @@ yydefault:
 	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3526
+//line sql.y:3539
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -11515,33 +11573,33 @@ yydefault:
 	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3530
+//line sql.y:3543
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3535
+//line sql.y:3548
 		{
 			yyVAL.str = ""
 		}
 	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3539
+//line sql.y:3552
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
 	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3543
+//line sql.y:3556
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3549
+//line sql.y:3562
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
@@ -11549,7 +11607,7 @@ yydefault:
 	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3553
+//line sql.y:3566
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
@@ -11557,7 +11615,7 @@ yydefault:
 	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3559
+//line sql.y:3572
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -11565,7 +11623,7 @@ yydefault:
 	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3563
+//line sql.y:3576
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -11573,7 +11631,7 @@ yydefault:
 	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3569
+//line sql.y:3582
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
@@ -11581,14 +11639,14 @@ yydefault:
 	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3575
+//line sql.y:3588
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3579
+//line sql.y:3592
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
@@ -11596,7 +11654,7 @@ yydefault:
 	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3585
+//line sql.y:3598
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
@@ -11604,7 +11662,7 @@ yydefault:
 	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3591
+//line sql.y:3604
 		{
 			yyLOCAL = Read
 		}
@@ -11612,7 +11670,7 @@ yydefault:
 	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3595
+//line sql.y:3608
 		{
 			yyLOCAL = ReadLocal
 		}
@@ -11620,7 +11678,7 @@ yydefault:
 	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3599
+//line sql.y:3612
 		{
 			yyLOCAL = Write
 		}
@@ -11628,7 +11686,7 @@ yydefault:
 	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3603
+//line sql.y:3616
 		{
 			yyLOCAL = LowPriorityWrite
 		}
@@ -11636,7 +11694,7 @@ yydefault:
 	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3609
+//line sql.y:3622
 		{
 			yyLOCAL = &UnlockTables{}
 		}
@@ -11644,7 +11702,7 @@ yydefault:
 	case 683:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3615
+//line sql.y:3628
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
@@ -11652,7 +11710,7 @@ yydefault:
 	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3621
+//line sql.y:3634
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
@@ -11660,7 +11718,7 @@ yydefault:
 	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3625
+//line sql.y:3638
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
@@ -11668,7 +11726,7 @@ yydefault:
 	case 686:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3629
+//line sql.y:3642
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
@@ -11676,7 +11734,7 @@ yydefault:
 	case 687:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3633
+//line sql.y:3646
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
@@ -11684,7 +11742,7 @@ yydefault:
 	case 688:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3637
+//line sql.y:3650
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
@@ -11692,99 +11750,99 @@ yydefault:
 	case 689:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3641
+//line sql.y:3654
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3647
+//line sql.y:3660
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
 	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3651
+//line sql.y:3664
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
 	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3657
+//line sql.y:3670
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3661
+//line sql.y:3674
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3665
+//line sql.y:3678
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3669
+//line sql.y:3682
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3673
+//line sql.y:3686
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3677
+//line sql.y:3690
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3681
+//line sql.y:3694
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3685
+//line sql.y:3698
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
 	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3689
+//line sql.y:3702
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3693
+//line sql.y:3706
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3697
+//line sql.y:3710
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3701
+//line sql.y:3714
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3706
+//line sql.y:3719
 		{
 			yyLOCAL = false
 		}
@@ -11792,7 +11850,7 @@ yydefault:
 	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3710
+//line sql.y:3723
 		{
 			yyLOCAL = true
 		}
@@ -11800,52 +11858,52 @@ yydefault:
 	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3714
+//line sql.y:3727
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 707:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3719
+//line sql.y:3732
 		{
 			yyVAL.str = ""
 		}
 	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3723
+//line sql.y:3736
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
 	case 709:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3728
+//line sql.y:3741
 		{
 			setAllowComments(yylex, true)
 		}
 	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3732
+//line sql.y:3745
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
 	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3738
+//line sql.y:3751
 		{
 			yyVAL.strs = nil
 		}
 	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3742
+//line sql.y:3755
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
 	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3748
+//line sql.y:3761
 		{
 			yyLOCAL = true
 		}
@@ -11853,7 +11911,7 @@ yydefault:
 	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3752
+//line sql.y:3765
 		{
 			yyLOCAL = false
 		}
@@ -11861,33 +11919,33 @@ yydefault:
 	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3756
+//line sql.y:3769
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3761
+//line sql.y:3774
 		{
 			yyVAL.str = ""
 		}
 	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3765
+//line sql.y:3778
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
 	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3769
+//line sql.y:3782
 		{
 			yyVAL.str = SQLCacheStr
 		}
 	case 719:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3774
+//line sql.y:3787
 		{
 			yyLOCAL = false
 		}
@@ -11895,7 +11953,7 @@ yydefault:
 	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3778
+//line sql.y:3791
 		{
 			yyLOCAL = true
 		}
@@ -11903,7 +11961,7 @@ yydefault:
 	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3782
+//line sql.y:3795
 		{
 			yyLOCAL = true
 		}
@@ -11911,7 +11969,7 @@ yydefault:
 	case 722:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3787
+//line sql.y:3800
 		{
 			yyLOCAL = nil
 		}
@@ -11919,94 +11977,94 @@ yydefault:
 	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3791
+//line sql.y:3804
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 724:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3796
+//line sql.y:3809
 		{
 			yyVAL.strs = nil
 		}
 	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3800
+//line sql.y:3813
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
 	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3804
+//line sql.y:3817
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
 	case 727:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3808
+//line sql.y:3821
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
 	case 728:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3812
+//line sql.y:3825
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
 	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3818
+//line sql.y:3831
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
 	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3822
+//line sql.y:3835
 		{
 			yyVAL.str = SQLCacheStr
 		}
 	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3826
+//line sql.y:3839
 		{
 			yyVAL.str = DistinctStr
 		}
 	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3830
+//line sql.y:3843
 		{
 			yyVAL.str = DistinctStr
 		}
 	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3834
+//line sql.y:3847
 		{
 			yyVAL.str = StraightJoinHint
 		}
 	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3838
+//line sql.y:3851
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
 	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3842
+//line sql.y:3855
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
 	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3848
+//line sql.y:3861
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3852
+//line sql.y:3865
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
@@ -12014,7 +12072,7 @@ yydefault:
 	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3858
+//line sql.y:3871
 		{
 			yyLOCAL = &StarExpr{}
 		}
@@ -12022,7 +12080,7 @@ yydefault:
 	case 739:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3862
+//line sql.y:3875
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
@@ -12030,7 +12088,7 @@ yydefault:
 	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3866
+//line sql.y:3879
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
@@ -12038,39 +12096,39 @@ yydefault:
 	case 741:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3870
+//line sql.y:3883
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
 	case 742:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3875
+//line sql.y:3888
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
 	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3879
+//line sql.y:3892
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3883
+//line sql.y:3896
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3890
+//line sql.y:3903
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
 	case 747:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3895
+//line sql.y:3908
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
@@ -12078,7 +12136,7 @@ yydefault:
 	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3899
+//line sql.y:3912
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
@@ -12086,7 +12144,7 @@ yydefault:
 	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3905
+//line sql.y:3918
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
@@ -12094,14 +12152,14 @@ yydefault:
 	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3911
+//line sql.y:3924
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3915
+//line sql.y:3928
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
@@ -12109,7 +12167,7 @@ yydefault:
 	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3925
+//line sql.y:3938
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
@@ -12117,7 +12175,7 @@ yydefault:
 	case 755:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3929
+//line sql.y:3942
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
@@ -12125,7 +12183,7 @@ yydefault:
 	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3933
+//line sql.y:3946
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
@@ -12133,7 +12191,7 @@ yydefault:
 	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3939
+//line sql.y:3952
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
@@ -12141,7 +12199,7 @@ yydefault:
 	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3945
+//line sql.y:3958
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
@@ -12149,7 +12207,7 @@ yydefault:
 	case 759:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3949
+//line sql.y:3962
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
@@ -12157,7 +12215,7 @@ yydefault:
 	case 760:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3954
+//line sql.y:3967
 		{
 			yyLOCAL = nil
 		}
@@ -12165,7 +12223,7 @@ yydefault:
 	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3958
+//line sql.y:3971
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
@@ -12173,14 +12231,14 @@ yydefault:
 	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3964
+//line sql.y:3977
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3968
+//line sql.y:3981
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
@@ -12188,7 +12246,7 @@ yydefault:
 	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3974
+//line sql.y:3987
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
@@ -12196,21 +12254,21 @@ yydefault:
 	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3978
+//line sql.y:3991
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
 	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3982
+//line sql.y:3995
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
 	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3986
+//line sql.y:3999
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
@@ -12218,14 +12276,14 @@ yydefault:
 	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3992
+//line sql.y:4005
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3996
+//line sql.y:4009
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
@@ -12233,7 +12291,7 @@ yydefault:
 	case 770:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4009
+//line sql.y:4022
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -12241,7 +12299,7 @@ yydefault:
 	case 771:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4013
+//line sql.y:4026
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -12249,7 +12307,7 @@ yydefault:
 	case 772:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4017
+//line sql.y:4030
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -12257,87 +12315,87 @@ yydefault:
 	case 773:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4021
+//line sql.y:4034
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4027
+//line sql.y:4040
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
 	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4029
+//line sql.y:4042
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
 	case 776:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4033
+//line sql.y:4046
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
 	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4035
+//line sql.y:4048
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
 	case 778:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4039
+//line sql.y:4052
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
 	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4041
+//line sql.y:4054
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
 	case 780:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4044
+//line sql.y:4057
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4046
+//line sql.y:4059
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 782:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4049
+//line sql.y:4062
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
 	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4053
+//line sql.y:4066
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
 	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4057
+//line sql.y:4070
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4064
+//line sql.y:4077
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
 	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4070
+//line sql.y:4083
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12345,7 +12403,7 @@ yydefault:
 	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4074
+//line sql.y:4087
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12353,7 +12411,7 @@ yydefault:
 	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4078
+//line sql.y:4091
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12361,7 +12419,7 @@ yydefault:
 	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4084
+//line sql.y:4097
 		{
 			yyLOCAL = StraightJoinType
 		}
@@ -12369,7 +12427,7 @@ yydefault:
 	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4090
+//line sql.y:4103
 		{
 			yyLOCAL = LeftJoinType
 		}
@@ -12377,7 +12435,7 @@ yydefault:
 	case 792:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4094
+//line sql.y:4107
 		{
 			yyLOCAL = LeftJoinType
 		}
@@ -12385,7 +12443,7 @@ yydefault:
 	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4098
+//line sql.y:4111
 		{
 			yyLOCAL = RightJoinType
 		}
@@ -12393,7 +12451,7 @@ yydefault:
 	case 794:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4102
+//line sql.y:4115
 		{
 			yyLOCAL = RightJoinType
 		}
@@ -12401,7 +12459,7 @@ yydefault:
 	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4108
+//line sql.y:4121
 		{
 			yyLOCAL = NaturalJoinType
 		}
@@ -12409,7 +12467,7 @@ yydefault:
 	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4112
+//line sql.y:4125
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -12420,38 +12478,38 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4122
+//line sql.y:4135
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
 	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4126
+//line sql.y:4139
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
 	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4132
+//line sql.y:4145
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
 	case 800:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4136
+//line sql.y:4149
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
 	case 801:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4142
+//line sql.y:4155
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
 	case 802:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4147
+//line sql.y:4160
 		{
 			yyLOCAL = nil
 		}
@@ -12459,7 +12517,7 @@ yydefault:
 	case 803:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4151
+//line sql.y:4164
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12467,7 +12525,7 @@ yydefault:
 	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4155
+//line sql.y:4168
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
@@ -12475,7 +12533,7 @@ yydefault:
 	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4159
+//line sql.y:4172
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12483,7 +12541,7 @@ yydefault:
 	case 806:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4163
+//line sql.y:4176
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12491,7 +12549,7 @@ yydefault:
 	case 807:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4168
+//line sql.y:4181
 		{
 			yyLOCAL = nil
 		}
@@ -12499,7 +12557,7 @@ yydefault:
 	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4172
+//line sql.y:4185
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
@@ -12507,7 +12565,7 @@ yydefault:
 	case 809:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4179
+//line sql.y:4192
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12515,7 +12573,7 @@ yydefault:
 	case 810:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4183
+//line sql.y:4196
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12523,7 +12581,7 @@ yydefault:
 	case 811:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4187
+//line sql.y:4200
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12531,7 +12589,7 @@ yydefault:
 	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4191
+//line sql.y:4204
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
@@ -12539,7 +12597,7 @@ yydefault:
 	case 813:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4195
+//line sql.y:4208
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
@@ -12547,7 +12605,7 @@ yydefault:
 	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4212
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12555,7 +12613,7 @@ yydefault:
 	case 815:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4206
+//line sql.y:4219
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
 		}
@@ -12563,7 +12621,7 @@ yydefault:
 	case 816:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4210
+//line sql.y:4223
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
 		}
@@ -12571,7 +12629,7 @@ yydefault:
 	case 817:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4214
+//line sql.y:4227
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12579,7 +12637,7 @@ yydefault:
 	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4218
+//line sql.y:4231
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12587,7 +12645,7 @@ yydefault:
 	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4224
+//line sql.y:4237
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
@@ -12595,7 +12653,7 @@ yydefault:
 	case 820:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4228
+//line sql.y:4241
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
@@ -12603,7 +12661,7 @@ yydefault:
 	case 821:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4232
+//line sql.y:4245
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
@@ -12611,7 +12669,7 @@ yydefault:
 	case 822:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4236
+//line sql.y:4249
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
@@ -12619,7 +12677,7 @@ yydefault:
 	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4240
+//line sql.y:4253
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12627,7 +12685,7 @@ yydefault:
 	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4244
+//line sql.y:4257
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
@@ -12635,7 +12693,7 @@ yydefault:
 	case 825:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4248
+//line sql.y:4261
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
@@ -12643,7 +12701,7 @@ yydefault:
 	case 826:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4252
+//line sql.y:4265
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
@@ -12651,7 +12709,7 @@ yydefault:
 	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4256
+//line sql.y:4269
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12659,7 +12717,7 @@ yydefault:
 	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4260
+//line sql.y:4273
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
@@ -12667,7 +12725,7 @@ yydefault:
 	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4264
+//line sql.y:4277
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12675,7 +12733,7 @@ yydefault:
 	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4270
+//line sql.y:4283
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12683,7 +12741,7 @@ yydefault:
 	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4274
+//line sql.y:4287
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12691,7 +12749,7 @@ yydefault:
 	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4278
+//line sql.y:4291
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12699,7 +12757,7 @@ yydefault:
 	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4282
+//line sql.y:4295
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12707,7 +12765,7 @@ yydefault:
 	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4286
+//line sql.y:4299
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12715,7 +12773,7 @@ yydefault:
 	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4290
+//line sql.y:4303
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12723,7 +12781,7 @@ yydefault:
 	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4294
+//line sql.y:4307
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12731,7 +12789,7 @@ yydefault:
 	case 837:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4298
+//line sql.y:4311
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12739,7 +12797,7 @@ yydefault:
 	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4302
+//line sql.y:4315
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12747,7 +12805,7 @@ yydefault:
 	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4306
+//line sql.y:4319
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12755,7 +12813,7 @@ yydefault:
 	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4310
+//line sql.y:4323
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12763,7 +12821,7 @@ yydefault:
 	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4314
+//line sql.y:4327
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12771,7 +12829,7 @@ yydefault:
 	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4318
+//line sql.y:4331
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12779,7 +12837,7 @@ yydefault:
 	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4324
+//line sql.y:4337
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12787,7 +12845,7 @@ yydefault:
 	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4328
+//line sql.y:4341
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12795,7 +12853,7 @@ yydefault:
 	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4332
+//line sql.y:4345
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12803,7 +12861,7 @@ yydefault:
 	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4336
+//line sql.y:4349
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12811,7 +12869,7 @@ yydefault:
 	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4340
+//line sql.y:4353
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
@@ -12819,7 +12877,7 @@ yydefault:
 	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4344
+//line sql.y:4357
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12827,7 +12885,7 @@ yydefault:
 	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4348
+//line sql.y:4361
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
@@ -12835,7 +12893,7 @@ yydefault:
 	case 850:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4352
+//line sql.y:4365
 		{
 			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
@@ -12843,7 +12901,7 @@ yydefault:
 	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4356
+//line sql.y:4369
 		{
 			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
@@ -12851,7 +12909,7 @@ yydefault:
 	case 852:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4360
+//line sql.y:4373
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
@@ -12859,7 +12917,7 @@ yydefault:
 	case 853:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4364
+//line sql.y:4377
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
@@ -12867,7 +12925,7 @@ yydefault:
 	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4368
+//line sql.y:4381
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
@@ -12875,7 +12933,7 @@ yydefault:
 	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4372
+//line sql.y:4385
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12883,7 +12941,7 @@ yydefault:
 	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4376
+//line sql.y:4389
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
@@ -12891,7 +12949,7 @@ yydefault:
 	case 857:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4380
+//line sql.y:4393
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
@@ -12899,7 +12957,7 @@ yydefault:
 	case 858:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4384
+//line sql.y:4397
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
@@ -12907,7 +12965,7 @@ yydefault:
 	case 859:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4388
+//line sql.y:4401
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
@@ -12915,7 +12973,7 @@ yydefault:
 	case 860:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4392
+//line sql.y:4405
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
@@ -12923,7 +12981,7 @@ yydefault:
 	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4396
+//line sql.y:4409
 		{
 			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
 			// To convert a string expression to a binary string, these constructs are equivalent:
@@ -12935,7 +12993,7 @@ yydefault:
 	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4404
+//line sql.y:4417
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
@@ -12943,7 +13001,7 @@ yydefault:
 	case 863:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4408
+//line sql.y:4421
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -12955,7 +13013,7 @@ yydefault:
 	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4416
+//line sql.y:4429
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -12963,27 +13021,27 @@ yydefault:
 	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4420
+//line sql.y:4433
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
 	case 866:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4427
+//line sql.y:4440
 		{
 			yyVAL.str = ""
 		}
 	case 867:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4431
+//line sql.y:4444
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4437
+//line sql.y:4450
 		{
 			yyLOCAL = BoolVal(true)
 		}
@@ -12991,7 +13049,7 @@ yydefault:
 	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4441
+//line sql.y:4454
 		{
 			yyLOCAL = BoolVal(false)
 		}
@@ -12999,7 +13057,7 @@ yydefault:
 	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4448
+//line sql.y:4461
 		{
 			yyLOCAL = IsTrueOp
 		}
@@ -13007,7 +13065,7 @@ yydefault:
 	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4452
+//line sql.y:4465
 		{
 			yyLOCAL = IsNotTrueOp
 		}
@@ -13015,7 +13073,7 @@ yydefault:
 	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4456
+//line sql.y:4469
 		{
 			yyLOCAL = IsFalseOp
 		}
@@ -13023,7 +13081,7 @@ yydefault:
 	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4460
+//line sql.y:4473
 		{
 			yyLOCAL = IsNotFalseOp
 		}
@@ -13031,7 +13089,7 @@ yydefault:
 	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4466
+//line sql.y:4479
 		{
 			yyLOCAL = EqualOp
 		}
@@ -13039,7 +13097,7 @@ yydefault:
 	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4470
+//line sql.y:4483
 		{
 			yyLOCAL = LessThanOp
 		}
@@ -13047,7 +13105,7 @@ yydefault:
 	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4474
+//line sql.y:4487
 		{
 			yyLOCAL = GreaterThanOp
 		}
@@ -13055,7 +13113,7 @@ yydefault:
 	case 877:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4478
+//line sql.y:4491
 		{
 			yyLOCAL = LessEqualOp
 		}
@@ -13063,7 +13121,7 @@ yydefault:
 	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4482
+//line sql.y:4495
 		{
 			yyLOCAL = GreaterEqualOp
 		}
@@ -13071,7 +13129,7 @@ yydefault:
 	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4486
+//line sql.y:4499
 		{
 			yyLOCAL = NotEqualOp
 		}
@@ -13079,7 +13137,7 @@ yydefault:
 	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4490
+//line sql.y:4503
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
@@ -13087,7 +13145,7 @@ yydefault:
 	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4496
+//line sql.y:4509
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
@@ -13095,7 +13153,7 @@ yydefault:
 	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4500
+//line sql.y:4513
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
@@ -13103,7 +13161,7 @@ yydefault:
 	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4504
+//line sql.y:4517
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
@@ -13112,7 +13170,7 @@ yydefault:
 	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:4511
+//line sql.y:4524
 		{
 			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
@@ -13120,14 +13178,14 @@ yydefault:
 	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4517
+//line sql.y:4530
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4521
+//line sql.y:4534
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
@@ -13135,7 +13193,7 @@ yydefault:
 	case 887:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4531
+//line sql.y:4544
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
@@ -13143,7 +13201,7 @@ yydefault:
 	case 888:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4535
+//line sql.y:4548
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
@@ -13151,699 +13209,838 @@ yydefault:
 	case 889:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4539
+//line sql.y:4552
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 890:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4556
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 891:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4543
+//line sql.y:4560
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 892:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4566
+		{
+			yyLOCAL = &OverClause{PartitionBy: yyDollar[3].exprsUnion(), OrderBy: yyDollar[4].orderByUnion(), Frame: yyDollar[5].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 893:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4571
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 894:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4575
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 895:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4580
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 896:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4584
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 897:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4588
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4594
+		{
+			// ROWS, CURRENT and ROW are not keywords of the grammar, so that they
+			// keep on being valid identifiers.
+			if NewColIdent(yyDollar[1].str).Lowered() != "rows" {
+				yylex.Error("expecting rows or range")
+				return 1
+			}
+			yyLOCAL = RowsUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4604
+		{
+			yyLOCAL = RangeUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 900:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4610
+		{
+			if NewColIdent(yyDollar[1].str).Lowered() != "current" || NewColIdent(yyDollar[2].str).Lowered() != "row" {
+				yylex.Error("expecting current row")
+				return 1
+			}
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 901:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4618
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 902:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4622
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 903:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4626
+		{
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 904:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4630
+		{
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 905:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4636
+		{
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 906:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4640
+		{
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
+		}
+		yyVAL.union = yyLOCAL
+	case 907:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4553
+//line sql.y:4651
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 908:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4557
+//line sql.y:4655
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 909:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4561
+//line sql.y:4659
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 910:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4565
+//line sql.y:4663
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 911:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4569
+//line sql.y:4667
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 912:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4573
+//line sql.y:4671
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 913:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4577
+//line sql.y:4675
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 914:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4581
+//line sql.y:4679
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 915:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4585
+//line sql.y:4683
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 916:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4589
+//line sql.y:4687
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4600
+//line sql.y:4698
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4604
+//line sql.y:4702
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4610
+//line sql.y:4708
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4614
+//line sql.y:4712
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4619
+//line sql.y:4717
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 922:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4623
+//line sql.y:4721
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 923:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4627
+//line sql.y:4725
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 924:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4631
+//line sql.y:4729
 		{
 			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 925:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4635
+//line sql.y:4733
 		{
 			yyLOCAL = &WeightStringFuncExpr{Expr: yyDollar[3].exprUnion(), As: yyDollar[4].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4641
+//line sql.y:4739
 		{
 		}
-	case 911:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4643
+//line sql.y:4741
 		{
 			yyLOCAL = IntervalDayHour
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4647
+//line sql.y:4745
 		{
 			yyLOCAL = IntervalDayMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4651
+//line sql.y:4749
 		{
 			yyLOCAL = IntervalDayMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4655
+//line sql.y:4753
 		{
 			yyLOCAL = IntervalDaySecond
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4659
+//line sql.y:4757
 		{
 			yyLOCAL = IntervalHourMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4663
+//line sql.y:4761
 		{
 			yyLOCAL = IntervalHourMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4667
+//line sql.y:4765
 		{
 			yyLOCAL = IntervalHourSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4671
+//line sql.y:4769
 		{
 			yyLOCAL = IntervalMinuteMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4675
+//line sql.y:4773
 		{
 			yyLOCAL = IntervalMinuteSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4679
+//line sql.y:4777
 		{
 			yyLOCAL = IntervalSecondMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 937:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4683
+//line sql.y:4781
 		{
 			yyLOCAL = IntervalYearMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4689
+//line sql.y:4787
 		{
 			yyLOCAL = IntervalDay
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4693
+//line sql.y:4791
 		{
 			yyLOCAL = IntervalWeek
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4697
+//line sql.y:4795
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4701
+//line sql.y:4799
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4705
+//line sql.y:4803
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4709
+//line sql.y:4807
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4713
+//line sql.y:4811
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4717
+//line sql.y:4815
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4721
+//line sql.y:4819
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4731
+//line sql.y:4829
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4735
+//line sql.y:4833
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4739
+//line sql.y:4837
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4749
+//line sql.y:4847
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 953:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4753
+//line sql.y:4851
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 954:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4757
+//line sql.y:4855
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4761
+//line sql.y:4859
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 956:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4765
+//line sql.y:4863
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 957:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4771
+//line sql.y:4869
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 958:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4775
+//line sql.y:4873
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 959:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4779
+//line sql.y:4877
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 960:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4783
+//line sql.y:4881
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4787
+//line sql.y:4885
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4793
+//line sql.y:4891
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 947:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4797
+//line sql.y:4895
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 948:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4801
+//line sql.y:4899
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 949:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4807
+//line sql.y:4905
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 966:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4811
+//line sql.y:4909
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 967:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4815
+//line sql.y:4913
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 968:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4821
+//line sql.y:4919
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4825
+//line sql.y:4923
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4829
+//line sql.y:4927
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4833
+//line sql.y:4931
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 972:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4837
+//line sql.y:4935
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4843
+//line sql.y:4941
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 974:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4847
+//line sql.y:4945
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4851
+//line sql.y:4949
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 976:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4855
+//line sql.y:4953
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 977:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4859
+//line sql.y:4957
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4863
+//line sql.y:4961
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 979:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4867
+//line sql.y:4965
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 980:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4871
+//line sql.y:4969
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4875
+//line sql.y:4973
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4879
+//line sql.y:4977
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 983:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4885
+//line sql.y:4983
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4889
+//line sql.y:4987
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 985:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4894
+//line sql.y:4992
 		{
 			yyVAL.str = string("")
 		}
-	case 970:
+	case 986:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4898
+//line sql.y:4996
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 971:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4904
+//line sql.y:5002
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4908
+//line sql.y:5006
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 973:
+	case 989:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4914
+//line sql.y:5012
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 990:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4919
+//line sql.y:5017
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4923
+//line sql.y:5021
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4929
+//line sql.y:5027
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 993:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4933
+//line sql.y:5031
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 994:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4937
+//line sql.y:5035
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4943
+//line sql.y:5041
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -13853,380 +14050,380 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4952
+//line sql.y:5050
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4956
+//line sql.y:5054
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 998:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4962
+//line sql.y:5060
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4966
+//line sql.y:5064
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1000:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4971
+//line sql.y:5069
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4975
+//line sql.y:5073
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1002:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4980
+//line sql.y:5078
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4984
+//line sql.y:5082
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1004:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4990
+//line sql.y:5088
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4996
+//line sql.y:5094
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1006:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5000
+//line sql.y:5098
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 991:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:5006
+//line sql.y:5104
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1008:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5011
+//line sql.y:5109
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5015
+//line sql.y:5113
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5019
+//line sql.y:5117
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5024
+//line sql.y:5122
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5028
+//line sql.y:5126
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1013:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5034
+//line sql.y:5132
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1014:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5038
+//line sql.y:5136
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1015:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5042
+//line sql.y:5140
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5047
+//line sql.y:5145
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1017:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5051
+//line sql.y:5149
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1018:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5055
+//line sql.y:5153
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5059
+//line sql.y:5157
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5063
+//line sql.y:5161
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5070
+//line sql.y:5168
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1022:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5074
+//line sql.y:5172
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5078
+//line sql.y:5176
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5082
+//line sql.y:5180
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1025:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5088
+//line sql.y:5186
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1026:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5092
+//line sql.y:5190
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1027:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5096
+//line sql.y:5194
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5101
+//line sql.y:5199
 		{
 			yyVAL.str = ""
 		}
-	case 1013:
+	case 1029:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5105
+//line sql.y:5203
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1014:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5109
+//line sql.y:5207
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1015:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5113
+//line sql.y:5211
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1016:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5118
+//line sql.y:5216
 		{
 			yyVAL.str = ""
 		}
-	case 1017:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5122
+//line sql.y:5220
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1018:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5128
+//line sql.y:5226
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1019:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5132
+//line sql.y:5230
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1020:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5137
+//line sql.y:5235
 		{
 			yyVAL.str = ""
 		}
-	case 1021:
+	case 1037:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5141
+//line sql.y:5239
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1022:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5146
+//line sql.y:5244
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 1023:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5150
+//line sql.y:5248
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1024:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5154
+//line sql.y:5252
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1025:
+	case 1041:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5159
+//line sql.y:5257
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5163
+//line sql.y:5261
 		{
 			yyLOCAL = yyDollar[3].definerUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5169
+//line sql.y:5267
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5175
+//line sql.y:5273
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1045:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5181
+//line sql.y:5279
 		{
 			yyLOCAL = &Definer{
 				Name:    yyDollar[1].str,
@@ -14234,361 +14431,361 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5190
+//line sql.y:5288
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 1031:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5194
+//line sql.y:5292
 		{
 			yyVAL.str = formatIdentifier(yyDollar[1].str)
 		}
-	case 1032:
+	case 1048:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5199
+//line sql.y:5297
 		{
 			yyVAL.str = ""
 		}
-	case 1033:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5203
+//line sql.y:5301
 		{
 			yyVAL.str = formatAddress(yyDollar[1].str)
 		}
-	case 1034:
+	case 1050:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5209
+//line sql.y:5307
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1051:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5213
+//line sql.y:5311
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1052:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5219
+//line sql.y:5317
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1053:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5223
+//line sql.y:5321
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1054:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5227
+//line sql.y:5325
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1055:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5232
+//line sql.y:5330
 		{
 			yyVAL.str = ""
 		}
-	case 1040:
+	case 1056:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5236
+//line sql.y:5334
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 1041:
+	case 1057:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5240
+//line sql.y:5338
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 1042:
+	case 1058:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5245
+//line sql.y:5343
 		{
 			yyVAL.str = ""
 		}
-	case 1043:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5249
+//line sql.y:5347
 		{
 			yyVAL.str = " header"
 		}
-	case 1044:
+	case 1060:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5254
+//line sql.y:5352
 		{
 			yyVAL.str = ""
 		}
-	case 1045:
+	case 1061:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5258
+//line sql.y:5356
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 1046:
+	case 1062:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5262
+//line sql.y:5360
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 1047:
+	case 1063:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5267
+//line sql.y:5365
 		{
 			yyVAL.str = ""
 		}
-	case 1048:
+	case 1064:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5271
+//line sql.y:5369
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 1049:
+	case 1065:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5275
+//line sql.y:5373
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 1050:
+	case 1066:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5281
+//line sql.y:5379
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1051:
+	case 1067:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5286
+//line sql.y:5384
 		{
 			yyVAL.str = ""
 		}
-	case 1052:
+	case 1068:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5290
+//line sql.y:5388
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 1053:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5296
+//line sql.y:5394
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1054:
+	case 1070:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5300
+//line sql.y:5398
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1055:
+	case 1071:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5306
+//line sql.y:5404
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1056:
+	case 1072:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5310
+//line sql.y:5408
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1057:
+	case 1073:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5315
+//line sql.y:5413
 		{
 			yyVAL.str = ""
 		}
-	case 1058:
+	case 1074:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5319
+//line sql.y:5417
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1059:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5325
+//line sql.y:5423
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1060:
+	case 1076:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5329
+//line sql.y:5427
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1061:
+	case 1077:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5335
+//line sql.y:5433
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1062:
+	case 1078:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5339
+//line sql.y:5437
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1063:
+	case 1079:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5343
+//line sql.y:5441
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1064:
+	case 1080:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5348
+//line sql.y:5446
 		{
 			yyVAL.str = ""
 		}
-	case 1065:
+	case 1081:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5352
+//line sql.y:5450
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1066:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5365
+//line sql.y:5463
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5369
+//line sql.y:5467
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1084:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5373
+//line sql.y:5471
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1085:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5377
+//line sql.y:5475
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1086:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5381
+//line sql.y:5479
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1087:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5387
+//line sql.y:5485
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1088:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5391
+//line sql.y:5489
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1089:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5395
+//line sql.y:5493
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1074:
+	case 1090:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5399
+//line sql.y:5497
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1075:
+	case 1091:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5404
+//line sql.y:5502
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1092:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5408
+//line sql.y:5506
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5414
+//line sql.y:5512
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1094:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5418
+//line sql.y:5516
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1079:
+	case 1095:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5424
+//line sql.y:5522
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1096:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5428
+//line sql.y:5526
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1097:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5434
+//line sql.y:5532
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1098:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5439
+//line sql.y:5537
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -14597,329 +14794,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1099:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5449
+//line sql.y:5547
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5453
+//line sql.y:5551
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1085:
+	case 1101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5459
+//line sql.y:5557
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5465
+//line sql.y:5563
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5469
+//line sql.y:5567
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1088:
+	case 1104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5475
+//line sql.y:5573
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5479
+//line sql.y:5577
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1106:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5483
+//line sql.y:5581
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5487
+//line sql.y:5585
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5491
+//line sql.y:5589
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5499
+//line sql.y:5597
 		{
 			yyVAL.str = "charset"
 		}
-	case 1097:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5509
+//line sql.y:5607
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5513
+//line sql.y:5611
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5517
+//line sql.y:5615
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5526
+//line sql.y:5624
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5528
+//line sql.y:5626
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1120:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5531
+//line sql.y:5629
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5533
+//line sql.y:5631
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1122:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5536
+//line sql.y:5634
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5538
+//line sql.y:5636
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1124:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5541
+//line sql.y:5639
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5543
+//line sql.y:5641
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1126:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5546
+//line sql.y:5644
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1111:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5548
+//line sql.y:5646
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1112:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5550
+//line sql.y:5648
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1113:
+	case 1129:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5554
+//line sql.y:5652
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1130:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5559
+//line sql.y:5657
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5563
+//line sql.y:5661
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1132:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5568
+//line sql.y:5666
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5570
+//line sql.y:5668
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5574
+//line sql.y:5672
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5580
+//line sql.y:5678
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1120:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5584
+//line sql.y:5682
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1122:
+	case 1138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5591
+//line sql.y:5689
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1123:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5597
+//line sql.y:5695
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1124:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5601
+//line sql.y:5699
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1125:
+	case 1141:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5607
+//line sql.y:5705
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1126:
+	case 1142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5611
+//line sql.y:5709
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1128:
+	case 1144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5618
+//line sql.y:5716
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1573:
+	case 1589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6087
+//line sql.y:6185
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1574:
+	case 1590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6096
+//line sql.y:6194
 		{
 			decNesting(yylex)
 		}
-	case 1575:
+	case 1591:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6101
+//line sql.y:6199
 		{
 			skipToEnd(yylex)
 		}
-	case 1576:
+	case 1592:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6106
+//line sql.y:6204
 		{
 			skipToEnd(yylex)
 		}
-	case 1577:
+	case 1593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6110
+//line sql.y:6208
 		{
 			skipToEnd(yylex)
 		}
-	case 1578:
+	case 1594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6114
+//line sql.y:6212
 		{
 			skipToEnd(yylex)
 		}
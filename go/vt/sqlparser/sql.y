@@ -171,6 +171,10 @@ func bindVariable(yylex yyLexer, bvar string) {
   partitionByType PartitionByType
   definer 	*Definer
   integer 	int
+  overClause	*OverClause
+  frameClause	*FrameClause
+  framePoint	*FramePoint
+  frameUnitType	FrameUnitType
 }
 
 %token LEX_ERROR
@@ -283,12 +287,15 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> TIMESTAMPADD TIMESTAMPDIFF
 %token <str> WEIGHT_STRING
 
+// Window functions
+%token <str> OVER
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
+%token <str> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
 %token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
@@ -368,6 +375,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <ins> insert_data
 %type <expr> num_val
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict
+%type <overClause> over_clause
+%type <exprs> window_partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameUnitType> frame_units
+%type <framePoint> frame_point
+%type <expr> frame_offset
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -551,11 +564,11 @@ load_statement:
 with_clause:
   WITH with_list
   {
-	$$ = &With{ctes: $2, Recursive: false}
+	$$ = &With{CTEs: $2, Recursive: false}
   }
 | WITH RECURSIVE with_list
   {
-	$$ = &With{ctes: $3, Recursive: true}
+	$$ = &With{CTEs: $3, Recursive: true}
   }
 
 with_clause_opt:
@@ -4539,11 +4552,96 @@ function_call_generic:
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+over_clause:
+  OVER openb window_partition_by_opt order_by_opt frame_clause_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+window_partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_units frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_units BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_units:
+  UNUSED
+  {
+    // ROWS, CURRENT and ROW are not keywords of the grammar, so that they
+    // keep on being valid identifiers.
+    if NewColIdent($1).Lowered() != "rows" {
+      yylex.Error("expecting rows or range")
+      return 1
+    }
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  ID UNUSED
+  {
+    if NewColIdent($1).Lowered() != "current" || NewColIdent($2).Lowered() != "row" {
+      yylex.Error("expecting current row")
+      return 1
+    }
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_offset PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_offset FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+frame_offset:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -33,11 +33,14 @@ import (
 	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/debezium"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 // This file contains the query command group for vtctl.
@@ -58,6 +61,12 @@ func init() {
 		params: "-server <vtgate> [-bind_variables <JSON map>] [-keyspace <default keyspace>] [-tablet_type <tablet type>] [-options <proto text options>] [-json] <sql>",
 		help:   "Executes the given SQL query with the provided bound variables against the vtgate server.",
 	})
+	addCommand(queriesGroupName, command{
+		name:   "VtGateVStream",
+		method: commandVtGateVStream,
+		params: "-server <vtgate> [-tablet_type <tablet type>] [-match <table name or regexp>] [-format vevent|debezium] [-count <count, default 1>] <keyspace>",
+		help:   "Streams the changes to the tables of a keyspace from the vtgate server, starting at the current position of every shard. With -format debezium, the row changes and DDLs are printed as Debezium change events. Will stop after getting <count> events.",
+	})
 
 	// VtTablet commands
 	addCommand(queriesGroupName, command{
@@ -188,6 +197,89 @@ func commandVtGateExecute(ctx context.Context, wr *wrangler.Wrangler, subFlags *
 	return nil
 }
 
+func commandVtGateVStream(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if !*enableQueries {
+		return fmt.Errorf("query commands are disabled (set the -enable_queries flag to enable)")
+	}
+
+	server := subFlags.String("server", "", "VtGate server to connect to")
+	tabletTypeStr := subFlags.String("tablet_type", "primary", "tablet type to stream from")
+	match := subFlags.String("match", "/.*/", "name of the table to stream, or a regexp of table names prefixed by /")
+	format := subFlags.String("format", "vevent", "output format of the events: vevent or debezium")
+	count := subFlags.Int("count", 1, "number of events to wait for")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the VtGateVStream command")
+	}
+	tabletType, err := topoproto.ParseTabletType(*tabletTypeStr)
+	if err != nil {
+		return err
+	}
+	flags := &vtgatepb.VStreamFlags{}
+	switch *format {
+	case "vevent":
+	case "debezium":
+		flags.Format = vtgatepb.VStreamFormat_DEBEZIUM
+	default:
+		return fmt.Errorf("unknown format %s, must be vevent or debezium", *format)
+	}
+
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+		Keyspace: subFlags.Arg(0),
+		Gtid:     "current",
+	}}}
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: *match}}}
+	reader, err := vtgateConn.VStream(ctx, tabletType, vgtid, filter, flags)
+	if err != nil {
+		return fmt.Errorf("vstream failed: %v", err)
+	}
+
+	i := 0
+	if flags.Format == vtgatepb.VStreamFormat_DEBEZIUM {
+		changeEventReader, err := debezium.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		for ; i < *count; i++ {
+			changeEvent, err := changeEventReader.Recv()
+			if err != nil {
+				return fmt.Errorf("vstream failed after %d events: %v", i, err)
+			}
+			wr.Logger().Printf("%s\n", changeEvent)
+		}
+		return nil
+	}
+	for i < *count {
+		events, err := reader.Recv()
+		if err != nil {
+			return fmt.Errorf("vstream failed after %d events: %v", i, err)
+		}
+		for _, event := range events {
+			if i >= *count {
+				break
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			wr.Logger().Printf("%v\n", string(data))
+			i++
+		}
+	}
+	return nil
+}
+
 func commandVtTabletExecute(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if !*enableQueries {
 		return fmt.Errorf("query commands are disabled (set the -enable_queries flag to enable)")
@@ -116,6 +116,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
@@ -486,7 +487,7 @@ var commands = []commandGroup{
 			{
 				name:   "Materialize",
 				method: commandMaterialize,
-				params: `[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
+				params: `[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-sink=<sink>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				help:   "Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL.",
 			},
 			{
@@ -506,8 +507,8 @@ var commands = []commandGroup{
 			{
 				name:   "VDiff",
 				method: commandVDiff,
-				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] [-max_extra_rows_to_compare=1000] <keyspace.workflow>",
-				help:   "Perform a diff of all tables in the workflow",
+				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] [-max_extra_rows_to_compare=1000] [-v2] [-incremental] <keyspace.workflow> [create|show|stop|resume|delete] [<uuid>|last]",
+				help:   "Perform a diff of all tables in the workflow. With -v2, the diff runs on the primary tablets of the target shards, persists its progress, and is managed with the create, show, stop, resume and delete actions: show takes a vdiff uuid, or last for the most recent vdiff of the workflow.",
 			},
 			{
 				name:   "MigrateServedTypes",
@@ -643,8 +644,8 @@ var commands = []commandGroup{
 			{
 				name:   "ApplySchema",
 				method: commandApplySchema,
-				params: "[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-uuid_list=<comma_separated_uuids>] [-migration_context=<unique-request-context>] [-skip_preflight] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				help:   "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to instruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -migration_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks.",
+				params: "[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-uuid_list=<comma_separated_uuids>] [-migration_context=<unique-request-context>] [-skip_preflight] {-sql=<sql> || -sql-file=<filename> || -declarative-dir=<dirname>} <keyspace>",
+				help:   "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to instruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -migration_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks. -declarative-dir reads the desired schema of the keyspace from the CREATE TABLE and CREATE VIEW statements of the .sql files in the directory, and submits the diff with the schema of each shard as declarative 'vitess' migrations of a single migration context. The command then waits until all migrations are ready to complete on all shards, and completes all of them together. If any migration fails or is cancelled on any shard, all migrations of the context are cancelled where they are pending, and reverted where they completed, on all shards.",
 			},
 			{
 				name:   "CopySchemaShard",
@@ -663,7 +664,8 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace cutover 82fa54ac_e83e_11ea_96b7_f875a4d24e90 (coordinates the cut-over of a -coordinated-cutover migration on all shards)",
 			},
 			{
 				name:   "ValidateVersionShard",
@@ -2656,6 +2658,7 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	sink := subFlags.String("sink", "", "Name of the sink to write to instead of the target tables. The target tables are not created.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2668,6 +2671,9 @@ func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	}
 	ms.Cell = *cells
 	ms.TabletTypes = *tabletTypes
+	if *sink != "" {
+		ms.Sink = *sink
+	}
 	return wr.Materialize(ctx, ms)
 }
 
@@ -2708,11 +2714,17 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
 	maxExtraRowsToCompare := subFlags.Int("max_extra_rows_to_compare", 1000, "If there are collation differences between the soruce and target, you can have rows that are identical but simply returned in a different order from MySQL. We will do a second pass to compare the rows for any actual differences in this case and this flag allows you to control the resources used for this operation.")
+	v2 := subFlags.Bool("v2", false, "Run the vdiff on the primary tablets of the target shards. The vdiff survives restarts, and is managed with the create, show, stop, resume and delete actions.")
+	incremental := subFlags.Bool("incremental", false, "With -v2, only diff the rows that changed on the sources since the tables were last verified without mismatches by a previous vdiff of the workflow.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
+	if *v2 {
+		if subFlags.NArg() < 2 || subFlags.NArg() > 3 {
+			return fmt.Errorf("<keyspace.workflow> and <action> are required with -v2")
+		}
+	} else if subFlags.NArg() != 1 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
@@ -2722,6 +2734,24 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if *v2 {
+		var tablesToInclude []string
+		if strings.TrimSpace(*tables) != "" {
+			tablesToInclude = strings.Split(strings.TrimSpace(*tables), ",")
+		}
+		options := &vdiff.Options{
+			SourceCell:                  *sourceCell,
+			TabletTypes:                 *tabletTypes,
+			Tables:                      tablesToInclude,
+			MaxRows:                     *maxRows,
+			MaxExtraRowsToCompare:       *maxExtraRowsToCompare,
+			FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+			DebugQuery:                  *debugQuery,
+			OnlyPKs:                     *onlyPks,
+			Incremental:                 *incremental,
+		}
+		return commandVDiffV2(ctx, wr, keyspace, workflow, subFlags.Arg(1), subFlags.Arg(2), options)
+	}
 	_, err = wr.
 		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, *debugQuery, *onlyPks, *maxExtraRowsToCompare)
 	if err != nil {
@@ -2733,6 +2763,35 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandVDiffV2(ctx context.Context, wr *wrangler.Wrangler, keyspace, workflow, action, uuid string, options *vdiff.Options) error {
+	if action != "create" && uuid == "" {
+		return fmt.Errorf("<uuid> is required for the %s action", action)
+	}
+	switch action {
+	case "create":
+		uuid, err := wr.VDiffCreate(ctx, keyspace, workflow, options)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("VDiff %s created on workflow %s.%s\n", uuid, keyspace, workflow)
+		return nil
+	case "show":
+		summary, err := wr.VDiffShow(ctx, keyspace, workflow, uuid)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), summary)
+	case "stop":
+		return wr.VDiffStop(ctx, keyspace, workflow, uuid)
+	case "resume":
+		return wr.VDiffResume(ctx, keyspace, workflow, uuid)
+	case "delete":
+		return wr.VDiffDelete(ctx, keyspace, workflow, uuid)
+	default:
+		return fmt.Errorf("invalid action %s for VDiff -v2, expected one of: create, show, stop, resume, delete", action)
+	}
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -3248,6 +3307,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
+	declarativeDir := subFlags.String("declarative-dir", "", "Identifies a directory of .sql files with the CREATE TABLE and CREATE VIEW statements of the desired schema of the keyspace")
 	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
 	uuidList := subFlags.String("uuid_list", "", "Optional: comma delimited explicit UUIDs for migration. If given, must match number of DDL changes")
 	migrationContext := subFlags.String("migration_context", "", "For Only DDL, optionally supply a custom unique string used as context for the migration(s) in this command. By default a unique context is auto-generated by Vitess")
@@ -3264,9 +3324,37 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	}
 
 	keyspace := subFlags.Arg(0)
-	change, err := getFileParam(*sql, *sqlFile, "sql")
-	if err != nil {
-		return err
+	var parts []string
+	if *declarativeDir != "" {
+		if *sql != "" || *sqlFile != "" {
+			return fmt.Errorf("-declarative-dir cannot be combined with -sql or -sql-file")
+		}
+		strategy, err := declarativeDDLStrategy(*ddlStrategy)
+		if err != nil {
+			return err
+		}
+		*ddlStrategy = strategy
+		desired, err := wrangler.ReadDeclarativeSchemaDir(*declarativeDir)
+		if err != nil {
+			return err
+		}
+		parts, err = wr.DeclarativeSchemaChanges(ctx, keyspace, desired)
+		if err != nil {
+			return err
+		}
+		if len(parts) == 0 {
+			wr.Logger().Printf("The schema of keyspace %s is identical to the schema in %s\n", keyspace, *declarativeDir)
+			return nil
+		}
+	} else {
+		change, err := getFileParam(*sql, *sqlFile, "sql")
+		if err != nil {
+			return err
+		}
+		parts, err = sqlparser.SplitStatementToPieces(change)
+		if err != nil {
+			return err
+		}
 	}
 
 	var cID *vtrpcpb.CallerID
@@ -3281,11 +3369,6 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		*migrationContext = *requestContext
 	}
 
-	parts, err := sqlparser.SplitStatementToPieces(change)
-	if err != nil {
-		return err
-	}
-
 	log.Info("Calling ApplySchema on VtctldServer")
 
 	resp, err := wr.VtctldServer().ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
@@ -3309,9 +3392,47 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		wr.Logger().Printf("%s\n", uuid)
 	}
 
+	if setting, err := schema.ParseDDLStrategy(*ddlStrategy); err == nil && setting.IsCoordinatedCutOver() {
+		if setting.IsAtomicContext() {
+			// The migrations of an atomic context complete all together, or not at all
+			return wr.CoordinatedContextCutOver(ctx, keyspace, resp.UuidList)
+		}
+		// Migrations do not run concurrently, so their cut-overs are coordinated one after the other
+		for _, uuid := range resp.UuidList {
+			if err := wr.CoordinatedCutOver(ctx, keyspace, uuid); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// declarativeDDLStrategy returns the given DDL strategy, with the options that submit declarative
+// migrations of an atomic context, whose cut-over is coordinated on all shards. Only the 'vitess'
+// strategy is able to run such migrations.
+func declarativeDDLStrategy(ddlStrategy string) (string, error) {
+	setting, err := schema.ParseDDLStrategy(ddlStrategy)
+	if err != nil {
+		return "", err
+	}
+	switch setting.Strategy {
+	case schema.DDLStrategyVitess, schema.DDLStrategyOnline:
+	default:
+		return "", fmt.Errorf("-declarative-dir requires -ddl_strategy 'vitess', found '%s'", setting.Strategy)
+	}
+	if !setting.IsDeclarative() {
+		ddlStrategy = fmt.Sprintf("%s -declarative", ddlStrategy)
+	}
+	if !setting.IsAtomicContext() {
+		ddlStrategy = fmt.Sprintf("%s -atomic-context", ddlStrategy)
+	}
+	if !setting.IsCoordinatedCutOver() {
+		ddlStrategy = fmt.Sprintf("%s -coordinated-cutover", ddlStrategy)
+	}
+	return ddlStrategy, nil
+}
+
 func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
 	if err := subFlags.Parse(args); err != nil {
@@ -3382,6 +3503,11 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 			return fmt.Errorf("UUID not allowed in %s", command)
 		}
 		query = `update _vt.schema_migrations set migration_status='cancel-all'`
+	case "cutover":
+		if arg == "" {
+			return fmt.Errorf("UUID required")
+		}
+		return wr.CoordinatedCutOver(ctx, keyspace, arg)
 	default:
 		return fmt.Errorf("Unknown OnlineDDL command: %s", command)
 	}
@@ -37,7 +37,8 @@ import (
 
 type fakeTMC struct {
 	tmclient.TabletManagerClient
-	vrepQueriesByTablet map[string]map[string]*querypb.QueryResult
+	vrepQueriesByTablet  map[string]map[string]*querypb.QueryResult
+	vexecQueriesByTablet map[string]map[string]*querypb.QueryResult
 }
 
 func (fake *fakeTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
@@ -55,6 +56,21 @@ func (fake *fakeTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Ta
 	return p3qr, nil
 }
 
+func (fake *fakeTMC) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	tabletQueries, ok := fake.vexecQueriesByTablet[alias]
+	if !ok {
+		return nil, fmt.Errorf("no vexec query map registered on fake for %s", alias)
+	}
+
+	p3qr, ok := tabletQueries[query]
+	if !ok {
+		return nil, fmt.Errorf("no result on fake for vexec query %q on tablet %s", query, alias)
+	}
+
+	return p3qr, nil
+}
+
 func TestCheckReshardingJournalExistsOnTablet(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow/vexec"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// VDiffShow returns the state of a vdiff running on the primary tablets of the
+// target shards of a workflow, aggregated over all the shards. If the request's
+// uuid is "last", the most recently created vdiff of the workflow is returned.
+//
+// It has the same signature as the vtctlservicepb.VtctldServer's VDiffShow rpc,
+// and grpcvtctldserver delegates to this function.
+func (s *Server) VDiffShow(ctx context.Context, req *vtctldatapb.VDiffShowRequest) (*vtctldatapb.VDiffShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffShow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	primaries, err := s.getKeyspacePrimaries(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	uuid := req.Uuid
+	if uuid == "last" {
+		if uuid, err = s.vdiffLastUUID(ctx, primaries, req.Keyspace, req.Workflow); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &vtctldatapb.VDiffShowResponse{
+		Uuid:           uuid,
+		ShardSummaries: make(map[string]*vtctldatapb.VDiffShardSummary),
+	}
+
+	results, err := s.vdiffExec(ctx, primaries, req.Keyspace, req.Workflow, func(primary *topo.TabletInfo) string {
+		return fmt.Sprintf("select state, last_error, started_at, completed_at from _vt.vdiff where db_name=%s and workflow=%s and vdiff_uuid=%s",
+			encodeString(primary.DbName()), encodeString(req.Workflow), encodeString(uuid))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for primary, result := range results {
+		for _, row := range result.Named().Rows {
+			resp.ShardSummaries[primary.Shard] = &vtctldatapb.VDiffShardSummary{
+				State:       row.AsString("state", ""),
+				LastError:   row.AsString("last_error", ""),
+				StartedAt:   row.AsString("started_at", ""),
+				CompletedAt: row.AsString("completed_at", ""),
+			}
+		}
+	}
+	if len(resp.ShardSummaries) == 0 {
+		return nil, fmt.Errorf("vdiff %s was not found for workflow %s.%s", uuid, req.Keyspace, req.Workflow)
+	}
+
+	results, err = s.vdiffExec(ctx, primaries, req.Keyspace, req.Workflow, func(primary *topo.TabletInfo) string {
+		return fmt.Sprintf("select table_name, state, table_rows, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_uuid=%s",
+			encodeString(uuid))
+	})
+	if err != nil {
+		return nil, err
+	}
+	addVDiffTableProgress(resp, results)
+	resp.State = aggregateVDiffState(resp.ShardSummaries)
+
+	return resp, nil
+}
+
+// vdiffLastUUID returns the UUID of the most recently created vdiff of the
+// workflow, over all the shards.
+func (s *Server) vdiffLastUUID(ctx context.Context, primaries []*topo.TabletInfo, keyspace, workflow string) (string, error) {
+	results, err := s.vdiffExec(ctx, primaries, keyspace, workflow, func(primary *topo.TabletInfo) string {
+		return fmt.Sprintf("select vdiff_uuid, created_at from _vt.vdiff where db_name=%s and workflow=%s order by id desc limit 1",
+			encodeString(primary.DbName()), encodeString(workflow))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var uuid, createdAt string
+	for _, result := range results {
+		for _, row := range result.Named().Rows {
+			if rowCreatedAt := row.AsString("created_at", ""); uuid == "" || rowCreatedAt > createdAt {
+				uuid = row.AsString("vdiff_uuid", "")
+				createdAt = rowCreatedAt
+			}
+		}
+	}
+	if uuid == "" {
+		return "", fmt.Errorf("no vdiff found for workflow %s.%s", keyspace, workflow)
+	}
+
+	return uuid, nil
+}
+
+// vdiffExec runs a query on the vdiff sidecar tables of each of the given
+// primaries, concurrently, and returns the results by primary.
+func (s *Server) vdiffExec(ctx context.Context, primaries []*topo.TabletInfo, keyspace, workflow string, queryFn func(primary *topo.TabletInfo) string) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		rec     concurrency.AllErrorRecorder
+		results = make(map[*topo.TabletInfo]*sqltypes.Result, len(primaries))
+	)
+
+	for _, primary := range primaries {
+		wg.Add(1)
+
+		go func(primary *topo.TabletInfo) {
+			defer wg.Done()
+
+			qr, err := s.tmc.VExec(ctx, primary.Tablet, queryFn(primary), workflow, keyspace)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("VExec on %v failed: %w", topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+
+			results[primary] = sqltypes.Proto3ToResult(qr)
+		}(primary)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	return results, nil
+}
+
+// getKeyspacePrimaries returns the primary tablets of all the shards of the
+// keyspace.
+func (s *Server) getKeyspacePrimaries(ctx context.Context, keyspace string) ([]*topo.TabletInfo, error) {
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("%w %s", vexec.ErrNoShardsForKeyspace, keyspace)
+	}
+
+	primaries := make([]*topo.TabletInfo, 0, len(shards))
+	for _, shard := range shards {
+		si, err := s.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if si.PrimaryAlias == nil {
+			return nil, fmt.Errorf("%w %s/%s", vexec.ErrNoShardPrimary, keyspace, shard)
+		}
+
+		primary, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+
+		primaries = append(primaries, primary)
+	}
+
+	return primaries, nil
+}
+
+// addVDiffTableProgress adds the progress of the tables of each shard to the
+// shard summaries of the response, and totals the compared rows and mismatches.
+func addVDiffTableProgress(resp *vtctldatapb.VDiffShowResponse, results map[*topo.TabletInfo]*sqltypes.Result) {
+	for primary, result := range results {
+		shardSummary, ok := resp.ShardSummaries[primary.Shard]
+		if !ok {
+			continue
+		}
+
+		for _, row := range result.Named().Rows {
+			progress := &vtctldatapb.VDiffTableProgress{
+				TableName:    row.AsString("table_name", ""),
+				State:        row.AsString("state", ""),
+				TableRows:    row.AsInt64("table_rows", 0),
+				RowsCompared: row.AsInt64("rows_compared", 0),
+				HasMismatch:  row.AsInt64("mismatch", 0) != 0,
+				Report:       row.AsString("report", ""),
+			}
+			shardSummary.Tables = append(shardSummary.Tables, progress)
+			resp.RowsCompared += progress.RowsCompared
+			resp.HasMismatch = resp.HasMismatch || progress.HasMismatch
+		}
+
+		sort.Slice(shardSummary.Tables, func(i, j int) bool {
+			return shardSummary.Tables[i].TableName < shardSummary.Tables[j].TableName
+		})
+	}
+}
+
+// aggregateVDiffState returns the state of a vdiff over all shards: an error on
+// any shard is an error, and the vdiff is completed only once all the shards
+// completed.
+func aggregateVDiffState(shardSummaries map[string]*vtctldatapb.VDiffShardSummary) string {
+	states := make(map[vdiff.State]bool)
+	for _, shardSummary := range shardSummaries {
+		states[vdiff.State(shardSummary.State)] = true
+	}
+
+	for _, state := range []vdiff.State{vdiff.ErrorState, vdiff.StartedState, vdiff.PendingState, vdiff.StoppedState} {
+		if states[state] {
+			return string(state)
+		}
+	}
+
+	return string(vdiff.CompletedState)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestVDiffShow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true},
+		&topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			Keyspace: "ks",
+			Shard:    "-80",
+			Type:     topodatapb.TabletType_PRIMARY,
+		},
+		&topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+			Keyspace: "ks",
+			Shard:    "80-",
+			Type:     topodatapb.TabletType_PRIMARY,
+		},
+	)
+
+	lastFields := sqltypes.MakeTestFields("vdiff_uuid|created_at", "varchar|timestamp")
+	vdiffFields := sqltypes.MakeTestFields("state|last_error|started_at|completed_at", "varbinary|varbinary|timestamp|timestamp")
+	tableFields := sqltypes.MakeTestFields("table_name|state|table_rows|rows_compared|mismatch|report", "varbinary|varbinary|int64|int64|int64|json")
+	result := func(fields []*querypb.Field, rows ...string) *querypb.QueryResult {
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, rows...))
+	}
+	const (
+		lastQuery  = "select vdiff_uuid, created_at from _vt.vdiff where db_name='vt_ks' and workflow='wf' order by id desc limit 1"
+		vdiffQuery = "select state, last_error, started_at, completed_at from _vt.vdiff where db_name='vt_ks' and workflow='wf' and vdiff_uuid='uuid2'"
+		tableQuery = "select table_name, state, table_rows, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_uuid='uuid2'"
+	)
+	tmc := &fakeTMC{
+		vexecQueriesByTablet: map[string]map[string]*querypb.QueryResult{
+			"zone1-0000000100": {
+				lastQuery:  result(lastFields, "uuid1|2022-01-01 00:00:00"),
+				vdiffQuery: result(vdiffFields, "completed||2022-01-02 00:00:00|2022-01-02 00:01:00"),
+				tableQuery: result(tableFields,
+					`t2|completed|5|5|0|{"ProcessedRows":5,"MatchingRows":5}`,
+					`t1|completed|10|10|0|{"ProcessedRows":10,"MatchingRows":10}`,
+				),
+			},
+			"zone1-0000000200": {
+				lastQuery:  result(lastFields, "uuid2|2022-01-02 00:00:00"),
+				vdiffQuery: result(vdiffFields, "started||2022-01-02 00:00:00|"),
+				tableQuery: result(tableFields,
+					`t1|started|20|8|1|{"ProcessedRows":8,"MatchingRows":7,"MismatchedRows":1}`,
+				),
+			},
+		},
+	}
+	ws := NewServer(ts, tmc)
+
+	resp, err := ws.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: "ks",
+		Workflow: "wf",
+		Uuid:     "last",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "uuid2", resp.Uuid)
+	assert.Equal(t, "started", resp.State)
+	assert.True(t, resp.HasMismatch)
+	assert.Equal(t, int64(23), resp.RowsCompared)
+	require.Len(t, resp.ShardSummaries, 2)
+	shard := resp.ShardSummaries["-80"]
+	assert.Equal(t, "completed", shard.State)
+	require.Len(t, shard.Tables, 2)
+	assert.Equal(t, "t1", shard.Tables[0].TableName)
+	assert.Equal(t, `{"ProcessedRows":10,"MatchingRows":10}`, shard.Tables[0].Report)
+
+	_, err = ws.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: "ks",
+		Workflow: "wf",
+		Uuid:     "uuid3",
+	})
+	assert.Error(t, err)
+}
+
+func TestAggregateVDiffState(t *testing.T) {
+	t.Parallel()
+
+	shardSummaries := map[string]*vtctldatapb.VDiffShardSummary{
+		"-80": {State: "completed"},
+		"80-": {State: "started"},
+	}
+	assert.Equal(t, "started", aggregateVDiffState(shardSummaries))
+
+	shardSummaries["80-"].State = "error"
+	assert.Equal(t, "error", aggregateVDiffState(shardSummaries))
+
+	shardSummaries["80-"].State = "completed"
+	assert.Equal(t, "completed", aggregateVDiffState(shardSummaries))
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debezium renders the events of a VStream as change events in
+// the JSON envelope of Debezium, for tools that consume Debezium topics.
+// Every row change becomes an Envelope with the before and after images
+// of the row, and every DDL becomes a SchemaChange. vtgate renders them
+// for the streams requested in the DEBEZIUM format of VStreamFlags.
+package debezium
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// Connector is the name of the connector in the source of the events.
+const Connector = "vitess"
+
+// The operations of an Envelope.
+const (
+	OpCreate = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+	OpRead   = "r"
+)
+
+// Envelope is the change event of a row. Before is null for an insert,
+// and After is null for a delete. The inserts of a table that are
+// streamed while the table is copied are reads.
+type Envelope struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+	Source Source          `json:"source"`
+	Op     string          `json:"op"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+// SchemaChange is the change event of a DDL.
+type SchemaChange struct {
+	Source       Source `json:"source"`
+	DatabaseName string `json:"databaseName"`
+	DDL          string `json:"ddl"`
+	TsMs         int64  `json:"ts_ms"`
+}
+
+// Source describes where a change event comes from. Gtid is the
+// position of the shard after the transaction of the change, and TsMs
+// is the time of the transaction.
+type Source struct {
+	Connector string `json:"connector"`
+	TsMs      int64  `json:"ts_ms"`
+	Keyspace  string `json:"keyspace"`
+	Shard     string `json:"shard"`
+	Table     string `json:"table,omitempty"`
+	Gtid      string `json:"gtid"`
+}
+
+// Converter converts the events of a VStream to Debezium change events.
+// It keeps the field info of the tables, and holds the row changes of a
+// transaction until the VGTID event that ends it, which provides their
+// gtid. The VGTID event also tells which tables of the shard are being
+// copied: vtgate lists them in the table_p_ks of the shard until their
+// copy completes. A Converter is not safe for concurrent use.
+type Converter struct {
+	// now is the clock used for the ts_ms of the envelopes.
+	now func() time.Time
+	// fields is keyed by shard and table.
+	fields map[string][]*querypb.Field
+	// pending, gtids and copying are keyed by shard.
+	pending map[string][]*Envelope
+	gtids   map[string]string
+	copying map[string]map[string]bool
+}
+
+// NewConverter creates a Converter.
+func NewConverter() *Converter {
+	return &Converter{
+		now:     time.Now,
+		fields:  make(map[string][]*querypb.Field),
+		pending: make(map[string][]*Envelope),
+		gtids:   make(map[string]string),
+		copying: make(map[string]map[string]bool),
+	}
+}
+
+// Convert converts events, and returns the JSON change events that are
+// complete, in the order of the events.
+func (c *Converter) Convert(events []*binlogdatapb.VEvent) ([][]byte, error) {
+	var out [][]byte
+	for _, ev := range events {
+		shard := ev.Keyspace + "/" + ev.Shard
+		switch ev.Type {
+		case binlogdatapb.VEventType_FIELD:
+			c.fields[shard+"/"+ev.FieldEvent.TableName] = ev.FieldEvent.Fields
+		case binlogdatapb.VEventType_ROW:
+			fields, ok := c.fields[shard+"/"+ev.RowEvent.TableName]
+			if !ok {
+				return nil, fmt.Errorf("no field info for table %s of shard %s", ev.RowEvent.TableName, shard)
+			}
+			for _, change := range ev.RowEvent.RowChanges {
+				c.pending[shard] = append(c.pending[shard], c.envelope(ev, fields, change))
+			}
+		case binlogdatapb.VEventType_VGTID:
+			for _, sgtid := range ev.Vgtid.GetShardGtids() {
+				if sgtid.Keyspace == ev.Keyspace && sgtid.Shard == ev.Shard {
+					c.gtids[shard] = sgtid.Gtid
+					c.copying[shard] = copyingTables(sgtid)
+				}
+			}
+			flushed, err := c.flush(shard)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, flushed...)
+		case binlogdatapb.VEventType_COMMIT:
+			flushed, err := c.flush(shard)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, flushed...)
+		case binlogdatapb.VEventType_DDL:
+			change, err := json.Marshal(&SchemaChange{
+				Source:       c.source(ev, ""),
+				DatabaseName: ev.Keyspace,
+				DDL:          ev.Statement,
+				TsMs:         c.now().UnixMilli(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, change)
+		}
+	}
+	return out, nil
+}
+
+// copyingTables returns the tables of a shard that are being copied.
+func copyingTables(sgtid *binlogdatapb.ShardGtid) map[string]bool {
+	tables := make(map[string]bool, len(sgtid.TablePKs))
+	for _, tablePK := range sgtid.TablePKs {
+		tables[tablePK.TableName] = true
+	}
+	return tables
+}
+
+// flush returns the pending change events of a shard with its gtid.
+// The inserts of the tables that are still being copied are reads.
+func (c *Converter) flush(shard string) ([][]byte, error) {
+	pending := c.pending[shard]
+	delete(c.pending, shard)
+	out := make([][]byte, 0, len(pending))
+	for _, env := range pending {
+		env.Source.Gtid = c.gtids[shard]
+		if env.Op == OpCreate && c.copying[shard][env.Source.Table] {
+			env.Op = OpRead
+		}
+		b, err := json.Marshal(env)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+func (c *Converter) envelope(ev *binlogdatapb.VEvent, fields []*querypb.Field, change *binlogdatapb.RowChange) *Envelope {
+	env := &Envelope{
+		Source: c.source(ev, ev.RowEvent.TableName),
+		TsMs:   c.now().UnixMilli(),
+	}
+	if change.Before != nil {
+		env.Before = rowJSON(fields, sqltypes.MakeRowTrusted(fields, change.Before))
+	}
+	if change.After != nil {
+		env.After = rowJSON(fields, sqltypes.MakeRowTrusted(fields, change.After))
+	}
+	switch {
+	case env.Before == nil:
+		env.Op = OpCreate
+	case env.After == nil:
+		env.Op = OpDelete
+	default:
+		env.Op = OpUpdate
+	}
+	return env
+}
+
+func (c *Converter) source(ev *binlogdatapb.VEvent, tableName string) Source {
+	return Source{
+		Connector: Connector,
+		TsMs:      ev.Timestamp * 1000,
+		Keyspace:  ev.Keyspace,
+		Shard:     ev.Shard,
+		// vtgate qualifies the table names with the keyspace.
+		Table: strings.TrimPrefix(tableName, ev.Keyspace+"."),
+		Gtid:  c.gtids[ev.Keyspace+"/"+ev.Shard],
+	}
+}
+
+// rowJSON returns a row as a JSON object in column order. Numbers other
+// than decimals are rendered as JSON numbers, binary values are base64
+// encoded, and other values are rendered as strings, as Debezium does
+// with decimal.handling.mode set to string.
+func rowJSON(fields []*querypb.Field, row []sqltypes.Value) json.RawMessage {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.Name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		val := row[i]
+		switch {
+		case val.IsNull():
+			buf.WriteString("null")
+		case val.IsIntegral() || val.IsFloat():
+			buf.Write(val.Raw())
+		case val.IsBinary():
+			b, _ := json.Marshal(val.Raw())
+			buf.Write(b)
+		default:
+			b, _ := json.Marshal(val.ToString())
+			buf.Write(b)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+// ResponseSender returns a function that sends the events of a VStream
+// with send, in the DEBEZIUM format of VStreamFlags: the row changes and
+// DDLs are sent as change events, and the events of a response are
+// limited to the VGTID and HEARTBEAT events, which clients need to
+// resume the stream and to detect that it is idle.
+func ResponseSender(send func(*vtgatepb.VStreamResponse) error) func(events []*binlogdatapb.VEvent) error {
+	return responseSender(NewConverter(), send)
+}
+
+func responseSender(converter *Converter, send func(*vtgatepb.VStreamResponse) error) func(events []*binlogdatapb.VEvent) error {
+	return func(events []*binlogdatapb.VEvent) error {
+		changeEvents, err := converter.Convert(events)
+		if err != nil {
+			return err
+		}
+		var sent []*binlogdatapb.VEvent
+		for _, ev := range events {
+			switch ev.Type {
+			case binlogdatapb.VEventType_VGTID, binlogdatapb.VEventType_HEARTBEAT:
+				sent = append(sent, ev)
+			}
+		}
+		if len(sent) == 0 && len(changeEvents) == 0 {
+			return nil
+		}
+		return send(&vtgatepb.VStreamResponse{
+			Events:       sent,
+			ChangeEvents: changeEvents,
+		})
+	}
+}
+
+// Reader reads the change events of a VStream.
+type Reader struct {
+	reader   vtgateconn.VStreamChangeEventReader
+	buffered [][]byte
+}
+
+// NewReader returns a Reader of the change events of a VStream, which must
+// be requested in the DEBEZIUM format of VStreamFlags.
+func NewReader(reader vtgateconn.VStreamReader) (*Reader, error) {
+	changeEventReader, ok := reader.(vtgateconn.VStreamChangeEventReader)
+	if !ok {
+		return nil, fmt.Errorf("VStream reader %T doesn't receive change events", reader)
+	}
+	return &Reader{reader: changeEventReader}, nil
+}
+
+// Recv returns the next change event as a JSON document.
+// It returns io.EOF if the stream ended.
+func (r *Reader) Recv() ([]byte, error) {
+	for len(r.buffered) == 0 {
+		_, changeEvents, err := r.reader.RecvChangeEvents()
+		if err != nil {
+			return nil, err
+		}
+		r.buffered = changeEvents
+	}
+	event := r.buffered[0]
+	r.buffered = r.buffered[1:]
+	return event, nil
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debezium
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func testRow(vals ...sqltypes.Value) *querypb.Row {
+	return sqltypes.RowToProto3(vals)
+}
+
+func testVGtid(gtid string) *binlogdatapb.VGtid {
+	return &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+		Keyspace: "ks",
+		Shard:    "-80",
+		Gtid:     gtid,
+	}, {
+		Keyspace: "ks",
+		Shard:    "80-",
+		Gtid:     "MySQL56/other:1-3",
+	}}}
+}
+
+var testEvents = [][]*binlogdatapb.VEvent{{{
+	Type:     binlogdatapb.VEventType_BEGIN,
+	Keyspace: "ks",
+	Shard:    "-80",
+}, {
+	Type:     binlogdatapb.VEventType_FIELD,
+	Keyspace: "ks",
+	Shard:    "-80",
+	FieldEvent: &binlogdatapb.FieldEvent{
+		TableName: "ks.t1",
+		Fields:    sqltypes.MakeTestFields("id|name|price|data", "int64|varchar|decimal|varbinary"),
+	},
+}, {
+	Type:      binlogdatapb.VEventType_ROW,
+	Timestamp: 1600000000,
+	Keyspace:  "ks",
+	Shard:     "-80",
+	RowEvent: &binlogdatapb.RowEvent{
+		TableName: "ks.t1",
+		RowChanges: []*binlogdatapb.RowChange{{
+			After: testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewDecimal("1.50"), sqltypes.NewVarBinary("\x00\x01")),
+		}, {
+			Before: testRow(sqltypes.NewInt64(2), sqltypes.NewVarChar("b"), sqltypes.NULL, sqltypes.NULL),
+			After:  testRow(sqltypes.NewInt64(2), sqltypes.NewVarChar("c"), sqltypes.NULL, sqltypes.NULL),
+		}},
+	},
+}}, {{
+	Type:      binlogdatapb.VEventType_ROW,
+	Timestamp: 1600000000,
+	Keyspace:  "ks",
+	Shard:     "-80",
+	RowEvent: &binlogdatapb.RowEvent{
+		TableName: "ks.t1",
+		RowChanges: []*binlogdatapb.RowChange{{
+			Before: testRow(sqltypes.NewInt64(3), sqltypes.NewVarChar("d"), sqltypes.NULL, sqltypes.NULL),
+		}},
+	},
+}, {
+	Type:     binlogdatapb.VEventType_VGTID,
+	Keyspace: "ks",
+	Shard:    "-80",
+	Vgtid:    testVGtid("MySQL56/uuid:1-10"),
+}, {
+	Type:     binlogdatapb.VEventType_COMMIT,
+	Keyspace: "ks",
+	Shard:    "-80",
+}}, {{
+	Type:     binlogdatapb.VEventType_VGTID,
+	Keyspace: "ks",
+	Shard:    "-80",
+	Vgtid:    testVGtid("MySQL56/uuid:1-11"),
+}, {
+	Type:      binlogdatapb.VEventType_DDL,
+	Timestamp: 1600000001,
+	Keyspace:  "ks",
+	Shard:     "-80",
+	Statement: "alter table t1 add column val int",
+}}}
+
+var testChangeEvents = []string{
+	`{"before":null,"after":{"id":1,"name":"a","price":"1.50","data":"AAE="},` +
+		`"source":{"connector":"vitess","ts_ms":1600000000000,"keyspace":"ks","shard":"-80","table":"t1","gtid":"MySQL56/uuid:1-10"},"op":"c","ts_ms":1700000000000}`,
+	`{"before":{"id":2,"name":"b","price":null,"data":null},"after":{"id":2,"name":"c","price":null,"data":null},` +
+		`"source":{"connector":"vitess","ts_ms":1600000000000,"keyspace":"ks","shard":"-80","table":"t1","gtid":"MySQL56/uuid:1-10"},"op":"u","ts_ms":1700000000000}`,
+	`{"before":{"id":3,"name":"d","price":null,"data":null},"after":null,` +
+		`"source":{"connector":"vitess","ts_ms":1600000000000,"keyspace":"ks","shard":"-80","table":"t1","gtid":"MySQL56/uuid:1-10"},"op":"d","ts_ms":1700000000000}`,
+	`{"source":{"connector":"vitess","ts_ms":1600000001000,"keyspace":"ks","shard":"-80","gtid":"MySQL56/uuid:1-11"},` +
+		`"databaseName":"ks","ddl":"alter table t1 add column val int","ts_ms":1700000000000}`,
+}
+
+func newTestConverter() *Converter {
+	c := NewConverter()
+	c.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return c
+}
+
+func TestConverter(t *testing.T) {
+	c := newTestConverter()
+
+	// The row changes are held until the end of their transaction.
+	out, err := c.Convert(testEvents[0])
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	var got []string
+	for _, events := range testEvents[1:] {
+		out, err := c.Convert(events)
+		require.NoError(t, err)
+		for _, b := range out {
+			got = append(got, string(b))
+		}
+	}
+	assert.Equal(t, testChangeEvents, got)
+
+	_, err = c.Convert([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		Keyspace: "ks",
+		Shard:    "80-",
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t1"},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no field info for table ks.t1 of shard ks/80-")
+}
+
+func TestConverterCopy(t *testing.T) {
+	c := newTestConverter()
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	insert := &binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		Keyspace: "ks",
+		Shard:    "-80",
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "ks.t1",
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+			}},
+		},
+	}
+	vgtid := func(tablePKs ...*binlogdatapb.TableLastPK) *binlogdatapb.VEvent {
+		return &binlogdatapb.VEvent{
+			Type:     binlogdatapb.VEventType_VGTID,
+			Keyspace: "ks",
+			Shard:    "-80",
+			Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "ks",
+				Shard:    "-80",
+				Gtid:     "MySQL56/uuid:1-10",
+				TablePKs: tablePKs,
+			}}},
+		}
+	}
+	op := func(events ...*binlogdatapb.VEvent) string {
+		out, err := c.Convert(events)
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		var env Envelope
+		require.NoError(t, json.Unmarshal(out[0], &env))
+		return env.Op
+	}
+
+	// Rows copied before the copy of their table completes are reads.
+	_, err := c.Convert([]*binlogdatapb.VEvent{{
+		Type:       binlogdatapb.VEventType_FIELD,
+		Keyspace:   "ks",
+		Shard:      "-80",
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: fields},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, OpRead, op(insert, vgtid(&binlogdatapb.TableLastPK{
+		TableName: "t1",
+		Lastpk:    sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")),
+	})))
+
+	// Once the copy completed, inserts are creates.
+	out, err := c.Convert([]*binlogdatapb.VEvent{vgtid()})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+	assert.Equal(t, OpCreate, op(insert, vgtid()))
+}
+
+func TestResponseSender(t *testing.T) {
+	var responses []*vtgatepb.VStreamResponse
+	send := responseSender(newTestConverter(), func(response *vtgatepb.VStreamResponse) error {
+		responses = append(responses, response)
+		return nil
+	})
+	for _, events := range testEvents {
+		require.NoError(t, send(events))
+	}
+
+	// Nothing is sent until the end of the first transaction.
+	require.Len(t, responses, 2)
+	var got []string
+	for i, response := range responses {
+		require.Len(t, response.Events, 1)
+		assert.Equal(t, binlogdatapb.VEventType_VGTID, response.Events[0].Type)
+		assert.Equal(t, testEvents[i+1][len(testEvents[i+1])-2].Vgtid, response.Events[0].Vgtid)
+		for _, b := range response.ChangeEvents {
+			got = append(got, string(b))
+		}
+	}
+	assert.Equal(t, testChangeEvents, got)
+}
+
+type testReader struct {
+	responses []*vtgatepb.VStreamResponse
+}
+
+func (r *testReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	events, _, err := r.RecvChangeEvents()
+	return events, err
+}
+
+func (r *testReader) RecvChangeEvents() ([]*binlogdatapb.VEvent, [][]byte, error) {
+	if len(r.responses) == 0 {
+		return nil, nil, io.EOF
+	}
+	response := r.responses[0]
+	r.responses = r.responses[1:]
+	return response.Events, response.ChangeEvents, nil
+}
+
+type eventReader struct{}
+
+func (eventReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	return nil, io.EOF
+}
+
+func TestReader(t *testing.T) {
+	reader, err := NewReader(&testReader{responses: []*vtgatepb.VStreamResponse{{
+		ChangeEvents: [][]byte{[]byte(testChangeEvents[0]), []byte(testChangeEvents[1])},
+	}, {
+		Events: []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_HEARTBEAT}},
+	}, {
+		ChangeEvents: [][]byte{[]byte(testChangeEvents[2])},
+	}}})
+	require.NoError(t, err)
+	var got []string
+	for {
+		b, err := reader.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, string(b))
+	}
+	assert.Equal(t, testChangeEvents[:3], got)
+
+	_, err = NewReader(eventReader{})
+	require.Error(t, err)
+}
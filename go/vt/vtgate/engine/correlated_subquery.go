@@ -0,0 +1,357 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*CorrelatedSubquery)(nil)
+
+// correlatedSubqueryBatchSize is the maximum number of outer rows
+// whose correlated values are sent together to the subquery
+// when the subquery can be batched.
+var correlatedSubqueryBatchSize = 100
+
+// CorrelatedSubquery is a primitive that evaluates a subquery that depends
+// on the columns of an outer query. The subquery is executed for the rows of
+// the Outer primitive, with the outer columns passed as bind variables, and its
+// result is stored in the bind variables of the pulled out subquery, as done by
+// PulloutSubquery. The Predicate and the Exprs are then evaluated for every
+// outer row using those bind variables.
+type CorrelatedSubquery struct {
+	Opcode PulloutOpcode
+
+	// SubqueryResult and HasValues are the bind variables that the
+	// result of the subquery is stored in for every outer row.
+	SubqueryResult string
+	HasValues      string
+
+	Outer    Primitive
+	Subquery Primitive
+
+	// Vars defines the list of bind variables that need to
+	// be built from every outer row before invoking the Subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// BatchVar is set when the outer rows can be sent to the Subquery in batches.
+	// Instead of executing the Subquery once per outer row, the values of the
+	// column BatchColumn of up to correlatedSubqueryBatchSize outer rows are
+	// sent as a list in BatchVar. The Subquery then returns the correlated value
+	// as its last column, which is used to give every outer row its own rows back.
+	BatchVar    string `json:",omitempty"`
+	BatchColumn int    `json:",omitempty"`
+
+	// Predicate is used to filter the outer rows. Only the rows
+	// for which it evaluates to true are returned.
+	Predicate    evalengine.Expr
+	ASTPredicate sqlparser.Expr
+
+	// Exprs are the expressions that are evaluated for every returned row.
+	// ExprColumns are the names of the columns they produce.
+	Exprs       []evalengine.Expr
+	ExprColumns []string
+
+	// Cols defines which columns are returned. Columns of
+	// the outer rows go as -1, -2, etc., while the Exprs
+	// go as 1, 2, etc. If Cols is {-1, 1, -2}, it means
+	// that the returned result will be {Outer0, Exprs0, Outer1}.
+	Cols []int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (cs *CorrelatedSubquery) RouteType() string {
+	return cs.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (cs *CorrelatedSubquery) GetKeyspaceName() string {
+	if cs.Outer.GetKeyspaceName() == cs.Subquery.GetKeyspaceName() {
+		return cs.Outer.GetKeyspaceName()
+	}
+	return cs.Outer.GetKeyspaceName() + "_" + cs.Subquery.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (cs *CorrelatedSubquery) GetTableName() string {
+	return cs.Outer.GetTableName() + "_" + cs.Subquery.GetTableName()
+}
+
+// TryExecute performs a non-streaming exec.
+func (cs *CorrelatedSubquery) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	outer, err := vcursor.ExecutePrimitive(cs.Outer, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return cs.apply(vcursor, bindVars, outer)
+}
+
+// TryStreamExecute performs a streaming exec.
+func (cs *CorrelatedSubquery) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(cs.Outer, bindVars, wantfields, func(outer *sqltypes.Result) error {
+		result, err := cs.apply(vcursor, bindVars, outer)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// apply evaluates the subquery for all the rows of the outer result.
+func (cs *CorrelatedSubquery) apply(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outer *sqltypes.Result) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	env := evalengine.EnvWithBindVars(nil, vcursor.ConnCollation())
+	batchSize := 1
+	if cs.BatchVar != "" {
+		batchSize = correlatedSubqueryBatchSize
+	}
+	for start := 0; start < len(outer.Rows); start += batchSize {
+		end := start + batchSize
+		if end > len(outer.Rows) {
+			end = len(outer.Rows)
+		}
+		batch := outer.Rows[start:end]
+		subqueryRows, err := cs.execSubquery(vcursor, bindVars, batch)
+		if err != nil {
+			return nil, err
+		}
+		for i, row := range batch {
+			env.BindVars = combineVars(bindVars, nil)
+			if err := bindSubqueryResult(cs.Opcode, cs.SubqueryResult, cs.HasValues, subqueryRows[i], env.BindVars); err != nil {
+				return nil, err
+			}
+			env.Row = row
+			if cs.Predicate != nil {
+				keep, err := evalBool(env, cs.Predicate)
+				if err != nil {
+					return nil, err
+				}
+				if !keep {
+					continue
+				}
+			}
+			if outer.Fields != nil && result.Fields == nil {
+				result.Fields, err = cs.fields(env, outer.Fields)
+				if err != nil {
+					return nil, err
+				}
+			}
+			projected, err := cs.project(env, row)
+			if err != nil {
+				return nil, err
+			}
+			result.Rows = append(result.Rows, projected)
+		}
+	}
+	if outer.Fields != nil && result.Fields == nil {
+		var err error
+		result.Fields, err = cs.placeholderFields(vcursor, bindVars, outer.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// execSubquery executes the subquery for the given outer rows,
+// and returns the rows that belong to every one of them.
+func (cs *CorrelatedSubquery) execSubquery(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outerRows [][]sqltypes.Value) ([][][]sqltypes.Value, error) {
+	subqueryRows := make([][][]sqltypes.Value, len(outerRows))
+	if cs.BatchVar == "" {
+		subqueryVars := make(map[string]*querypb.BindVariable, len(cs.Vars))
+		for i, row := range outerRows {
+			for k, col := range cs.Vars {
+				subqueryVars[k] = sqltypes.ValueBindVariable(row[col])
+			}
+			qr, err := vcursor.ExecutePrimitive(cs.Subquery, combineVars(bindVars, subqueryVars), false)
+			if err != nil {
+				return nil, err
+			}
+			subqueryRows[i] = qr.Rows
+		}
+		return subqueryRows, nil
+	}
+
+	values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for _, row := range outerRows {
+		// a NULL value can never be equal to anything, so there is no need to send it
+		if row[cs.BatchColumn].IsNull() {
+			continue
+		}
+		values.Values = append(values.Values, sqltypes.ValueToProto(row[cs.BatchColumn]))
+	}
+	if len(values.Values) == 0 {
+		return subqueryRows, nil
+	}
+	qr, err := vcursor.ExecutePrimitive(cs.Subquery, combineVars(bindVars, map[string]*querypb.BindVariable{cs.BatchVar: values}), false)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range outerRows {
+		outerVal := row[cs.BatchColumn]
+		if outerVal.IsNull() {
+			continue
+		}
+		for _, subqueryRow := range qr.Rows {
+			last := len(subqueryRow) - 1
+			if subqueryRow[last].IsNull() {
+				continue
+			}
+			cmp, err := evalengine.NullsafeCompare(outerVal, subqueryRow[last], vcursor.ConnCollation())
+			if err != nil {
+				return nil, err
+			}
+			if cmp == 0 {
+				subqueryRows[i] = append(subqueryRows[i], subqueryRow[:last])
+			}
+		}
+	}
+	return subqueryRows, nil
+}
+
+// evalBool evaluates the predicate and tells whether it is true. NULL is treated as false.
+func evalBool(env *evalengine.ExpressionEnv, predicate evalengine.Expr) (bool, error) {
+	evalResult, err := env.Evaluate(predicate)
+	if err != nil {
+		return false, err
+	}
+	value := evalResult.Value()
+	if value.IsNull() {
+		return false, nil
+	}
+	intEvalResult, err := value.ToInt64()
+	if err != nil {
+		return false, err
+	}
+	return intEvalResult == 1, nil
+}
+
+func (cs *CorrelatedSubquery) project(env *evalengine.ExpressionEnv, row []sqltypes.Value) ([]sqltypes.Value, error) {
+	projected := make([]sqltypes.Value, len(cs.Cols))
+	for i, index := range cs.Cols {
+		if index < 0 {
+			projected[i] = row[-index-1]
+			continue
+		}
+		evalResult, err := env.Evaluate(cs.Exprs[index-1])
+		if err != nil {
+			return nil, err
+		}
+		projected[i] = evalResult.Value()
+	}
+	return projected, nil
+}
+
+func (cs *CorrelatedSubquery) fields(env *evalengine.ExpressionEnv, outerFields []*querypb.Field) ([]*querypb.Field, error) {
+	fields := make([]*querypb.Field, len(cs.Cols))
+	for i, index := range cs.Cols {
+		if index < 0 {
+			fields[i] = outerFields[-index-1]
+			continue
+		}
+		typ, err := env.TypeOf(cs.Exprs[index-1])
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = &querypb.Field{
+			Name: cs.ExprColumns[index-1],
+			Type: typ,
+		}
+	}
+	return fields, nil
+}
+
+// placeholderFields returns the fields when there is no row to evaluate the expressions on.
+// The subquery is then considered empty, and all the outer columns NULL.
+func (cs *CorrelatedSubquery) placeholderFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outerFields []*querypb.Field) ([]*querypb.Field, error) {
+	env := evalengine.EnvWithBindVars(combineVars(bindVars, nil), vcursor.ConnCollation())
+	if err := bindSubqueryResult(cs.Opcode, cs.SubqueryResult, cs.HasValues, nil, env.BindVars); err != nil {
+		return nil, err
+	}
+	env.Row = make([]sqltypes.Value, len(outerFields))
+	return cs.fields(env, outerFields)
+}
+
+// GetFields fetches the field info.
+func (cs *CorrelatedSubquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := cs.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := cs.placeholderFields(vcursor, bindVars, qr.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: fields}, nil
+}
+
+// Inputs returns the input primitives for this correlated subquery
+func (cs *CorrelatedSubquery) Inputs() []Primitive {
+	return []Primitive{cs.Outer, cs.Subquery}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (cs *CorrelatedSubquery) NeedsTransaction() bool {
+	return cs.Outer.NeedsTransaction() || cs.Subquery.NeedsTransaction()
+}
+
+func (cs *CorrelatedSubquery) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":        cs.GetTableName(),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(cs.Cols)), ","), "[]"),
+	}
+	var pulloutVars []string
+	if cs.HasValues != "" {
+		pulloutVars = append(pulloutVars, cs.HasValues)
+	}
+	if cs.SubqueryResult != "" {
+		pulloutVars = append(pulloutVars, cs.SubqueryResult)
+	}
+	if len(pulloutVars) > 0 {
+		other["PulloutVars"] = pulloutVars
+	}
+	if len(cs.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(cs.Vars)
+	}
+	if cs.BatchVar != "" {
+		other["BatchVar"] = cs.BatchVar
+		other["BatchColumn"] = cs.BatchColumn
+	}
+	if cs.ASTPredicate != nil {
+		other["Predicate"] = sqlparser.String(cs.ASTPredicate)
+	}
+	if len(cs.Exprs) > 0 {
+		var exprs []string
+		for _, e := range cs.Exprs {
+			exprs = append(exprs, evalengine.FormatExpr(e))
+		}
+		other["Expressions"] = exprs
+		other["Columns"] = cs.ExprColumns
+	}
+	return PrimitiveDescription{
+		OperatorType: "CorrelatedSubquery",
+		Variant:      cs.Opcode.String(),
+		Other:        other,
+	}
+}
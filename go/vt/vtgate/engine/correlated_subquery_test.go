@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// offsetLookup resolves the column names to their position in the list.
+type offsetLookup []string
+
+func (o offsetLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	for i, name := range o {
+		if col.Name.EqualString(name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column %s", sqlparser.String(col))
+}
+
+func (o offsetLookup) CollationForExpr(sqlparser.Expr) collations.ID {
+	return collations.Default()
+}
+
+func (o offsetLookup) DefaultCollation() collations.ID {
+	return collations.Default()
+}
+
+func translate(t *testing.T, expr string, columns ...string) (evalengine.Expr, sqlparser.Expr) {
+	t.Helper()
+	ast, err := sqlparser.ParseExpr(expr)
+	require.NoError(t, err)
+	translated, err := evalengine.Translate(ast, offsetLookup(columns))
+	require.NoError(t, err)
+	return translated, ast
+}
+
+func outerUsers() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|30",
+			),
+		},
+	}
+}
+
+func TestCorrelatedSubqueryIn(t *testing.T) {
+	outer := outerUsers()
+	subqueryFields := sqltypes.MakeTestFields("col", "int64")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subqueryFields, "10", "11"),
+			sqltypes.MakeTestResult(subqueryFields),
+			sqltypes.MakeTestResult(subqueryFields, "31"),
+		},
+	}
+	predicate, astPredicate := translate(t, ":__sq_has_values1 = 1 and col in ::__sq1", "id", "col")
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutIn,
+		SubqueryResult: "__sq1",
+		HasValues:      "__sq_has_values1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars:           map[string]int{"u_id": 0},
+		Predicate:      predicate,
+		ASTPredicate:   astPredicate,
+		Cols:           []int{-1},
+	}
+
+	result, err := cs.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(10)}, true)
+	require.NoError(t, err)
+	outer.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	subquery.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" u_id: type:INT64 value:"1" false`,
+		`Execute a: type:INT64 value:"10" u_id: type:INT64 value:"2" false`,
+		`Execute a: type:INT64 value:"10" u_id: type:INT64 value:"3" false`,
+	})
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"), result)
+}
+
+func TestCorrelatedSubqueryNotExists(t *testing.T) {
+	outer := outerUsers()
+	subqueryFields := sqltypes.MakeTestFields("1", "int64")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subqueryFields, "1"),
+			sqltypes.MakeTestResult(subqueryFields),
+			sqltypes.MakeTestResult(subqueryFields, "1"),
+		},
+	}
+	predicate, astPredicate := translate(t, "not :__sq_has_values1")
+	cs := &CorrelatedSubquery{
+		Opcode:       PulloutExists,
+		HasValues:    "__sq_has_values1",
+		Outer:        outer,
+		Subquery:     subquery,
+		Vars:         map[string]int{"u_col": 1},
+		Predicate:    predicate,
+		ASTPredicate: astPredicate,
+		Cols:         []int{-1, -2},
+	}
+
+	var results []*sqltypes.Result
+	err := cs.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	subquery.ExpectLog(t, []string{
+		`Execute u_col: type:INT64 value:"10" false`,
+		`Execute u_col: type:INT64 value:"20" false`,
+		`Execute u_col: type:INT64 value:"30" false`,
+	})
+	wantFields := sqltypes.MakeTestFields("id|col", "int64|int64")
+	utils.MustMatch(t, []*sqltypes.Result{
+		{Fields: wantFields},
+		{Rows: sqltypes.MakeTestResult(wantFields, "2|20").Rows},
+		{},
+	}, results)
+}
+
+func TestCorrelatedSubqueryScalarProjection(t *testing.T) {
+	outer := outerUsers()
+	subqueryFields := sqltypes.MakeTestFields("name", "varchar")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subqueryFields, "a"),
+			sqltypes.MakeTestResult(subqueryFields),
+			sqltypes.MakeTestResult(subqueryFields, "c"),
+		},
+	}
+	expr, _ := translate(t, ":__sq1")
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutValue,
+		SubqueryResult: "__sq1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars:           map[string]int{"u_id": 0},
+		Exprs:          []evalengine.Expr{expr},
+		ExprColumns:    []string{"name"},
+		Cols:           []int{-1, 1},
+	}
+
+	result, err := cs.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|name",
+			"int64|varchar",
+		),
+		"1|a",
+		"2|null",
+		"3|c",
+	)
+	utils.MustMatch(t, want, result)
+
+	// a scalar subquery can not return more than one row
+	cs.Subquery = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subqueryFields, "a", "b"),
+		},
+	}
+	cs.Outer = outerUsers()
+	_, err = cs.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "subquery returned more than one row")
+}
+
+func TestCorrelatedSubqueryBatched(t *testing.T) {
+	outer := outerUsers()
+	// the subquery returns the correlated column last
+	subqueryFields := sqltypes.MakeTestFields("col|uid", "int64|int64")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subqueryFields, "10|1", "20|1", "21|2"),
+			sqltypes.MakeTestResult(subqueryFields, "30|3"),
+		},
+	}
+	predicate, astPredicate := translate(t, ":__sq_has_values1 = 0 or col not in ::__sq1", "id", "col")
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutNotIn,
+		SubqueryResult: "__sq1",
+		HasValues:      "__sq_has_values1",
+		Outer:          outer,
+		Subquery:       subquery,
+		BatchVar:       "__sq_batch1",
+		BatchColumn:    0,
+		Predicate:      predicate,
+		ASTPredicate:   astPredicate,
+		Cols:           []int{-1, -2},
+	}
+
+	saveBatchSize := correlatedSubqueryBatchSize
+	correlatedSubqueryBatchSize = 2
+	defer func() {
+		correlatedSubqueryBatchSize = saveBatchSize
+	}()
+
+	result, err := cs.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	subquery.ExpectLog(t, []string{
+		`Execute __sq_batch1: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} false`,
+		`Execute __sq_batch1: type:TUPLE values:{type:INT64 value:"3"} false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|col",
+			"int64|int64",
+		),
+		"2|20",
+	)
+	utils.MustMatch(t, want, result)
+}
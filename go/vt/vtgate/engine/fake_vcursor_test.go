@@ -68,6 +68,10 @@ func (t *noopVCursor) StreamExecutePrimitive(primitive Primitive, bindVars map[s
 	return primitive.TryStreamExecute(t, bindVars, wantfields, callback)
 }
 
+func (t *noopVCursor) StreamExecutePrimitiveStandalone(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return primitive.TryStreamExecute(t, bindVars, wantfields, callback)
+}
+
 func (t *noopVCursor) HasSystemVariables() bool {
 	panic("implement me")
 }
@@ -221,6 +225,10 @@ func (t *noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t *noopVCursor) MaxHashAggregateRows() int {
+	return testMaxMemoryRows
+}
+
 func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
@@ -256,6 +264,10 @@ func (t *noopVCursor) AutocommitApproval() bool {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) IsAutocommittable() bool {
+	panic("unimplemented")
+}
+
 func (t *noopVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	panic("unimplemented")
 }
@@ -318,6 +330,7 @@ type loggingVCursor struct {
 	inReservedConn  bool
 	systemVariables map[string]string
 	disableSetVar   bool
+	autocommittable bool
 }
 
 type tableRoutes struct {
@@ -332,6 +345,11 @@ func (f *loggingVCursor) StreamExecutePrimitive(primitive Primitive, bindVars ma
 	return primitive.TryStreamExecute(f, bindVars, wantfields, callback)
 }
 
+func (f *loggingVCursor) StreamExecutePrimitiveStandalone(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	f.log = append(f.log, "StreamExecutePrimitiveStandalone")
+	return primitive.TryStreamExecute(f, bindVars, wantfields, callback)
+}
+
 func (f *loggingVCursor) KeyspaceAvailable(ks string) bool {
 	return f.ksAvailable
 }
@@ -446,6 +464,10 @@ func (f *loggingVCursor) AutocommitApproval() bool {
 	return true
 }
 
+func (f *loggingVCursor) IsAutocommittable() bool {
+	return f.autocommittable
+}
+
 func (f *loggingVCursor) SubmitOnlineDDL(onlineDDL *schema.OnlineDDL) error {
 	f.log = append(f.log, fmt.Sprintf("SubmitOnlineDDL: %s", onlineDDL.ToString()))
 	return nil
@@ -0,0 +1,577 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashAggregate)(nil)
+
+// hashAggregatePartitions is the number of temporary files
+// the groups are spread over when a hash aggregation spills to disk.
+const hashAggregatePartitions = 16
+
+// HashAggregate is a primitive that aggregates the rows of its input
+// without needing them to be sorted by the grouping keys.
+// The groups are kept in a hash table. When their number exceeds
+// VCursor.MaxHashAggregateRows, the partially aggregated groups are
+// spilled to temporary files, partitioned by the hash of their keys.
+// Once the input has been read, every partition is aggregated on its own.
+// The rows are not returned in any specific order.
+type HashAggregate struct {
+	// PreProcess is true if one of the aggregates needs preprocessing.
+	PreProcess bool `json:",omitempty"`
+	// Aggregates specifies the aggregation parameters for each
+	// aggregation function: function opcode and input column number.
+	Aggregates []*AggregateParams
+
+	// GroupByKeys specifies the input values that must be used for
+	// the aggregation key.
+	GroupByKeys []*GroupByParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Collations stores the collation ID per column offset.
+	// It is used for grouping keys.
+	Collations map[int]collations.ID
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (ha *HashAggregate) RouteType() string {
+	return ha.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (ha *HashAggregate) GetKeyspaceName() string {
+	return ha.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (ha *HashAggregate) GetTableName() string {
+	return ha.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (ha *HashAggregate) SetTruncateColumnCount(count int) {
+	ha.TruncateColumnCount = count
+}
+
+// TryExecute is a Primitive function.
+func (ha *HashAggregate) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(ha.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	agg, err := ha.newAggregator(result.Fields, vcursor.MaxHashAggregateRows())
+	if err != nil {
+		return nil, err
+	}
+	defer agg.close()
+
+	for _, row := range result.Rows {
+		if err := agg.add(row); err != nil {
+			return nil, err
+		}
+	}
+	out := &sqltypes.Result{Fields: agg.fields}
+	err = agg.finish(func(rows [][]sqltypes.Value) error {
+		out.Rows = append(out.Rows, rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Truncate(ha.TruncateColumnCount), nil
+}
+
+// TryStreamExecute is a Primitive function.
+func (ha *HashAggregate) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(ha.TruncateColumnCount))
+	}
+
+	var agg *hashAggregator
+	defer func() {
+		if agg != nil {
+			agg.close()
+		}
+	}()
+	err := vcursor.StreamExecutePrimitive(ha.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if agg == nil && len(qr.Fields) != 0 {
+			var err error
+			agg, err = ha.newAggregator(qr.Fields, vcursor.MaxHashAggregateRows())
+			if err != nil {
+				return err
+			}
+			if err := cb(&sqltypes.Result{Fields: agg.fields}); err != nil {
+				return err
+			}
+		}
+		if len(qr.Rows) == 0 {
+			return nil
+		}
+		if agg == nil {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] hash aggregation received rows before the fields")
+		}
+		for _, row := range qr.Rows {
+			if err := agg.add(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if agg == nil {
+		// no fields were received, we still produce the row of an aggregation without grouping keys
+		agg, err = ha.newAggregator(nil, vcursor.MaxHashAggregateRows())
+		if err != nil {
+			return err
+		}
+	}
+	return agg.finish(func(rows [][]sqltypes.Value) error {
+		return cb(&sqltypes.Result{Rows: rows})
+	})
+}
+
+// GetFields is a Primitive function.
+func (ha *HashAggregate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ha.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: ha.ordered().convertFields(qr.Fields)}
+	return qr.Truncate(ha.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this aggregation
+func (ha *HashAggregate) Inputs() []Primitive {
+	return []Primitive{ha.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (ha *HashAggregate) NeedsTransaction() bool {
+	return ha.Input.NeedsTransaction()
+}
+
+func (ha *HashAggregate) description() PrimitiveDescription {
+	aggregates := GenericJoin(ha.Aggregates, aggregateParamsToString)
+	groupBy := GenericJoin(ha.GroupByKeys, groupByParamsToString)
+	other := map[string]interface{}{
+		"Aggregates": aggregates,
+		"GroupBy":    groupBy,
+	}
+	if ha.TruncateColumnCount > 0 {
+		other["ResultColumns"] = ha.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Aggregate",
+		Variant:      "Hash",
+		Other:        other,
+	}
+}
+
+// ordered returns an OrderedAggregate with the same aggregation parameters,
+// which is used to convert and merge the input rows.
+func (ha *HashAggregate) ordered() *OrderedAggregate {
+	return &OrderedAggregate{
+		PreProcess:  ha.PreProcess,
+		Aggregates:  ha.Aggregates,
+		GroupByKeys: ha.GroupByKeys,
+		Collations:  ha.Collations,
+	}
+}
+
+func (ha *HashAggregate) newAggregator(fields []*querypb.Field, maxRows int) (*hashAggregator, error) {
+	for _, aggr := range ha.Aggregates {
+		if aggr.isDistinct() || aggr.Opcode == AggregateGtid {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] %s is not supported by hash aggregations", aggr.Opcode.String())
+		}
+	}
+	if maxRows <= 0 {
+		maxRows = 1
+	}
+	oa := ha.ordered()
+	return &hashAggregator{
+		ha:      ha,
+		oa:      oa,
+		fields:  oa.convertFields(fields),
+		maxRows: maxRows,
+		table:   newGroupTable(),
+	}, nil
+}
+
+// hashAggregator holds the state of a single execution of a HashAggregate
+type hashAggregator struct {
+	ha      *HashAggregate
+	oa      *OrderedAggregate
+	fields  []*querypb.Field
+	maxRows int
+
+	table *groupTable
+	// partitions are the temporary files the groups are spilled to.
+	// It is nil as long as all the groups fit in memory.
+	partitions []*spillPartition
+	inputRows  int
+}
+
+// groupTable is a hash table of aggregated rows. The order
+// in which the groups were created is kept, so the output is stable.
+type groupTable struct {
+	groups map[evalengine.HashCode][]int
+	rows   [][]sqltypes.Value
+	codes  []evalengine.HashCode
+}
+
+func newGroupTable() *groupTable {
+	return &groupTable{groups: map[evalengine.HashCode][]int{}}
+}
+
+// add aggregates an input row into the groups held in memory
+func (h *hashAggregator) add(row []sqltypes.Value) error {
+	h.inputRows++
+	code, err := h.hashKeys(row)
+	if err != nil {
+		return err
+	}
+	idx, err := h.find(h.table, code, row)
+	if err != nil {
+		return err
+	}
+	if idx != -1 {
+		h.table.rows[idx], _, err = h.oa.merge(h.fields, h.table.rows[idx], row, nil, h.ha.Collations)
+		return err
+	}
+	converted, _ := h.oa.convertRow(row)
+	h.insert(h.table, code, converted)
+	if len(h.table.rows) >= h.maxRows {
+		return h.spill()
+	}
+	return nil
+}
+
+// addPartial aggregates a row that has already been aggregated into the given table
+func (h *hashAggregator) addPartial(table *groupTable, code evalengine.HashCode, row []sqltypes.Value) error {
+	idx, err := h.find(table, code, row)
+	if err != nil {
+		return err
+	}
+	if idx == -1 {
+		h.insert(table, code, row)
+		return nil
+	}
+	table.rows[idx], err = h.mergePartials(table.rows[idx], row)
+	return err
+}
+
+func (h *hashAggregator) insert(table *groupTable, code evalengine.HashCode, row []sqltypes.Value) {
+	table.groups[code] = append(table.groups[code], len(table.rows))
+	table.rows = append(table.rows, row)
+	table.codes = append(table.codes, code)
+}
+
+// find returns the index of the group the row belongs to, or -1 if there is none yet
+func (h *hashAggregator) find(table *groupTable, code evalengine.HashCode, row []sqltypes.Value) (int, error) {
+	for _, idx := range table.groups[code] {
+		equal, err := h.keysEqual(table.rows[idx], row)
+		if err != nil {
+			return -1, err
+		}
+		if equal {
+			return idx, nil
+		}
+	}
+	return -1, nil
+}
+
+// mergePartials merges two rows that both hold partial aggregation results
+func (h *hashAggregator) mergePartials(row1, row2 []sqltypes.Value) ([]sqltypes.Value, error) {
+	result := sqltypes.CopyRow(row1)
+	for _, aggr := range h.ha.Aggregates {
+		var err error
+		switch aggr.Opcode {
+		case AggregateCount, AggregateCountRaw:
+			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], row2[aggr.Col], sqltypes.Int64)
+		case AggregateSum:
+			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], row2[aggr.Col], h.fields[aggr.Col].Type)
+		case AggregateMin:
+			result[aggr.Col], err = evalengine.Min(row1[aggr.Col], row2[aggr.Col], h.ha.Collations[aggr.Col])
+		case AggregateMax:
+			result[aggr.Col], err = evalengine.Max(row1[aggr.Col], row2[aggr.Col], h.ha.Collations[aggr.Col])
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected opcode in hash aggregation: %v", aggr.Opcode)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// finish returns the aggregated rows through the callback
+func (h *hashAggregator) finish(callback func([][]sqltypes.Value) error) error {
+	if h.inputRows == 0 && len(h.ha.GroupByKeys) == 0 {
+		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
+		// different aggregation functions
+		row, err := h.oa.createEmptyRow()
+		if err != nil {
+			return err
+		}
+		return callback([][]sqltypes.Value{row})
+	}
+
+	if h.partitions == nil {
+		return h.emit(h.table, callback)
+	}
+
+	if err := h.spill(); err != nil {
+		return err
+	}
+	for _, partition := range h.partitions {
+		table := newGroupTable()
+		err := partition.read(func(row []sqltypes.Value) error {
+			code, err := h.hashKeys(row)
+			if err != nil {
+				return err
+			}
+			return h.addPartial(table, code, row)
+		})
+		if err != nil {
+			return err
+		}
+		if err := h.emit(table, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *hashAggregator) emit(table *groupTable, callback func([][]sqltypes.Value) error) error {
+	if len(table.rows) == 0 {
+		return nil
+	}
+	rows := make([][]sqltypes.Value, 0, len(table.rows))
+	for _, row := range table.rows {
+		final, err := h.oa.convertFinal(row)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, final)
+	}
+	return callback(rows)
+}
+
+// spill writes the groups held in memory to the partitions, and empties the hash table
+func (h *hashAggregator) spill() error {
+	if h.partitions == nil {
+		h.partitions = make([]*spillPartition, 0, hashAggregatePartitions)
+		for i := 0; i < hashAggregatePartitions; i++ {
+			partition, err := newSpillPartition()
+			if err != nil {
+				return err
+			}
+			h.partitions = append(h.partitions, partition)
+		}
+	}
+	for idx, row := range h.table.rows {
+		partition := h.partitions[h.table.codes[idx]%hashAggregatePartitions]
+		if err := partition.write(row); err != nil {
+			return err
+		}
+	}
+	h.table = newGroupTable()
+	return nil
+}
+
+// close removes the temporary files
+func (h *hashAggregator) close() {
+	for _, partition := range h.partitions {
+		partition.close()
+	}
+	h.partitions = nil
+}
+
+func (h *hashAggregator) hashKeys(row []sqltypes.Value) (evalengine.HashCode, error) {
+	code := evalengine.HashCode(17)
+	for _, key := range h.ha.GroupByKeys {
+		value := row[key.KeyCol]
+		collation := h.ha.Collations[key.KeyCol]
+		if key.WeightStringCol != -1 && key.WeightStringCol != key.KeyCol {
+			// the weight_string is always hashable, while the value might
+			// be a text without a known collation
+			value = row[key.WeightStringCol]
+			collation = collations.CollationBinaryID
+		}
+		if collation == collations.Unknown && sqltypes.IsBinary(value.Type()) {
+			collation = collations.CollationBinaryID
+		}
+		hash, err := evalengine.NullsafeHashcode(value, collation, value.Type())
+		if err != nil {
+			return 0, err
+		}
+		code = code*31 + hash
+	}
+	return code, nil
+}
+
+func (h *hashAggregator) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, key := range h.ha.GroupByKeys {
+		cmp, err := evalengine.NullsafeCompare(row1[key.KeyCol], row2[key.KeyCol], h.ha.Collations[key.KeyCol])
+		if err != nil {
+			if !canUseWeightString(err, key) {
+				return false, err
+			}
+			cmp, err = evalengine.NullsafeCompare(row1[key.WeightStringCol], row2[key.WeightStringCol], collations.Unknown)
+			if err != nil {
+				return false, err
+			}
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// canUseWeightString returns true if the weight_string of the key
+// can be compared instead of its value, after the value failed to be compared
+func canUseWeightString(err error, key *GroupByParams) bool {
+	if key.WeightStringCol == -1 || key.WeightStringCol == key.KeyCol {
+		return false
+	}
+	switch err.(type) {
+	case evalengine.UnsupportedComparisonError, evalengine.UnsupportedCollationError:
+		return true
+	}
+	return false
+}
+
+// spillPartition is a temporary file holding partially aggregated rows
+type spillPartition struct {
+	file   *os.File
+	writer *bufio.Writer
+}
+
+func newSpillPartition() (*spillPartition, error) {
+	file, err := os.CreateTemp("", "vtgate-hash-aggregate-")
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "unable to create a temporary file for the hash aggregation")
+	}
+	return &spillPartition{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+// write encodes a row as its number of values, followed by
+// the type, the length and the bytes of each value
+func (sp *spillPartition) write(row []sqltypes.Value) error {
+	var buf [binary.MaxVarintLen64]byte
+	writeVarint := func(v int64) error {
+		n := binary.PutVarint(buf[:], v)
+		_, err := sp.writer.Write(buf[:n])
+		return err
+	}
+	if err := writeVarint(int64(len(row))); err != nil {
+		return err
+	}
+	for _, value := range row {
+		if err := writeVarint(int64(value.Type())); err != nil {
+			return err
+		}
+		if value.IsNull() {
+			if err := writeVarint(-1); err != nil {
+				return err
+			}
+			continue
+		}
+		raw := value.Raw()
+		if err := writeVarint(int64(len(raw))); err != nil {
+			return err
+		}
+		if _, err := sp.writer.Write(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read calls the callback for every row written in the partition
+func (sp *spillPartition) read(callback func([]sqltypes.Value) error) error {
+	if err := sp.writer.Flush(); err != nil {
+		return err
+	}
+	if _, err := sp.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(sp.file)
+	for {
+		count, err := binary.ReadVarint(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row := make([]sqltypes.Value, 0, count)
+		for i := int64(0); i < count; i++ {
+			typ, err := binary.ReadVarint(reader)
+			if err != nil {
+				return err
+			}
+			length, err := binary.ReadVarint(reader)
+			if err != nil {
+				return err
+			}
+			if length == -1 {
+				row = append(row, sqltypes.NULL)
+				continue
+			}
+			raw := make([]byte, length)
+			if _, err := io.ReadFull(reader, raw); err != nil {
+				return err
+			}
+			row = append(row, sqltypes.MakeTrusted(querypb.Type(typ), raw))
+		}
+		if err := callback(row); err != nil {
+			return err
+		}
+	}
+}
+
+func (sp *spillPartition) close() {
+	sp.file.Close()
+	os.Remove(sp.file.Name())
+}
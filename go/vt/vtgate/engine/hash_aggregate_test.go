@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+// hashAggregateVCursor overrides the number of groups a hash aggregation holds in memory
+type hashAggregateVCursor struct {
+	noopVCursor
+	maxRows int
+}
+
+func (vc *hashAggregateVCursor) MaxHashAggregateRows() int {
+	return vc.maxRows
+}
+
+func TestHashAggregateExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)|sum(val)|min(val)",
+		"varbinary|decimal|decimal|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|10|10",
+			"b|2|5|2",
+			"a|1|3|3",
+			"null|1|1|1",
+			"b|1|1|1",
+			"null|3|null|null",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+		}, {
+			Opcode: AggregateMin,
+			Col:    3,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		Input:       fp,
+	}
+
+	result, err := ha.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|2|13|3",
+		"b|3|6|1",
+		"null|4|1|1",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestHashAggregateStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|2",
+			"a|3",
+			"c|1",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		Input:       fp,
+	}
+
+	var results []*sqltypes.Result
+	err := ha.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantRows := sqltypes.MakeTestResult(
+		fields,
+		"a|4",
+		"b|2",
+		"c|1",
+	)
+	wantResults := []*sqltypes.Result{{Fields: fields}, {Rows: wantRows.Rows}}
+	utils.MustMatch(t, wantResults, results)
+}
+
+func TestHashAggregateSpill(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	fields := sqltypes.MakeTestFields(
+		"col|val|1",
+		"int64|int64|int64",
+	)
+	var rows []string
+	for i := 0; i < 3; i++ {
+		for key := 0; key < 50; key++ {
+			if key%10 == 0 {
+				rows = append(rows, fmt.Sprintf("%d|null|1", key))
+				continue
+			}
+			rows = append(rows, fmt.Sprintf("%d|%d|1", key, key*10+i))
+		}
+	}
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+	}
+
+	ha := &HashAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateMax,
+			Col:    1,
+		}, {
+			Opcode: AggregateCountRaw,
+			Col:    2,
+			Alias:  "count(*)",
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		Input:       fp,
+	}
+
+	result, err := ha.TryExecute(&hashAggregateVCursor{maxRows: 7}, nil, false)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 50)
+
+	// every group is returned once, with all its rows aggregated
+	got := map[int64][]sqltypes.Value{}
+	for _, row := range result.Rows {
+		key, err := row[0].ToInt64()
+		require.NoError(t, err)
+		got[key] = row
+	}
+	for key := int64(0); key < 50; key++ {
+		row, ok := got[key]
+		require.True(t, ok, "missing group %d", key)
+		if key%10 == 0 {
+			assert.True(t, row[1].IsNull(), "group %d", key)
+		} else {
+			assert.Equal(t, fmt.Sprintf("%d", key*10+2), row[1].ToString(), "group %d", key)
+		}
+		assert.Equal(t, "3", row[2].ToString(), "group %d", key)
+	}
+
+	// the temporary files are removed once the query is done
+	files, err := filepath.Glob(filepath.Join(os.TempDir(), "vtgate-hash-aggregate-*"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestHashAggregateWeightString(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)|weight_string(col)",
+		"varchar|decimal|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|A",
+			"b|1|B",
+			"A|1|A",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		GroupByKeys:         []*GroupByParams{{KeyCol: 0, WeightStringCol: 2}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := ha.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields[:2],
+		"a|2",
+		"b|1",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestHashAggregateCollation(t *testing.T) {
+	collationEnv := collations.Local()
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varchar|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"A|1",
+			"Ç|1",
+			"c|1",
+		)},
+	}
+
+	collationID, _ := collationEnv.LookupID("utf8mb4_0900_ai_ci")
+	ha := &HashAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		Collations:  map[int]collations.ID{0: collationID},
+		Input:       fp,
+	}
+
+	result, err := ha.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|2",
+		"Ç|2",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestHashAggregateNoInputAndNoGroupingKeys(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)|sum(col)",
+				"int64|int64",
+			),
+		)},
+	}
+
+	ha := &HashAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCountRaw,
+			Col:    0,
+			Alias:  "count(*)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    1,
+		}},
+		Input: fp,
+	}
+
+	result, err := ha.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*)|sum(col)",
+			"int64|int64",
+		),
+		"0|null",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestHashAggregateDistinctNotSupported(t *testing.T) {
+	ha := &HashAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    0,
+		}},
+		Input: &fakePrimitive{results: []*sqltypes.Result{{}}},
+	}
+
+	_, err := ha.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "[BUG] count_distinct is not supported by hash aggregations")
+}
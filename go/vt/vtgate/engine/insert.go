@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -41,6 +42,10 @@ import (
 
 var _ Primitive = (*Insert)(nil)
 
+// insertSelectBatchSize is the number of rows returned by the select
+// of an InsertSelect that are routed and inserted together.
+var insertSelectBatchSize = 1000
+
 // Insert represents the instructions to perform an insert operation.
 type Insert struct {
 	// Opcode is the execution opcode.
@@ -54,6 +59,10 @@ type Insert struct {
 	// and Prefix, Mid and Suffix are used instead.
 	Query string
 
+	// Ignore is for INSERT IGNORE and INSERT...ON DUPLICATE KEY constructs
+	// for InsertSelect plans, where rows that cannot be routed are dropped.
+	Ignore bool
+
 	// VindexValues specifies values for all the vindex columns.
 	// This is a three-dimensional data structure:
 	// Insert.Values[i] represents the values to be inserted for the i'th colvindex (i < len(Insert.Table.ColumnVindexes))
@@ -64,6 +73,14 @@ type Insert struct {
 	// ColVindexes are the vindexes that will use the VindexValues
 	ColVindexes []*vindexes.ColumnVindex
 
+	// VindexValueOffset stores, for InsertSelect plans, the offset of each column
+	// of each ColVindex in the rows returned by Input.
+	// VindexValueOffset[i][j] is the offset of the j'th column of the i'th colVindex.
+	VindexValueOffset [][]int
+
+	// Input is the primitive that produces the rows to insert for InsertSelect plans.
+	Input Primitive
+
 	// Table specifies the table for the insert.
 	Table *vindexes.Table
 
@@ -71,6 +88,8 @@ type Insert struct {
 	Generate *Generate
 
 	// Prefix, Mid and Suffix are for sharded insert plans.
+	// InsertSelect plans only use Prefix and Suffix, the Mid
+	// for each row is generated at execution time.
 	Prefix string
 	Mid    []string
 	Suffix string
@@ -87,8 +106,9 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// ForceNonStreaming is set for InsertSelect plans whose select reads the table
+	// the rows are inserted into. All the rows are then read before any is inserted.
+	ForceNonStreaming bool
 
 	// Insert needs tx handling
 	txNeeded
@@ -135,6 +155,9 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values evalengine.Expr
+	// Offset is used for InsertSelect plans and is the offset
+	// of the auto-inc column in the rows returned by Input.
+	Offset int
 }
 
 // InsertOpcode is a number representing the opcode
@@ -153,12 +176,17 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertSelect is for routing an insert statement whose
+	// rows are produced by the Input primitive. Each row is
+	// routed using the primary vindex of the target table.
+	InsertSelect
 )
 
 var insName = map[InsertOpcode]string{
 	InsertUnsharded:     "InsertUnsharded",
 	InsertSharded:       "InsertSharded",
 	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertSelect:        "InsertSelect",
 }
 
 // String returns the opcode
@@ -172,6 +200,14 @@ func (code InsertOpcode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(insName[code])
 }
 
+// Inputs implements the Primitive interface
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 // RouteType returns a description of the query routing type used by the primitive
 func (ins *Insert) RouteType() string {
 	return insName[ins.Opcode]
@@ -202,6 +238,8 @@ func (ins *Insert) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		return ins.execInsertUnsharded(vcursor, bindVars)
 	case InsertSharded, InsertShardedIgnore:
 		return ins.execInsertSharded(vcursor, bindVars)
+	case InsertSelect:
+		return ins.execInsertFromSelect(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported query route: %v", ins)
@@ -280,6 +318,264 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+func (ins *Insert) execInsertFromSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if ins.Input == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] no input primitive for insert into select")
+	}
+
+	output := &sqltypes.Result{}
+	batches := 0
+	insertBatch := func(rows [][]sqltypes.Value, last bool) error {
+		// Only a statement that fits in a single batch can be autocommitted.
+		qr, err := ins.insertSelectRows(vcursor, bindVars, rows, last && batches == 0)
+		batches++
+		if err != nil {
+			return err
+		}
+		output.RowsAffected += qr.RowsAffected
+		if output.InsertID == 0 {
+			output.InsertID = qr.InsertID
+		}
+		return nil
+	}
+
+	if ins.ForceNonStreaming || !vcursor.IsAutocommittable() {
+		// The select has to see the changes of the transaction, or reads
+		// the table the rows are inserted into: all the rows are read before
+		// the first one is inserted.
+		result, err := vcursor.ExecutePrimitive(ins.Input, bindVars, false)
+		if err != nil {
+			return nil, err
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		rows := result.Rows
+		for len(rows) > insertSelectBatchSize {
+			if err := insertBatch(rows[:insertSelectBatchSize], false); err != nil {
+				return nil, err
+			}
+			rows = rows[insertSelectBatchSize:]
+		}
+		if len(rows) > 0 {
+			if err := insertBatch(rows, true); err != nil {
+				return nil, err
+			}
+		}
+		return output, nil
+	}
+
+	// The select is streamed outside of the transaction, so that the shards it
+	// reads from can receive the inserts while the stream is still running.
+	var mu sync.Mutex
+	var pending [][]sqltypes.Value
+	err := vcursor.StreamExecutePrimitiveStandalone(ins.Input, bindVars, false, func(result *sqltypes.Result) error {
+		// The batches are inserted one at a time, since they all use the
+		// same transaction on the shards.
+		mu.Lock()
+		defer mu.Unlock()
+		pending = append(pending, result.Rows...)
+		for len(pending) >= insertSelectBatchSize {
+			if err := insertBatch(pending[:insertSelectBatchSize], false); err != nil {
+				return err
+			}
+			pending = pending[insertSelectBatchSize:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		if err := insertBatch(pending, true); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// insertSelectRows routes and inserts a batch of the rows returned by the input.
+func (ins *Insert) insertSelectRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value, canAutocommit bool) (*sqltypes.Result, error) {
+	rows = ins.padInsertSelectRows(rows)
+
+	insertID, err := ins.processGenerateFromRows(vcursor, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rss, queries, err := ins.getInsertSelectQueries(vcursor, bindVars, rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	autocommit := canAutocommit && (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	err = allowOnlyPrimary(rss...)
+	if err != nil {
+		return nil, err
+	}
+	qr, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+
+	if insertID != 0 {
+		qr.InsertID = uint64(insertID)
+	}
+	return qr, nil
+}
+
+// padInsertSelectRows adds a NULL value to the rows for every vindex or auto-inc
+// column that the planner had to append to the column list of the insert,
+// because the select does not provide it.
+func (ins *Insert) padInsertSelectRows(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	width := 0
+	for _, offsets := range ins.VindexValueOffset {
+		for _, offset := range offsets {
+			if offset >= width {
+				width = offset + 1
+			}
+		}
+	}
+	if ins.Generate != nil && ins.Generate.Offset >= width {
+		width = ins.Generate.Offset + 1
+	}
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, sqltypes.NULL)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// getInsertSelectQueries performs all the vindex related work on the rows returned
+// by the input and returns the shards and the query to send to each of them.
+// For an unsharded target, all the rows are sent to the single shard of the keyspace.
+func (ins *Insert) getInsertSelectQueries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	if !ins.Keyspace.Sharded {
+		rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rss) != 1 {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
+		}
+		queryBindVars := copyBindVars(bindVars)
+		mids := make([]string, len(rows))
+		for rowNum, row := range rows {
+			mids[rowNum] = insertSelectMid(row, rowNum, queryBindVars)
+		}
+		return rss, []*querypb.BoundQuery{{
+			Sql:           ins.Prefix + strings.Join(mids, ",") + ins.Suffix,
+			BindVariables: queryBindVars,
+		}}, nil
+	}
+
+	colVindexes := ins.ColVindexes
+	if colVindexes == nil {
+		colVindexes = ins.Table.ColumnVindexes
+	}
+	if len(ins.VindexValueOffset) == 0 || len(colVindexes) == 0 {
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
+	}
+
+	// vindexRowsValues is indexed by colVindex, row and col, which is the format the Vindex APIs require.
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		if len(offsets) != len(colVindexes[vIdx].Columns) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] supplied vindex column offsets don't match vschema: %v %v", offsets, colVindexes[vIdx].Columns)
+		}
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			rowColumnKeys := make([]sqltypes.Value, len(offsets))
+			for colIdx, offset := range offsets {
+				if offset >= len(row) {
+					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] vindex column offset %d out of range for a row of %d columns", offset, len(row))
+				}
+				rowColumnKeys[colIdx] = row[offset]
+			}
+			vindexRowsValues[vIdx][rowNum] = rowColumnKeys
+		}
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues, colVindexes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Unowned vindexes may have reverse mapped their values from the keyspace id,
+	// so the vindex values are copied back into the rows that are going to be inserted.
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
+			for colIdx, offset := range offsets {
+				rows[rowNum][offset] = rowColumnKeys[colIdx]
+			}
+		}
+	}
+
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for i, ksid := range keyspaceIDs {
+		if ksid != nil {
+			indexes = append(indexes, &querypb.Value{
+				Value: strconv.AppendInt(nil, int64(i), 10),
+			})
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		}
+	}
+	if len(destinations) == 0 {
+		// All the rows were dropped by an IGNORE insert.
+		return nil, nil, nil
+	}
+
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queryBindVars := copyBindVars(bindVars)
+		var mids []string
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
+			if keyspaceIDs[index] != nil {
+				mids = append(mids, insertSelectMid(rows[index], int(index), queryBindVars))
+			}
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           ins.Prefix + strings.Join(mids, ",") + ins.Suffix,
+			BindVariables: queryBindVars,
+		}
+	}
+	return rss, queries, nil
+}
+
+// insertSelectMid returns the values tuple for a row of an InsertSelect, and
+// adds the bind variables that the tuple refers to.
+func insertSelectMid(row []sqltypes.Value, rowNum int, bindVars map[string]*querypb.BindVariable) string {
+	buf := &strings.Builder{}
+	buf.WriteString("(")
+	for colIdx, value := range row {
+		if colIdx > 0 {
+			buf.WriteString(", ")
+		}
+		name := insertSelectVarName(rowNum, colIdx)
+		bindVars[name] = sqltypes.ValueBindVariable(value)
+		buf.WriteString(":")
+		buf.WriteString(name)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func insertSelectVarName(rowNum, colIdx int) string {
+	return fmt.Sprintf("_c%d_%d", rowNum, colIdx)
+}
+
 // shouldGenerate determines if a sequence value should be generated for a given value
 func shouldGenerate(v sqltypes.Value) bool {
 	if v.IsNull() {
@@ -354,6 +650,54 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	return insertID, nil
 }
 
+// processGenerateFromRows generates new values using a sequence for the rows of an
+// InsertSelect that did not supply a value for the auto-inc column, and writes them
+// into the rows. If no value was generated, it returns 0.
+func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+	var count int64
+	for _, row := range rows {
+		if ins.Generate.Offset >= len(row) {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] auto-inc column offset %d out of range for a row of %d columns", ins.Generate.Offset, len(row))
+		}
+		if shouldGenerate(row[ins.Generate.Offset]) {
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	if err != nil {
+		return 0, err
+	}
+	if len(rss) != 1 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
+	qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
+	if err != nil {
+		return 0, err
+	}
+	insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return 0, err
+	}
+
+	// Fill the holes where no value was supplied.
+	cur := insertID
+	for _, row := range rows {
+		if shouldGenerate(row[ins.Generate.Offset]) {
+			row[ins.Generate.Offset] = sqltypes.NewInt64(cur)
+			cur++
+		}
+	}
+	return insertID, nil
+}
+
 // getInsertShardedRoute performs all the vindex related work
 // and returns a map of shard to queries.
 // Using the primary vindex, it computes the target keyspace ids.
@@ -415,24 +759,11 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	if len(vindexRowsValues) == 0 || len(colVindexes) == 0 {
 		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
 	}
-	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], colVindexes[0])
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues, colVindexes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for vIdx := 1; vIdx < len(colVindexes); vIdx++ {
-		colVindex := colVindexes[vIdx]
-		var err error
-		if colVindex.Owned {
-			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		} else {
-			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		}
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
 	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
 	// we're executing an insert ignore.
 	for vIdx, colVindex := range colVindexes {
@@ -492,6 +823,35 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	return rss, queries, nil
 }
 
+// processVindexes maps the primary vindex values to keyspace ids, and then
+// creates, reverse maps or validates the values of the remaining vindexes.
+func (ins *Insert) processVindexes(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value, colVindexes []*vindexes.ColumnVindex) ([][]byte, error) {
+	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], colVindexes[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for vIdx := 1; vIdx < len(colVindexes); vIdx++ {
+		colVindex := colVindexes[vIdx]
+		var err error
+		if colVindex.Owned {
+			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		} else {
+			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyspaceIDs, nil
+}
+
+// ignoreUnroutable returns true if rows that cannot be routed
+// should be dropped instead of failing the insert.
+func (ins *Insert) ignoreUnroutable() bool {
+	return ins.Opcode == InsertShardedIgnore || (ins.Opcode == InsertSelect && ins.Ignore)
+}
+
 // processPrimary maps the primary vindex values to the keyspace ids.
 func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex) ([][]byte, error) {
 	destinations, err := vindexes.Map(colVindex.Vindex, vcursor, vindexColumnsKeys)
@@ -507,7 +867,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 			keyspaceIDs[i] = d
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
-			if ins.Opcode != InsertShardedIgnore {
+			if !ins.ignoreUnroutable() {
 				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
 			}
 		default:
@@ -520,7 +880,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 
 // processOwned creates vindex entries for the values of an owned column.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
-	if ins.Opcode == InsertSharded {
+	if !ins.ignoreUnroutable() {
 		return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, vindexColumnsKeys, ksids, false /* ignoreMode */)
 	}
 
@@ -621,7 +981,7 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltype
 		for i, v := range verified {
 			rowNum := verifyIndexes[i]
 			if !v {
-				if ins.Opcode != InsertShardedIgnore {
+				if !ins.ignoreUnroutable() {
 					mismatchVindexKeys = append(mismatchVindexKeys, vindexColumnsKeys[rowNum])
 					continue
 				}
@@ -653,6 +1013,20 @@ func (ins *Insert) description() PrimitiveDescription {
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if ins.Opcode == InsertSelect {
+		if ins.Ignore {
+			other["Ignore"] = true
+		}
+		if len(ins.VindexValueOffset) > 0 {
+			other["VindexOffsetFromSelect"] = ins.VindexValueOffset
+		}
+		if ins.Generate != nil {
+			other["AutoIncrementOffset"] = ins.Generate.Offset
+		}
+		if ins.ForceNonStreaming {
+			other["ForceNonStreaming"] = true
+		}
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -1471,3 +1472,356 @@ func TestInsertShardedUnownedReverseMapSuccess(t *testing.T) {
 	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
 	require.NoError(t, err)
 }
+
+func TestInsertSelectSimple(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:            InsertSelect,
+		Keyspace:          ks.Keyspace,
+		Query:             "dummy_insert",
+		Table:             ks.Tables["t1"],
+		VindexValueOffset: [][]int{{1}},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name|id", "varchar|int64"),
+			"a|1",
+			"a|3",
+			"b|2")}},
+		Prefix: "prefix ",
+		Suffix: " suffix",
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// the select query is executed by the fake primitive, the rows are then routed by the id column.
+		`ResolveDestinations sharded [value:"0" value:"1" value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(4eb190c9a2fa169c),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// rows 1 & 3 go to 20-, row 2 goes to -20
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1),(:_c2_0, :_c2_1) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" _c2_0: type:VARCHAR value:"b" _c2_1: type:INT64 value:"2"} ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1) suffix ` +
+			`{_c1_0: type:VARCHAR value:"a" _c1_1: type:INT64 value:"3"} ` +
+			`true false`,
+	})
+}
+
+func TestInsertSelectOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:            InsertSelect,
+		Keyspace:          ks.Keyspace,
+		Query:             "dummy_insert",
+		Table:             ks.Tables["t1"],
+		VindexValueOffset: [][]int{{1}, {0}},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("c3|id", "varchar|int64"),
+			"a|1",
+			"b|3",
+			"c|2")}},
+		Prefix: "prefix ",
+		Suffix: " suffix",
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1), (:from_2, :toc_2) ` +
+			`from_0: type:VARCHAR value:"a" from_1: type:VARCHAR value:"b" from_2: type:VARCHAR value:"c" ` +
+			`toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" toc_1: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" ` +
+			`toc_2: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`ResolveDestinations sharded [value:"0" value:"1" value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(4eb190c9a2fa169c),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1),(:_c2_0, :_c2_1) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" _c2_0: type:VARCHAR value:"c" _c2_1: type:INT64 value:"2"} ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1) suffix ` +
+			`{_c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"3"} ` +
+			`true false`,
+	})
+}
+
+func TestInsertSelectIgnore(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"primary": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "prim",
+							"from":  "from1",
+							"to":    "toc",
+						},
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "primary",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:            InsertSelect,
+		Ignore:            true,
+		Keyspace:          ks.Keyspace,
+		Query:             "dummy_insert",
+		Table:             ks.Tables["t1"],
+		VindexValueOffset: [][]int{{0}},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id", "int64"),
+			"1",
+			"2")}},
+		Prefix: "prefix ",
+		Suffix: " suffix",
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20"}
+	vc.results = []*sqltypes.Result{
+		// primary vindex lookup: row 2 can't be routed.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"from|to",
+				"int64|varbinary",
+			),
+			"1|\x00",
+		),
+	}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`Execute select from1, toc from prim where from1 in ::from1 from1: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} false`,
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteMultiShard sharded.-20: prefix (:_c0_0) suffix {_c0_0: type:INT64 value:"1"} true true`,
+	})
+}
+
+func TestInsertSelectGenerate(t *testing.T) {
+	ins := &Insert{
+		Opcode: InsertSelect,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Query: "dummy_insert",
+		Table: &vindexes.Table{
+			Name: sqlparser.NewTableIdent("t1"),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name", "varchar"),
+			"a",
+			"b")}},
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query:  "dummy_generate",
+			Offset: 1,
+		},
+		Prefix: "prefix ",
+		Suffix: " suffix",
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"4",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// the auto-inc column is not provided by the select, so both rows get a generated value.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2" ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix (:_c0_0, :_c0_1),(:_c1_0, :_c1_1) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"4" _c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"5"} ` +
+			`true true`,
+	})
+
+	// The insert id returned by ExecuteMultiShard should be overwritten by the generated value.
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+}
+
+func TestInsertSelectStreamingBatches(t *testing.T) {
+	saveBatchSize := insertSelectBatchSize
+	insertSelectBatchSize = 2
+	defer func() {
+		insertSelectBatchSize = saveBatchSize
+	}()
+
+	ins := &Insert{
+		Opcode: InsertSelect,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Query: "dummy_insert",
+		Table: &vindexes.Table{
+			Name: sqlparser.NewTableIdent("t1"),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name", "varchar"),
+			"a",
+			"b",
+			"c")}},
+		Prefix: "prefix ",
+		Suffix: " suffix",
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.autocommittable = true
+	vc.results = []*sqltypes.Result{{RowsAffected: 2, InsertID: 1}, {RowsAffected: 1, InsertID: 3}}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// the select is streamed outside of the transaction, and the rows are inserted in batches.
+		`StreamExecutePrimitiveStandalone`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix (:_c0_0),(:_c1_0) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c1_0: type:VARCHAR value:"b"} ` +
+			`true false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix (:_c0_0) suffix ` +
+			`{_c0_0: type:VARCHAR value:"c"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 1})
+}
+
+func TestInsertSelectNonStreaming(t *testing.T) {
+	saveBatchSize := insertSelectBatchSize
+	insertSelectBatchSize = 2
+	defer func() {
+		insertSelectBatchSize = saveBatchSize
+	}()
+
+	ins := &Insert{
+		Opcode: InsertSelect,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Query: "dummy_insert",
+		Table: &vindexes.Table{
+			Name: sqlparser.NewTableIdent("t1"),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name", "varchar"),
+			"a",
+			"b",
+			"c")}},
+		Prefix:            "prefix ",
+		Suffix:            " suffix",
+		ForceNonStreaming: true,
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.autocommittable = true
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// all the rows are read before the first batch is inserted.
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix (:_c0_0),(:_c1_0) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c1_0: type:VARCHAR value:"b"} ` +
+			`true false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix (:_c0_0) suffix ` +
+			`{_c0_0: type:VARCHAR value:"c"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+	ins.Input.(*fakePrimitive).rewind()
+	vc.Rewind()
+	_, err = ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
@@ -21,6 +21,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -38,6 +39,10 @@ type MStream struct {
 	// TableName specifies the table on which stream will be executed.
 	TableName string
 
+	// ConsumerGroup specifies the consumer group of the table to stream messages from.
+	// An empty value stands for the default consumer group.
+	ConsumerGroup string
+
 	noTxNeeded
 
 	noInputs
@@ -69,7 +74,7 @@ func (m *MStream) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return err
 	}
-	return vcursor.MessageStream(rss, m.TableName, callback)
+	return vcursor.MessageStream(rss, schema.MessageStreamName(m.TableName, m.ConsumerGroup), callback)
 }
 
 // GetFields implements the Primitive interface
@@ -78,11 +83,15 @@ func (m *MStream) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVa
 }
 
 func (m *MStream) description() PrimitiveDescription {
+	other := map[string]interface{}{"Table": m.TableName}
+	if m.ConsumerGroup != "" {
+		other["ConsumerGroup"] = m.ConsumerGroup
+	}
 	return PrimitiveDescription{
 		OperatorType:      "MStream",
 		Keyspace:          m.Keyspace,
 		TargetDestination: m.TargetDestination,
 
-		Other: map[string]interface{}{"Table": m.TableName},
+		Other: other,
 	}
 }
@@ -103,6 +103,11 @@ type AggregateParams struct {
 	WCol        int
 	WAssigned   bool
 	CollationID collations.ID
+	// Unordered is set when the rows of a group are not sorted by the
+	// aggregated column, which happens when a query has several distinct
+	// aggregations on different columns. All the values of the group are
+	// then kept to find out if a value was already aggregated.
+	Unordered bool `json:",omitempty"`
 
 	Alias string `json:",omitempty"`
 	Expr  sqlparser.Expr
@@ -113,7 +118,7 @@ func (ap *AggregateParams) isDistinct() bool {
 }
 
 func (ap *AggregateParams) preProcess() bool {
-	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct || ap.Opcode == AggregateGtid
+	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct || ap.Opcode == AggregateGtid || ap.Opcode == AggregateCountRaw
 }
 
 func (ap *AggregateParams) String() string {
@@ -125,6 +130,9 @@ func (ap *AggregateParams) String() string {
 		collation := collations.Local().LookupByID(ap.CollationID)
 		keyCol += " COLLATE " + collation.Name()
 	}
+	if ap.Unordered {
+		keyCol += " UNORDERED"
+	}
 	if ap.Alias != "" {
 		return fmt.Sprintf("%s(%s) AS %s", ap.Opcode.String(), keyCol, ap.Alias)
 	}
@@ -143,6 +151,9 @@ const (
 	AggregateCountDistinct
 	AggregateSumDistinct
 	AggregateGtid
+	// AggregateCountRaw counts the non-null values of a column, and is used
+	// when the rows being aggregated have not been aggregated by the shards.
+	AggregateCountRaw
 )
 
 var (
@@ -150,6 +161,7 @@ var (
 	OpcodeType = map[AggregateOpcode]querypb.Type{
 		AggregateCountDistinct: sqltypes.Int64,
 		AggregateCount:         sqltypes.Int64,
+		AggregateCountRaw:      sqltypes.Int64,
 		AggregateSumDistinct:   sqltypes.Decimal,
 		AggregateSum:           sqltypes.Decimal,
 		AggregateGtid:          sqltypes.VarChar,
@@ -172,6 +184,7 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	"count_distinct": AggregateCountDistinct,
 	"sum_distinct":   AggregateSumDistinct,
 	"vgtid":          AggregateGtid,
+	"count_raw":      AggregateCountRaw,
 }
 
 func (code AggregateOpcode) String() string {
@@ -229,7 +242,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
-	var curDistincts []sqltypes.Value
+	var curDistincts *distincts
 	for _, row := range result.Rows {
 		if current == nil {
 			current, curDistincts = oa.convertRow(row)
@@ -274,7 +287,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 // TryStreamExecute is a Primitive function.
 func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
-	var curDistincts []sqltypes.Value
+	var curDistincts *distincts
 	var fields []*querypb.Field
 
 	cb := func(qr *sqltypes.Result) error {
@@ -345,16 +358,19 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistincts []sqltypes.Value) {
+func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistincts *distincts) {
 	if !oa.PreProcess {
 		return row, nil
 	}
 	newRow = append(newRow, row...)
-	curDistincts = make([]sqltypes.Value, len(oa.Aggregates))
+	curDistincts = &distincts{
+		last: make([]sqltypes.Value, len(oa.Aggregates)),
+		seen: make([]distinctSet, len(oa.Aggregates)),
+	}
 	for index, aggr := range oa.Aggregates {
 		switch aggr.Opcode {
 		case AggregateCountDistinct:
-			curDistincts[index] = findComparableCurrentDistinct(row, aggr)
+			curDistincts.last[index] = oa.firstDistinct(row, aggr)
 			// Type is int64. Ok to call MakeTrusted.
 			if row[aggr.KeyCol].IsNull() {
 				newRow[aggr.Col] = countZero
@@ -362,12 +378,18 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 				newRow[aggr.Col] = countOne
 			}
 		case AggregateSumDistinct:
-			curDistincts[index] = findComparableCurrentDistinct(row, aggr)
+			curDistincts.last[index] = oa.firstDistinct(row, aggr)
 			var err error
 			newRow[aggr.Col], err = evalengine.Cast(row[aggr.Col], OpcodeType[aggr.Opcode])
 			if err != nil {
 				newRow[aggr.Col] = sumZero
 			}
+		case AggregateCountRaw:
+			if row[aggr.Col].IsNull() {
+				newRow[aggr.Col] = countZero
+			} else {
+				newRow[aggr.Col] = countOne
+			}
 		case AggregateGtid:
 			vgtid := &binlogdatapb.VGtid{}
 			vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
@@ -383,6 +405,15 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 	return newRow, curDistincts
 }
 
+// firstDistinct returns the value a distinct aggregation keeps track of for the first row of a group
+func (oa *OrderedAggregate) firstDistinct(row []sqltypes.Value, aggr *AggregateParams) sqltypes.Value {
+	if aggr.Unordered {
+		value, _ := aggr.distinctValue(row, oa.Collations)
+		return value
+	}
+	return findComparableCurrentDistinct(row, aggr)
+}
+
 func findComparableCurrentDistinct(row []sqltypes.Value, aggr *AggregateParams) sqltypes.Value {
 	curDistinct := row[aggr.KeyCol]
 	if aggr.WAssigned && !curDistinct.IsComparable() {
@@ -434,21 +465,32 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value, colls map[int
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistincts []sqltypes.Value, colls map[int]collations.ID) ([]sqltypes.Value, []sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistincts *distincts, colls map[int]collations.ID) ([]sqltypes.Value, *distincts, error) {
 	result := sqltypes.CopyRow(row1)
 	for index, aggr := range oa.Aggregates {
-		if aggr.isDistinct() {
+		if aggr.isDistinct() && aggr.Unordered {
+			if row2[aggr.Col].IsNull() {
+				continue
+			}
+			added, err := curDistincts.add(index, row2, aggr, colls)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !added {
+				continue
+			}
+		} else if aggr.isDistinct() {
 			if row2[aggr.KeyCol].IsNull() {
 				continue
 			}
-			cmp, err := evalengine.NullsafeCompare(curDistincts[index], row2[aggr.KeyCol], colls[aggr.KeyCol])
+			cmp, err := evalengine.NullsafeCompare(curDistincts.last[index], row2[aggr.KeyCol], colls[aggr.KeyCol])
 			if err != nil {
 				return nil, nil, err
 			}
 			if cmp == 0 {
 				continue
 			}
-			curDistincts[index] = findComparableCurrentDistinct(row2, aggr)
+			curDistincts.last[index] = findComparableCurrentDistinct(row2, aggr)
 		}
 		var err error
 		switch aggr.Opcode {
@@ -464,6 +506,12 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], countOne, OpcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
 			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], row2[aggr.Col], OpcodeType[aggr.Opcode])
+		case AggregateCountRaw:
+			if row2[aggr.Col].IsNull() {
+				result[aggr.Col] = row1[aggr.Col]
+				break
+			}
+			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], countOne, OpcodeType[aggr.Opcode])
 		case AggregateGtid:
 			vgtid := &binlogdatapb.VGtid{}
 			rowBytes, err := row1[aggr.Col].ToBytes()
@@ -492,6 +540,65 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 	return result, curDistincts, nil
 }
 
+// distincts keeps track of the values aggregated by the distinct aggregations of the current group
+type distincts struct {
+	// last holds the last value aggregated by every distinct aggregation.
+	// When the values are sorted, a value was already aggregated if it is equal to the last one.
+	last []sqltypes.Value
+	// seen holds all the values aggregated by the unordered distinct aggregations
+	seen []distinctSet
+}
+
+// distinctSet is a hash set of values
+type distinctSet map[evalengine.HashCode][]sqltypes.Value
+
+// add adds the value of the row to the values seen by an unordered distinct aggregation,
+// and returns true if it was not seen before
+func (d *distincts) add(index int, row []sqltypes.Value, aggr *AggregateParams, colls map[int]collations.ID) (bool, error) {
+	value, collation := aggr.distinctValue(row, colls)
+	if d.seen[index] == nil {
+		// the value of the first row of the group is only known from last
+		d.seen[index] = distinctSet{}
+		if _, err := d.seen[index].add(d.last[index], collation); err != nil {
+			return false, err
+		}
+	}
+	return d.seen[index].add(value, collation)
+}
+
+func (s distinctSet) add(value sqltypes.Value, collation collations.ID) (bool, error) {
+	code, err := evalengine.NullsafeHashcode(value, collation, value.Type())
+	if err != nil {
+		return false, err
+	}
+	for _, seen := range s[code] {
+		cmp, err := evalengine.NullsafeCompare(seen, value, collation)
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return false, nil
+		}
+	}
+	s[code] = append(s[code], value)
+	return true, nil
+}
+
+// distinctValue returns the value used by an unordered distinct aggregation to find out
+// if a value was already aggregated, along with the collation to compare it with.
+// The weight_string is preferred when available, since it can always be hashed.
+func (ap *AggregateParams) distinctValue(row []sqltypes.Value, colls map[int]collations.ID) (sqltypes.Value, collations.ID) {
+	if ap.WAssigned {
+		return row[ap.WCol], collations.CollationBinaryID
+	}
+	value := row[ap.Col]
+	collation := colls[ap.Col]
+	if collation == collations.Unknown && sqltypes.IsBinary(value.Type()) {
+		collation = collations.CollationBinaryID
+	}
+	return value, collation
+}
+
 // creates the empty row for the case when we are missing grouping keys and have empty input table
 func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
 	out := make([]sqltypes.Value, len(oa.Aggregates))
@@ -509,7 +616,8 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 	switch opcode {
 	case
 		AggregateCountDistinct,
-		AggregateCount:
+		AggregateCount,
+		AggregateCountRaw:
 		return countZero, nil
 	case
 		AggregateSumDistinct,
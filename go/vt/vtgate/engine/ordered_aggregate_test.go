@@ -342,6 +342,54 @@ func TestOrderedAggregateExecuteCountDistinct(t *testing.T) {
 	assert.Equal(wantResult, result)
 }
 
+func TestOrderedAggregateExecuteCountRaw(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|1|col2",
+				"varbinary|int64|int64|int64",
+			),
+			"a|1|1|1",
+			"a|null|1|null",
+			"a|2|1|2",
+			"b|null|1|null",
+			"c|3|1|3",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCountRaw,
+			Col:    1,
+			Alias:  "count(col2)",
+		}, {
+			Opcode: AggregateCountRaw,
+			Col:    2,
+			Alias:  "count(*)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    3,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|count(col2)|count(*)|col2",
+			"varbinary|int64|int64|int64",
+		),
+		"a|2|3|3",
+		"b|0|1|null",
+		"c|1|1|3",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
 func TestOrderedAggregateStreamCountDistinct(t *testing.T) {
 	assert := assert.New(t)
 	fp := &fakePrimitive{
@@ -683,6 +731,11 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		AggregateCount,
 		"0",
 		"int64",
+	}, {
+		"count(col1)",
+		AggregateCountRaw,
+		"0",
+		"int64",
 	}, {
 		"sum(distinct col1)",
 		AggregateSumDistinct,
@@ -1055,6 +1108,71 @@ func TestMultiDistinct(t *testing.T) {
 	assert.Equal(t, want, results)
 }
 
+func TestMultiDistinctUnordered(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3",
+		"int64|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			// c3 is only sorted within each value of c2
+			"10|1|3",
+			"10|1|1",
+			"10|2|1",
+			"10|2|3",
+			"10|3|null",
+			"20|1|null",
+			"20|2|5",
+			"20|3|5",
+			"30|1|2",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct c2)",
+		}, {
+			Opcode:    AggregateSumDistinct,
+			Col:       2,
+			KeyCol:    2,
+			Alias:     "sum(distinct c3)",
+			Unordered: true,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"c1|count(distinct c2)|sum(distinct c3)",
+			"int64|int64|decimal",
+		),
+		`10|3|4`,
+		`20|3|5`,
+		`30|1|2`,
+	)
+
+	qr, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, qr)
+
+	fp.rewind()
+	results := &sqltypes.Result{}
+	err = oa.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			results.Fields = qr.Fields
+		}
+		results.Rows = append(results.Rows, qr.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, results)
+}
+
 func TestOrderedAggregateCollate(t *testing.T) {
 	assert := assert.New(t)
 	fields := sqltypes.MakeTestFields(
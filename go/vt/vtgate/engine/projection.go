@@ -106,6 +106,12 @@ func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 }
 
 func (p *Projection) addFields(env *evalengine.ExpressionEnv, qr *sqltypes.Result) error {
+	// the expressions referencing the columns of the input are
+	// typed using empty values of the types of these columns
+	env.Row = make([]sqltypes.Value, 0, len(qr.Fields))
+	for _, field := range qr.Fields {
+		env.Row = append(env.Row, sqltypes.MakeTrusted(field.Type, nil))
+	}
 	for i, col := range p.Cols {
 		q, err := env.TypeOf(p.Exprs[i])
 		if err != nil {
@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*RecursiveCTE)(nil)
+
+// cteMaxRecursionDepth is the maximum number of iterations of a
+// recursive common table expression, same as the default value
+// of @@cte_max_recursion_depth in MySQL.
+var cteMaxRecursionDepth = 1000
+
+// RecursiveCTE is a primitive that evaluates a recursive common table
+// expression at the vtgate level.
+// The Anchor is executed once to produce the initial working set.
+// For every iteration, the Recursive primitive is then executed once to
+// fetch the rows of the other tables of the recursive query block, and
+// every row of the working set is combined with every fetched row. The
+// combinations for which the Predicate is true give the rows of the next
+// working set through the Exprs. This continues until an iteration produces
+// no rows. The result is the union of all the working sets.
+type RecursiveCTE struct {
+	Anchor Primitive
+
+	// Recursive is nil when the recursive query block
+	// only reads the common table expression.
+	Recursive Primitive
+
+	// BatchVar is set when the rows fetched by Recursive only have to match
+	// the rows of the working set on a single column: the distinct values of
+	// the column BatchColumn of the working set are then sent as a list in
+	// BatchVar, so that Recursive only returns the rows that can match.
+	BatchVar    string `json:",omitempty"`
+	BatchColumn int    `json:",omitempty"`
+
+	// Predicate and Exprs are evaluated on the combined rows,
+	// where the columns of the working set come first, followed
+	// by the columns of the row fetched by Recursive.
+	Predicate    evalengine.Expr
+	ASTPredicate sqlparser.Expr
+	Exprs        []evalengine.Expr
+
+	// Columns are the names of the columns of the common table expression.
+	Columns []string
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (r *RecursiveCTE) RouteType() string {
+	return "RecursiveCTE"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (r *RecursiveCTE) GetKeyspaceName() string {
+	if r.Recursive == nil || r.Anchor.GetKeyspaceName() == r.Recursive.GetKeyspaceName() {
+		return r.Anchor.GetKeyspaceName()
+	}
+	return r.Anchor.GetKeyspaceName() + "_" + r.Recursive.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (r *RecursiveCTE) GetTableName() string {
+	if r.Recursive == nil {
+		return r.Anchor.GetTableName()
+	}
+	return r.Anchor.GetTableName() + "_" + r.Recursive.GetTableName()
+}
+
+// TryExecute performs a non-streaming exec.
+func (r *RecursiveCTE) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := r.run(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryStreamExecute performs a streaming exec.
+// The rows of every working set are sent as soon as they are known.
+func (r *RecursiveCTE) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return r.run(vcursor, bindVars, wantfields, callback)
+}
+
+func (r *RecursiveCTE) run(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	anchor, err := vcursor.ExecutePrimitive(r.Anchor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	if err := r.checkColumns(anchor.Rows); err != nil {
+		return err
+	}
+	if err := callback(&sqltypes.Result{Fields: r.fields(anchor.Fields), Rows: anchor.Rows}); err != nil {
+		return err
+	}
+
+	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	workingSet := anchor.Rows
+	for iteration := 1; len(workingSet) > 0; iteration++ {
+		if iteration > cteMaxRecursionDepth {
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", iteration)
+		}
+		fetched, err := r.fetch(vcursor, bindVars, workingSet)
+		if err != nil {
+			return err
+		}
+		var next [][]sqltypes.Value
+		for _, row := range workingSet {
+			for _, fetchedRow := range fetched {
+				env.Row = append(append(make([]sqltypes.Value, 0, len(row)+len(fetchedRow)), row...), fetchedRow...)
+				if r.Predicate != nil {
+					keep, err := evalBool(env, r.Predicate)
+					if err != nil {
+						return err
+					}
+					if !keep {
+						continue
+					}
+				}
+				nextRow := make([]sqltypes.Value, 0, len(r.Exprs))
+				for _, expr := range r.Exprs {
+					evalResult, err := env.Evaluate(expr)
+					if err != nil {
+						return err
+					}
+					nextRow = append(nextRow, evalResult.Value())
+				}
+				next = append(next, nextRow)
+				if vcursor.ExceedsMaxMemoryRows(len(next)) {
+					return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+				}
+			}
+		}
+		if err := r.checkColumns(next); err != nil {
+			return err
+		}
+		if len(next) > 0 {
+			if err := callback(&sqltypes.Result{Rows: next}); err != nil {
+				return err
+			}
+		}
+		workingSet = next
+	}
+	return nil
+}
+
+// fetch executes the Recursive primitive once for the given working set.
+// Without a Recursive primitive, a single empty row is returned, so that
+// every row of the working set is evaluated on its own.
+func (r *RecursiveCTE) fetch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, workingSet [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	if r.Recursive == nil {
+		return [][]sqltypes.Value{nil}, nil
+	}
+	if r.BatchVar == "" {
+		qr, err := vcursor.ExecutePrimitive(r.Recursive, bindVars, false)
+		if err != nil {
+			return nil, err
+		}
+		return qr.Rows, nil
+	}
+
+	values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool, len(workingSet))
+	for _, row := range workingSet {
+		value := row[r.BatchColumn]
+		// a NULL value can never be equal to anything, so there is no need to send it
+		if value.IsNull() {
+			continue
+		}
+		key := value.Type().String() + ":" + value.ToString()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		values.Values = append(values.Values, sqltypes.ValueToProto(value))
+	}
+	if len(values.Values) == 0 {
+		return nil, nil
+	}
+	qr, err := vcursor.ExecutePrimitive(r.Recursive, combineVars(bindVars, map[string]*querypb.BindVariable{r.BatchVar: values}), false)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Rows, nil
+}
+
+// checkColumns makes sure that all the rows have one value per column of the common table expression.
+func (r *RecursiveCTE) checkColumns(rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		if len(row) != len(r.Columns) {
+			return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.WrongNumberOfColumnsInSelect, "The used SELECT statements have a different number of columns")
+		}
+	}
+	return nil
+}
+
+// fields returns the fields of the anchor, renamed after the columns of the common table expression.
+func (r *RecursiveCTE) fields(anchor []*querypb.Field) []*querypb.Field {
+	if anchor == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, len(anchor))
+	for i, field := range anchor {
+		f := proto.Clone(field).(*querypb.Field)
+		if i < len(r.Columns) {
+			f.Name = r.Columns[i]
+		}
+		fields[i] = f
+	}
+	return fields
+}
+
+// GetFields fetches the field info.
+func (r *RecursiveCTE) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := r.Anchor.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: r.fields(qr.Fields)}, nil
+}
+
+// Inputs returns the input primitives for this recursive common table expression
+func (r *RecursiveCTE) Inputs() []Primitive {
+	if r.Recursive == nil {
+		return []Primitive{r.Anchor}
+	}
+	return []Primitive{r.Anchor, r.Recursive}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (r *RecursiveCTE) NeedsTransaction() bool {
+	return r.Anchor.NeedsTransaction() || (r.Recursive != nil && r.Recursive.NeedsTransaction())
+}
+
+func (r *RecursiveCTE) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Columns": r.Columns,
+	}
+	if r.BatchVar != "" {
+		other["BatchVar"] = r.BatchVar
+		other["BatchColumn"] = r.BatchColumn
+	}
+	if r.ASTPredicate != nil {
+		other["Predicate"] = sqlparser.String(r.ASTPredicate)
+	}
+	var exprs []string
+	for _, e := range r.Exprs {
+		exprs = append(exprs, evalengine.FormatExpr(e))
+	}
+	other["Expressions"] = exprs
+	return PrimitiveDescription{
+		OperatorType: "RecursiveCTE",
+		Other:        other,
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func orgChartInputs() (*fakePrimitive, *fakePrimitive) {
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|name|lvl",
+				"int64|varchar|int64",
+			),
+			"1|ceo|1",
+		)},
+	}
+	fields := sqltypes.MakeTestFields(
+		"id|name|manager_id",
+		"int64|varchar|int64",
+	)
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "2|cto|1", "3|cfo|1"),
+			sqltypes.MakeTestResult(fields, "4|dev|2"),
+			sqltypes.MakeTestResult(fields),
+		},
+	}
+	return anchor, recursive
+}
+
+// orgChart returns a RecursiveCTE that joins the working set
+// with the employees reporting to them.
+func orgChart(t *testing.T) (*RecursiveCTE, *fakePrimitive, *fakePrimitive) {
+	anchor, recursive := orgChartInputs()
+	columns := []string{"cte_id", "cte_name", "lvl", "id", "name", "manager_id"}
+	predicate, astPredicate := translate(t, "cte_id = manager_id", columns...)
+	id, _ := translate(t, "id", columns...)
+	name, _ := translate(t, "name", columns...)
+	lvl, _ := translate(t, "lvl + 1", columns...)
+	return &RecursiveCTE{
+		Anchor:       anchor,
+		Recursive:    recursive,
+		BatchVar:     "cte_id",
+		BatchColumn:  0,
+		Predicate:    predicate,
+		ASTPredicate: astPredicate,
+		Exprs:        []evalengine.Expr{id, name, lvl},
+		Columns:      []string{"emp_id", "emp_name", "lvl"},
+	}, anchor, recursive
+}
+
+func TestRecursiveCTEExecute(t *testing.T) {
+	cte, anchor, recursive := orgChart(t)
+
+	result, err := cte.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(10)}, true)
+	require.NoError(t, err)
+	anchor.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	recursive.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" cte_id: type:TUPLE values:{type:INT64 value:"1"} false`,
+		`Execute a: type:INT64 value:"10" cte_id: type:TUPLE values:{type:INT64 value:"2"} values:{type:INT64 value:"3"} false`,
+		`Execute a: type:INT64 value:"10" cte_id: type:TUPLE values:{type:INT64 value:"4"} false`,
+	})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"emp_id|emp_name|lvl",
+			"int64|varchar|int64",
+		),
+		"1|ceo|1",
+		"2|cto|2",
+		"3|cfo|2",
+		"4|dev|3",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestRecursiveCTEStreamExecute(t *testing.T) {
+	cte, _, _ := orgChart(t)
+
+	var results []*sqltypes.Result
+	err := cte.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	fields := sqltypes.MakeTestFields(
+		"emp_id|emp_name|lvl",
+		"int64|varchar|int64",
+	)
+	wantResults := []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "1|ceo|1"),
+		{Rows: sqltypes.MakeTestResult(fields, "2|cto|2", "3|cfo|2").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "4|dev|3").Rows},
+	}
+	utils.MustMatch(t, wantResults, results)
+}
+
+func TestRecursiveCTEBatchValues(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|parent", "int64|int64")
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|null", "2|1", "3|1")},
+	}
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"))},
+	}
+	columns := []string{"cte_id", "parent", "id"}
+	predicate, astPredicate := translate(t, "parent = id", columns...)
+	id, _ := translate(t, "id", columns...)
+	cte := &RecursiveCTE{
+		Anchor:       anchor,
+		Recursive:    recursive,
+		BatchVar:     "cte_parent",
+		BatchColumn:  1,
+		Predicate:    predicate,
+		ASTPredicate: astPredicate,
+		Exprs:        []evalengine.Expr{id, id},
+		Columns:      []string{"id", "parent"},
+	}
+
+	_, err := cte.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	// the NULL and duplicate values are not sent
+	recursive.ExpectLog(t, []string{
+		`Execute cte_parent: type:TUPLE values:{type:INT64 value:"1"} false`,
+	})
+}
+
+func TestRecursiveCTEWithoutRecursiveInput(t *testing.T) {
+	columns := []string{"n"}
+	predicate, astPredicate := translate(t, "n < 3", columns...)
+	next, _ := translate(t, "n + 1", columns...)
+	cte := &RecursiveCTE{
+		Anchor: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1")},
+		},
+		Predicate:    predicate,
+		ASTPredicate: astPredicate,
+		Exprs:        []evalengine.Expr{next},
+		Columns:      columns,
+	}
+
+	result, err := cte.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("n", "int64"), "1", "2", "3"), result)
+}
+
+func TestRecursiveCTEMaxRecursionDepth(t *testing.T) {
+	next, _ := translate(t, "n + 1", "n")
+	cte := &RecursiveCTE{
+		Anchor: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("n", "int64"), "1")},
+		},
+		Exprs:   []evalengine.Expr{next},
+		Columns: []string{"n"},
+	}
+
+	saveMax := cteMaxRecursionDepth
+	cteMaxRecursionDepth = 2
+	defer func() {
+		cteMaxRecursionDepth = saveMax
+	}()
+
+	_, err := cte.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "Recursive query aborted after 3 iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
+}
+
+func TestRecursiveCTEWrongNumberOfColumns(t *testing.T) {
+	next, _ := translate(t, "n + 1", "n")
+	cte := &RecursiveCTE{
+		Anchor: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("n", "int64"), "1")},
+		},
+		Exprs:   []evalengine.Expr{next, next},
+		Columns: []string{"n"},
+	}
+
+	_, err := cte.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "The used SELECT statements have a different number of columns")
+}
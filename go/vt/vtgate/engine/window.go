@@ -0,0 +1,378 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions at the vtgate level.
+// It is used when the rows of a single window partition can come from more
+// than one shard. All the rows of the input are buffered, and every row is
+// returned with the result of each window function appended to it, in the
+// order of Funcs. The order of the returned rows is not defined.
+//
+// Only the default window frame is supported: if the window has an ORDER BY,
+// the frame goes from the start of the partition up to the last peer of the
+// current row, otherwise the frame is the whole partition.
+type Window struct {
+	Funcs []*WindowFunc
+	Input Primitive
+}
+
+// WindowFunc is a single window function evaluated by the Window primitive.
+type WindowFunc struct {
+	Opcode WindowOpcode
+	// Col is the offset of the argument of the function in the input.
+	// It is -1 for functions that don't take an argument, like ROW_NUMBER() and COUNT(*).
+	Col         int
+	PartitionBy []OrderByParams
+	OrderBy     []OrderByParams
+	// CollationID is the collation of the argument, used by MIN() and MAX().
+	CollationID collations.ID `json:",omitempty"`
+	// Alias is the name of the column produced by the function.
+	Alias string `json:",omitempty"`
+}
+
+// WindowOpcode is the window function to be evaluated.
+type WindowOpcode int
+
+// These constants list the possible window opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the names of the window functions
+// that can be evaluated at the vtgate level to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+	"count":      WindowCount,
+	"sum":        WindowSum,
+	"min":        WindowMin,
+	"max":        WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// String returns a string. Used for plan descriptions
+func (wf *WindowFunc) String() string {
+	arg := ""
+	switch {
+	case wf.Col != -1:
+		arg = strconv.Itoa(wf.Col)
+	case wf.Opcode == WindowCount:
+		arg = "*"
+	}
+	var over []string
+	if len(wf.PartitionBy) > 0 {
+		keys := make([]string, 0, len(wf.PartitionBy))
+		for _, key := range wf.PartitionBy {
+			val := strconv.Itoa(key.Col)
+			if key.WeightStringCol != -1 && key.WeightStringCol != key.Col {
+				val = fmt.Sprintf("(%s|%d)", val, key.WeightStringCol)
+			}
+			keys = append(keys, val)
+		}
+		over = append(over, "partition by "+strings.Join(keys, ", "))
+	}
+	if len(wf.OrderBy) > 0 {
+		over = append(over, "order by "+GenericJoin(wf.OrderBy, orderByParamsToString))
+	}
+	return fmt.Sprintf("%s(%s) over (%s)", wf.Opcode.String(), arg, strings.Join(over, " "))
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// TryExecute satisfies the Primitive interface.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(w.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return w.evaluate(result)
+}
+
+// TryStreamExecute satisfies the Primitive interface.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result := &sqltypes.Result{}
+	err := vcursor.StreamExecutePrimitive(w.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	out, err := w.evaluate(result)
+	if err != nil {
+		return err
+	}
+	return callback(out)
+}
+
+// GetFields satisfies the Primitive interface.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.fields(qr.Fields)}, nil
+}
+
+// Inputs returns the input to the window primitive
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) fields(input []*querypb.Field) []*querypb.Field {
+	if input == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, 0, len(input)+len(w.Funcs))
+	fields = append(fields, input...)
+	for _, wf := range w.Funcs {
+		typ := sqltypes.Int64
+		switch wf.Opcode {
+		case WindowSum:
+			typ = sqltypes.Decimal
+		case WindowMin, WindowMax:
+			typ = input[wf.Col].Type
+		}
+		fields = append(fields, &querypb.Field{Name: wf.Alias, Type: typ})
+	}
+	return fields
+}
+
+func (w *Window) evaluate(in *sqltypes.Result) (*sqltypes.Result, error) {
+	out := &sqltypes.Result{
+		Fields: w.fields(in.Fields),
+		Rows:   make([][]sqltypes.Value, len(in.Rows)),
+	}
+	for i, row := range in.Rows {
+		newRow := make([]sqltypes.Value, len(row), len(row)+len(w.Funcs))
+		copy(newRow, row)
+		out.Rows[i] = newRow
+	}
+	for _, wf := range w.Funcs {
+		values, err := wf.evaluate(in.Rows)
+		if err != nil {
+			return nil, err
+		}
+		for i, value := range values {
+			out.Rows[i] = append(out.Rows[i], value)
+		}
+	}
+	return out, nil
+}
+
+// evaluate calculates the value of the window function for each one of the
+// given rows. The values are returned in the same order as the rows.
+func (wf *WindowFunc) evaluate(rows [][]sqltypes.Value) ([]sqltypes.Value, error) {
+	partitionBy := extractSlices(wf.PartitionBy)
+	orderBy := extractSlices(wf.OrderBy)
+
+	var err error
+	compare := func(comparers []*comparer, r1, r2 []sqltypes.Value) int {
+		for _, c := range comparers {
+			if err != nil {
+				return 0
+			}
+			var cmp int
+			cmp, err = c.compare(r1, r2)
+			if cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	}
+
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		r1, r2 := rows[order[i]], rows[order[j]]
+		if cmp := compare(partitionBy, r1, r2); cmp != 0 {
+			return cmp < 0
+		}
+		return compare(orderBy, r1, r2) < 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]sqltypes.Value, len(rows))
+	for start := 0; start < len(order); {
+		// find the end of the partition that starts at this row
+		end := start + 1
+		for end < len(order) && compare(partitionBy, rows[order[start]], rows[order[end]]) == 0 {
+			end++
+		}
+		if err := wf.evaluatePartition(rows, order[start:end], orderBy, compare, values); err != nil {
+			return nil, err
+		}
+		start = end
+	}
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// evaluatePartition calculates the values of the window function for the rows of
+// a single partition. partition holds the indexes of the rows, sorted by the ORDER BY
+// of the window.
+func (wf *WindowFunc) evaluatePartition(
+	rows [][]sqltypes.Value,
+	partition []int,
+	orderBy []*comparer,
+	compare func([]*comparer, []sqltypes.Value, []sqltypes.Value) int,
+	values []sqltypes.Value,
+) error {
+	var (
+		err       error
+		acc       = sqltypes.NULL
+		count     int64
+		rank      int64
+		denseRank int64
+	)
+	for peerStart := 0; peerStart < len(partition); {
+		// rows that are equal according to the ORDER BY of the window are peers,
+		// and share the same frame. Without an ORDER BY all the rows are peers.
+		peerEnd := peerStart + 1
+		for peerEnd < len(partition) && compare(orderBy, rows[partition[peerStart]], rows[partition[peerEnd]]) == 0 {
+			peerEnd++
+		}
+		rank = int64(peerStart + 1)
+		denseRank++
+
+		for _, idx := range partition[peerStart:peerEnd] {
+			var v sqltypes.Value
+			if wf.Col != -1 {
+				v = rows[idx][wf.Col]
+			}
+			switch wf.Opcode {
+			case WindowCount:
+				if wf.Col == -1 || !v.IsNull() {
+					count++
+				}
+			case WindowSum:
+				if !v.IsNull() {
+					acc, err = evalengine.NullSafeAdd(acc, v, sqltypes.Decimal)
+				}
+			case WindowMin:
+				acc, err = evalengine.Min(acc, v, wf.CollationID)
+			case WindowMax:
+				acc, err = evalengine.Max(acc, v, wf.CollationID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for i, idx := range partition[peerStart:peerEnd] {
+			switch wf.Opcode {
+			case WindowRowNumber:
+				values[idx] = sqltypes.NewInt64(int64(peerStart + i + 1))
+			case WindowRank:
+				values[idx] = sqltypes.NewInt64(rank)
+			case WindowDenseRank:
+				values[idx] = sqltypes.NewInt64(denseRank)
+			case WindowCount:
+				values[idx] = sqltypes.NewInt64(count)
+			default:
+				values[idx] = acc
+			}
+		}
+		peerStart = peerEnd
+	}
+	return nil
+}
+
+func (w *Window) description() PrimitiveDescription {
+	var funcs []string
+	for _, wf := range w.Funcs {
+		f := wf.String()
+		if wf.Alias != "" {
+			f += " AS " + wf.Alias
+		}
+		funcs = append(funcs, f)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other: map[string]interface{}{
+			"Functions": strings.Join(funcs, ", "),
+		},
+	}
+}
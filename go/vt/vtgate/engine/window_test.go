@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWindowRanking(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"grp|score",
+		"int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"2|10",
+			"1|30",
+			"1|10",
+			"2|20",
+			"1|20",
+			"1|20",
+		)},
+	}
+
+	partition := []OrderByParams{{Col: 0, WeightStringCol: -1}}
+	order := []OrderByParams{{Col: 1, WeightStringCol: -1}}
+	w := &Window{
+		Funcs: []*WindowFunc{{
+			Opcode:      WindowRowNumber,
+			Col:         -1,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "rn",
+		}, {
+			Opcode:      WindowRank,
+			Col:         -1,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "r",
+		}, {
+			Opcode:      WindowDenseRank,
+			Col:         -1,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "dr",
+		}},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"grp|score|rn|r|dr",
+			"int64|int64|int64|int64|int64",
+		),
+		"2|10|1|1|1",
+		"1|30|4|4|3",
+		"1|10|1|1|1",
+		"2|20|2|2|2",
+		"1|20|2|2|2",
+		"1|20|3|2|2",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowAggregates(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"grp|id|val",
+		"int64|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|3|5",
+			"2|1|7",
+			"1|1|2",
+			"1|2|null",
+			"1|2|4",
+		)},
+	}
+
+	partition := []OrderByParams{{Col: 0, WeightStringCol: -1}}
+	order := []OrderByParams{{Col: 1, WeightStringCol: -1}}
+	w := &Window{
+		Funcs: []*WindowFunc{{
+			// without an order by, the frame is the whole partition
+			Opcode:      WindowSum,
+			Col:         2,
+			PartitionBy: partition,
+			Alias:       "s",
+		}, {
+			Opcode:      WindowCount,
+			Col:         2,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "c",
+		}, {
+			// running count, peers share the same value
+			Opcode:      WindowCount,
+			Col:         -1,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "cs",
+		}, {
+			Opcode:      WindowMin,
+			Col:         2,
+			PartitionBy: partition,
+			OrderBy:     order,
+			Alias:       "mn",
+		}, {
+			Opcode: WindowMax,
+			Col:    2,
+			Alias:  "mx",
+		}},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"grp|id|val|s|c|cs|mn|mx",
+			"int64|int64|int64|decimal|int64|int64|int64|int64",
+		),
+		"1|3|5|11|3|4|2|7",
+		"2|1|7|7|1|1|7|7",
+		"1|1|2|11|1|1|2|7",
+		"1|2|null|11|2|3|2|7",
+		"1|2|4|11|2|3|2|7",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col",
+		"varchar",
+	)
+	fp := &fakePrimitive{
+		results: sqltypes.MakeTestStreamingResults(
+			fields,
+			"b",
+			"a",
+			"---",
+			"c",
+		),
+		allResultsInOneCall: true,
+	}
+
+	collationID, _ := collations.Local().LookupID("utf8mb4_0900_ai_ci")
+	w := &Window{
+		Funcs: []*WindowFunc{{
+			Opcode:  WindowRowNumber,
+			Col:     -1,
+			OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1, CollationID: collationID}},
+			Alias:   "row_number() over (order by col asc)",
+		}},
+		Input: fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := []*sqltypes.Result{sqltypes.MakeTestResult(
+		[]*querypb.Field{fields[0], {Name: "row_number() over (order by col asc)", Type: sqltypes.Int64}},
+		"b|2",
+		"a|1",
+		"c|3",
+	)}
+	utils.MustMatch(t, wantResults, results)
+}
+
+func TestWindowMaxMemoryRows(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("col", "int64"),
+			"1",
+			"2",
+			"3",
+		)},
+	}
+	w := &Window{
+		Funcs: []*WindowFunc{{Opcode: WindowRowNumber, Col: -1}},
+		Input: fp,
+	}
+
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	_, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
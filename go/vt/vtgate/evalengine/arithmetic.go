@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"math"
 	"strings"
 
 	"vitess.io/vitess/go/hack"
@@ -271,6 +272,65 @@ func divideNumericWithError(v1, v2 *EvalResult, precise bool, out *EvalResult) e
 	}
 }
 
+func moduloNumericWithError(v1, v2 *EvalResult, out *EvalResult) error {
+	v1.makeNumeric()
+	v2.makeNumeric()
+	switch {
+	case v1.typeof() == sqltypes.Float64 || v2.typeof() == sqltypes.Float64:
+		v1f, err := v1.coerceToFloat()
+		if err != nil {
+			return err
+		}
+		v2f, err := v2.coerceToFloat()
+		if err != nil {
+			return err
+		}
+		if v2f == 0.0 {
+			out.setNull()
+			return nil
+		}
+		out.setFloat(math.Mod(v1f, v2f))
+	case v1.typeof() == sqltypes.Decimal || v2.typeof() == sqltypes.Decimal:
+		v1d := v1.coerceToDecimal()
+		v2d := v2.coerceToDecimal()
+		if v2d.IsZero() {
+			out.setNull()
+			return nil
+		}
+		out.setDecimal(v1d.Rem(v2d), maxprec(v1.length_, v2.length_))
+	default:
+		// the integer modulo is computed on the absolute values, and the result
+		// has the sign of the dividend
+		var neg bool
+		var u1, u2 uint64
+		if v1.typeof() == sqltypes.Int64 && v1.int64() < 0 {
+			neg = true
+			u1 = uint64(-v1.int64())
+		} else {
+			u1 = v1.uint64()
+		}
+		if v2.typeof() == sqltypes.Int64 && v2.int64() < 0 {
+			u2 = uint64(-v2.int64())
+		} else {
+			u2 = v2.uint64()
+		}
+		if u2 == 0 {
+			out.setNull()
+			return nil
+		}
+		rem := u1 % u2
+		switch {
+		case v1.typeof() == sqltypes.Uint64:
+			out.setUint64(rem)
+		case neg:
+			out.setInt64(-int64(rem))
+		default:
+			out.setInt64(int64(rem))
+		}
+	}
+	return nil
+}
+
 // makeNumericAndPrioritize reorders the input parameters
 // to be Float64, Decimal, Uint64, Int64.
 func makeNumericAndPrioritize(i1, i2 *EvalResult) (*EvalResult, *EvalResult) {
@@ -35,12 +35,14 @@ type (
 	OpSubstraction   struct{}
 	OpMultiplication struct{}
 	OpDivision       struct{}
+	OpModulo         struct{}
 )
 
 var _ ArithmeticOp = (*OpAddition)(nil)
 var _ ArithmeticOp = (*OpSubstraction)(nil)
 var _ ArithmeticOp = (*OpMultiplication)(nil)
 var _ ArithmeticOp = (*OpDivision)(nil)
+var _ ArithmeticOp = (*OpModulo)(nil)
 
 func (b *ArithmeticExpr) eval(env *ExpressionEnv, out *EvalResult) {
 	var left, right EvalResult
@@ -83,6 +85,20 @@ func (b *ArithmeticExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
 			return sqltypes.Float64, flags
 		}
 		return sqltypes.Decimal, flags
+	case *OpModulo:
+		// the modulo by zero is NULL
+		flags |= flagNullable
+		switch {
+		case t1 == sqltypes.Float64 || t2 == sqltypes.Float64:
+			return sqltypes.Float64, flags
+		case t1 == sqltypes.Decimal || t2 == sqltypes.Decimal:
+			return sqltypes.Decimal, flags
+		case t1 == sqltypes.Uint64:
+			// the sign of the result is the sign of the dividend
+			return sqltypes.Uint64, flags
+		default:
+			return sqltypes.Int64, flags
+		}
 	}
 
 	switch t1 {
@@ -123,3 +139,8 @@ func (d *OpDivision) eval(left, right, out *EvalResult) error {
 	return divideNumericWithError(left, right, true, out)
 }
 func (d *OpDivision) String() string { return "/" }
+
+func (m *OpModulo) eval(left, right, out *EvalResult) error {
+	return moduloNumericWithError(left, right, out)
+}
+func (m *OpModulo) String() string { return "%" }
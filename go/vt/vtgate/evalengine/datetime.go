@@ -0,0 +1,717 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	layoutDate     = "2006-01-02"
+	layoutTime     = "15:04:05"
+	layoutDatetime = "2006-01-02 15:04:05"
+)
+
+type (
+	// DateAddExpr is a call to DATE_ADD or DATE_SUB, or an arithmetic
+	// operation between a date and an INTERVAL. Left is the date and
+	// Right is the value of the interval.
+	DateAddExpr struct {
+		BinaryExpr
+		Unit string
+		Sub  bool
+	}
+
+	// TimestampDiffExpr is a call to TIMESTAMPDIFF, which returns Right - Left in the given unit
+	TimestampDiffExpr struct {
+		BinaryExpr
+		Unit string
+	}
+)
+
+var _ Expr = (*DateAddExpr)(nil)
+var _ Expr = (*TimestampDiffExpr)(nil)
+
+// builtinNow implements NOW() and the other functions returning the current date or time
+type builtinNow struct {
+	name string
+	utc  bool
+	tt   sqltypes.Type
+}
+
+func (n builtinNow) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	now := time.Now()
+	if n.utc {
+		now = now.UTC()
+	}
+
+	var fsp int64
+	if len(args) == 1 {
+		args[0].makeSignedIntegral()
+		fsp = args[0].int64()
+		if fsp < 0 || fsp > 6 {
+			throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Too-big precision %d specified for '%s'. Maximum is 6.", fsp, strings.ToLower(n.name)))
+		}
+	}
+
+	var formatted []byte
+	switch n.tt {
+	case sqltypes.Date:
+		formatted = now.AppendFormat(nil, layoutDate)
+	case sqltypes.Time:
+		formatted = appendFraction(now.AppendFormat(nil, layoutTime), now, int(fsp))
+	default:
+		formatted = appendFraction(now.AppendFormat(nil, layoutDatetime), now, int(fsp))
+	}
+	result.setRaw(n.tt, formatted, collationNumeric)
+}
+
+func (n builtinNow) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) > 1 || (len(args) == 1 && n.tt == sqltypes.Date) {
+		throwArgError(strings.ToUpper(n.name))
+	}
+	return n.tt, 0
+}
+
+// appendFraction appends the given number of digits of the fractional seconds of t
+func appendFraction(buf []byte, t time.Time, digits int) []byte {
+	if digits == 0 {
+		return buf
+	}
+	micro := strconv.AppendInt(nil, int64(t.Nanosecond()/1000)+1000000, 10)
+	buf = append(buf, '.')
+	return append(buf, micro[1:1+digits]...)
+}
+
+func translateCurTimeFuncExpr(fn *sqlparser.CurTimeFuncExpr, lookup TranslationLookup) (Expr, error) {
+	var args TupleExpr
+	if fn.Fsp != nil {
+		fsp, err := translateLiteral(fn.Fsp, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fsp)
+	}
+
+	method := fn.Name.Lowered()
+	call, ok := builtinFunctions[method]
+	if !ok {
+		return nil, translateExprNotSupported(fn)
+	}
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    method,
+		F:         call,
+	}, nil
+}
+
+// parseDateTime parses the argument of a date function, and returns whether
+// it has a time part. It returns false if the argument is not a valid date.
+func parseDateTime(arg *EvalResult) (t time.Time, hasTime bool, ok bool) {
+	var str string
+	switch tt := arg.typeof(); {
+	case tt == sqltypes.Date:
+		t, err := time.Parse(layoutDate, arg.string())
+		return t, false, err == nil
+	case tt == sqltypes.Datetime || tt == sqltypes.Timestamp:
+		str = arg.string()
+	case sqltypes.IsIntegral(tt):
+		// integers are dates in the YYYYMMDD or YYYYMMDDhhmmss formats
+		str = string(arg.toRawBytes())
+		switch len(str) {
+		case 8:
+			t, err := time.Parse("20060102", str)
+			return t, false, err == nil
+		case 14:
+			t, err := time.Parse("20060102150405", str)
+			return t, true, err == nil
+		default:
+			return time.Time{}, false, false
+		}
+	case sqltypes.IsQuoted(tt):
+		str = strings.TrimSpace(arg.string())
+	default:
+		return time.Time{}, false, false
+	}
+
+	if len(str) <= len(layoutDate) {
+		t, err := time.Parse(layoutDate, str)
+		return t, false, err == nil
+	}
+	// the fractional seconds are optional
+	t, err := time.Parse("2006-01-02 15:04:05.999999999", str)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04:05.999999999", str)
+	}
+	return t, true, err == nil
+}
+
+// formatDateTime formats a date returned by a date function
+func formatDateTime(t time.Time, hasTime bool) []byte {
+	if !hasTime {
+		return t.AppendFormat(nil, layoutDate)
+	}
+	buf := t.AppendFormat(nil, layoutDatetime)
+	if t.Nanosecond() != 0 {
+		buf = appendFraction(buf, t, 6)
+	}
+	return buf
+}
+
+type builtinDateFormat struct{}
+
+func (builtinDateFormat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	date, format := &args[0], &args[1]
+	if date.null() || format.null() {
+		result.setNull()
+		return
+	}
+	t, _, ok := parseDateTime(date)
+	if !ok {
+		result.setNull()
+		return
+	}
+
+	format.makeStringArgument(env)
+	formatted := dateFormat(nil, t, format.string())
+	result.setRaw(sqltypes.VarChar, formatted, collations.TypedCollation{
+		Collation:    env.DefaultCollation,
+		Coercibility: collations.CoerceCoercible,
+		Repertoire:   collations.RepertoireASCII,
+	})
+}
+
+func (builtinDateFormat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("DATE_FORMAT")
+	}
+	// invalid dates are formatted as NULL
+	return sqltypes.VarChar, flagNullable
+}
+
+var daySuffixes = []string{"th", "st", "nd", "rd"}
+
+// dateFormat appends the date formatted with the format of MySQL's DATE_FORMAT
+func dateFormat(buf []byte, t time.Time, format string) []byte {
+	appendPadded := func(buf []byte, i, width int) []byte {
+		s := strconv.Itoa(i)
+		for n := len(s); n < width; n++ {
+			buf = append(buf, '0')
+		}
+		return append(buf, s...)
+	}
+	hour12 := func(t time.Time) int {
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return h
+	}
+	ampm := func(t time.Time) string {
+		if t.Hour() < 12 {
+			return "AM"
+		}
+		return "PM"
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, t.Weekday().String()[:3]...)
+		case 'b':
+			buf = append(buf, t.Month().String()[:3]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(t.Month()), 10)
+		case 'D':
+			day := t.Day()
+			buf = strconv.AppendInt(buf, int64(day), 10)
+			if day/10 == 1 || day%10 > 3 {
+				buf = append(buf, "th"...)
+			} else {
+				buf = append(buf, daySuffixes[day%10]...)
+			}
+		case 'd':
+			buf = appendPadded(buf, t.Day(), 2)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+		case 'f':
+			buf = appendPadded(buf, t.Nanosecond()/1000, 6)
+		case 'H':
+			buf = appendPadded(buf, t.Hour(), 2)
+		case 'h', 'I':
+			buf = appendPadded(buf, hour12(t), 2)
+		case 'i':
+			buf = appendPadded(buf, t.Minute(), 2)
+		case 'j':
+			buf = appendPadded(buf, t.YearDay(), 3)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(t.Hour()), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12(t)), 10)
+		case 'M':
+			buf = append(buf, t.Month().String()...)
+		case 'm':
+			buf = appendPadded(buf, int(t.Month()), 2)
+		case 'p':
+			buf = append(buf, ampm(t)...)
+		case 'r':
+			buf = appendPadded(buf, hour12(t), 2)
+			buf = append(buf, ':')
+			buf = appendPadded(buf, t.Minute(), 2)
+			buf = append(buf, ':')
+			buf = appendPadded(buf, t.Second(), 2)
+			buf = append(buf, ' ')
+			buf = append(buf, ampm(t)...)
+		case 'S', 's':
+			buf = appendPadded(buf, t.Second(), 2)
+		case 'T':
+			buf = t.AppendFormat(buf, layoutTime)
+		case 'U':
+			_, week := calcWeek(t, weekFirstWeekday)
+			buf = appendPadded(buf, week, 2)
+		case 'u':
+			_, week := calcWeek(t, weekMondayFirst)
+			buf = appendPadded(buf, week, 2)
+		case 'V':
+			_, week := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = appendPadded(buf, week, 2)
+		case 'v':
+			_, week := calcWeek(t, weekYear|weekMondayFirst)
+			buf = appendPadded(buf, week, 2)
+		case 'W':
+			buf = append(buf, t.Weekday().String()...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'X':
+			year, _ := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = appendPadded(buf, year, 4)
+		case 'x':
+			year, _ := calcWeek(t, weekYear|weekMondayFirst)
+			buf = appendPadded(buf, year, 4)
+		case 'Y':
+			buf = appendPadded(buf, t.Year(), 4)
+		case 'y':
+			buf = appendPadded(buf, t.Year()%100, 2)
+		default:
+			// any other character is copied as is, which includes '%'
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
+
+// the behaviours of MySQL's week calculation
+const (
+	weekMondayFirst  = 1
+	weekYear         = 2
+	weekFirstWeekday = 4
+)
+
+// calcWeek returns the week of the year of t, and the year the week belongs to,
+// the same way MySQL's calc_week does
+func calcWeek(t time.Time, behaviour int) (int, int) {
+	mondayFirst := behaviour&weekMondayFirst != 0
+	useWeekYear := behaviour&weekYear != 0
+	firstWeekday := behaviour&weekFirstWeekday != 0
+
+	year := t.Year()
+	dayNr := t.YearDay() - 1
+	firstDayNr := 0
+
+	// the weekday of the first day of the year, starting at 0
+	weekday := int(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	if mondayFirst {
+		weekday = (weekday + 6) % 7
+	}
+
+	if t.Month() == time.January && t.Day() <= 7-weekday {
+		if !useWeekYear && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return year, 0
+		}
+		useWeekYear = true
+		year--
+		days := daysInYear(year)
+		firstDayNr -= days
+		weekday = (weekday + 53*7 - days) % 7
+	}
+
+	var days int
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days = dayNr - (firstDayNr + (7 - weekday))
+	} else {
+		days = dayNr - (firstDayNr - weekday)
+	}
+
+	if useWeekYear && days >= 52*7 {
+		weekday = (weekday + daysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return year + 1, 1
+		}
+	}
+	return year, days/7 + 1
+}
+
+func daysInYear(year int) int {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+	return 365
+}
+
+// intervalUnits are the parts of the INTERVAL units that can be added to a date,
+// from the largest to the smallest
+var intervalUnits = map[string][]string{
+	"year":               {"year"},
+	"quarter":            {"quarter"},
+	"month":              {"month"},
+	"week":               {"week"},
+	"day":                {"day"},
+	"hour":               {"hour"},
+	"minute":             {"minute"},
+	"second":             {"second"},
+	"microsecond":        {"microsecond"},
+	"year_month":         {"year", "month"},
+	"day_hour":           {"day", "hour"},
+	"day_minute":         {"day", "hour", "minute"},
+	"day_second":         {"day", "hour", "minute", "second"},
+	"day_microsecond":    {"day", "hour", "minute", "second", "microsecond"},
+	"hour_minute":        {"hour", "minute"},
+	"hour_second":        {"hour", "minute", "second"},
+	"hour_microsecond":   {"hour", "minute", "second", "microsecond"},
+	"minute_second":      {"minute", "second"},
+	"minute_microsecond": {"minute", "second", "microsecond"},
+	"second_microsecond": {"second", "microsecond"},
+}
+
+// intervalHasTime returns whether an INTERVAL with the given unit changes the time of a date
+func intervalHasTime(unit string) bool {
+	parts := intervalUnits[unit]
+	switch parts[len(parts)-1] {
+	case "year", "quarter", "month", "week", "day":
+		return false
+	default:
+		return true
+	}
+}
+
+// parseInterval returns the value of each part of an INTERVAL unit. The parts of the value can be
+// separated by any non-digit character, and when some are missing, the leftmost ones are omitted.
+func parseInterval(value *EvalResult, unit string) ([]int64, bool) {
+	parts := intervalUnits[unit]
+	values := make([]int64, len(parts))
+
+	if len(parts) == 1 {
+		switch tt := value.typeof(); {
+		case sqltypes.IsQuoted(tt):
+			values[0] = int64(parseStringToFloat(value.string()))
+		default:
+			value.makeSignedIntegral()
+			values[0] = value.int64()
+		}
+		return values, true
+	}
+
+	str := strings.TrimSpace(string(value.toRawBytes()))
+	neg := strings.HasPrefix(str, "-")
+	fields := strings.FieldsFunc(str, func(r rune) bool { return r < '0' || r > '9' })
+	if len(fields) > len(parts) {
+		return nil, false
+	}
+	offset := len(parts) - len(fields)
+	for i, field := range fields {
+		v, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		if parts[offset+i] == "microsecond" {
+			// the microseconds are the digits of a fraction
+			for n := len(field); n < 6; n++ {
+				v *= 10
+			}
+		}
+		if neg {
+			v = -v
+		}
+		values[offset+i] = v
+	}
+	return values, true
+}
+
+// addInterval adds the parts of an INTERVAL to a date. When adding months would give
+// a day that does not exist, the day is the last day of the month instead.
+func addInterval(t time.Time, unit string, values []int64) time.Time {
+	var months, days int64
+	var duration time.Duration
+	for i, part := range intervalUnits[unit] {
+		v := values[i]
+		switch part {
+		case "year":
+			months += v * 12
+		case "quarter":
+			months += v * 3
+		case "month":
+			months += v
+		case "week":
+			days += v * 7
+		case "day":
+			days += v
+		case "hour":
+			duration += time.Duration(v) * time.Hour
+		case "minute":
+			duration += time.Duration(v) * time.Minute
+		case "second":
+			duration += time.Duration(v) * time.Second
+		case "microsecond":
+			duration += time.Duration(v) * time.Microsecond
+		}
+	}
+
+	if months != 0 {
+		month := int64(t.Year())*12 + int64(t.Month()) - 1 + months
+		year, mon := int(month/12), time.Month(month%12+1)
+		day := t.Day()
+		if last := time.Date(year, mon+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+			day = last
+		}
+		t = time.Date(year, mon, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return t.AddDate(0, 0, int(days)).Add(duration)
+}
+
+func (d *DateAddExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	var date, interval EvalResult
+	date.init(env, d.Left)
+	interval.init(env, d.Right)
+	if date.null() || interval.null() {
+		result.setNull()
+		return
+	}
+
+	tt, _ := d.typeof(env)
+	t, hasTime, ok := parseDateTime(&date)
+	if !ok {
+		result.setNull()
+		return
+	}
+	values, ok := parseInterval(&interval, d.Unit)
+	if !ok {
+		result.setNull()
+		return
+	}
+	if d.Sub {
+		for i := range values {
+			values[i] = -values[i]
+		}
+	}
+
+	t = addInterval(t, d.Unit, values)
+	if t.Year() < 0 || t.Year() > 9999 {
+		result.setNull()
+		return
+	}
+
+	hasTime = hasTime || intervalHasTime(d.Unit)
+	switch tt {
+	case sqltypes.Date, sqltypes.Datetime:
+		result.setRaw(tt, formatDateTime(t, hasTime), collationNumeric)
+	default:
+		result.setRaw(sqltypes.VarChar, formatDateTime(t, hasTime), collations.TypedCollation{
+			Collation:    env.DefaultCollation,
+			Coercibility: collations.CoerceCoercible,
+			Repertoire:   collations.RepertoireASCII,
+		})
+	}
+}
+
+func (d *DateAddExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	tt, _ := d.Left.typeof(env)
+	// invalid dates and intervals are NULL
+	switch tt {
+	case sqltypes.Date:
+		if intervalHasTime(d.Unit) {
+			return sqltypes.Datetime, flagNullable
+		}
+		return sqltypes.Date, flagNullable
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.Datetime, flagNullable
+	default:
+		return sqltypes.VarChar, flagNullable
+	}
+}
+
+// timestampDiff returns the difference between two dates in the given unit, rounded toward zero
+func timestampDiff(t1, t2 time.Time, unit string) int64 {
+	switch unit {
+	case "year", "quarter", "month":
+		months := int64(t2.Year()-t1.Year())*12 + int64(t2.Month()-t1.Month())
+		// a month is only complete if the day and time of the month are reached
+		rest1 := t1.AddDate(0, 0, -t1.Day()).Sub(t1)
+		rest2 := t2.AddDate(0, 0, -t2.Day()).Sub(t2)
+		if months > 0 && rest2 > rest1 {
+			months--
+		} else if months < 0 && rest2 < rest1 {
+			months++
+		}
+		switch unit {
+		case "year":
+			return months / 12
+		case "quarter":
+			return months / 3
+		default:
+			return months
+		}
+	}
+
+	diff := t2.Sub(t1)
+	switch unit {
+	case "week":
+		return int64(diff / (7 * 24 * time.Hour))
+	case "day":
+		return int64(diff / (24 * time.Hour))
+	case "hour":
+		return int64(diff / time.Hour)
+	case "minute":
+		return int64(diff / time.Minute)
+	case "second":
+		return int64(diff / time.Second)
+	default:
+		return int64(diff / time.Microsecond)
+	}
+}
+
+func (d *TimestampDiffExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	var left, right EvalResult
+	left.init(env, d.Left)
+	right.init(env, d.Right)
+	if left.null() || right.null() {
+		result.setNull()
+		return
+	}
+
+	t1, _, ok1 := parseDateTime(&left)
+	t2, _, ok2 := parseDateTime(&right)
+	if !ok1 || !ok2 {
+		result.setNull()
+		return
+	}
+	result.setInt64(timestampDiff(t1, t2, d.Unit))
+}
+
+func (d *TimestampDiffExpr) typeof(*ExpressionEnv) (sqltypes.Type, flag) {
+	// invalid dates are NULL
+	return sqltypes.Int64, flagNullable
+}
+
+// translateIntervalUnit returns the lowered name of an INTERVAL unit supported by the evalengine
+func translateIntervalUnit(unit string) (string, error) {
+	unit = strings.ToLower(unit)
+	if _, ok := intervalUnits[unit]; !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: interval unit %s", ErrTranslateExprNotSupported, unit)
+	}
+	return unit, nil
+}
+
+func translateDateAdd(date sqlparser.Expr, interval *sqlparser.IntervalExpr, sub bool, lookup TranslationLookup) (Expr, error) {
+	unit, err := translateIntervalUnit(interval.Unit)
+	if err != nil {
+		return nil, err
+	}
+	left, err := translateExpr(date, lookup)
+	if err != nil {
+		return nil, err
+	}
+	right, err := translateExpr(interval.Expr, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &DateAddExpr{
+		BinaryExpr: BinaryExpr{Left: left, Right: right},
+		Unit:       unit,
+		Sub:        sub,
+	}, nil
+}
+
+// translateDateAddFuncExpr translates DATE_ADD, DATE_SUB, ADDDATE and SUBDATE. The interval
+// of ADDDATE and SUBDATE can also be a number of days.
+func translateDateAddFuncExpr(fn *sqlparser.FuncExpr, sub bool, lookup TranslationLookup) (Expr, error) {
+	if len(fn.Exprs) != 2 {
+		return nil, argError(fn.Name.String())
+	}
+	var args [2]sqlparser.Expr
+	for i, expr := range fn.Exprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, translateExprNotSupported(fn)
+		}
+		args[i] = aliased.Expr
+	}
+
+	interval, ok := args[1].(*sqlparser.IntervalExpr)
+	if !ok {
+		switch fn.Name.Lowered() {
+		case "adddate", "subdate":
+			interval = &sqlparser.IntervalExpr{Expr: args[1], Unit: "day"}
+		default:
+			return nil, translateExprNotSupported(fn)
+		}
+	}
+	return translateDateAdd(args[0], interval, sub, lookup)
+}
+
+func translateTimestampFuncExpr(fn *sqlparser.TimestampFuncExpr, lookup TranslationLookup) (Expr, error) {
+	unit, err := translateIntervalUnit(fn.Unit)
+	if err != nil {
+		return nil, err
+	}
+	if len(intervalUnits[unit]) != 1 {
+		return nil, translateExprNotSupported(fn)
+	}
+
+	switch strings.ToLower(fn.Name) {
+	case "timestampadd":
+		return translateDateAdd(fn.Expr2, &sqlparser.IntervalExpr{Expr: fn.Expr1, Unit: unit}, false, lookup)
+	case "timestampdiff":
+		left, err := translateExpr(fn.Expr1, lookup)
+		if err != nil {
+			return nil, err
+		}
+		right, err := translateExpr(fn.Expr2, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &TimestampDiffExpr{
+			BinaryExpr: BinaryExpr{Left: left, Right: right},
+			Unit:       unit,
+		}, nil
+	default:
+		return nil, translateExprNotSupported(fn)
+	}
+}
@@ -716,6 +716,8 @@ func (er *EvalResult) setValue(value sqltypes.Value, collation collations.TypedC
 		er.setRaw(sqltypes.VarBinary, value.Raw(), collationBinary)
 	case sqltypes.Time, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Date:
 		er.setRaw(value.Type(), value.Raw(), collationNumeric)
+	case sqltypes.TypeJSON:
+		er.setRaw(sqltypes.TypeJSON, value.Raw(), collationJSON)
 	case sqltypes.Null:
 		er.setNull()
 	default:
@@ -194,6 +194,8 @@ func (env *ExpressionEnv) typecheck(expr Expr) {
 		env.typecheckUnary(expr.Inner)
 	case *BitwiseNotExpr:
 		env.typecheckUnary(expr.Inner)
+	case *NotExpr:
+		env.typecheckUnary(expr.Inner)
 	case *WeightStringCallExpr:
 		env.typecheckUnary(expr.String)
 	case *ArithmeticExpr:
@@ -204,6 +206,17 @@ func (env *ExpressionEnv) typecheck(expr Expr) {
 		env.typecheckBinary(expr.Left, expr.Right)
 	case *LikeExpr:
 		env.typecheckBinary(expr.Left, expr.Right)
+	case *DateAddExpr:
+		env.typecheckBinary(expr.Left, expr.Right)
+	case *TimestampDiffExpr:
+		env.typecheckBinary(expr.Left, expr.Right)
+	case *CaseExpr:
+		for _, wt := range expr.Cases {
+			env.typecheckBinary(wt.When, wt.Then)
+		}
+		if expr.Else != nil {
+			env.typecheckUnary(expr.Else)
+		}
 	case *ComparisonExpr:
 		left := env.cardinality(expr.Left)
 		right := env.cardinality(expr.Right)
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+type (
+	// CaseExpr is a searched CASE expression. The simple form of CASE is translated into a
+	// searched CASE where every condition compares the value with the WHEN expression.
+	CaseExpr struct {
+		Cases []WhenThen
+		Else  Expr
+	}
+
+	WhenThen struct {
+		When Expr
+		Then Expr
+	}
+)
+
+var _ Expr = (*CaseExpr)(nil)
+
+// results returns all the expressions that can be the result of the CASE
+func (c *CaseExpr) results() []Expr {
+	var results []Expr
+	for _, wt := range c.Cases {
+		results = append(results, wt.Then)
+	}
+	if c.Else != nil {
+		results = append(results, c.Else)
+	}
+	return results
+}
+
+func (c *CaseExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	tt, _ := c.typeof(env)
+	for _, wt := range c.Cases {
+		var cond EvalResult
+		cond.init(env, wt.When)
+		if cond.truthy() == boolTrue {
+			result.init(env, wt.Then)
+			result.coerceToType(env, tt)
+			return
+		}
+	}
+	if c.Else == nil {
+		result.setNull()
+		return
+	}
+	result.init(env, c.Else)
+	result.coerceToType(env, tt)
+}
+
+func (c *CaseExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	results := c.results()
+	var f flag
+	for _, expr := range results {
+		_, rf := expr.typeof(env)
+		f |= nullableFlags(rf)
+	}
+	if c.Else == nil {
+		f |= flagNullable
+	}
+	return aggregatedType(env, results), f
+}
+
+// coerceToType converts the result of a control flow expression to the type aggregated from
+// all the results the expression can have
+func (er *EvalResult) coerceToType(env *ExpressionEnv, tt sqltypes.Type) {
+	er.resolve()
+	if er.null() || er.typeof() == tt {
+		return
+	}
+	switch {
+	case tt == sqltypes.Float64:
+		er.makeFloat()
+	case tt == sqltypes.Decimal:
+		er.makeNumeric()
+		if er.typeof() != sqltypes.Decimal {
+			er.setDecimal(er.coerceToDecimal(), 0)
+		}
+	case tt == sqltypes.VarBinary:
+		er.makeBinary()
+	case tt == sqltypes.VarChar && !sqltypes.IsText(er.typeof()):
+		er.makeStringArgument(env)
+	}
+}
+
+func translateCaseExpr(node *sqlparser.CaseExpr, lookup TranslationLookup) (Expr, error) {
+	var value Expr
+	if node.Expr != nil {
+		var err error
+		value, err = translateExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &CaseExpr{}
+	for _, when := range node.Whens {
+		cond, err := translateExpr(when.Cond, lookup)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			cond, err = translateComparisonExpr2(sqlparser.EqualOp, value, cond)
+			if err != nil {
+				return nil, err
+			}
+		}
+		then, err := translateExpr(when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		result.Cases = append(result.Cases, WhenThen{When: cond, Then: then})
+	}
+
+	if node.Else != nil {
+		var err error
+		result.Else, err = translateExpr(node.Else, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// builtinIfRewrite translates IF(cond, then, else) into the equivalent CASE expression
+func builtinIfRewrite(args []Expr, _ TranslationLookup) (Expr, error) {
+	if len(args) != 3 {
+		return nil, argError("IF")
+	}
+	return &CaseExpr{
+		Cases: []WhenThen{{When: args[0], Then: args[1]}},
+		Else:  args[2],
+	}, nil
+}
@@ -213,3 +213,42 @@ func (c *ConvertUsingExpr) format(buf *formatter, depth int) {
 	buf.WriteString(collations.Local().LookupByID(c.Collation).Name())
 	buf.WriteByte(')')
 }
+
+func (d *DateAddExpr) format(w *formatter, depth int) {
+	if d.Sub {
+		w.WriteString("DATE_SUB(")
+	} else {
+		w.WriteString("DATE_ADD(")
+	}
+	d.Left.format(w, depth)
+	w.WriteString(", INTERVAL ")
+	d.Right.format(w, depth)
+	w.WriteByte(' ')
+	w.WriteString(strings.ToUpper(d.Unit))
+	w.WriteByte(')')
+}
+
+func (d *TimestampDiffExpr) format(w *formatter, depth int) {
+	w.WriteString("TIMESTAMPDIFF(")
+	w.WriteString(strings.ToUpper(d.Unit))
+	w.WriteString(", ")
+	d.Left.format(w, depth)
+	w.WriteString(", ")
+	d.Right.format(w, depth)
+	w.WriteByte(')')
+}
+
+func (c *CaseExpr) format(w *formatter, depth int) {
+	w.WriteString("CASE")
+	for _, wt := range c.Cases {
+		w.WriteString(" WHEN ")
+		wt.When.format(w, depth)
+		w.WriteString(" THEN ")
+		wt.Then.format(w, depth)
+	}
+	if c.Else != nil {
+		w.WriteString(" ELSE ")
+		c.Else.format(w, depth)
+	}
+	w.WriteString(" END")
+}
@@ -37,10 +37,52 @@ var builtinFunctions = map[string]builtin{
 	"bit_count": builtinBitCount{},
 	"hex":       builtinHex{},
 	"pi":        builtinPi{},
+
+	"concat":           builtinConcat{},
+	"lower":            builtinChangeCase{},
+	"lcase":            builtinChangeCase{},
+	"upper":            builtinChangeCase{upper: true},
+	"ucase":            builtinChangeCase{upper: true},
+	"length":           builtinLength{},
+	"octet_length":     builtinLength{},
+	"char_length":      builtinLength{chars: true},
+	"character_length": builtinLength{chars: true},
+	"trim":             builtinTrim{name: "TRIM", leading: true, trailing: true},
+	"ltrim":            builtinTrim{name: "LTRIM", leading: true},
+	"rtrim":            builtinTrim{name: "RTRIM", trailing: true},
+	"replace":          builtinReplace{},
+	"substring":        builtinSubstring{},
+	"substr":           builtinSubstring{},
+	"mid":              builtinSubstring{},
+
+	"abs":     builtinAbs{},
+	"ceil":    builtinCeilFloor{ceil: true},
+	"ceiling": builtinCeilFloor{ceil: true},
+	"floor":   builtinCeilFloor{},
+	"round":   builtinRound{},
+
+	"now":               builtinNow{name: "NOW", tt: sqltypes.Datetime},
+	"current_timestamp": builtinNow{name: "CURRENT_TIMESTAMP", tt: sqltypes.Datetime},
+	"localtime":         builtinNow{name: "LOCALTIME", tt: sqltypes.Datetime},
+	"localtimestamp":    builtinNow{name: "LOCALTIMESTAMP", tt: sqltypes.Datetime},
+	"sysdate":           builtinNow{name: "SYSDATE", tt: sqltypes.Datetime},
+	"utc_timestamp":     builtinNow{name: "UTC_TIMESTAMP", utc: true, tt: sqltypes.Datetime},
+	"curdate":           builtinNow{name: "CURDATE", tt: sqltypes.Date},
+	"current_date":      builtinNow{name: "CURRENT_DATE", tt: sqltypes.Date},
+	"utc_date":          builtinNow{name: "UTC_DATE", utc: true, tt: sqltypes.Date},
+	"curtime":           builtinNow{name: "CURTIME", tt: sqltypes.Time},
+	"current_time":      builtinNow{name: "CURRENT_TIME", tt: sqltypes.Time},
+	"utc_time":          builtinNow{name: "UTC_TIME", utc: true, tt: sqltypes.Time},
+	"date_format":       builtinDateFormat{},
+
+	"json_extract": builtinJSONExtract{},
+	"json_unquote": builtinJSONUnquote{},
 }
 
 var builtinFunctionsRewrite = map[string]builtinRewrite{
 	"isnull": builtinIsNullRewrite,
+	"mod":    builtinModRewrite,
+	"if":     builtinIfRewrite,
 }
 
 type builtin interface {
@@ -585,3 +585,128 @@ func TestCharsetConversionOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestStringFunctions(t *testing.T) {
+	var inputs = []string{
+		`"foobar"`, `"  Foo Bar  "`, `_latin1 "ÀÉÍ"`, `"ñandú"`, `_binary "FooBar"`, `NULL`, `12.5`,
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range []string{"UPPER", "LOWER", "LENGTH", "CHAR_LENGTH", "TRIM", "LTRIM", "RTRIM"} {
+		for _, arg := range inputs {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT %s(%s)", fn, arg))
+		}
+	}
+	for _, lhs := range inputs {
+		for _, rhs := range inputs {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT CONCAT(%s, %s)", lhs, rhs))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT REPLACE(%s, 'o', %s)", lhs, rhs))
+		}
+	}
+	for _, arg := range inputs {
+		for _, pos := range []string{"0", "1", "3", "-2", "100", "NULL"} {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT SUBSTRING(%s, %s)", arg, pos))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT SUBSTRING(%s FROM %s FOR 2)", arg, pos))
+		}
+	}
+}
+
+func TestMathFunctions(t *testing.T) {
+	var inputs = []string{
+		"0", "-1", "42", "-9223372036854775807", "18446744073709551615",
+		"1.5", "-1.5", "2.45", "-0.0001", "1.5e0", "-2.5e0", "1e100", "'3.7'", "NULL",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range []string{"ABS", "CEIL", "FLOOR", "ROUND"} {
+		for _, arg := range inputs {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT %s(%s)", fn, arg))
+		}
+	}
+	for _, arg := range inputs {
+		for _, places := range []string{"0", "1", "-1", "40"} {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT ROUND(%s, %s)", arg, places))
+		}
+	}
+	for _, lhs := range inputs {
+		for _, rhs := range inputs {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT MOD(%s, %s)", lhs, rhs))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT %s %% %s", lhs, rhs))
+		}
+	}
+}
+
+func TestDateFunctions(t *testing.T) {
+	var dates = []string{
+		"'2022-01-31'", "'2020-02-29 23:59:59'", "'1999-12-31 12:00:00.5'", "DATE '2022-05-01'",
+		"TIMESTAMP '2021-01-03 10:00:00'", "20220101", "'not a date'", "NULL",
+	}
+	var intervals = []string{
+		"INTERVAL 1 DAY", "INTERVAL -1 MONTH", "INTERVAL 13 MONTH", "INTERVAL 2 YEAR",
+		"INTERVAL 90 MINUTE", "INTERVAL '1:30' HOUR_MINUTE", "INTERVAL '1 1:30:15' DAY_SECOND", "INTERVAL 1 WEEK",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, date := range dates {
+		for _, interval := range intervals {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_ADD(%s, %s)", date, interval))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_SUB(%s, %s)", date, interval))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT %s + %s", date, interval))
+		}
+		compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_FORMAT(%s, '%%a %%b %%c %%D %%d %%e %%f %%H %%h %%i %%j %%k %%l %%M %%m %%p %%r %%S %%T %%W %%w %%Y %%y %%%%')", date))
+		compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_FORMAT(%s, '%%U %%u %%V %%v %%X %%x')", date))
+		for _, other := range dates {
+			for _, unit := range []string{"MICROSECOND", "SECOND", "MINUTE", "HOUR", "DAY", "WEEK", "MONTH", "QUARTER", "YEAR"} {
+				compareRemoteQuery(t, conn, fmt.Sprintf("SELECT TIMESTAMPDIFF(%s, %s, %s)", unit, date, other))
+			}
+		}
+	}
+}
+
+func TestJSONExtract(t *testing.T) {
+	var docs = []string{
+		`'{"a": 1, "b": [1, 2.5, "three", {"c": null}], "aa": {"b": true}}'`,
+		`'[1, [2, [3, 4]], "x"]'`,
+		`'"scalar"'`,
+		`NULL`,
+	}
+	var paths = []string{
+		"'$'", "'$.a'", "'$.b[1]'", "'$.b[last]'", "'$.b[last-1]'", "'$.b[*]'", "'$.*'",
+		"'$**.b'", "'$[1][1][0]'", "'$[0]'", "'$.\"aa\".b'", "'$.missing'",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, doc := range docs {
+		for _, path := range paths {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT JSON_EXTRACT(%s, %s)", doc, path))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT JSON_UNQUOTE(JSON_EXTRACT(%s, %s))", doc, path))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT JSON_EXTRACT(%s, %s, '$[0]')", doc, path))
+		}
+	}
+}
+
+func TestCaseIf(t *testing.T) {
+	var conds = []string{"1", "0", "NULL", "'foo'", "1 = 1", "0.0"}
+	var values = []string{"1", "-1.5", "2.5e0", "'foo'", "_binary 'bar'", "NULL", "18446744073709551615"}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, cond := range conds {
+		for _, lhs := range values {
+			for _, rhs := range values {
+				compareRemoteQuery(t, conn, fmt.Sprintf("SELECT IF(%s, %s, %s)", cond, lhs, rhs))
+				compareRemoteQuery(t, conn, fmt.Sprintf("SELECT CASE WHEN %s THEN %s ELSE %s END", cond, lhs, rhs))
+				compareRemoteQuery(t, conn, fmt.Sprintf("SELECT CASE %s WHEN 1 THEN %s WHEN 'foo' THEN %s END", cond, lhs, rhs))
+			}
+		}
+	}
+}
@@ -277,6 +277,39 @@ func (d Decimal) abs() Decimal {
 	}
 }
 
+// Abs returns the absolute value of the decimal.
+func (d Decimal) Abs() Decimal {
+	return d.abs()
+}
+
+// Floor returns the nearest integer value less than or equal to d.
+func (d Decimal) Floor() Decimal {
+	if d.exp >= 0 {
+		return d
+	}
+	d.ensureInitialized()
+	exp := bigPow10(uint64(-d.exp))
+	// big.Int.Div uses Euclidean division, which rounds towards negative infinity
+	// for positive divisors
+	z := new(big.Int).Div(d.value, exp)
+	return Decimal{value: z, exp: 0}
+}
+
+// Ceil returns the nearest integer value greater than or equal to d.
+func (d Decimal) Ceil() Decimal {
+	if d.exp >= 0 {
+		return d
+	}
+	return d.Neg().Floor().Neg()
+}
+
+// Rem returns the remainder of the integer division of d by d2.
+// The result has the same sign as d, like MySQL's MOD operator.
+func (d Decimal) Rem(d2 Decimal) Decimal {
+	_, r := d.quoRem(d2, 0)
+	return r
+}
+
 // Add returns d + d2.
 func (d Decimal) Add(d2 Decimal) Decimal {
 	rd, rd2 := RescalePair(d, d2)
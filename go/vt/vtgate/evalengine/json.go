@@ -0,0 +1,455 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// collationJSON is the collation of JSON values, which are always utf8mb4_bin
+var collationJSON = func() collations.TypedCollation {
+	id, _ := collations.Local().LookupID("utf8mb4_bin")
+	return collations.TypedCollation{
+		Collation:    id,
+		Coercibility: collations.CoerceImplicit,
+		Repertoire:   collations.RepertoireUnicode,
+	}
+}()
+
+// parseJSON parses a JSON document, keeping all the numbers as json.Number
+func parseJSON(doc []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: %v", err)
+	}
+	if dec.More() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: The document root must not be followed by other values.")
+	}
+	return value, nil
+}
+
+// appendJSON serializes a JSON value the same way MySQL does: object keys are sorted by length
+// and then by their bytes, and the members of arrays and objects are separated by ", "
+func appendJSON(buf []byte, value interface{}) []byte {
+	switch value := value.(type) {
+	case nil:
+		return append(buf, "null"...)
+	case bool:
+		return strconv.AppendBool(buf, value)
+	case json.Number:
+		if _, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			return append(buf, value...)
+		}
+		if _, err := strconv.ParseUint(string(value), 10, 64); err == nil {
+			return append(buf, value...)
+		}
+		f, _ := value.Float64()
+		formatted := FormatFloat(sqltypes.Float64, f)
+		buf = append(buf, formatted...)
+		if !bytes.ContainsAny(formatted, ".e") {
+			buf = append(buf, ".0"...)
+		}
+		return buf
+	case string:
+		return appendJSONString(buf, value)
+	case []interface{}:
+		buf = append(buf, '[')
+		for i, elem := range value {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSON(buf, elem)
+		}
+		return append(buf, ']')
+	case map[string]interface{}:
+		buf = append(buf, '{')
+		for i, key := range jsonKeys(value) {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSONString(buf, key)
+			buf = append(buf, ": "...)
+			buf = appendJSON(buf, value[key])
+		}
+		return append(buf, '}')
+	default:
+		panic("BUG: unexpected JSON value")
+	}
+}
+
+// jsonKeys returns the keys of a JSON object in the order MySQL stores them
+func jsonKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func appendJSONString(buf []byte, str string) []byte {
+	buf = append(buf, '"')
+	for _, r := range str {
+		switch r {
+		case '"':
+			buf = append(buf, `\"`...)
+		case '\\':
+			buf = append(buf, `\\`...)
+		case '\b':
+			buf = append(buf, `\b`...)
+		case '\f':
+			buf = append(buf, `\f`...)
+		case '\n':
+			buf = append(buf, `\n`...)
+		case '\r':
+			buf = append(buf, `\r`...)
+		case '\t':
+			buf = append(buf, `\t`...)
+		default:
+			if r < 0x20 {
+				buf = append(buf, `\u00`...)
+				buf = append(buf, "0123456789abcdef"[r>>4], "0123456789abcdef"[r&0xf])
+				continue
+			}
+			buf = append(buf, string(r)...)
+		}
+	}
+	return append(buf, '"')
+}
+
+type jsonPathLegKind int
+
+const (
+	jsonPathKey jsonPathLegKind = iota
+	jsonPathAnyKey
+	jsonPathIndex
+	jsonPathLastIndex
+	jsonPathAnyIndex
+	jsonPathDoubleWildcard
+)
+
+// jsonPathLeg is one step in a JSON path
+type jsonPathLeg struct {
+	kind  jsonPathLegKind
+	key   string
+	index int
+}
+
+type jsonPath struct {
+	legs     []jsonPathLeg
+	wildcard bool
+}
+
+func jsonPathError(path string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON path expression %q.", path)
+}
+
+// parseJSONPath parses a JSON path expression like `$.a[2].*`
+func parseJSONPath(path string) (*jsonPath, error) {
+	p := strings.TrimSpace(path)
+	if !strings.HasPrefix(p, "$") {
+		return nil, jsonPathError(path)
+	}
+	p = strings.TrimLeft(p[1:], " ")
+
+	var parsed jsonPath
+	for len(p) > 0 {
+		switch {
+		case strings.HasPrefix(p, "**"):
+			parsed.legs = append(parsed.legs, jsonPathLeg{kind: jsonPathDoubleWildcard})
+			parsed.wildcard = true
+			p = p[2:]
+		case p[0] == '.':
+			p = strings.TrimLeft(p[1:], " ")
+			switch {
+			case strings.HasPrefix(p, "*"):
+				parsed.legs = append(parsed.legs, jsonPathLeg{kind: jsonPathAnyKey})
+				parsed.wildcard = true
+				p = p[1:]
+			case strings.HasPrefix(p, `"`):
+				end := 1
+				for end < len(p) && p[end] != '"' {
+					if p[end] == '\\' {
+						end++
+					}
+					end++
+				}
+				if end >= len(p) {
+					return nil, jsonPathError(path)
+				}
+				key, err := strconv.Unquote(p[:end+1])
+				if err != nil {
+					return nil, jsonPathError(path)
+				}
+				parsed.legs = append(parsed.legs, jsonPathLeg{kind: jsonPathKey, key: key})
+				p = p[end+1:]
+			default:
+				end := strings.IndexAny(p, ".[* ")
+				if end < 0 {
+					end = len(p)
+				}
+				if end == 0 {
+					return nil, jsonPathError(path)
+				}
+				parsed.legs = append(parsed.legs, jsonPathLeg{kind: jsonPathKey, key: p[:end]})
+				p = p[end:]
+			}
+		case p[0] == '[':
+			end := strings.IndexByte(p, ']')
+			if end < 0 {
+				return nil, jsonPathError(path)
+			}
+			leg, err := parseJSONPathIndex(strings.TrimSpace(p[1:end]))
+			if err != nil {
+				return nil, jsonPathError(path)
+			}
+			if leg.kind == jsonPathAnyIndex {
+				parsed.wildcard = true
+			}
+			parsed.legs = append(parsed.legs, leg)
+			p = p[end+1:]
+		default:
+			return nil, jsonPathError(path)
+		}
+		p = strings.TrimLeft(p, " ")
+	}
+
+	if n := len(parsed.legs); n > 0 && parsed.legs[n-1].kind == jsonPathDoubleWildcard {
+		// a path cannot end with **
+		return nil, jsonPathError(path)
+	}
+	return &parsed, nil
+}
+
+func parseJSONPathIndex(index string) (jsonPathLeg, error) {
+	switch {
+	case index == "*":
+		return jsonPathLeg{kind: jsonPathAnyIndex}, nil
+	case strings.HasPrefix(index, "last"):
+		var offset int
+		if rest := strings.TrimSpace(index[len("last"):]); rest != "" {
+			if !strings.HasPrefix(rest, "-") {
+				return jsonPathLeg{}, jsonPathError(index)
+			}
+			var err error
+			if offset, err = strconv.Atoi(strings.TrimSpace(rest[1:])); err != nil || offset < 0 {
+				return jsonPathLeg{}, jsonPathError(index)
+			}
+		}
+		return jsonPathLeg{kind: jsonPathLastIndex, index: offset}, nil
+	default:
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 {
+			return jsonPathLeg{}, jsonPathError(index)
+		}
+		return jsonPathLeg{kind: jsonPathIndex, index: i}, nil
+	}
+}
+
+// match appends to matches all the values in doc that match the given path legs
+func (p *jsonPath) match(matches []interface{}, doc interface{}, legs []jsonPathLeg) []interface{} {
+	if len(legs) == 0 {
+		return append(matches, doc)
+	}
+
+	leg, rest := legs[0], legs[1:]
+	switch leg.kind {
+	case jsonPathKey:
+		if obj, ok := doc.(map[string]interface{}); ok {
+			if value, ok := obj[leg.key]; ok {
+				matches = p.match(matches, value, rest)
+			}
+		}
+	case jsonPathAnyKey:
+		if obj, ok := doc.(map[string]interface{}); ok {
+			for _, key := range jsonKeys(obj) {
+				matches = p.match(matches, obj[key], rest)
+			}
+		}
+	case jsonPathIndex, jsonPathLastIndex:
+		arr, ok := doc.([]interface{})
+		if !ok {
+			// scalars and objects are treated as an array with a single element
+			arr = []interface{}{doc}
+		}
+		i := leg.index
+		if leg.kind == jsonPathLastIndex {
+			i = len(arr) - 1 - leg.index
+		}
+		if i >= 0 && i < len(arr) {
+			matches = p.match(matches, arr[i], rest)
+		}
+	case jsonPathAnyIndex:
+		if arr, ok := doc.([]interface{}); ok {
+			for _, elem := range arr {
+				matches = p.match(matches, elem, rest)
+			}
+		}
+	case jsonPathDoubleWildcard:
+		matches = p.match(matches, doc, rest)
+		switch doc := doc.(type) {
+		case map[string]interface{}:
+			for _, key := range jsonKeys(doc) {
+				matches = p.match(matches, doc[key], legs)
+			}
+		case []interface{}:
+			for _, elem := range doc {
+				matches = p.match(matches, elem, legs)
+			}
+		}
+	}
+	return matches
+}
+
+// jsonArgument returns the JSON document of the argument of a JSON function
+func jsonArgument(env *ExpressionEnv, arg *EvalResult) interface{} {
+	raw := arg.bytes()
+	if arg.typeof() != sqltypes.TypeJSON {
+		arg.makeStringArgument(env)
+		raw = arg.bytes()
+		if arg.typeof() == sqltypes.VarChar {
+			raw = utf8mb4Text(raw, arg.collation().Collation)
+		}
+	}
+	doc, err := parseJSON(raw)
+	if err != nil {
+		throwEvalError(err)
+	}
+	return doc
+}
+
+type builtinJSONExtract struct{}
+
+func (builtinJSONExtract) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].null() {
+			result.setNull()
+			return
+		}
+	}
+
+	doc := jsonArgument(env, &args[0])
+
+	var matches []interface{}
+	var wrap = len(args) > 2
+	for i := 1; i < len(args); i++ {
+		args[i].makeStringArgument(env)
+		path, err := parseJSONPath(args[i].string())
+		if err != nil {
+			throwEvalError(err)
+		}
+		wrap = wrap || path.wildcard
+		matches = path.match(matches, doc, path.legs)
+	}
+
+	switch {
+	case len(matches) == 0:
+		result.setNull()
+	case wrap:
+		result.setRaw(sqltypes.TypeJSON, appendJSON(nil, matches), collationJSON)
+	default:
+		result.setRaw(sqltypes.TypeJSON, appendJSON(nil, matches[0]), collationJSON)
+	}
+}
+
+func (builtinJSONExtract) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 2 {
+		throwArgError("JSON_EXTRACT")
+	}
+	// paths that do not match are NULL
+	return sqltypes.TypeJSON, flagNullable
+}
+
+type builtinJSONUnquote struct{}
+
+func (builtinJSONUnquote) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	var unquoted []byte
+	if arg.typeof() == sqltypes.TypeJSON {
+		doc := jsonArgument(env, arg)
+		if str, ok := doc.(string); ok {
+			unquoted = []byte(str)
+		} else {
+			unquoted = arg.bytes()
+		}
+	} else {
+		arg.makeStringArgument(env)
+		raw := arg.bytes()
+		unquoted = raw
+		if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+			doc := jsonArgument(env, arg)
+			str, _ := doc.(string)
+			unquoted = []byte(str)
+		}
+	}
+	result.setRaw(sqltypes.VarChar, unquoted, collations.TypedCollation{
+		Collation:    collations.CollationUtf8mb4ID,
+		Coercibility: collations.CoerceImplicit,
+		Repertoire:   collations.RepertoireUnicode,
+	})
+}
+
+func (builtinJSONUnquote) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_UNQUOTE")
+	}
+	_, f := args[0].typeof(env)
+	return sqltypes.VarChar, nullableFlags(f)
+}
+
+// translateJSONExtract translates the `col->path` and `col->>path` operators into calls to
+// JSON_EXTRACT and JSON_UNQUOTE
+func translateJSONExtract(binary BinaryExpr, unquote bool) Expr {
+	var expr Expr = &CallExpr{
+		Arguments: TupleExpr{binary.Left, binary.Right},
+		Aliases:   make([]sqlparser.ColIdent, 2),
+		Method:    "json_extract",
+		F:         builtinJSONExtract{},
+	}
+	if unquote {
+		expr = &CallExpr{
+			Arguments: TupleExpr{expr},
+			Aliases:   make([]sqlparser.ColIdent, 1),
+			Method:    "json_unquote",
+			F:         builtinJSONUnquote{},
+		}
+	}
+	return expr
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine/internal/decimal"
+)
+
+// numericType returns the type an argument of the given type has once it is made numeric
+func numericType(tt sqltypes.Type, f flag) sqltypes.Type {
+	switch {
+	case sqltypes.IsSigned(tt):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(tt):
+		return sqltypes.Uint64
+	case tt == sqltypes.Decimal:
+		return sqltypes.Decimal
+	case tt == sqltypes.VarBinary && f&flagHex != 0:
+		return sqltypes.Uint64
+	default:
+		return sqltypes.Float64
+	}
+}
+
+type builtinAbs struct{}
+
+func (builtinAbs) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	arg.makeNumeric()
+	switch arg.typeof() {
+	case sqltypes.Int64:
+		i := arg.int64()
+		if i == math.MinInt64 {
+			throwEvalError(vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'abs(%d)'", i))
+		}
+		if i < 0 {
+			i = -i
+		}
+		result.setInt64(i)
+	case sqltypes.Uint64:
+		result.setUint64(arg.uint64())
+	case sqltypes.Decimal:
+		result.setDecimal(arg.decimal().Abs(), arg.length_)
+	default:
+		result.setFloat(math.Abs(arg.float64()))
+	}
+}
+
+func (builtinAbs) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("ABS")
+	}
+	tt, f := args[0].typeof(env)
+	return numericType(tt, f), nullableFlags(f)
+}
+
+type builtinCeilFloor struct {
+	ceil bool
+}
+
+func (c builtinCeilFloor) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	arg.makeNumeric()
+	switch arg.typeof() {
+	case sqltypes.Int64:
+		result.setInt64(arg.int64())
+	case sqltypes.Uint64:
+		result.setUint64(arg.uint64())
+	case sqltypes.Decimal:
+		if c.ceil {
+			result.setDecimal(arg.decimal().Ceil(), 0)
+		} else {
+			result.setDecimal(arg.decimal().Floor(), 0)
+		}
+	default:
+		if c.ceil {
+			result.setFloat(math.Ceil(arg.float64()))
+		} else {
+			result.setFloat(math.Floor(arg.float64()))
+		}
+	}
+}
+
+func (c builtinCeilFloor) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		if c.ceil {
+			throwArgError("CEIL")
+		}
+		throwArgError("FLOOR")
+	}
+	tt, f := args[0].typeof(env)
+	return numericType(tt, f), nullableFlags(f)
+}
+
+type builtinRound struct{}
+
+func (builtinRound) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	var places int64
+	if len(args) == 2 {
+		if args[1].null() {
+			result.setNull()
+			return
+		}
+		args[1].makeSignedIntegral()
+		places = args[1].int64()
+	}
+
+	arg.makeNumeric()
+	switch arg.typeof() {
+	case sqltypes.Int64:
+		result.setInt64(roundInteger(arg.int64(), places))
+	case sqltypes.Uint64:
+		result.setUint64(roundUnsigned(arg.uint64(), places))
+	case sqltypes.Decimal:
+		if places > decimal.MyMaxScale {
+			places = decimal.MyMaxScale
+		}
+		if places < -decimal.MyMaxPrecision {
+			places = -decimal.MyMaxPrecision
+		}
+		frac := arg.length_
+		if int32(places) < frac {
+			frac = int32(places)
+		}
+		if frac < 0 {
+			frac = 0
+		}
+		result.setDecimal(arg.decimal().Round(int32(places)), frac)
+	default:
+		result.setFloat(roundFloat(arg.float64(), places))
+	}
+}
+
+func (builtinRound) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 && len(args) != 2 {
+		throwArgError("ROUND")
+	}
+	tt, f := args[0].typeof(env)
+	if len(args) == 2 {
+		_, f2 := args[1].typeof(env)
+		f |= f2
+	}
+	return numericType(tt, f), nullableFlags(f)
+}
+
+// roundInteger rounds half away from zero an integer to the given number of decimal places,
+// which only changes the integer when they are negative
+func roundInteger(i int64, places int64) int64 {
+	if places >= 0 {
+		return i
+	}
+	if i < 0 {
+		return -int64(roundUnsigned(uint64(-i), places))
+	}
+	return int64(roundUnsigned(uint64(i), places))
+}
+
+func roundUnsigned(u uint64, places int64) uint64 {
+	if places >= 0 {
+		return u
+	}
+	if places < -19 {
+		return 0
+	}
+	pow := uint64(1)
+	for i := int64(0); i < -places; i++ {
+		pow *= 10
+	}
+	rem := u % pow
+	u -= rem
+	if rem >= pow-rem {
+		u += pow
+	}
+	return u
+}
+
+// roundFloat rounds a float the way MySQL does, which rounds to the nearest even value
+// when the float is halfway between two values
+func roundFloat(f float64, places int64) float64 {
+	if places > 308 || math.IsInf(f, 0) || math.IsNaN(f) {
+		return f
+	}
+	if places < -308 {
+		return 0
+	}
+	pow := math.Pow10(int(abs64(places)))
+	if places >= 0 {
+		rounded := math.RoundToEven(f*pow) / pow
+		if math.IsInf(rounded, 0) || math.IsNaN(rounded) {
+			return f
+		}
+		return rounded
+	}
+	return math.RoundToEven(f/pow) * pow
+}
+
+func abs64(i int64) int64 {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+func builtinModRewrite(args []Expr, _ TranslationLookup) (Expr, error) {
+	if len(args) != 2 {
+		return nil, argError("MOD")
+	}
+	return &ArithmeticExpr{
+		BinaryExpr: BinaryExpr{Left: args[0], Right: args[1]},
+		Op:         &OpModulo{},
+	}, nil
+}
@@ -163,6 +163,10 @@ func (expr *UnaryExpr) simplify(env *ExpressionEnv) error {
 }
 
 func (c *CallExpr) constant() bool {
+	if _, ok := c.F.(builtinNow); ok {
+		// the current time is never constant, even though it has no arguments
+		return false
+	}
 	return c.Arguments.constant()
 }
 
@@ -180,6 +184,32 @@ func (c *WeightStringCallExpr) simplify(env *ExpressionEnv) error {
 	return err
 }
 
+func (c *CaseExpr) constant() bool {
+	for _, wt := range c.Cases {
+		if !wt.When.constant() || !wt.Then.constant() {
+			return false
+		}
+	}
+	return c.Else == nil || c.Else.constant()
+}
+
+func (c *CaseExpr) simplify(env *ExpressionEnv) error {
+	var err error
+	for i := range c.Cases {
+		wt := &c.Cases[i]
+		if wt.When, err = simplifyExpr(env, wt.When); err != nil {
+			return err
+		}
+		if wt.Then, err = simplifyExpr(env, wt.Then); err != nil {
+			return err
+		}
+	}
+	if c.Else != nil {
+		c.Else, err = simplifyExpr(env, c.Else)
+	}
+	return err
+}
+
 func simplifyExpr(env *ExpressionEnv, e Expr) (Expr, error) {
 	if e.constant() {
 		res, err := env.Evaluate(e)
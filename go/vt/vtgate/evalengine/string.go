@@ -0,0 +1,407 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// stringType returns the type of the result of a string function for an argument of the given type:
+// binary strings stay binary, and any other value is converted to a nonbinary string
+func stringType(tt sqltypes.Type) sqltypes.Type {
+	if sqltypes.IsBinary(tt) {
+		return sqltypes.VarBinary
+	}
+	return sqltypes.VarChar
+}
+
+// nullableFlags returns the nullability flags of the given flags
+func nullableFlags(f flag) flag {
+	return f & (flagNull | flagNullable)
+}
+
+// makeStringArgument converts the argument of a string function into a string.
+// Binary strings are kept as they are, and nonbinary strings without a known collation,
+// as well as any other value, are converted to text with the default collation.
+func (er *EvalResult) makeStringArgument(env *ExpressionEnv) {
+	tt := er.typeof()
+	switch {
+	case sqltypes.IsBinary(tt):
+		er.makeBinary()
+	case sqltypes.IsText(tt) && er.collation().Collation != collations.Unknown:
+	default:
+		collation := env.DefaultCollation
+		if collation == collations.Unknown {
+			collation = collations.Default()
+		}
+		er.makeTextual(collation)
+	}
+}
+
+// transformText applies the given transformation to the text of the argument of a string function.
+// Nonbinary strings are transformed as utf8mb4, regardless of their character set, so the
+// transformation can work on runes.
+func transformText(arg *EvalResult, transform func([]byte) []byte) []byte {
+	if arg.typeof() == sqltypes.VarBinary {
+		return transform(arg.bytes())
+	}
+
+	env := collations.Local()
+	collation := env.LookupByID(arg.collation().Collation)
+	utf8mb4 := env.LookupByID(collations.CollationUtf8mb4ID)
+
+	text, err := collations.Convert(nil, utf8mb4, arg.bytes(), collation)
+	if err != nil {
+		throwEvalError(err)
+	}
+	text, err = collations.Convert(nil, collation, transform(text), utf8mb4)
+	if err != nil {
+		throwEvalError(err)
+	}
+	return text
+}
+
+// setStringResult sets the result of a string function of the same kind as its argument
+func setStringResult(result, arg *EvalResult, raw []byte) {
+	if arg.typeof() == sqltypes.VarBinary {
+		result.setRaw(sqltypes.VarBinary, raw, collationBinary)
+		return
+	}
+	result.setRaw(sqltypes.VarChar, raw, arg.collation())
+}
+
+// mergeStringArguments converts the given arguments to strings with a common collation, and returns
+// the type and collation of the result. If any of the arguments is binary, the result is binary.
+func mergeStringArguments(env *ExpressionEnv, args []EvalResult) (sqltypes.Type, collations.TypedCollation) {
+	for i := range args {
+		args[i].makeStringArgument(env)
+	}
+	for i := range args {
+		if args[i].typeof() == sqltypes.VarBinary {
+			for j := range args {
+				args[j].makeBinary()
+			}
+			return sqltypes.VarBinary, collationBinary
+		}
+	}
+
+	merged := args[0].collation()
+	for i := 1; i < len(args); i++ {
+		if merged.Collation == args[i].collation().Collation {
+			continue
+		}
+		mc, _, _, err := collations.Local().MergeCollations(merged, args[i].collation(), collations.CoercionOptions{
+			ConvertToSuperset:   true,
+			ConvertWithCoercion: true,
+		})
+		if err != nil {
+			throwEvalError(err)
+		}
+		merged = mc
+	}
+
+	collationEnv := collations.Local()
+	to := collationEnv.LookupByID(merged.Collation)
+	for i := range args {
+		if args[i].collation().Collation == merged.Collation {
+			continue
+		}
+		from := collationEnv.LookupByID(args[i].collation().Collation)
+		converted, err := collations.Convert(nil, to, args[i].bytes(), from)
+		if err != nil {
+			throwEvalError(err)
+		}
+		args[i].setRaw(sqltypes.VarChar, converted, merged)
+	}
+	return sqltypes.VarChar, merged
+}
+
+// mergedStringType returns the type of the result of a string function with several arguments
+func mergedStringType(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	var flags flag
+	tt := sqltypes.VarChar
+	for _, arg := range args {
+		t, f := arg.typeof(env)
+		flags |= nullableFlags(f)
+		if sqltypes.IsBinary(t) {
+			tt = sqltypes.VarBinary
+		}
+	}
+	return tt, flags
+}
+
+type builtinConcat struct{}
+
+func (builtinConcat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].null() {
+			result.setNull()
+			return
+		}
+	}
+
+	tt, collation := mergeStringArguments(env, args)
+	var concat []byte
+	for i := range args {
+		concat = append(concat, args[i].bytes()...)
+	}
+	result.setRaw(tt, concat, collation)
+}
+
+func (builtinConcat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 1 {
+		throwArgError("CONCAT")
+	}
+	return mergedStringType(env, args)
+}
+
+type builtinChangeCase struct {
+	upper bool
+}
+
+func (c builtinChangeCase) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	arg.makeStringArgument(env)
+	if arg.typeof() == sqltypes.VarBinary {
+		// the case of binary strings is never changed
+		setStringResult(result, arg, arg.bytes())
+		return
+	}
+
+	changeCase := bytes.ToLower
+	if c.upper {
+		changeCase = bytes.ToUpper
+	}
+	setStringResult(result, arg, transformText(arg, changeCase))
+}
+
+func (c builtinChangeCase) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		if c.upper {
+			throwArgError("UPPER")
+		}
+		throwArgError("LOWER")
+	}
+	t, f := args[0].typeof(env)
+	return stringType(t), nullableFlags(f)
+}
+
+type builtinLength struct {
+	chars bool
+}
+
+func (l builtinLength) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	arg.makeStringArgument(env)
+	if !l.chars || arg.typeof() == sqltypes.VarBinary {
+		result.setInt64(int64(len(arg.bytes())))
+		return
+	}
+
+	var count int
+	transformText(arg, func(text []byte) []byte {
+		count = utf8.RuneCount(text)
+		return text
+	})
+	result.setInt64(int64(count))
+}
+
+func (l builtinLength) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		if l.chars {
+			throwArgError("CHAR_LENGTH")
+		}
+		throwArgError("LENGTH")
+	}
+	_, f := args[0].typeof(env)
+	return sqltypes.Int64, nullableFlags(f)
+}
+
+type builtinTrim struct {
+	name     string
+	leading  bool
+	trailing bool
+}
+
+func (t builtinTrim) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.null() {
+		result.setNull()
+		return
+	}
+
+	arg.makeStringArgument(env)
+	setStringResult(result, arg, transformText(arg, func(text []byte) []byte {
+		if t.leading {
+			text = bytes.TrimLeft(text, " ")
+		}
+		if t.trailing {
+			text = bytes.TrimRight(text, " ")
+		}
+		return text
+	}))
+}
+
+func (t builtinTrim) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(t.name)
+	}
+	tt, f := args[0].typeof(env)
+	return stringType(tt), nullableFlags(f)
+}
+
+type builtinReplace struct{}
+
+func (builtinReplace) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].null() {
+			result.setNull()
+			return
+		}
+	}
+
+	tt, collation := mergeStringArguments(env, args)
+	str, from, to := &args[0], args[1].bytes(), args[2].bytes()
+	if len(from) == 0 {
+		result.setRaw(tt, str.bytes(), collation)
+		return
+	}
+
+	// the replacement is case-sensitive, regardless of the collation
+	replaced := transformText(str, func(text []byte) []byte {
+		if tt == sqltypes.VarBinary {
+			return bytes.ReplaceAll(text, from, to)
+		}
+		return bytes.ReplaceAll(text, utf8mb4Text(from, collation.Collation), utf8mb4Text(to, collation.Collation))
+	})
+	result.setRaw(tt, replaced, collation)
+}
+
+func (builtinReplace) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError("REPLACE")
+	}
+	return mergedStringType(env, args)
+}
+
+// utf8mb4Text converts text in the given collation to utf8mb4
+func utf8mb4Text(text []byte, collation collations.ID) []byte {
+	env := collations.Local()
+	converted, err := collations.Convert(nil, env.LookupByID(collations.CollationUtf8mb4ID), text, env.LookupByID(collation))
+	if err != nil {
+		throwEvalError(err)
+	}
+	return converted
+}
+
+type builtinSubstring struct{}
+
+func (builtinSubstring) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].null() {
+			result.setNull()
+			return
+		}
+	}
+
+	str := &args[0]
+	str.makeStringArgument(env)
+
+	pos := &args[1]
+	pos.makeSignedIntegral()
+	length := int64(-1)
+	if len(args) == 3 {
+		args[2].makeSignedIntegral()
+		length = args[2].int64()
+	}
+
+	setStringResult(result, str, transformText(str, func(text []byte) []byte {
+		if str.typeof() == sqltypes.VarBinary {
+			start, end := substringBounds(int64(len(text)), pos.int64(), length)
+			return text[start:end]
+		}
+		runes := bytes.Runes(text)
+		start, end := substringBounds(int64(len(runes)), pos.int64(), length)
+		return []byte(string(runes[start:end]))
+	}))
+}
+
+// substringBounds returns the bounds of a substring of a string with n characters, starting
+// at the 1-based position pos, counted from the end when it is negative, and with at most
+// length characters, or up to the end of the string if length is negative
+func substringBounds(n, pos, length int64) (start, end int64) {
+	switch {
+	case pos > 0 && pos <= n:
+		start = pos - 1
+	case pos < 0 && -pos <= n:
+		start = n + pos
+	default:
+		return 0, 0
+	}
+	end = n
+	if length >= 0 && start+length < n {
+		end = start + length
+	}
+	return start, end
+}
+
+func (builtinSubstring) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && len(args) != 3 {
+		throwArgError("SUBSTRING")
+	}
+	tt, f := args[0].typeof(env)
+	for _, arg := range args[1:] {
+		_, af := arg.typeof(env)
+		f |= af
+	}
+	return stringType(tt), nullableFlags(f)
+}
+
+func translateSubstrExpr(substr *sqlparser.SubstrExpr, lookup TranslationLookup) (Expr, error) {
+	var args TupleExpr
+	for _, expr := range []sqlparser.Expr{substr.Name, substr.From, substr.To} {
+		if expr == nil {
+			continue
+		}
+		arg, err := translateExpr(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    "substring",
+		F:         builtinSubstring{},
+	}, nil
+}
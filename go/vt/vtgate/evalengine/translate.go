@@ -209,6 +209,20 @@ func translateLiteral(lit *sqlparser.Literal, lookup TranslationLookup) (*Litera
 }
 
 func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup) (Expr, error) {
+	switch binary.Operator {
+	case sqlparser.PlusOp:
+		if interval, ok := binary.Left.(*sqlparser.IntervalExpr); ok {
+			return translateDateAdd(binary.Right, interval, false, lookup)
+		}
+		if interval, ok := binary.Right.(*sqlparser.IntervalExpr); ok {
+			return translateDateAdd(binary.Left, interval, false, lookup)
+		}
+	case sqlparser.MinusOp:
+		if interval, ok := binary.Right.(*sqlparser.IntervalExpr); ok {
+			return translateDateAdd(binary.Left, interval, true, lookup)
+		}
+	}
+
 	left, err := translateExpr(binary.Left, lookup)
 	if err != nil {
 		return nil, err
@@ -231,6 +245,8 @@ func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup)
 		return &ArithmeticExpr{BinaryExpr: binaryExpr, Op: &OpMultiplication{}}, nil
 	case sqlparser.DivOp:
 		return &ArithmeticExpr{BinaryExpr: binaryExpr, Op: &OpDivision{}}, nil
+	case sqlparser.ModOp:
+		return &ArithmeticExpr{BinaryExpr: binaryExpr, Op: &OpModulo{}}, nil
 	case sqlparser.BitAndOp:
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitAnd{}}, nil
 	case sqlparser.BitOrOp:
@@ -241,6 +257,10 @@ func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup)
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftLeft{}}, nil
 	case sqlparser.ShiftRightOp:
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftRight{}}, nil
+	case sqlparser.JSONExtractOp:
+		return translateJSONExtract(binaryExpr, false), nil
+	case sqlparser.JSONUnquoteExtractOp:
+		return translateJSONExtract(binaryExpr, true), nil
 	default:
 		return nil, translateExprNotSupported(binary)
 	}
@@ -318,6 +338,13 @@ func translateIntroducerExpr(introduced *sqlparser.IntroducerExpr, lookup Transl
 }
 
 func translateFuncExpr(fn *sqlparser.FuncExpr, lookup TranslationLookup) (Expr, error) {
+	switch fn.Name.Lowered() {
+	case "date_add", "adddate":
+		return translateDateAddFuncExpr(fn, false, lookup)
+	case "date_sub", "subdate":
+		return translateDateAddFuncExpr(fn, true, lookup)
+	}
+
 	var args TupleExpr
 	var aliases []sqlparser.ColIdent
 	for _, expr := range fn.Exprs {
@@ -542,6 +569,14 @@ func translateExpr(e sqlparser.Expr, lookup TranslationLookup) (Expr, error) {
 		return translateConvertExpr(node, lookup)
 	case *sqlparser.ConvertUsingExpr:
 		return translateConvertUsingExpr(node, lookup)
+	case *sqlparser.SubstrExpr:
+		return translateSubstrExpr(node, lookup)
+	case *sqlparser.CaseExpr:
+		return translateCaseExpr(node, lookup)
+	case *sqlparser.CurTimeFuncExpr:
+		return translateCurTimeFuncExpr(node, lookup)
+	case *sqlparser.TimestampFuncExpr:
+		return translateTimestampFuncExpr(node, lookup)
 	default:
 		return nil, translateExprNotSupported(e)
 	}
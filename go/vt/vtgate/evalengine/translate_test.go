@@ -294,6 +294,184 @@ func TestEvaluate(t *testing.T) {
 	}
 }
 
+func TestEvaluateFunctions(t *testing.T) {
+	type testCase struct {
+		expression string
+		expected   sqltypes.Value
+	}
+
+	tests := []testCase{{
+		expression: "concat('foo', 'bar', 42)",
+		expected:   sqltypes.NewVarChar("foobar42"),
+	}, {
+		expression: "concat('foo', null)",
+		expected:   NULL,
+	}, {
+		expression: "concat('foo', _binary'bar')",
+		expected:   sqltypes.NewVarBinary("foobar"),
+	}, {
+		expression: "upper('abcñ')",
+		expected:   sqltypes.NewVarChar("ABCÑ"),
+	}, {
+		expression: "lower(_binary'ABC')",
+		expected:   sqltypes.NewVarBinary("ABC"),
+	}, {
+		expression: "length('ñ')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "char_length('ñ')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "trim('  foo  ')",
+		expected:   sqltypes.NewVarChar("foo"),
+	}, {
+		expression: "ltrim('  foo  ')",
+		expected:   sqltypes.NewVarChar("foo  "),
+	}, {
+		expression: "replace('www.mysql.com', 'w', 'Ww')",
+		expected:   sqltypes.NewVarChar("WwWwWw.mysql.com"),
+	}, {
+		expression: "substring('Quadratically', 5)",
+		expected:   sqltypes.NewVarChar("ratically"),
+	}, {
+		expression: "substring('Sakila' from -4 for 2)",
+		expected:   sqltypes.NewVarChar("ki"),
+	}, {
+		expression: "substr('ñandú', 2, 3)",
+		expected:   sqltypes.NewVarChar("and"),
+	}, {
+		expression: "abs(-42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "abs(-1.5)",
+		expected:   sqltypes.NewDecimal("1.5"),
+	}, {
+		expression: "floor(-1.5)",
+		expected:   sqltypes.NewDecimal("-2"),
+	}, {
+		expression: "ceil(1.23)",
+		expected:   sqltypes.NewDecimal("2"),
+	}, {
+		expression: "round(1.2345, 2)",
+		expected:   sqltypes.NewDecimal("1.23"),
+	}, {
+		expression: "round(-1.5)",
+		expected:   sqltypes.NewDecimal("-2"),
+	}, {
+		expression: "round(1234, -2)",
+		expected:   sqltypes.NewInt64(1200),
+	}, {
+		expression: "round(2.5e0)",
+		expected:   sqltypes.NewFloat64(2),
+	}, {
+		expression: "mod(-7, 3)",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "7 % 0",
+		expected:   NULL,
+	}, {
+		expression: "mod(7.5, 2)",
+		expected:   sqltypes.NewDecimal("1.5"),
+	}, {
+		expression: "date_format('2022-01-02 15:04:05', '%Y/%m/%d %H:%i:%s %W %D %U %v %x')",
+		expected:   sqltypes.NewVarChar("2022/01/02 15:04:05 Sunday 2nd 01 52 2021"),
+	}, {
+		expression: "date_format('not a date', '%Y')",
+		expected:   NULL,
+	}, {
+		expression: "date_add('2022-01-31', interval 1 month)",
+		expected:   sqltypes.NewVarChar("2022-02-28"),
+	}, {
+		expression: "date_sub('2022-03-01 00:00:00', interval '1 1:30' day_minute)",
+		expected:   sqltypes.NewVarChar("2022-02-27 22:30:00"),
+	}, {
+		expression: "'2020-02-29' + interval 1 year",
+		expected:   sqltypes.NewVarChar("2021-02-28"),
+	}, {
+		expression: "adddate('2022-01-01', 31)",
+		expected:   sqltypes.NewVarChar("2022-02-01"),
+	}, {
+		expression: "timestampdiff(month, '2022-01-31', '2022-02-28')",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "timestampdiff(month, '2022-01-31', '2022-03-31')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "timestampdiff(day, '2022-03-01', '2022-01-01')",
+		expected:   sqltypes.NewInt64(-59),
+	}, {
+		expression: "timestampadd(minute, 90, '2022-01-01 00:00:00')",
+		expected:   sqltypes.NewVarChar("2022-01-01 01:30:00"),
+	}, {
+		expression: `json_extract('{"a": [1, 2.5, {"b": "c"}]}', '$.a[2].b')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`"c"`)),
+	}, {
+		expression: `json_extract('{"a": [1, 2.5, {"b": "c"}]}', '$.a[last]', '$.a[0]')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[{"b": "c"}, 1]`)),
+	}, {
+		expression: `json_extract('{"bb": 1, "a": {"b": 2}}', '$**.b')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[2]`)),
+	}, {
+		expression: `json_extract('[1, 2]', '$[5]')`,
+		expected:   NULL,
+	}, {
+		expression: `json_unquote(json_extract('{"a": "b"}', '$.a'))`,
+		expected:   sqltypes.NewVarChar("b"),
+	}, {
+		expression: "case when 1 = 2 then 'a' when 2 = 2 then 'b' end",
+		expected:   sqltypes.NewVarChar("b"),
+	}, {
+		expression: "case 3 when 1 then 'a' else 'c' end",
+		expected:   sqltypes.NewVarChar("c"),
+	}, {
+		expression: "case 3 when 1 then 'a' end",
+		expected:   NULL,
+	}, {
+		expression: "case when 1 then 1 else 2.5 end",
+		expected:   sqltypes.NewDecimal("1"),
+	}, {
+		expression: "if(null, 1, 2)",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "if(1 < 2, 'yes', 'no')",
+		expected:   sqltypes.NewVarChar("yes"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Translate(astExpr, LookupDefaultCollation(45))
+			require.NoError(t, err)
+
+			r, err := EmptyExpressionEnv().Evaluate(expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value(), "expected %s", test.expected.String())
+		})
+	}
+}
+
+func TestEvaluateNow(t *testing.T) {
+	for _, expression := range []string{"now()", "current_timestamp(3)", "curdate()", "utc_time()", "now() + interval 1 day"} {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Translate(astExpr, LookupDefaultCollation(45))
+			require.NoError(t, err)
+
+			// the current time must not be folded into a literal when translating
+			_, isLiteral := expr.(*Literal)
+			assert.False(t, isLiteral)
+
+			r, err := EmptyExpressionEnv().Evaluate(expr)
+			require.NoError(t, err)
+			assert.NotEmpty(t, r.Value().ToString())
+		})
+	}
+}
+
 func TestEvaluateTuple(t *testing.T) {
 	type testCase struct {
 		expression string
@@ -219,6 +219,14 @@ func (a *vstreamAdapter) Recv() ([]*binlogdatapb.VEvent, error) {
 	return r.Events, nil
 }
 
+func (a *vstreamAdapter) RecvChangeEvents() ([]*binlogdatapb.VEvent, [][]byte, error) {
+	r, err := a.stream.Recv()
+	if err != nil {
+		return nil, nil, vterrors.FromGRPC(err)
+	}
+	return r.Events, r.ChangeEvents, nil
+}
+
 func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
 
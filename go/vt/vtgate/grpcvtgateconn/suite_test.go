@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/debezium"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
@@ -250,7 +251,10 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 }
 
 func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
-	panic("unimplemented")
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	return send(vstreamEvents)
 }
 
 // CreateFakeServer returns the fake server for the tests
@@ -295,6 +299,7 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testStreamExecute(t, session)
 	testExecuteBatch(t, session)
 	testPrepare(t, session)
+	testVStream(t, conn)
 
 	// force a panic at every call, then test that works
 	fs.panics = true
@@ -603,3 +608,68 @@ var streamResultFields = sqltypes.Result{
 }
 
 var dtid2 = "aa"
+
+func testVStream(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Gtid: "current"}}}
+	reader, err := conn.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, nil)
+	require.NoError(t, err)
+	events, err := reader.Recv()
+	require.NoError(t, err)
+	require.Len(t, events, len(vstreamEvents))
+	for i, event := range events {
+		require.True(t, proto.Equal(vstreamEvents[i], event), "got %v, want %v", event, vstreamEvents[i])
+	}
+
+	// The row changes are rendered by the server in the DEBEZIUM format.
+	reader, err = conn.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{Format: vtgatepb.VStreamFormat_DEBEZIUM})
+	require.NoError(t, err)
+	events, changeEvents, err := reader.(vtgateconn.VStreamChangeEventReader).RecvChangeEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.True(t, proto.Equal(vstreamEvents[2], events[0]), "got %v, want %v", events[0], vstreamEvents[2])
+	require.Len(t, changeEvents, 1)
+	require.Contains(t, string(changeEvents[0]), `"before":null,"after":{"id":1},`)
+	require.Contains(t, string(changeEvents[0]), `"gtid":"MySQL56/uuid:1-10"},"op":"c"`)
+
+	reader, err = conn.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{Format: vtgatepb.VStreamFormat_DEBEZIUM})
+	require.NoError(t, err)
+	changeEventReader, err := debezium.NewReader(reader)
+	require.NoError(t, err)
+	changeEvent, err := changeEventReader.Recv()
+	require.NoError(t, err)
+	require.Contains(t, string(changeEvent), `"before":null,"after":{"id":1},`)
+	_, err = changeEventReader.Recv()
+	require.Equal(t, io.EOF, err)
+}
+
+var vstreamEvents = []*binlogdatapb.VEvent{{
+	Type:     binlogdatapb.VEventType_FIELD,
+	Keyspace: "ks",
+	Shard:    "-80",
+	FieldEvent: &binlogdatapb.FieldEvent{
+		TableName: "ks.t1",
+		Fields:    []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+	},
+}, {
+	Type:     binlogdatapb.VEventType_ROW,
+	Keyspace: "ks",
+	Shard:    "-80",
+	RowEvent: &binlogdatapb.RowEvent{
+		TableName:  "ks.t1",
+		RowChanges: []*binlogdatapb.RowChange{{After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})}},
+	},
+}, {
+	Type:     binlogdatapb.VEventType_VGTID,
+	Keyspace: "ks",
+	Shard:    "-80",
+	Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+		Keyspace: "ks",
+		Shard:    "-80",
+		Gtid:     "MySQL56/uuid:1-10",
+	}}},
+}, {
+	Type:     binlogdatapb.VEventType_COMMIT,
+	Keyspace: "ks",
+	Shard:    "-80",
+}}
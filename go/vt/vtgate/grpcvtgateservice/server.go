@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate"
+	"vitess.io/vitess/go/vt/vtgate/debezium"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -237,16 +238,20 @@ func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservic
 	if tabletType == topodatapb.TabletType_UNKNOWN {
 		tabletType = topodatapb.TabletType_PRIMARY
 	}
+	send := func(events []*binlogdatapb.VEvent) error {
+		return stream.Send(&vtgatepb.VStreamResponse{
+			Events: events,
+		})
+	}
+	if request.Flags.GetFormat() == vtgatepb.VStreamFormat_DEBEZIUM {
+		send = debezium.ResponseSender(stream.Send)
+	}
 	vtgErr := vtg.server.VStream(ctx,
 		tabletType,
 		request.Vgtid,
 		request.Filter,
 		request.Flags,
-		func(events []*binlogdatapb.VEvent) error {
-			return stream.Send(&vtgatepb.VStreamResponse{
-				Events: events,
-			})
-		})
+		send)
 	return vterrors.ToGRPC(vtgErr)
 }
 
@@ -83,6 +83,9 @@ func getOperatorFromTableExpr(tableExpr sqlparser.TableExpr, semTable *semantics
 			qg.Tables = append(qg.Tables, qt)
 			return qg, nil
 		case *sqlparser.DerivedTable:
+			if cte, isCTE := semTable.RecursiveCTEs[tbl]; isCTE {
+				return &RecursiveCTE{ID: semTable.TableSetFor(tableExpr), Alias: tableExpr.As.String(), CTE: cte}, nil
+			}
 			inner, err := CreateOperatorFromAST(tbl.Select, semTable)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package abstract
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+// RecursiveCTE represents the rows of a recursive common table expression,
+// which are computed at the vtgate level. Nothing can be pushed into it,
+// so the predicates using it are kept in a Filter on top of it.
+type RecursiveCTE struct {
+	ID    semantics.TableSet
+	Alias string
+	CTE   *engine.RecursiveCTE
+}
+
+var _ LogicalOperator = (*RecursiveCTE)(nil)
+
+func (*RecursiveCTE) iLogical() {}
+
+// TableID implements the Operator interface
+func (r *RecursiveCTE) TableID() semantics.TableSet {
+	return r.ID
+}
+
+// PushPredicate implements the Operator interface
+func (r *RecursiveCTE) PushPredicate(expr sqlparser.Expr, _ *semantics.SemTable) (LogicalOperator, error) {
+	return &Filter{
+		Source:     r,
+		Predicates: []sqlparser.Expr{expr},
+	}, nil
+}
+
+// UnsolvedPredicates implements the Operator interface
+func (r *RecursiveCTE) UnsolvedPredicates(*semantics.SemTable) []sqlparser.Expr {
+	return nil
+}
+
+// CheckValid implements the Operator interface
+func (r *RecursiveCTE) CheckValid() error {
+	return nil
+}
+
+// Compact implements the Operator interface
+func (r *RecursiveCTE) Compact(*semantics.SemTable) (LogicalOperator, error) {
+	return r, nil
+}
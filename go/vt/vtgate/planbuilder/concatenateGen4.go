@@ -21,6 +21,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
 
@@ -114,3 +115,33 @@ func (c *concatenateGen4) Inputs() []logicalPlan {
 func (c *concatenateGen4) OutputColumns() []sqlparser.SelectExpr {
 	return c.sources[0].OutputColumns()
 }
+
+// pushWeightString adds the weight_string of a column returned by the concatenate.
+// The column is already projected by all sources, so the weight_string is computed
+// by every source on its own expression at the same offset.
+func (c *concatenateGen4) pushWeightString(ctx *plancontext.PlanningContext, expr sqlparser.Expr, inner bool) (int, bool, error) {
+	offset, added, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: expr}, c.sources[0], inner, true, false)
+	if err != nil {
+		return 0, false, err
+	}
+	if added {
+		return 0, false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "pushing weight_string(%s) on concatenate should reference an existing column", sqlparser.String(expr))
+	}
+	wsOffset := -1
+	wsAdded := false
+	for _, source := range c.sources {
+		col, ok := source.OutputColumns()[offset].(*sqlparser.AliasedExpr)
+		if !ok {
+			return 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: weight_string on '%s' in a union", sqlparser.String(source.OutputColumns()[offset]))
+		}
+		sourceOffset, added, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: weightStringFor(col.Expr)}, source, inner, true, false)
+		if err != nil {
+			return 0, false, err
+		}
+		if wsOffset != -1 && (sourceOffset != wsOffset || added != wsAdded) {
+			return 0, false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] weight_string(%s) was pushed to different offsets in the union", sqlparser.String(expr))
+		}
+		wsOffset, wsAdded = sourceOffset, added
+	}
+	return wsOffset, wsAdded, nil
+}
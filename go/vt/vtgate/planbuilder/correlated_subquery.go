@@ -0,0 +1,291 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+type (
+	// correlatedSubquery is the logicalPlan for engine.CorrelatedSubquery.
+	// This gets built when a subquery depends on the outer query and can't
+	// be merged with it. The subquery is then evaluated at the vtgate level
+	// for the rows of the outer query, and so are all the expressions using it.
+	correlatedSubquery struct {
+		gen4Plan
+		outer, inner logicalPlan
+		extracted    *sqlparser.ExtractedSubquery
+		eSubquery    *engine.CorrelatedSubquery
+
+		// exprs are the expressions evaluated at the vtgate level, in the same order as eSubquery.Exprs
+		exprs []sqlparser.Expr
+	}
+
+	// correlatedColumnLookup resolves the columns of the expressions evaluated
+	// by a correlatedSubquery by pushing them to the outer side. These expressions
+	// are copies in which the subqueries have been replaced by their bind variables,
+	// so the columns are matched against the columns of the original expression.
+	correlatedColumnLookup struct {
+		simpleConverterLookup
+		columns []*sqlparser.ColName
+	}
+)
+
+var _ logicalPlan = (*correlatedSubquery)(nil)
+var _ evalengine.TranslationLookup = (*correlatedColumnLookup)(nil)
+
+// newCorrelatedSubquery builds a new correlatedSubquery.
+func newCorrelatedSubquery(ctx *plancontext.PlanningContext, op *physical.CorrelatedSubQueryOp, outer, inner logicalPlan) (*correlatedSubquery, error) {
+	cs := &correlatedSubquery{
+		outer:     outer,
+		inner:     inner,
+		extracted: op.Extracted,
+		eSubquery: &engine.CorrelatedSubquery{
+			Opcode:      engine.PulloutOpcode(op.Extracted.OpCode),
+			Vars:        op.Vars,
+			BatchVar:    op.BatchVar,
+			BatchColumn: op.BatchColumn,
+			Cols:        op.Columns,
+		},
+	}
+	if cs.eSubquery.Opcode == engine.PulloutExists {
+		cs.eSubquery.HasValues = op.Extracted.GetArgName()
+	} else {
+		cs.eSubquery.SubqueryResult = op.Extracted.GetArgName()
+		cs.eSubquery.HasValues = op.Extracted.GetHasValuesArg()
+	}
+	if op.Predicate != nil {
+		predicate, err := cs.translate(ctx, op.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		cs.eSubquery.Predicate = predicate
+		cs.eSubquery.ASTPredicate = op.Predicate
+	}
+	return cs, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (cs *correlatedSubquery) Primitive() engine.Primitive {
+	cs.eSubquery.Outer = cs.outer.Primitive()
+	cs.eSubquery.Subquery = cs.inner.Primitive()
+	return cs.eSubquery
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (cs *correlatedSubquery) WireupGen4(semTable *semantics.SemTable) error {
+	if err := cs.outer.WireupGen4(semTable); err != nil {
+		return err
+	}
+	return cs.inner.WireupGen4(semTable)
+}
+
+// Rewrite implements the logicalPlan interface
+func (cs *correlatedSubquery) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "correlatedSubquery: wrong number of inputs")
+	}
+	cs.outer = inputs[0]
+	cs.inner = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (cs *correlatedSubquery) ContainsTables() semantics.TableSet {
+	return cs.outer.ContainsTables().Merge(cs.inner.ContainsTables())
+}
+
+// Inputs implements the logicalPlan interface
+func (cs *correlatedSubquery) Inputs() []logicalPlan {
+	return []logicalPlan{cs.outer, cs.inner}
+}
+
+// OutputColumns implements the logicalPlan interface
+func (cs *correlatedSubquery) OutputColumns() []sqlparser.SelectExpr {
+	outerColumns := cs.outer.OutputColumns()
+	columns := make([]sqlparser.SelectExpr, 0, len(cs.eSubquery.Cols))
+	for _, col := range cs.eSubquery.Cols {
+		if col < 0 {
+			columns = append(columns, outerColumns[-col-1])
+			continue
+		}
+		columns = append(columns, &sqlparser.AliasedExpr{
+			Expr: sqlparser.NewColName(cs.eSubquery.ExprColumns[col-1]),
+		})
+	}
+	return columns
+}
+
+// pushProjection adds the expression to the columns returned by the correlatedSubquery.
+// Expressions using the subquery are evaluated at the vtgate level, and all others are pushed to the outer side.
+func (cs *correlatedSubquery) pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExpr, inner, reuseCol, hasAggregation bool) (offset int, added bool, err error) {
+	if hasAggregation {
+		return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on top of a cross-shard correlated subquery")
+	}
+	if !cs.usesSubquery(expr.Expr) {
+		passDownReuseCol := reuseCol
+		if !reuseCol {
+			passDownReuseCol = expr.As.IsEmpty()
+		}
+		offset, added, err := pushProjection(ctx, expr, cs.outer, inner, passDownReuseCol, hasAggregation)
+		if err != nil {
+			return 0, false, err
+		}
+		column := -(offset + 1)
+		if reuseCol && !added {
+			for idx, col := range cs.eSubquery.Cols {
+				if column == col {
+					return idx, false, nil
+				}
+			}
+		}
+		cs.eSubquery.Cols = append(cs.eSubquery.Cols, column)
+		return len(cs.eSubquery.Cols) - 1, true, nil
+	}
+
+	if reuseCol {
+		for i, e := range cs.exprs {
+			if !sqlparser.EqualsExpr(e, expr.Expr) {
+				continue
+			}
+			for idx, col := range cs.eSubquery.Cols {
+				if col == i+1 {
+					return idx, false, nil
+				}
+			}
+		}
+	}
+	evalExpr, err := cs.translate(ctx, expr.Expr)
+	if err != nil {
+		return 0, false, err
+	}
+	cs.exprs = append(cs.exprs, expr.Expr)
+	cs.eSubquery.Exprs = append(cs.eSubquery.Exprs, evalExpr)
+	cs.eSubquery.ExprColumns = append(cs.eSubquery.ExprColumns, subqueryColumnName(expr))
+	cs.eSubquery.Cols = append(cs.eSubquery.Cols, len(cs.eSubquery.Exprs))
+	return len(cs.eSubquery.Cols) - 1, true, nil
+}
+
+// usesSubquery returns true if the expression needs the result of the subquery to be evaluated
+func (cs *correlatedSubquery) usesSubquery(expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		// we are comparing the ArgNames in case the expressions have been cloned
+		if es, ok := node.(*sqlparser.ExtractedSubquery); ok && es.GetArgName() == cs.extracted.GetArgName() {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+// translate turns an expression using the subquery into an evalengine expression,
+// that is evaluated against the rows of the outer side.
+func (cs *correlatedSubquery) translate(ctx *plancontext.PlanningContext, expr sqlparser.Expr) (evalengine.Expr, error) {
+	// the columns of the outer query are the ones found outside of the subqueries,
+	// and the ones they are compared to
+	var columns []*sqlparser.ColName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			columns = append(columns, node)
+		case *sqlparser.ExtractedSubquery:
+			if col, ok := node.OtherSide.(*sqlparser.ColName); ok {
+				columns = append(columns, col)
+			} else if node.OtherSide != nil {
+				_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+					if col, ok := node.(*sqlparser.ColName); ok {
+						columns = append(columns, col)
+					}
+					return true, nil
+				}, node.OtherSide)
+			}
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+
+	// every subquery is replaced by the expression using its bind variables
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		if es, ok := cursor.Node().(*sqlparser.ExtractedSubquery); ok {
+			cursor.Replace(sqlparser.CloneExpr(es.GetAlternative()))
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+
+	lookup := &correlatedColumnLookup{
+		simpleConverterLookup: simpleConverterLookup{
+			ctx:               ctx,
+			plan:              cs.outer,
+			canPushProjection: true,
+		},
+		columns: columns,
+	}
+	return evalengine.Translate(rewritten, lookup)
+}
+
+// subqueryColumnName returns the name of the column produced by an expression using a subquery
+func subqueryColumnName(expr *sqlparser.AliasedExpr) string {
+	if !expr.As.IsEmpty() {
+		return expr.As.String()
+	}
+	original := sqlparser.Rewrite(sqlparser.CloneExpr(expr.Expr), func(cursor *sqlparser.Cursor) bool {
+		if es, ok := cursor.Node().(*sqlparser.ExtractedSubquery); ok {
+			cursor.Replace(es.Original)
+			return false
+		}
+		return true
+	}, nil)
+	return sqlparser.String(original)
+}
+
+// ColumnLookup implements the TranslationLookup interface
+func (l *correlatedColumnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	original, err := l.original(col)
+	if err != nil {
+		return 0, err
+	}
+	return l.simpleConverterLookup.ColumnLookup(original)
+}
+
+// CollationForExpr implements the TranslationLookup interface
+func (l *correlatedColumnLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		if original, err := l.original(col); err == nil {
+			expr = original
+		}
+	}
+	return l.simpleConverterLookup.CollationForExpr(expr)
+}
+
+func (l *correlatedColumnLookup) original(col *sqlparser.ColName) (*sqlparser.ColName, error) {
+	for _, column := range l.columns {
+		if sqlparser.EqualsRefOfColName(col, column) {
+			return column, nil
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] column %s not found in the outer query", sqlparser.String(col))
+}
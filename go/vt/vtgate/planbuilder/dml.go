@@ -23,6 +23,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -277,12 +279,12 @@ func costForDML(foundVindex *vindexes.ColumnVindex, opcode engine.Opcode) costDM
 	}
 }
 
-func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, error) {
+func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, []*engine.PulloutSubquery, error) {
 	edml := engine.NewDML()
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 	rb, err := pb.processDMLTable(tableExprs, reservedVars, nil)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
@@ -293,17 +295,25 @@ func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.St
 		if pb.finalizeUnshardedDMLSubqueries(reservedVars, subqueryArgs...) {
 			vschema.WarnUnshardedOnly("subqueries can't be sharded in DML")
 		} else {
-			return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, nil
+		return edml, nil, nil, nil
+	}
+
+	var pullouts []*engine.PulloutSubquery
+	if vschema.Planner() != V3 && hasSubquery(stmt) {
+		pullouts, err = planDMLSubqueriesGen4(stmt, reservedVars, vschema)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -318,7 +328,7 @@ func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.St
 	edml.QueryTimeout = queryTimeout(directives)
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
 	}
 	for _, tval := range pb.st.tables {
 		// There is only one table.
@@ -327,29 +337,125 @@ func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.St
 
 	routingType, ksidVindex, vindex, values, err := getDMLRouting(where, edml.Table)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_PRIMARY {
-			return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, nil
+		return edml, ksidVindex, pullouts, nil
 	}
 
 	edml.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
 		}
 	} else {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
 
-	return edml, ksidVindex, nil
+	return edml, ksidVindex, pullouts, nil
+}
+
+// planDMLSubqueriesGen4 plans the subqueries of a sharded DML statement with the Gen4 planner.
+// The semantic analysis binds the columns of the statement, and a subquery that depends on the
+// tables of the DML is correlated, so it can't be pulled out. The other subqueries are planned
+// as operators, and replaced in the AST by the bind variables that the returned PulloutSubquery
+// primitives populate before the DML is executed.
+func planDMLSubqueriesGen4(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) ([]*engine.PulloutSubquery, error) {
+	ksName := ""
+	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	semTable, err := semantics.Analyze(stmt, ksName, vschema)
+	if err != nil {
+		return nil, err
+	}
+	vschema.PlannerWarning(semTable.Warning)
+
+	extracted := semTable.SubqueryMap[stmt]
+	for _, sq := range extracted {
+		if semTable.RecursiveDeps(sq.Subquery).NumberOfTables() > 0 {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery in DML")
+		}
+	}
+	err = queryRewrite(semTable, reservedVars, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := plancontext.NewPlanningContext(reservedVars, semTable, vschema, vschema.Planner())
+	pullouts := make([]*engine.PulloutSubquery, 0, len(extracted))
+	for _, sq := range extracted {
+		inner, err := planSubqueryGen4(ctx, sq.Subquery.Select)
+		if err != nil {
+			return nil, err
+		}
+		pullout := &engine.PulloutSubquery{
+			Opcode:         engine.PulloutOpcode(sq.OpCode),
+			SubqueryResult: sq.GetArgName(),
+			HasValues:      sq.GetHasValuesArg(),
+			Subquery:       inner.Primitive(),
+		}
+		if pullout.Opcode == engine.PulloutExists {
+			// the argument of an EXISTS subquery tells if it has values
+			pullout.SubqueryResult, pullout.HasValues = "", sq.GetArgName()
+		}
+		pullouts = append(pullouts, pullout)
+	}
+
+	// The routing of the DML is planned on the bind variables that replace the subqueries.
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		if sq, ok := cursor.Node().(*sqlparser.ExtractedSubquery); ok {
+			cursor.Replace(sq.GetAlternative())
+			return false
+		}
+		return true
+	}, nil)
+	return pullouts, nil
+}
+
+// planSubqueryGen4 plans a subquery that has been extracted by the semantic analysis.
+func planSubqueryGen4(ctx *plancontext.PlanningContext, sel sqlparser.SelectStatement) (logicalPlan, error) {
+	logical, err := abstract.CreateOperatorFromAST(sel, ctx.SemTable)
+	if err != nil {
+		return nil, err
+	}
+	err = logical.CheckValid()
+	if err != nil {
+		return nil, err
+	}
+	physOp, err := physical.CreatePhysicalOperator(ctx, logical)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := transformToLogicalPlan(ctx, physOp)
+	if err != nil {
+		return nil, err
+	}
+	plan, err = planHorizon(ctx, plan, sel)
+	if err != nil {
+		return nil, err
+	}
+	if err := plan.WireupGen4(ctx.SemTable); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// wrapWithPullouts places the DML primitive underneath the subqueries that have been pulled out of it,
+// so that their results are available as bind variables when the DML is executed.
+func wrapWithPullouts(dml engine.Primitive, pullouts []*engine.PulloutSubquery) engine.Primitive {
+	for i := len(pullouts) - 1; i >= 0; i-- {
+		pullouts[i].Underlying = dml
+		dml = pullouts[i]
+	}
+	return dml
 }
 
 func generateDMLSubquery(tblExpr sqlparser.TableExpr, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent) string {
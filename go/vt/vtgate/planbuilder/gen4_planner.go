@@ -38,6 +38,9 @@ func gen4Planner(query string, plannerVersion querypb.ExecuteOptions_PlannerVers
 		}
 		switch node := selStatement.(type) {
 		case *sqlparser.Select:
+			if node.With != nil && node.With.Recursive {
+				return gen4PlanRecursiveCTE(node, reservedVars, vschema, plannerVersion)
+			}
 			if node.With != nil {
 				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
 			}
@@ -153,7 +156,18 @@ func newBuildSelectPlan(
 		return nil, semTable.NotUnshardedErr
 	}
 
-	err = queryRewrite(semTable, reservedVars, selStmt)
+	return buildSelectPlanWithSemTable(selStmt, semTable, reservedVars, vschema, version)
+}
+
+// buildSelectPlanWithSemTable plans a sharded query that has already gone through semantic analysis
+func buildSelectPlanWithSemTable(
+	selStmt sqlparser.SelectStatement,
+	semTable *semantics.SemTable,
+	reservedVars *sqlparser.ReservedVars,
+	vschema plancontext.VSchema,
+	version querypb.ExecuteOptions_PlannerVersion,
+) (logicalPlan, error) {
+	err := queryRewrite(semTable, reservedVars, selStmt)
 	if err != nil {
 		return nil, err
 	}
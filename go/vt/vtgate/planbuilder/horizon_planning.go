@@ -61,6 +61,10 @@ func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan lo
 
 	hp.qp = qp
 
+	if hasWindowFunctions(hp.sel) && !canPushWindowFunctions(ctx, plan, hp.sel) {
+		return hp.planWindowFunctions(ctx, plan)
+	}
+
 	needAggrOrHaving := hp.qp.NeedsAggregation() || hp.sel.Having != nil
 	canShortcut := isRoute && !needAggrOrHaving && len(hp.qp.OrderExprs) == 0
 
@@ -109,6 +113,10 @@ func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan lo
 		}
 	}
 
+	if !hp.qp.NeedsDistinct() {
+		plan = hp.planHashAggregation(plan)
+	}
+
 	plan, err = hp.planDistinct(ctx, plan)
 	if err != nil {
 		return nil, err
@@ -136,13 +144,17 @@ func pushProjections(ctx *plancontext.PlanningContext, plan logicalPlan, selectE
 }
 
 func (hp *horizonPlanning) truncateColumnsIfNeeded(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
+	if evaluatesAggregationExpressions(plan) {
+		// the evaluated expressions are not in the order of the select list
+		return hp.projectSelectExpressions(ctx, plan)
+	}
 	if len(plan.OutputColumns()) == hp.sel.GetColumnCount() {
 		return plan, nil
 	}
 	switch p := plan.(type) {
 	case *routeGen4:
 		p.eroute.SetTruncateColumnCount(hp.sel.GetColumnCount())
-	case *joinGen4, *semiJoin, *hashJoin:
+	case *joinGen4, *semiJoin, *hashJoin, *correlatedSubquery:
 		// since this is a join, we can safely add extra columns and not need to truncate them
 	case *orderedAggregate:
 		p.eaggr.SetTruncateColumnCount(hp.sel.GetColumnCount())
@@ -155,19 +167,37 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(ctx *plancontext.PlanningCont
 		}
 		p.underlying = newUnderlyingPlan
 	default:
-		plan = &simpleProjection{
-			logicalPlanCommon: newBuilderCommon(plan),
-			eSimpleProj:       &engine.SimpleProjection{},
-		}
+		return hp.projectSelectExpressions(ctx, plan)
+	}
+	return plan, nil
+}
 
-		err := pushProjections(ctx, plan, hp.qp.SelectExprs)
-		if err != nil {
-			return nil, err
-		}
+// projectSelectExpressions returns the columns of the select list in their order
+func (hp *horizonPlanning) projectSelectExpressions(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
+	plan = &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eSimpleProj:       &engine.SimpleProjection{},
+	}
+
+	err := pushProjections(ctx, plan, hp.qp.SelectExprs)
+	if err != nil {
+		return nil, err
 	}
 	return plan, nil
 }
 
+// evaluatesAggregationExpressions returns true if the rows of the plan
+// come from a projection evaluating expressions on aggregated rows
+func evaluatesAggregationExpressions(plan logicalPlan) bool {
+	switch node := plan.(type) {
+	case *projection:
+		return true
+	case *memorySort:
+		return evaluatesAggregationExpressions(node.input)
+	}
+	return false
+}
+
 // pushProjection pushes a projection to the plan.
 func pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExpr, plan logicalPlan, inner, reuseCol, hasAggregation bool) (offset int, added bool, err error) {
 	switch node := plan.(type) {
@@ -201,6 +231,12 @@ func pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExp
 		if err != nil {
 			return 0, false, err
 		}
+		if reuseCol {
+			// the rewritten expression might already be projected by the route
+			if i := checkIfAlreadyExists(expr, node.Select, ctx.SemTable); i != -1 {
+				return i, false, nil
+			}
+		}
 
 		offset := len(sel.SelectExprs)
 		sel.SelectExprs = append(sel.SelectExprs, expr)
@@ -382,10 +418,23 @@ func pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExp
 		}
 		node.cols = append(node.cols, column)
 		return len(node.cols) - 1, true, nil
+	case *correlatedSubquery:
+		return node.pushProjection(ctx, expr, inner, reuseCol, hasAggregation)
+	case *projection:
+		return node.pushProjection(ctx, expr, inner, reuseCol, hasAggregation)
+	case *recursiveCTE:
+		return node.pushProjection(ctx, expr, reuseCol)
+	case *memorySort:
+		return pushProjection(ctx, expr, node.input, inner, reuseCol, hasAggregation)
 	case *concatenateGen4:
 		if hasAggregation {
 			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
 		}
+		if fExpr, isFunc := expr.Expr.(*sqlparser.FuncExpr); isFunc && fExpr.Name.Lowered() == "weight_string" && len(fExpr.Exprs) == 1 {
+			if arg, ok := fExpr.Exprs[0].(*sqlparser.AliasedExpr); ok {
+				return node.pushWeightString(ctx, arg.Expr, inner)
+			}
+		}
 		offset, added, err := pushProjection(ctx, expr, node.sources[0], inner, reuseCol, hasAggregation)
 		if err != nil {
 			return 0, false, err
@@ -469,7 +518,8 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 	var oa *orderedAggregate
 	uniqVindex := hasUniqueVindex(ctx.VSchema, ctx.SemTable, hp.qp.GroupByExprs)
 	joinPlan := isJoin(plan)
-	if !uniqVindex || joinPlan {
+	rawRows := aggregatesOnRawRows(plan)
+	if !uniqVindex || joinPlan || rawRows {
 		if hp.qp.ProjectionError != nil {
 			return nil, hp.qp.ProjectionError
 		}
@@ -486,10 +536,14 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 		hp.vtgateGrouping = true
 	}
 
-	if joinPlan && hp.qp.HasAggr && len(hp.qp.GroupByExprs) > 0 {
+	havingAggr := hp.sel.Having != nil && sqlparser.ContainsAggregation(hp.sel.Having.Expr)
+	if joinPlan && (hp.qp.HasAggr || havingAggr) && len(hp.qp.GroupByExprs) > 0 {
 		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
 	}
 
+	// aggrExprs are the select expressions evaluated on the results of the ordered aggregate
+	var aggrExprs []*sqlparser.AliasedExpr
+
 	for _, e := range hp.qp.SelectExprs {
 		aliasExpr, err := e.GetAliasedExpr()
 		if err != nil {
@@ -505,28 +559,29 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 			continue
 		}
 
-		fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
-		if !isFunc {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
-		}
-		funcName := fExpr.Name.Lowered()
-		opcode, found := engine.SupportedAggregates[funcName]
-		if !found {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function '%s'", funcName)
+		if isAggregationExpression(aliasExpr.Expr) {
+			// the aggregations used by the expression are computed by the ordered aggregate,
+			// and the expression is evaluated on the aggregated rows
+			aggrExprs = append(aggrExprs, aliasExpr)
+			err = hp.planAggregationsOf(ctx, plan, oa, expandAverages(aliasExpr.Expr), rawRows)
+			if err != nil {
+				return nil, err
+			}
+			continue
 		}
-		handleDistinct, innerAliased, err := hp.needDistinctHandling(ctx, fExpr, opcode, plan)
+
+		err = hp.planAggregation(ctx, plan, oa, e, aliasExpr, rawRows)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		pushExpr, param := hp.createPushExprAndAlias(ctx, e, handleDistinct, innerAliased, opcode, oa)
-		offset, _, err := pushProjection(ctx, pushExpr, plan, true, false, true)
+	if oa != nil && hp.sel.Having != nil {
+		// aggregations only used in the HAVING clause also have to be computed by the ordered aggregate
+		err := hp.planAggregationsOf(ctx, plan, oa, expandAverages(hp.sel.Having.Expr), rawRows)
 		if err != nil {
 			return nil, err
 		}
-		param.Col = offset
-		param.Expr = fExpr
-		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, param)
 	}
 
 	for _, groupExpr := range hp.qp.GroupByExprs {
@@ -541,6 +596,13 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 		return nil, err
 	}
 
+	if len(aggrExprs) > 0 {
+		newPlan, err = planAggregationExpressions(ctx, newPlan, oa, aggrExprs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if !hp.qp.CanPushDownSorting && oa != nil {
 		var orderExprs []abstract.OrderBy
 		// if we can't at a later stage push down the sorting to our inputs, we have to do ordering here
@@ -556,14 +618,14 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 				return nil, err
 			}
 			oa.input = newInput
-			plan = oa
+			plan = newPlan
 		}
 	} else {
 		plan = newPlan
 	}
 
 	// done with aggregation planning. let's check if we should fail the query
-	if _, planIsRoute := plan.(*routeGen4); !planIsRoute {
+	if _, planIsRoute := plan.(*routeGen4); !planIsRoute && oa == nil {
 		// if we had to build up additional operators around the route, we have to fail this query
 		for _, expr := range hp.qp.SelectExprs {
 			colExpr, err := expr.GetExpr()
@@ -579,6 +641,130 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 	return plan, nil
 }
 
+// planAggregation pushes down what is needed to compute the aggregation function,
+// and adds the aggregation to the ordered aggregate
+func (hp *horizonPlanning) planAggregation(
+	ctx *plancontext.PlanningContext,
+	plan logicalPlan,
+	oa *orderedAggregate,
+	e abstract.SelectExpr,
+	aliasExpr *sqlparser.AliasedExpr,
+	rawRows bool,
+) error {
+	fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
+	if !isFunc {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
+	}
+	funcName := fExpr.Name.Lowered()
+	opcode, found := engine.SupportedAggregates[funcName]
+	if !found {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function '%s'", funcName)
+	}
+	handleDistinct, innerAliased, err := hp.needDistinctHandling(ctx, fExpr, opcode, plan)
+	if err != nil {
+		return err
+	}
+
+	pushExpr, param := hp.createPushExprAndAlias(ctx, e, handleDistinct, innerAliased, opcode, oa)
+	if rawRows && !handleDistinct {
+		// the input can't aggregate, so we push down the argument of the
+		// aggregation function and compute the aggregation on the returned rows
+		pushExpr, param.Opcode, err = rawAggregationArgument(fExpr, opcode)
+		if err != nil {
+			return err
+		}
+		if param.Opcode == engine.AggregateCountRaw {
+			oa.eaggr.PreProcess = true
+		}
+	}
+	offset, _, err := pushProjection(ctx, pushExpr, plan, true, false, !rawRows)
+	if err != nil {
+		return err
+	}
+	param.Col = offset
+	param.KeyCol = offset
+	param.Expr = fExpr
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, param)
+	return nil
+}
+
+// planAggregationsOf adds to the ordered aggregate the aggregations used by an expression
+// that are not computed by it yet, such as the aggregations used in the HAVING clause
+// that are not part of the SELECT list
+func (hp *horizonPlanning) planAggregationsOf(ctx *plancontext.PlanningContext, plan logicalPlan, oa *orderedAggregate, expr sqlparser.Expr, rawRows bool) error {
+	var aggrs []*sqlparser.FuncExpr
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.GroupConcatExpr:
+			return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function 'group_concat'")
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true, nil
+			}
+			if findAggregateParams(oa, node) == nil {
+				aggrs = append(aggrs, node)
+			}
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return err
+	}
+
+	for _, fExpr := range aggrs {
+		if findAggregateParams(oa, fExpr) != nil {
+			// the same aggregation was used more than once
+			continue
+		}
+		aliasExpr := &sqlparser.AliasedExpr{Expr: fExpr}
+		err := hp.planAggregation(ctx, plan, oa, abstract.SelectExpr{Col: aliasExpr, Aggr: true}, aliasExpr, rawRows)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findAggregateParams(oa *orderedAggregate, fExpr *sqlparser.FuncExpr) *engine.AggregateParams {
+	for _, aggr := range oa.eaggr.Aggregates {
+		if sqlparser.EqualsExpr(aggr.Expr, fExpr) {
+			return aggr
+		}
+	}
+	return nil
+}
+
+// aggregatesOnRawRows returns true if the aggregation functions can't be pushed down
+// to the plan, and have to be computed at the vtgate level on the rows it returns.
+// This is the case for derived tables that could not be merged into a route, and for unions.
+func aggregatesOnRawRows(plan logicalPlan) bool {
+	switch plan.(type) {
+	case *simpleProjection, *concatenateGen4:
+		return true
+	}
+	return false
+}
+
+// rawAggregationArgument returns the expression that needs to be pushed down
+// to compute the aggregation function on raw rows, and the opcode to use for it
+func rawAggregationArgument(fExpr *sqlparser.FuncExpr, opcode engine.AggregateOpcode) (*sqlparser.AliasedExpr, engine.AggregateOpcode, error) {
+	if opcode == engine.AggregateCount {
+		opcode = engine.AggregateCountRaw
+		if _, isStar := fExpr.Exprs[0].(*sqlparser.StarExpr); isStar {
+			// every row is counted, so any non-null value can be used
+			return &sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}, opcode, nil
+		}
+	}
+	arg, ok := fExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok || len(fExpr.Exprs) != 1 {
+		return nil, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error: %s", sqlparser.String(fExpr))
+	}
+	return &sqlparser.AliasedExpr{Expr: arg.Expr}, opcode, nil
+}
+
 // createPushExprAndAlias creates the expression that should be pushed down to the leaves,
 // and changes the opcode so it is a distinct one if needed
 func (hp *horizonPlanning) createPushExprAndAlias(
@@ -627,9 +813,32 @@ func (hp *horizonPlanning) createPushExprAndAlias(
 		Alias:       alias,
 		CollationID: collID,
 	}
+	if handleDistinct {
+		param.Unordered = hasDistinctOnOtherExpr(oa, innerAliased.Expr)
+	}
 	return aliasExpr, param
 }
 
+// hasDistinctOnOtherExpr returns true if the ordered aggregate already has a distinct aggregation on
+// another expression. The rows are sorted by the expression of the first distinct aggregation only,
+// so the following ones on other expressions have to keep track of the values they have seen.
+func hasDistinctOnOtherExpr(oa *orderedAggregate, expr sqlparser.Expr) bool {
+	for _, aggr := range oa.eaggr.Aggregates {
+		if aggr.Opcode != engine.AggregateCountDistinct && aggr.Opcode != engine.AggregateSumDistinct {
+			continue
+		}
+		fExpr, isFunc := aggr.Expr.(*sqlparser.FuncExpr)
+		if !isFunc || len(fExpr.Exprs) != 1 {
+			continue
+		}
+		arg, isAliased := fExpr.Exprs[0].(*sqlparser.AliasedExpr)
+		if isAliased && !sqlparser.EqualsExpr(arg.Expr, expr) {
+			return true
+		}
+	}
+	return false
+}
+
 func hasUniqueVindex(vschema plancontext.VSchema, semTable *semantics.SemTable, groupByExprs []abstract.GroupBy) bool {
 	for _, groupByExpr := range groupByExprs {
 		if exprHasUniqueVindex(vschema, semTable, groupByExpr.WeightStrExpr) {
@@ -674,13 +883,70 @@ func planGroupByGen4(ctx *plancontext.PlanningContext, groupExpr abstract.GroupB
 		return nil
 	case *pulloutSubquery:
 		return planGroupByGen4(ctx, groupExpr, node.underlying, wsAdded)
-	case *semiJoin:
+	case *simpleProjection, *concatenateGen4:
+		// the rows are grouped at the vtgate level, there is nothing to push down
+		return nil
+	case *semiJoin, *correlatedSubquery:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by in a query having a correlated subquery")
 	default:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by on: %T", plan)
 	}
 }
 
+// planHashAggregation turns an ordered aggregation into a hash aggregation when its input has to be sorted
+// at the vtgate level, because the grouping keys could not be pushed down as an ORDER BY to the shards.
+// If the query has an ORDER BY, the aggregated rows are sorted instead of the input rows.
+func (hp *horizonPlanning) planHashAggregation(plan logicalPlan) logicalPlan {
+	switch node := plan.(type) {
+	case *orderedAggregate:
+		ms, isMS := node.input.(*memorySort)
+		if !isMS || !canHashAggregate(node.eaggr) {
+			return plan
+		}
+		node.input = ms.input
+		node.hash = true
+		if len(hp.qp.OrderExprs) == 0 {
+			return node
+		}
+		// the aggregated rows have their columns at the same offsets as the input rows,
+		// so the memory sort can be used as is
+		ms.input = node
+		return ms
+	case *filter, *projection:
+		inputs := node.Inputs()
+		newInput := hp.planHashAggregation(inputs[0])
+		if ms, isMS := newInput.(*memorySort); isMS && newInput != inputs[0] {
+			// the memory sort was moved above the aggregation, we sort the rows of this plan,
+			// which have the columns of the aggregated rows at the same offsets
+			newInput = ms.input
+			ms.input = node
+			plan = ms
+		}
+		_ = node.Rewrite(newInput)
+		return plan
+	case *memorySort:
+		newInput := hp.planHashAggregation(node.input)
+		if ms, isMS := newInput.(*memorySort); isMS && newInput != node.input {
+			// the rows are already sorted by this memory sort
+			newInput = ms.input
+		}
+		node.input = newInput
+		return node
+	}
+	return plan
+}
+
+// canHashAggregate returns true if the aggregations do not need the input rows to be sorted
+func canHashAggregate(eaggr *engine.OrderedAggregate) bool {
+	for _, aggr := range eaggr.Aggregates {
+		switch aggr.Opcode {
+		case engine.AggregateCountDistinct, engine.AggregateSumDistinct, engine.AggregateGtid:
+			return false
+		}
+	}
+	return true
+}
+
 func (hp *horizonPlanning) planGroupByUsingOrderBy(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
 	var orderExprs []abstract.OrderBy
 	for _, groupExpr := range hp.qp.GroupByExprs {
@@ -737,6 +1003,10 @@ func (hp *horizonPlanning) planOrderBy(ctx *plancontext.PlanningContext, orderEx
 		}
 		orderExprs = orderExprsWithoutNils
 
+		if plan.hash {
+			// the rows of a hash aggregation are not sorted
+			return createMemorySortPlanOnAggregation(plan, orderExprs)
+		}
 		for _, order := range orderExprs {
 			if sqlparser.ContainsAggregation(order.WeightStrExpr) {
 				ms, err := createMemorySortPlanOnAggregation(plan, orderExprs)
@@ -754,11 +1024,50 @@ func (hp *horizonPlanning) planOrderBy(ctx *plancontext.PlanningContext, orderEx
 		return plan, nil
 	case *memorySort:
 		return plan, nil
+	case *projection:
+		for _, order := range orderExprs {
+			for _, expr := range plan.exprs {
+				if sqlparser.EqualsExpr(order.WeightStrExpr, expr) {
+					// the rows are sorted once the expressions are evaluated
+					return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
+				}
+			}
+		}
+		newInput, err := hp.planOrderBy(ctx, orderExprs, plan.input)
+		if err != nil {
+			return nil, err
+		}
+		plan.input = newInput
+		return plan, nil
 	case *simpleProjection:
+		if _, isCTE := plan.input.(*recursiveCTE); isCTE {
+			// The rows of a recursive common table expression are computed at VTGate, so weight_string function cannot be used.
+			return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
+		}
 		return hp.createMemorySortPlan(ctx, plan, orderExprs, true)
 	case *vindexFunc:
 		// This is evaluated at VTGate only, so weight_string function cannot be used.
 		return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
+	case *correlatedSubquery:
+		for _, order := range orderExprs {
+			if plan.usesSubquery(order.WeightStrExpr) {
+				// the values are only known at the vtgate level, so we have to sort there
+				var vtgateOrderExprs []abstract.OrderBy
+				for _, order := range orderExprs {
+					vtgateOrderExprs = append(vtgateOrderExprs, abstract.OrderBy{
+						Inner:         &sqlparser.Order{Expr: order.WeightStrExpr, Direction: order.Inner.Direction},
+						WeightStrExpr: order.WeightStrExpr,
+					})
+				}
+				return hp.createMemorySortPlan(ctx, plan, vtgateOrderExprs /* useWeightStr */, false)
+			}
+		}
+		newOuter, err := hp.planOrderBy(ctx, orderExprs, plan.outer)
+		if err != nil {
+			return nil, err
+		}
+		plan.outer = newOuter
+		return plan, nil
 	case *limit, *semiJoin, *filter, *pulloutSubquery:
 		inputs := plan.Inputs()
 		if len(inputs) == 0 {
@@ -1031,10 +1340,15 @@ func (hp *horizonPlanning) planDistinct(ctx *plancontext.PlanningContext, plan l
 		}
 
 		return hp.addDistinct(ctx, plan)
-	case *joinGen4, *pulloutSubquery:
+	case *joinGen4, *pulloutSubquery, *correlatedSubquery:
 		return hp.addDistinct(ctx, plan)
 	case *orderedAggregate:
 		return hp.planDistinctOA(ctx.SemTable, p)
+	case *projection, *memorySort:
+		if evaluatesAggregationExpressions(p) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: distinct on expressions using aggregation functions")
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown plan type for DISTINCT %T", plan)
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown plan type for DISTINCT %T", plan)
 	}
@@ -1205,9 +1519,145 @@ func (hp *horizonPlanning) planHaving(ctx *plancontext.PlanningContext, plan log
 	if hp.sel.Having == nil {
 		return plan, nil
 	}
+	if oa, isOA := plan.(*orderedAggregate); isOA {
+		return planHavingOnAggregate(ctx, hp.sel.Having.Expr, expandAverages(hp.sel.Having.Expr), oa)
+	}
 	return pushHaving(ctx, hp.sel.Having.Expr, plan)
 }
 
+// planHavingOnAggregate builds a filter evaluating the HAVING clause on the output of the ordered aggregate.
+// The aggregation functions of expr, in which the averages have been expanded, are replaced by columns
+// referencing the aggregates computed by it.
+func planHavingOnAggregate(ctx *plancontext.PlanningContext, original, expr sqlparser.Expr, oa *orderedAggregate) (logicalPlan, error) {
+	rewritten, err := replaceAggregationsByColumns(ctx, expr, oa)
+	if err != nil {
+		return nil, err
+	}
+	scl := &simpleConverterLookup{
+		ctx:  ctx,
+		plan: oa,
+	}
+	predicate, err := evalengine.Translate(rewritten, scl)
+	if err != nil {
+		return nil, err
+	}
+	return &filter{
+		logicalPlanCommon: newBuilderCommon(oa),
+		efilter: &engine.Filter{
+			Predicate:    predicate,
+			ASTPredicate: original,
+		},
+	}, nil
+}
+
+// planAggregationExpressions builds a projection evaluating the select expressions
+// that use aggregation functions on the output of the ordered aggregate
+func planAggregationExpressions(ctx *plancontext.PlanningContext, plan logicalPlan, oa *orderedAggregate, exprs []*sqlparser.AliasedExpr) (logicalPlan, error) {
+	proj := newProjection(plan)
+	for _, expr := range exprs {
+		rewritten, err := replaceAggregationsByColumns(ctx, expandAverages(expr.Expr), oa)
+		if err != nil {
+			return nil, err
+		}
+		name := expr.As.String()
+		if name == "" {
+			name = sqlparser.String(expr.Expr)
+		}
+		_, err = proj.addExpression(ctx, expr.Expr, rewritten, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return proj, nil
+}
+
+// replaceAggregationsByColumns returns a copy of the expression in which the aggregation functions
+// are replaced by columns referencing the aggregates computed by the ordered aggregate
+func replaceAggregationsByColumns(ctx *plancontext.PlanningContext, expr sqlparser.Expr, oa *orderedAggregate) (sqlparser.Expr, error) {
+	var err error
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			return false
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true
+			}
+			aggr := findAggregateParams(oa, node)
+			if aggr == nil {
+				err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] aggregation %s not found in the ordered aggregate", sqlparser.String(node))
+				return false
+			}
+			col := sqlparser.NewColName(aggr.Alias)
+			ctx.SemTable.CopyExprInfo(aggr.Expr, col)
+			cursor.Replace(col)
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return rewritten, nil
+}
+
+// isAggregationExpression returns true if the expression uses aggregation functions,
+// but can't be computed by a single aggregation of the ordered aggregate
+func isAggregationExpression(expr sqlparser.Expr) bool {
+	fExpr, isFunc := expr.(*sqlparser.FuncExpr)
+	if isFunc && fExpr.IsAggregate() {
+		return fExpr.Name.Lowered() == "avg"
+	}
+	return sqlparser.ContainsAggregation(expr)
+}
+
+// expandAverages returns a copy of the expression in which AVG(x) is replaced by SUM(x) / COUNT(x),
+// so the average can be computed from the partial results of the shards.
+// The aggregation functions of the copy are the ones of the original expression,
+// so the semantic information about their arguments is kept.
+func expandAverages(expr sqlparser.Expr) sqlparser.Expr {
+	var aggrs []*sqlparser.FuncExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				aggrs = append(aggrs, node)
+				return false, nil
+			}
+		}
+		return true, nil
+	}, expr)
+	if len(aggrs) == 0 {
+		return expr
+	}
+
+	// the aggregations are found in the same order in the copy
+	idx := 0
+	return sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			return false
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true
+			}
+			original := aggrs[idx]
+			idx++
+			if original.Name.Lowered() != "avg" {
+				cursor.Replace(original)
+				return false
+			}
+			sum := &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Distinct: original.Distinct, Exprs: original.Exprs}
+			count := &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Distinct: original.Distinct, Exprs: original.Exprs}
+			cursor.Replace(&sqlparser.BinaryExpr{Operator: sqlparser.DivOp, Left: sum, Right: count})
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+}
+
 func pushHaving(ctx *plancontext.PlanningContext, expr sqlparser.Expr, plan logicalPlan) (logicalPlan, error) {
 	switch node := plan.(type) {
 	case *routeGen4:
@@ -1225,9 +1675,11 @@ func pushHaving(ctx *plancontext.PlanningContext, expr sqlparser.Expr, plan logi
 }
 
 func isJoin(plan logicalPlan) bool {
-	switch plan.(type) {
+	switch plan := plan.(type) {
 	case *joinGen4, *hashJoin:
 		return true
+	case *pulloutSubquery:
+		return isJoin(plan.underlying)
 	default:
 		return false
 	}
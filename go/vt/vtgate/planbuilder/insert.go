@@ -60,6 +60,18 @@ func buildInsertPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if !rb.eroute.Keyspace.Sharded {
 		if pb.finalizeUnshardedDMLSubqueries(reservedVars, ins) {
 			vschema.WarnUnshardedOnly("subqueries can't be sharded for INSERT")
+		} else if sel, isSelect := ins.Rows.(sqlparser.SelectStatement); isSelect {
+			// The rows come from a sharded query, which has to be executed by vtgate.
+			// The failed attempt to merge it with the insert has bound its columns
+			// to the symbol table of that attempt, so the bindings are cleared first.
+			_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				if col, isCol := node.(*sqlparser.ColName); isCol {
+					col.Metadata = nil
+				}
+				return true, nil
+			}, sel)
+			eins := engine.NewSimpleInsert(engine.InsertSelect, vschemaTable, vschemaTable.Keyspace)
+			return buildInsertSelectPlan(ins, eins, reservedVars, vschema)
 		} else {
 			return nil, errors.New("unsupported: sharded subquery in insert values")
 		}
@@ -68,7 +80,7 @@ func buildInsertPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if ins.Action == sqlparser.ReplaceAct {
 		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
 	}
-	return buildInsertShardedPlan(ins, vschemaTable)
+	return buildInsertShardedPlan(ins, vschemaTable, reservedVars, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -115,7 +127,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -146,7 +158,7 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+		return buildInsertSelectPlan(ins, eins, reservedVars, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -206,6 +218,120 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan for an INSERT whose rows come from a SELECT
+// that cannot be sent down together with the insert. The SELECT is planned as the
+// input of the insert, and every row it returns is routed by vtgate.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	eins.Ignore = eins.Opcode == engine.InsertShardedIgnore || bool(ins.Ignore) || ins.OnDup != nil
+	eins.Opcode = engine.InsertSelect
+	table := eins.Table
+
+	if len(ins.Columns) == 0 {
+		if !table.ColumnListAuthoritative {
+			return nil, errors.New("unsupported: insert into select without a column list on a table with non-authoritative columns")
+		}
+		populateInsertColumnlist(ins, table)
+	}
+
+	// Query is only used to describe the plan, the inserts sent to the shards use Prefix and Suffix.
+	eins.Query = generateQuery(ins)
+
+	sel := ins.Rows.(sqlparser.SelectStatement)
+	selectExprs := sqlparser.GetFirstSelect(sel).SelectExprs
+	hasStar := false
+	for _, expr := range selectExprs {
+		if _, isStar := expr.(*sqlparser.StarExpr); isStar {
+			hasStar = true
+		}
+	}
+	if !hasStar && len(selectExprs) != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+
+	// The select is planned on its own; its rows are the input of the insert.
+	plannerFunc, err := getConfiguredPlanner(vschema, buildSelectPlan, sel, sqlparser.String(sel))
+	if err != nil {
+		return nil, err
+	}
+	input, err := plannerFunc(sel, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	eins.Input = input
+	eins.ForceNonStreaming = selectReadsTable(sel, ins.Table)
+
+	// columnOffset returns the offset of the column in the rows that will be inserted.
+	// Columns that the select does not provide are appended to the column list,
+	// and are filled in with NULL or a generated value when the rows are routed.
+	columnOffset := func(col sqlparser.ColIdent) (int, error) {
+		if offset := findColumn(ins, col); offset != -1 {
+			return offset, nil
+		}
+		if hasStar {
+			return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column '%v' must be in the column list for insert into select with '*'", col)
+		}
+		ins.Columns = append(ins.Columns, col)
+		return len(ins.Columns) - 1, nil
+	}
+
+	if table.AutoIncrement != nil {
+		offset, err := columnOffset(table.AutoIncrement.Column)
+		if err != nil {
+			return nil, err
+		}
+		eins.Generate = &engine.Generate{
+			Keyspace: table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(table.AutoIncrement.Sequence.Name)),
+			Offset:   offset,
+		}
+	}
+
+	if eins.Keyspace.Sharded {
+		var colVindexes []*vindexes.ColumnVindex
+		for _, colVindex := range table.ColumnVindexes {
+			if colVindex.IgnoreInDML() {
+				continue
+			}
+			colVindexes = append(colVindexes, colVindex)
+		}
+		vindexValueOffset := make([][]int, len(colVindexes))
+		for vIdx, colVindex := range colVindexes {
+			for _, col := range colVindex.Columns {
+				offset, err := columnOffset(col)
+				if err != nil {
+					return nil, err
+				}
+				vindexValueOffset[vIdx] = append(vindexValueOffset[vIdx], offset)
+			}
+		}
+		eins.ColVindexes = colVindexes
+		eins.VindexValueOffset = vindexValueOffset
+	}
+
+	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
+		ins.Comments, ins.Ignore.ToString(),
+		ins.Table, ins.Columns)
+	eins.Prefix = prefixBuf.String()
+	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	suffixBuf.Myprintf("%v", ins.OnDup)
+	eins.Suffix = suffixBuf.String()
+	return eins, nil
+}
+
+// selectReadsTable returns true if the select refers to a table with the same name
+// as the given one, in which case it could read the rows that are inserted into it.
+func selectReadsTable(sel sqlparser.SelectStatement, table sqlparser.TableName) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tbl, isTable := node.(sqlparser.TableName); isTable && tbl.Name == table.Name {
+			found = true
+		}
+		return !found, nil
+	}, sel)
+	return found
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -261,14 +387,22 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 	return nil
 }
 
-// findOrAddColumn finds the position of a column in the insert. If it's
-// absent it appends it to the with NULL values and returns that position.
-func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
+// findColumn returns the position of a column in the insert column list, or -1 if it's absent.
+func findColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	for i, column := range ins.Columns {
 		if col.Equal(column) {
 			return i
 		}
 	}
+	return -1
+}
+
+// findOrAddColumn finds the position of a column in the insert. If it's
+// absent it appends it to the with NULL values and returns that position.
+func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
+	if colNum := findColumn(ins, col); colNum != -1 {
+		return colNum
+	}
 	ins.Columns = append(ins.Columns, col)
 	rows := ins.Rows.(sqlparser.Values)
 	for i := range rows {
@@ -55,6 +55,8 @@ func transformToLogicalPlan(ctx *plancontext.PlanningContext, op abstract.Physic
 		return transformCorrelatedSubQueryPlan(ctx, op)
 	case *physical.Derived:
 		return transformDerivedPlan(ctx, op)
+	case *physical.RecursiveCTE:
+		return transformRecursiveCTEPlan(op)
 	case *physical.Filter:
 		plan, err := transformToLogicalPlan(ctx, op.Source)
 		if err != nil {
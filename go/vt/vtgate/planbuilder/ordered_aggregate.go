@@ -64,6 +64,10 @@ type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+
+	// hash is set when the input is not sorted by the grouping keys.
+	// The aggregation is then done by an engine.HashAggregate.
+	hash bool
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -216,6 +220,16 @@ func findAlias(colname *sqlparser.ColName, selects sqlparser.SelectExprs) sqlpar
 // Primitive implements the logicalPlan interface
 func (oa *orderedAggregate) Primitive() engine.Primitive {
 	oa.eaggr.Input = oa.input.Primitive()
+	if oa.hash {
+		return &engine.HashAggregate{
+			PreProcess:          oa.eaggr.PreProcess,
+			Aggregates:          oa.eaggr.Aggregates,
+			GroupByKeys:         oa.eaggr.GroupByKeys,
+			TruncateColumnCount: oa.eaggr.TruncateColumnCount,
+			Collations:          oa.eaggr.Collations,
+			Input:               oa.eaggr.Input,
+		}
+	}
 	return oa.eaggr
 }
 
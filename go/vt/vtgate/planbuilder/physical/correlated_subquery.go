@@ -28,6 +28,21 @@ type (
 		Extracted    *sqlparser.ExtractedSubquery
 		// arguments that need to be copied from the outer to inner
 		Vars map[string]int
+
+		// Predicate is the predicate of the outer query that uses the subquery.
+		// It has been removed from the outer query, and is evaluated at the vtgate level.
+		// It is nil when the subquery is not used in the WHERE clause.
+		Predicate sqlparser.Expr
+
+		// BatchVar is set when the correlation is a simple equality between an
+		// inner and an outer column, and the subquery can be evaluated for a list
+		// of outer values at once. The values of the column at offset BatchColumn
+		// in the outer are then sent as a list in BatchVar.
+		BatchVar    string
+		BatchColumn int
+
+		// Columns stores the offsets of the outer columns that are returned
+		Columns []int
 	}
 
 	SubQueryOp struct {
@@ -83,6 +98,12 @@ func (s *SubQueryOp) Clone() abstract.PhysicalOperator {
 	return result
 }
 
+// IsSemiJoin returns true when the outer rows only need to be filtered
+// on whether the subquery returns any row or not.
+func (c *CorrelatedSubQueryOp) IsSemiJoin() bool {
+	return isSemiJoin(c.Predicate, c.Extracted)
+}
+
 func (c *CorrelatedSubQueryOp) TableID() semantics.TableSet {
 	return c.Inner.TableID().Merge(c.Outer.TableID())
 }
@@ -106,10 +127,21 @@ func (c *CorrelatedSubQueryOp) Cost() int {
 }
 
 func (c *CorrelatedSubQueryOp) Clone() abstract.PhysicalOperator {
+	columns := make([]int, len(c.Columns))
+	copy(columns, c.Columns)
+	vars := make(map[string]int, len(c.Vars))
+	for k, v := range c.Vars {
+		vars[k] = v
+	}
 	result := &CorrelatedSubQueryOp{
-		Outer:     c.Outer.Clone(),
-		Inner:     c.Inner.Clone(),
-		Extracted: c.Extracted,
+		Outer:       c.Outer.Clone(),
+		Inner:       c.Inner.Clone(),
+		Extracted:   c.Extracted,
+		Vars:        vars,
+		Predicate:   c.Predicate,
+		BatchVar:    c.BatchVar,
+		BatchColumn: c.BatchColumn,
+		Columns:     columns,
 	}
 	return result
 }
@@ -112,9 +112,10 @@ func PushPredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op abs
 			return op, err
 		}
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "Cannot push predicate: %s", sqlparser.String(expr))
-	case *Table:
+	case *Table, *RecursiveCTE:
 		// We do not add the predicate to op.qtable because that is an immutable struct that should not be
-		// changed by physical operators.
+		// changed by physical operators. The rows of a recursive common table expression are filtered
+		// at the vtgate level.
 		return &Filter{
 			Source:     op,
 			Predicates: []sqlparser.Expr{expr},
@@ -140,6 +141,21 @@ func PushPredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op abs
 		}
 		op.Source = newSrc
 		return op, err
+	case *SubQueryOp:
+		// the predicates are evaluated on the rows of the outer query
+		newOuter, err := PushPredicate(ctx, expr, op.Outer)
+		if err != nil {
+			return nil, err
+		}
+		op.Outer = newOuter
+		return op, nil
+	case *CorrelatedSubQueryOp:
+		newOuter, err := PushPredicate(ctx, expr, op.Outer)
+		if err != nil {
+			return nil, err
+		}
+		op.Outer = newOuter
+		return op, nil
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "we cannot push predicates into %T", op)
 	}
@@ -211,9 +227,32 @@ func PushOutputColumns(ctx *plancontext.PlanningContext, op abstract.PhysicalOpe
 		newSrc, ints, err := PushOutputColumns(ctx, op.Source, columns...)
 		op.Source = newSrc
 		return op, ints, err
+	case *CorrelatedSubQueryOp:
+		newOuter, offsets, err := PushOutputColumns(ctx, op.Outer, columns...)
+		if err != nil {
+			return nil, nil, err
+		}
+		op.Outer = newOuter
+		outputColumns := make([]int, len(offsets))
+		for i, offset := range offsets {
+			op.Columns, outputColumns[i] = addToIntSlice(op.Columns, -offset-1)
+		}
+		return op, outputColumns, nil
 	case *Vindex:
 		idx, err := op.PushOutputColumns(columns)
 		return op, idx, err
+	case *RecursiveCTE:
+		var offsets []int
+		for _, col := range columns {
+			offset, err := op.columnOffset(col)
+			if err != nil {
+				return nil, nil, err
+			}
+			var pos int
+			op.ColumnsOffset, pos = addToIntSlice(op.ColumnsOffset, offset)
+			offsets = append(offsets, pos)
+		}
+		return op, offsets, nil
 	case *Derived:
 		var noQualifierNames []*sqlparser.ColName
 		var offsets []int
@@ -268,7 +307,21 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 			return nil, err
 		}
 		op.Source = newSrc
-		return op, err
+
+		// the predicate might have been used for routing, so we have to redo the routing without it
+		var seenPredicates []sqlparser.Expr
+		for _, predicate := range op.SeenPredicates {
+			if !sqlparser.EqualsExpr(predicate, expr) {
+				seenPredicates = append(seenPredicates, predicate)
+			}
+		}
+		if len(seenPredicates) != len(op.SeenPredicates) {
+			op.SeenPredicates = seenPredicates
+			if err := op.resetRoutingSelections(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return op, nil
 	case *ApplyJoin:
 		isRemoved := false
 		deps := ctx.SemTable.RecursiveDeps(expr)
@@ -327,6 +380,31 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 		// remove the predicate from this filter
 		op.Predicates = append(op.Predicates[:idx], op.Predicates[idx+1:]...)
 		return op, nil
+	case *CorrelatedSubQueryOp:
+		newOuter, err := RemovePredicate(ctx, expr, op.Outer)
+		if err != nil {
+			return nil, err
+		}
+		op.Outer = newOuter
+		return op, nil
+	case *Table:
+		var predicates []sqlparser.Expr
+		for _, predicate := range op.QTable.Predicates {
+			if !sqlparser.EqualsExpr(predicate, expr) {
+				predicates = append(predicates, predicate)
+			}
+		}
+		if len(predicates) == len(op.QTable.Predicates) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
+		}
+		// the QueryTable is immutable, so we work on a copy of it
+		qTable := *op.QTable
+		qTable.Predicates = predicates
+		return &Table{
+			QTable:  &qTable,
+			VTable:  op.VTable,
+			Columns: op.Columns,
+		}, nil
 
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
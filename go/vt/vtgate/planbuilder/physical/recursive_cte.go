@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package physical
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+// RecursiveCTE is a leaf operator producing the rows of a recursive common
+// table expression, which are computed at the vtgate level.
+type RecursiveCTE struct {
+	ID    semantics.TableSet
+	Alias string
+	CTE   *engine.RecursiveCTE
+
+	// ColumnsOffset are the columns of the common table expression needed to feed other plans
+	ColumnsOffset []int
+}
+
+var _ abstract.PhysicalOperator = (*RecursiveCTE)(nil)
+
+// IPhysical implements the PhysicalOperator interface
+func (r *RecursiveCTE) IPhysical() {}
+
+// Cost implements the PhysicalOperator interface
+func (r *RecursiveCTE) Cost() int {
+	return 1
+}
+
+// Clone implements the PhysicalOperator interface
+func (r *RecursiveCTE) Clone() abstract.PhysicalOperator {
+	clone := *r
+	clone.ColumnsOffset = append([]int(nil), r.ColumnsOffset...)
+	return &clone
+}
+
+// TableID implements the PhysicalOperator interface
+func (r *RecursiveCTE) TableID() semantics.TableSet {
+	return r.ID
+}
+
+// UnsolvedPredicates implements the PhysicalOperator interface
+func (r *RecursiveCTE) UnsolvedPredicates(*semantics.SemTable) []sqlparser.Expr {
+	return nil
+}
+
+// CheckValid implements the PhysicalOperator interface
+func (r *RecursiveCTE) CheckValid() error {
+	return nil
+}
+
+// columnOffset returns the offset of the given column in the rows of the common table expression
+func (r *RecursiveCTE) columnOffset(col *sqlparser.ColName) (int, error) {
+	for i, column := range r.CTE.Columns {
+		if col.Name.EqualString(column) {
+			return i, nil
+		}
+	}
+	return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+}
+
+// containsRecursiveCTE returns true if the rows of a recursive common
+// table expression are used by the given operator tree
+func containsRecursiveCTE(op abstract.PhysicalOperator) bool {
+	found := false
+	_ = VisitOperators(op, func(op abstract.PhysicalOperator) (bool, error) {
+		if _, isCTE := op.(*RecursiveCTE); isCTE {
+			found = true
+		}
+		return !found, nil
+	})
+	return found
+}
@@ -605,7 +605,9 @@ func (r *Route) resetRoutingSelections(ctx *plancontext.PlanningContext) error {
 		r.VindexPreds[i] = &VindexPlusPredicates{ColVindex: vp.ColVindex, TableID: vp.TableID}
 	}
 
-	for _, predicate := range r.SeenPredicates {
+	seenPredicates := r.SeenPredicates
+	r.SeenPredicates = nil
+	for _, predicate := range seenPredicates {
 		err := r.UpdateRoutingLogic(ctx, predicate)
 		if err != nil {
 			return err
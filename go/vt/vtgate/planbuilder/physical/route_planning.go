@@ -78,6 +78,8 @@ func CreatePhysicalOperator(ctx *plancontext.PlanningContext, opTree abstract.Lo
 		return optimizeSubQuery(ctx, op)
 	case *abstract.Vindex:
 		return optimizeVindex(ctx, op)
+	case *abstract.RecursiveCTE:
+		return &RecursiveCTE{ID: op.ID, Alias: op.Alias, CTE: op.CTE}, nil
 	case *abstract.Concatenate:
 		return optimizeUnion(ctx, op)
 	case *abstract.Filter:
@@ -377,6 +379,15 @@ func mergeOrJoin(ctx *plancontext.PlanningContext, lhs, rhs abstract.PhysicalOpe
 		return newPlan, nil
 	}
 
+	if containsRecursiveCTE(rhs) && !containsRecursiveCTE(lhs) {
+		// the right-hand side is evaluated for every row of the left-hand side,
+		// so the recursive common table expression is kept on the left-hand side
+		if !inner {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on the right-hand side of a left join")
+		}
+		lhs, rhs = rhs, lhs
+	}
+
 	join := &ApplyJoin{
 		LHS:      lhs.Clone(),
 		RHS:      rhs.Clone(),
@@ -505,7 +516,7 @@ func tryMerge(
 			return nil, nil
 		}
 		if !sameKeyspace {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+			return nil, nil
 		}
 
 		canMerge := canMergeOnFilters(ctx, aRoute, bRoute, joinPredicates)
@@ -537,7 +548,7 @@ func isDualTable(route *Route) bool {
 func leaves(op abstract.Operator) (sources []abstract.Operator) {
 	switch op := op.(type) {
 	// these are the leaves
-	case *abstract.QueryGraph, *abstract.Vindex, *Table:
+	case *abstract.QueryGraph, *abstract.Vindex, *abstract.RecursiveCTE, *Table, *RecursiveCTE:
 		return []abstract.Operator{op}
 
 		// logical
@@ -684,7 +695,7 @@ func VisitOperators(op abstract.PhysicalOperator, f func(tbl abstract.PhysicalOp
 	}
 
 	switch op := op.(type) {
-	case *Table, *Vindex:
+	case *Table, *Vindex, *RecursiveCTE:
 		// leaf - no children to visit
 	case *Route:
 		err := VisitOperators(op.Source, f)
@@ -848,7 +859,7 @@ func pushJoinPredicates(
 		return pushJoinPredicateOnJoin(ctx, exprs, op)
 	case *Route:
 		return pushJoinPredicateOnRoute(ctx, exprs, op)
-	case *Table:
+	case *Table, *RecursiveCTE:
 		return PushPredicate(ctx, sqlparser.AndExpressions(exprs...), op)
 	case *Derived:
 		return pushJoinPredicateOnDerived(ctx, exprs, op)
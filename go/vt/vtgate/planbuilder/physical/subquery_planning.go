@@ -6,6 +6,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -53,16 +54,11 @@ func optimizeSubQuery(ctx *plancontext.PlanningContext, op *abstract.SubQuery) (
 			continue
 		}
 
-		if inner.ExtractedSubquery.OpCode == int(engine.PulloutExists) {
-			correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
-			if err != nil {
-				return nil, err
-			}
-			outerOp = correlatedTree
-			continue
+		correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
+		if err != nil {
+			return nil, err
 		}
-
-		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		outerOp = correlatedTree
 	}
 
 	/*
@@ -233,9 +229,22 @@ func createCorrelatedSubqueryOp(
 	preds []sqlparser.Expr,
 	extractedSubquery *sqlparser.ExtractedSubquery,
 ) (*CorrelatedSubQueryOp, error) {
-	newOuter, err := RemovePredicate(ctx, extractedSubquery, outerOp)
-	if err != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+	// the predicate using the subquery can't be sent to the outer query anymore,
+	// since the subquery has to be evaluated for every row of the outer query
+	predicate := findPredicateUsingSubquery(outerOp, extractedSubquery)
+	newOuter := outerOp
+	if predicate != nil {
+		var err error
+		newOuter, err = RemovePredicate(ctx, predicate, outerOp)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery in predicate: %s", sqlparser.String(predicate))
+		}
+	}
+
+	if !isSemiJoin(predicate, extractedSubquery) {
+		if outerCol, innerCol := batchColumns(ctx, preds, outerOp.TableID(), extractedSubquery); outerCol != nil {
+			return createBatchedCorrelatedSubqueryOp(ctx, innerOp, newOuter, predicate, outerCol, innerCol, extractedSubquery)
+		}
 	}
 
 	resultOuterOp := newOuter
@@ -280,6 +289,9 @@ func createCorrelatedSubqueryOp(
 		if rewriteError != nil {
 			return nil, rewriteError
 		}
+		// the dependencies cached for the predicate still include the outer tables
+		delete(ctx.SemTable.Recursive, pred)
+		delete(ctx.SemTable.Direct, pred)
 		var err error
 		innerOp, err = PushPredicate(ctx, pred, innerOp)
 		if err != nil {
@@ -291,5 +303,136 @@ func createCorrelatedSubqueryOp(
 		Inner:     innerOp,
 		Extracted: extractedSubquery,
 		Vars:      vars,
+		Predicate: predicate,
+	}, nil
+}
+
+// createBatchedCorrelatedSubqueryOp creates a correlated subquery that is evaluated for a list
+// of outer rows at once. The correlation predicate `inner.col = outer.col` is replaced by
+// `inner.col in ::list`, and inner.col is added to the columns returned by the subquery so
+// that the rows it returns can be given back to the outer rows they belong to.
+func createBatchedCorrelatedSubqueryOp(
+	ctx *plancontext.PlanningContext,
+	innerOp, outerOp abstract.PhysicalOperator,
+	predicate sqlparser.Expr,
+	outerCol, innerCol *sqlparser.ColName,
+	extractedSubquery *sqlparser.ExtractedSubquery,
+) (*CorrelatedSubQueryOp, error) {
+	newOuterOp, offsets, err := PushOutputColumns(ctx, outerOp, outerCol)
+	if err != nil {
+		return nil, err
+	}
+	batchVar := ctx.ReservedVars.ReserveColName(outerCol)
+	inPredicate := &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InOp,
+		Left:     innerCol,
+		Right:    sqlparser.ListArg(batchVar),
+	}
+	innerOp, err = PushPredicate(ctx, inPredicate, innerOp)
+	if err != nil {
+		return nil, err
+	}
+	sel := extractedSubquery.Subquery.Select.(*sqlparser.Select)
+	sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: innerCol})
+	return &CorrelatedSubQueryOp{
+		Outer:       newOuterOp,
+		Inner:       innerOp,
+		Extracted:   extractedSubquery,
+		Vars:        map[string]int{},
+		Predicate:   predicate,
+		BatchVar:    batchVar,
+		BatchColumn: offsets[0],
 	}, nil
 }
+
+// batchColumns returns the outer and inner columns of the correlation when it is a simple
+// equality between an inner and an outer column, and when the subquery returns, for a list
+// of outer values, the union of the rows it returns for every one of them.
+// Otherwise, nil is returned and the subquery has to be executed once per outer row.
+func batchColumns(
+	ctx *plancontext.PlanningContext,
+	preds []sqlparser.Expr,
+	outerID semantics.TableSet,
+	extractedSubquery *sqlparser.ExtractedSubquery,
+) (outerCol, innerCol *sqlparser.ColName) {
+	if len(preds) != 1 {
+		return nil, nil
+	}
+	sel, ok := extractedSubquery.Subquery.Select.(*sqlparser.Select)
+	if !ok || sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, nil
+	}
+	cmp, ok := preds[0].(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	left, lok := cmp.Left.(*sqlparser.ColName)
+	right, rok := cmp.Right.(*sqlparser.ColName)
+	if !lok || !rok {
+		return nil, nil
+	}
+	leftIsOuter := ctx.SemTable.RecursiveDeps(left).IsSolvedBy(outerID)
+	rightIsOuter := ctx.SemTable.RecursiveDeps(right).IsSolvedBy(outerID)
+	switch {
+	case leftIsOuter && !rightIsOuter:
+		return left, right
+	case rightIsOuter && !leftIsOuter:
+		return right, left
+	}
+	return nil, nil
+}
+
+// findPredicateUsingSubquery returns the predicate of the outer operator that uses the subquery, if any
+func findPredicateUsingSubquery(op abstract.PhysicalOperator, extractedSubquery *sqlparser.ExtractedSubquery) sqlparser.Expr {
+	switch op := op.(type) {
+	case *Route:
+		return findPredicateUsingSubquery(op.Source, extractedSubquery)
+	case *Filter:
+		for _, predicate := range op.Predicates {
+			if usesSubquery(predicate, extractedSubquery) {
+				return predicate
+			}
+		}
+		return findPredicateUsingSubquery(op.Source, extractedSubquery)
+	case *ApplyJoin:
+		for _, predicate := range sqlparser.SplitAndExpression(nil, op.Predicate) {
+			if usesSubquery(predicate, extractedSubquery) {
+				return predicate
+			}
+		}
+		if predicate := findPredicateUsingSubquery(op.LHS, extractedSubquery); predicate != nil {
+			return predicate
+		}
+		return findPredicateUsingSubquery(op.RHS, extractedSubquery)
+	case *Table:
+		for _, predicate := range op.QTable.Predicates {
+			if usesSubquery(predicate, extractedSubquery) {
+				return predicate
+			}
+		}
+	case *Derived:
+		return findPredicateUsingSubquery(op.Source, extractedSubquery)
+	case *CorrelatedSubQueryOp:
+		return findPredicateUsingSubquery(op.Outer, extractedSubquery)
+	}
+	return nil
+}
+
+func usesSubquery(expr sqlparser.Expr, extractedSubquery *sqlparser.ExtractedSubquery) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		// we are comparing the ArgNames in case the expressions have been cloned
+		if es, ok := node.(*sqlparser.ExtractedSubquery); ok && es.GetArgName() == extractedSubquery.GetArgName() {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+// isSemiJoin returns true when the predicate is a plain EXISTS, for which
+// we only need to know whether the subquery returns any row.
+func isSemiJoin(predicate sqlparser.Expr, extractedSubquery *sqlparser.ExtractedSubquery) bool {
+	es, ok := predicate.(*sqlparser.ExtractedSubquery)
+	return ok && es == extractedSubquery && engine.PulloutOpcode(extractedSubquery.OpCode) == engine.PulloutExists
+}
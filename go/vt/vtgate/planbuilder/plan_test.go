@@ -239,6 +239,8 @@ func TestPlan(t *testing.T) {
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "stream_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "systemtables_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "recursive_cte_cases.txt", testOutputTempDir, vschemaWrapper)
 }
 
 func TestSysVarSetDisabled(t *testing.T) {
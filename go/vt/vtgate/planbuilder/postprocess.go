@@ -103,7 +103,7 @@ var _ planVisitor = setUpperLimit
 // A primitive that cannot perform this can ignore the request.
 func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 	switch node := plan.(type) {
-	case *join, *joinGen4, *hashJoin:
+	case *join, *joinGen4, *hashJoin, *window:
 		return false, node, nil
 	case *memorySort:
 		pv := evalengine.NewBindVar("__upper_limit", collations.TypedCollation{})
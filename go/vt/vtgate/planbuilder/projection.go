@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+var _ logicalPlan = (*projection)(nil)
+
+// projection is the logicalPlan for engine.Projection.
+// It is used by the Gen4 planner to evaluate the expressions using
+// aggregation functions, such as SUM(a) / COUNT(b) or AVG(a), once the
+// aggregation is done. The results are appended to the input columns,
+// so a simpleProjection is needed to return the columns in the right order.
+type projection struct {
+	logicalPlanCommon
+
+	// exprs are the original expressions, in the same order as eProjection.Exprs
+	exprs       []sqlparser.Expr
+	eProjection *engine.Projection
+}
+
+// newProjection builds a new projection.
+func newProjection(plan logicalPlan) *projection {
+	return &projection{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eProjection:       &engine.Projection{},
+	}
+}
+
+// Primitive implements the logicalPlan interface
+func (p *projection) Primitive() engine.Primitive {
+	p.eProjection.Input = p.input.Primitive()
+	return p.eProjection
+}
+
+// OutputColumns implements the logicalPlan interface
+func (p *projection) OutputColumns() []sqlparser.SelectExpr {
+	columns := sqlparser.CloneSelectExprs(p.input.OutputColumns())
+	for i, expr := range p.exprs {
+		columns = append(columns, &sqlparser.AliasedExpr{
+			Expr: sqlparser.CloneExpr(expr),
+			As:   sqlparser.NewColIdent(p.eProjection.Cols[i]),
+		})
+	}
+	return columns
+}
+
+// addExpression adds an expression evaluated on the input rows, and returns its offset.
+// The evaluated expression references the columns of the input, while the original
+// expression is used to find it when it is pushed as a projection.
+func (p *projection) addExpression(ctx *plancontext.PlanningContext, original, evaluated sqlparser.Expr, name string) (int, error) {
+	for i, expr := range p.exprs {
+		if sqlparser.EqualsExpr(expr, original) {
+			return p.offset(i), nil
+		}
+	}
+	scl := &simpleConverterLookup{
+		ctx:  ctx,
+		plan: p.input,
+	}
+	evalExpr, err := evalengine.Translate(evaluated, scl)
+	if err != nil {
+		return 0, err
+	}
+	p.exprs = append(p.exprs, original)
+	p.eProjection.Exprs = append(p.eProjection.Exprs, evalExpr)
+	p.eProjection.Cols = append(p.eProjection.Cols, name)
+	return p.offset(len(p.exprs) - 1), nil
+}
+
+// pushProjection returns the offset of the expression if it is evaluated by the projection,
+// otherwise the expression is pushed to the input.
+func (p *projection) pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExpr, inner, reuseCol, hasAggregation bool) (offset int, added bool, err error) {
+	for i, e := range p.exprs {
+		if sqlparser.EqualsExpr(e, expr.Expr) {
+			return p.offset(i), false, nil
+		}
+	}
+	return pushProjection(ctx, expr, p.input, inner, reuseCol, hasAggregation)
+}
+
+// offset returns the offset of the i-th evaluated expression in the rows returned by the projection
+func (p *projection) offset(i int) int {
+	return len(p.input.OutputColumns()) + i
+}
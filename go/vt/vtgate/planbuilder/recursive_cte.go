@@ -0,0 +1,822 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// gen4PlanRecursiveCTE plans a SELECT statement with a WITH RECURSIVE clause.
+//
+// The common table expression must be a UNION ALL of an anchor SELECT and of a recursive
+// SELECT that reads the common table expression once. When both SELECTs go to the same
+// unsharded keyspace, the whole query is sent to it. Otherwise, the recursion happens at the
+// vtgate level: an engine.RecursiveCTE runs the anchor, and then, for every iteration, runs
+// a single query fetching the rows of the other tables of the recursive SELECT for the whole
+// working set. The outer query is planned on top of it like any other query, with the
+// common table expression standing for a table computed at the vtgate level.
+func gen4PlanRecursiveCTE(sel *sqlparser.Select, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, version querypb.ExecuteOptions_PlannerVersion) (engine.Primitive, error) {
+	if len(sel.With.CTEs) != 1 {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: more than one common table expression in recursive with clause")
+	}
+	cte := sel.With.CTEs[0]
+	cteName := cte.TableID.String()
+
+	union, isUnion := cte.Subquery.Select.(*sqlparser.Union)
+	if !isUnion {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: common table expression %s is not recursive", cteName)
+	}
+	anchor, isAnchorSel := union.Left.(*sqlparser.Select)
+	recursive, isRecursiveSel := union.Right.(*sqlparser.Select)
+	if !isAnchorSel || !isRecursiveSel {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s with more than one anchor or recursive query block", cteName)
+	}
+	if union.Distinct {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: UNION DISTINCT in recursive common table expression")
+	}
+	if union.OrderBy != nil || union.Limit != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ORDER BY or LIMIT in recursive common table expression")
+	}
+	if referencesTable(anchor, cteName) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones", cteName)
+	}
+
+	columns, err := cteColumns(cte, anchor)
+	if err != nil {
+		return nil, err
+	}
+	anchorPlan, err := newBuildSelectPlan(sqlparser.CloneRefOfSelect(anchor), reservedVars, vschema, version)
+	if err != nil {
+		return nil, err
+	}
+	step, err := planRecursiveStep(recursive, anchor, cteName, columns, reservedVars, vschema, version)
+	if err != nil {
+		return nil, err
+	}
+
+	recursivePlan := anchorPlan
+	if step.plan != nil {
+		recursivePlan = step.plan
+	}
+	ks, tableName := sameUnshardedKeyspace(anchorPlan, recursivePlan)
+	if ks != nil {
+		ks, err = outerQueryKeyspace(sel, anchor, cteName, columns, ks, vschema)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ks != nil {
+		removeKeyspacesFromStatement(sel)
+		plan := &routeGen4{
+			eroute: &engine.Route{
+				RoutingParameters: &engine.RoutingParameters{
+					Opcode:   engine.Unsharded,
+					Keyspace: ks,
+				},
+				TableName: tableName,
+			},
+			Select: sel,
+		}
+		if err := plan.WireupGen4(nil); err != nil {
+			return nil, err
+		}
+		return plan.Primitive(), nil
+	}
+
+	eCTE := &engine.RecursiveCTE{
+		Anchor:       anchorPlan.Primitive(),
+		BatchVar:     step.batchVar,
+		BatchColumn:  step.batchColumn,
+		Predicate:    step.predicate,
+		ASTPredicate: step.astPredicate,
+		Exprs:        step.exprs,
+		Columns:      columns,
+	}
+	if step.plan != nil {
+		eCTE.Recursive = step.plan.Primitive()
+	}
+	plan, err := planRecursiveCTEQuery(sel, anchor, cteName, columns, eCTE, reservedVars, vschema, version)
+	if err != nil {
+		return nil, err
+	}
+	return plan.Primitive(), nil
+}
+
+// cteColumns returns the names of the columns of the common table expression, which
+// are either listed explicitly or come from the select list of the anchor.
+func cteColumns(cte *sqlparser.CommonTableExpr, anchor *sqlparser.Select) ([]string, error) {
+	if len(cte.Columns) > 0 {
+		if len(cte.Columns) != len(anchor.SelectExprs) {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongNumberOfColumnsInSelect, "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+		}
+		var columns []string
+		for _, col := range cte.Columns {
+			columns = append(columns, col.String())
+		}
+		return columns, nil
+	}
+
+	var columns []string
+	for _, expr := range anchor.SelectExprs {
+		ae, isAliased := expr.(*sqlparser.AliasedExpr)
+		if !isAliased {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in the anchor of a recursive common table expression", sqlparser.String(expr))
+		}
+		switch {
+		case !ae.As.IsEmpty():
+			columns = append(columns, ae.As.String())
+		case isColName(ae.Expr):
+			columns = append(columns, ae.Expr.(*sqlparser.ColName).Name.String())
+		default:
+			columns = append(columns, sqlparser.String(ae.Expr))
+		}
+	}
+	return columns, nil
+}
+
+func isColName(expr sqlparser.Expr) bool {
+	_, ok := expr.(*sqlparser.ColName)
+	return ok
+}
+
+// cteDerivedTable returns a derived table with the anchor of the common table expression,
+// with its columns named like the ones of the common table expression. It is used in place
+// of the common table expression for the semantic analysis.
+func cteDerivedTable(anchor *sqlparser.Select, columns []string) (*sqlparser.DerivedTable, error) {
+	sel := sqlparser.CloneRefOfSelect(anchor)
+	for i, expr := range sel.SelectExprs {
+		ae, isAliased := expr.(*sqlparser.AliasedExpr)
+		if !isAliased {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in the anchor of a recursive common table expression", sqlparser.String(expr))
+		}
+		ae.As = sqlparser.NewColIdent(columns[i])
+	}
+	return &sqlparser.DerivedTable{Select: sel}, nil
+}
+
+// replaceCTE replaces the references to the common table expression found in the given
+// FROM clause, outside of subqueries, with derived tables of its anchor.
+func replaceCTE(from sqlparser.TableExprs, cteName string, anchor *sqlparser.Select, columns []string) ([]*sqlparser.AliasedTableExpr, error) {
+	var refs []*sqlparser.AliasedTableExpr
+	var err error
+	var replace func(tableExpr sqlparser.TableExpr)
+	replace = func(tableExpr sqlparser.TableExpr) {
+		switch node := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if !isTableNamed(node.Expr, cteName) || err != nil {
+				return
+			}
+			var derived *sqlparser.DerivedTable
+			derived, err = cteDerivedTable(anchor, columns)
+			if node.As.IsEmpty() {
+				node.As = sqlparser.NewTableIdent(cteName)
+			}
+			node.Expr = derived
+			refs = append(refs, node)
+		case *sqlparser.JoinTableExpr:
+			replace(node.LeftExpr)
+			replace(node.RightExpr)
+		case *sqlparser.ParenTableExpr:
+			for _, expr := range node.Exprs {
+				replace(expr)
+			}
+		}
+	}
+	for _, tableExpr := range from {
+		replace(tableExpr)
+	}
+	return refs, err
+}
+
+// recursiveStep holds what is needed to compute the next working set of a recursive
+// common table expression: the plan fetching the rows of the other tables of the
+// recursive query block, and the expressions evaluated against the working set.
+type recursiveStep struct {
+	plan         logicalPlan
+	batchVar     string
+	batchColumn  int
+	predicate    evalengine.Expr
+	astPredicate sqlparser.Expr
+	exprs        []evalengine.Expr
+}
+
+// planRecursiveStep plans the recursive query block of the common table expression.
+// The columns are resolved with a semantic analysis in which the common table expression
+// is replaced by a derived table of its anchor. The predicates and expressions that do not
+// use the common table expression are sent to the other tables, along with the equality
+// used to fetch only the rows matching the working set, if any.
+func planRecursiveStep(
+	sel, anchor *sqlparser.Select,
+	cteName string,
+	columns []string,
+	reservedVars *sqlparser.ReservedVars,
+	vschema plancontext.VSchema,
+	version querypb.ExecuteOptions_PlannerVersion,
+) (*recursiveStep, error) {
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sqlparser.ContainsAggregation(sel.SelectExprs) || sqlparser.ContainsWindowFunction(sel.SelectExprs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Recursive Common Table Expression '%s' can contain neither aggregation nor window functions in recursive query block", cteName)
+	}
+	if sel.OrderBy != nil || sel.Limit != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ORDER BY or LIMIT in recursive query block of common table expression")
+	}
+
+	rec := sqlparser.CloneRefOfSelect(sel)
+	refs, err := replaceCTE(rec.From, cteName, anchor, columns)
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) == 0 {
+		if referencesTable(rec, cteName) {
+			return nil, errRecursiveReference(cteName)
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: common table expression %s is not recursive", cteName)
+	}
+	if len(refs) > 1 || referencesTable(rec, cteName) {
+		return nil, errRecursiveReference(cteName)
+	}
+
+	ksName := ""
+	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	semTable, err := semantics.Analyze(rec, ksName, vschema)
+	if err != nil {
+		return nil, err
+	}
+
+	var from sqlparser.TableExprs
+	var predicates []sqlparser.Expr
+	for _, tableExpr := range rec.From {
+		newExpr, preds, err := removeCTE(tableExpr, refs[0])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, preds...)
+		if newExpr != nil {
+			from = append(from, newExpr)
+		}
+	}
+	if rec.Where != nil {
+		predicates = append(predicates, rec.Where.Expr)
+	}
+
+	lookup := &recursiveStepLookup{
+		semTable: semTable,
+		cteID:    semTable.TableSetFor(refs[0]),
+		columns:  columns,
+		hasFrom:  len(from) > 0,
+	}
+	step := &recursiveStep{}
+	var fetchPredicates, vtgatePredicates []sqlparser.Expr
+	for _, expr := range sqlparser.SplitAndExpression(nil, sqlparser.AndExpressions(predicates...)) {
+		if lookup.hasFrom && !lookup.usesCTE(expr) {
+			fetchPredicates = append(fetchPredicates, expr)
+			continue
+		}
+		vtgatePredicates = append(vtgatePredicates, expr)
+		if step.batchVar == "" && lookup.hasFrom {
+			if col, other := lookup.batchKey(expr); col != nil {
+				step.batchVar = reservedVars.ReserveColName(col)
+				step.batchColumn = columnOffset(columns, col)
+				fetchPredicates = append(fetchPredicates, &sqlparser.ComparisonExpr{
+					Operator: sqlparser.InOp,
+					Left:     other,
+					Right:    sqlparser.ListArg(step.batchVar),
+				})
+			}
+		}
+	}
+
+	for _, expr := range rec.SelectExprs {
+		ae, isAliased := expr.(*sqlparser.AliasedExpr)
+		if !isAliased {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in recursive query block of common table expression", sqlparser.String(expr))
+		}
+		evalExpr, err := lookup.translate(ae.Expr)
+		if err != nil {
+			return nil, err
+		}
+		step.exprs = append(step.exprs, evalExpr)
+	}
+	if len(vtgatePredicates) > 0 {
+		step.astPredicate = sqlparser.AndExpressions(vtgatePredicates...)
+		step.predicate, err = evalengine.Translate(step.astPredicate, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !lookup.hasFrom {
+		return step, nil
+	}
+
+	fetch := &sqlparser.Select{
+		Comments: rec.Comments,
+		From:     from,
+	}
+	for _, expr := range lookup.fetched {
+		fetch.SelectExprs = append(fetch.SelectExprs, &sqlparser.AliasedExpr{Expr: expr})
+	}
+	if len(fetch.SelectExprs) == 0 {
+		fetch.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}}
+	}
+	for _, expr := range fetchPredicates {
+		fetch.AddWhere(expr)
+	}
+	step.plan, err = newBuildSelectPlan(sqlparser.CloneRefOfSelect(fetch), reservedVars, vschema, version)
+	if err != nil {
+		return nil, err
+	}
+	return step, nil
+}
+
+// recursiveStepLookup resolves the columns of the recursive query block. The columns of the
+// common table expression come first in the rows it is evaluated on, followed by the columns
+// fetched from the other tables.
+type recursiveStepLookup struct {
+	semTable *semantics.SemTable
+	cteID    semantics.TableSet
+	columns  []string
+	hasFrom  bool
+
+	// fetched are the expressions fetched from the other tables
+	fetched []sqlparser.Expr
+}
+
+var _ evalengine.TranslationLookup = (*recursiveStepLookup)(nil)
+
+// usesCTE returns true if the expression uses a column of the common table expression
+func (l *recursiveStepLookup) usesCTE(expr sqlparser.Expr) bool {
+	return l.semTable.DirectDeps(expr).IsOverlapping(l.cteID)
+}
+
+// batchKey returns the column of the common table expression and the expression of the
+// other tables it is compared to, if the given predicate is such an equality.
+func (l *recursiveStepLookup) batchKey(expr sqlparser.Expr) (*sqlparser.ColName, sqlparser.Expr) {
+	cmp, isCmp := expr.(*sqlparser.ComparisonExpr)
+	if !isCmp || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	for _, sides := range [][2]sqlparser.Expr{{cmp.Left, cmp.Right}, {cmp.Right, cmp.Left}} {
+		col, isCol := sides[0].(*sqlparser.ColName)
+		if !isCol || !l.semTable.DirectDeps(col).Equals(l.cteID) {
+			continue
+		}
+		if l.usesCTE(sides[1]) || l.semTable.RecursiveDeps(sides[1]).NumberOfTables() == 0 {
+			continue
+		}
+		return col, sqlparser.CloneExpr(sides[1])
+	}
+	return nil, nil
+}
+
+// translate turns an expression of the recursive query block into an evalengine expression.
+// An expression that does not use the common table expression is fetched from the other tables.
+func (l *recursiveStepLookup) translate(expr sqlparser.Expr) (evalengine.Expr, error) {
+	if l.hasFrom && !l.usesCTE(expr) {
+		return evalengine.NewColumn(l.fetch(expr), l.typedCollation(expr)), nil
+	}
+	return evalengine.Translate(expr, l)
+}
+
+// fetch returns the offset of the expression in the rows evaluated by the recursive step,
+// adding it to the expressions fetched from the other tables if needed.
+func (l *recursiveStepLookup) fetch(expr sqlparser.Expr) int {
+	for i, fetched := range l.fetched {
+		if sqlparser.EqualsExpr(fetched, expr) {
+			return len(l.columns) + i
+		}
+	}
+	l.fetched = append(l.fetched, sqlparser.CloneExpr(expr))
+	return len(l.columns) + len(l.fetched) - 1
+}
+
+func (l *recursiveStepLookup) typedCollation(expr sqlparser.Expr) collations.TypedCollation {
+	return collations.TypedCollation{
+		Collation:    l.CollationForExpr(expr),
+		Coercibility: collations.CoerceCoercible,
+		Repertoire:   collations.RepertoireUnicode,
+	}
+}
+
+// ColumnLookup implements the evalengine.TranslationLookup interface
+func (l *recursiveStepLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if !l.semTable.DirectDeps(col).Equals(l.cteID) {
+		return l.fetch(col), nil
+	}
+	offset := columnOffset(l.columns, col)
+	if offset == -1 {
+		return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+	}
+	return offset, nil
+}
+
+// CollationForExpr implements the evalengine.TranslationLookup interface
+func (l *recursiveStepLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	return l.semTable.CollationForExpr(expr)
+}
+
+// DefaultCollation implements the evalengine.TranslationLookup interface
+func (l *recursiveStepLookup) DefaultCollation() collations.ID {
+	return l.semTable.Collation
+}
+
+// removeCTE removes the given reference to the common table expression from the table expression.
+// The conditions of the inner joins it was part of are returned as predicates.
+func removeCTE(tableExpr sqlparser.TableExpr, ref *sqlparser.AliasedTableExpr) (sqlparser.TableExpr, []sqlparser.Expr, error) {
+	switch node := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		if node == ref {
+			return nil, nil, nil
+		}
+		return node, nil, nil
+	case *sqlparser.JoinTableExpr:
+		if !containsTableExpr(node, ref) {
+			return node, nil, nil
+		}
+		lhs, lhsPreds, err := removeCTE(node.LeftExpr, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		rhs, rhsPreds, err := removeCTE(node.RightExpr, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case node.Join != sqlparser.NormalJoinType && node.Join != sqlparser.StraightJoinType:
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s with recursive common table expression", node.Join.ToString())
+		case node.Condition != nil && node.Condition.Using != nil:
+			return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: join with USING(column_list) clause on recursive common table expression")
+		}
+		preds := append(lhsPreds, rhsPreds...)
+		if node.Condition != nil && node.Condition.On != nil {
+			preds = append(preds, node.Condition.On)
+		}
+		switch {
+		case lhs == nil:
+			return rhs, preds, nil
+		case rhs == nil:
+			return lhs, preds, nil
+		}
+		// the condition of this join has been moved to the predicates
+		node.LeftExpr, node.RightExpr, node.Condition = lhs, rhs, &sqlparser.JoinCondition{}
+		return node, preds, nil
+	case *sqlparser.ParenTableExpr:
+		if !containsTableExpr(node, ref) {
+			return node, nil, nil
+		}
+		var exprs sqlparser.TableExprs
+		var preds []sqlparser.Expr
+		for _, expr := range node.Exprs {
+			newExpr, newPreds, err := removeCTE(expr, ref)
+			if err != nil {
+				return nil, nil, err
+			}
+			preds = append(preds, newPreds...)
+			if newExpr != nil {
+				exprs = append(exprs, newExpr)
+			}
+		}
+		if len(exprs) == 0 {
+			return nil, preds, nil
+		}
+		node.Exprs = exprs
+		return node, preds, nil
+	}
+	return tableExpr, nil, nil
+}
+
+func containsTableExpr(node sqlparser.SQLNode, ref *sqlparser.AliasedTableExpr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok && tableExpr == ref {
+			found = true
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+func errRecursiveReference(cteName string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once, and not in any subquery", cteName)
+}
+
+// referencesTable returns true if the given node uses an unqualified table with the given name.
+func referencesTable(node sqlparser.SQLNode, name string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if aliasedTable, ok := node.(*sqlparser.AliasedTableExpr); ok && isTableNamed(aliasedTable.Expr, name) {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+func isTableNamed(expr sqlparser.SimpleTableExpr, name string) bool {
+	tableName, ok := expr.(sqlparser.TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name
+}
+
+func columnOffset(columns []string, col *sqlparser.ColName) int {
+	for i, column := range columns {
+		if col.Name.EqualString(column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameUnshardedKeyspace returns the keyspace and the tables used by the given plans
+// if both are routes to the same unsharded keyspace.
+func sameUnshardedKeyspace(anchor, recursive logicalPlan) (*vindexes.Keyspace, string) {
+	anchorRoute, ok := unshardedRoute(anchor)
+	if !ok {
+		return nil, ""
+	}
+	recursiveRoute, ok := unshardedRoute(recursive)
+	if !ok || recursiveRoute.eroute.Keyspace.Name != anchorRoute.eroute.Keyspace.Name {
+		return nil, ""
+	}
+	tableName := anchorRoute.eroute.TableName
+	if recursiveRoute.eroute.TableName != tableName {
+		tableName += ", " + recursiveRoute.eroute.TableName
+	}
+	return anchorRoute.eroute.Keyspace, tableName
+}
+
+// outerQueryKeyspace returns the given unsharded keyspace if the outer query only uses its tables.
+func outerQueryKeyspace(sel, anchor *sqlparser.Select, cteName string, columns []string, ks *vindexes.Keyspace, vschema plancontext.VSchema) (*vindexes.Keyspace, error) {
+	outer := sqlparser.CloneRefOfSelect(sel)
+	outer.With = nil
+	if _, err := replaceCTE(outer.From, cteName, anchor, columns); err != nil {
+		return nil, err
+	}
+	if referencesTable(outer, cteName) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s used in a subquery", cteName)
+	}
+	semTable, err := semantics.Analyze(outer, ks.Name, vschema)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range semTable.Tables {
+		if _, isDerived := table.(*semantics.DerivedTable); isDerived {
+			continue
+		}
+		vindexTable := table.GetVindexTable()
+		if vindexTable == nil || vindexTable.Keyspace == nil || vindexTable.Keyspace.Name != ks.Name {
+			return nil, nil
+		}
+	}
+	return ks, nil
+}
+
+func unshardedRoute(plan logicalPlan) (*routeGen4, bool) {
+	route, ok := plan.(*routeGen4)
+	if !ok {
+		return nil, false
+	}
+	switch route.eroute.Opcode {
+	case engine.Unsharded:
+		return route, true
+	case engine.Reference:
+		return route, !route.eroute.Keyspace.Sharded
+	}
+	return nil, false
+}
+
+// planRecursiveCTEQuery plans the outer query through the Gen4 operators and horizon planning,
+// with the references to the common table expression replaced by derived tables of its anchor
+// for the semantic analysis. These derived tables are then planned as the given RecursiveCTE.
+func planRecursiveCTEQuery(
+	sel, anchor *sqlparser.Select,
+	cteName string,
+	columns []string,
+	eCTE *engine.RecursiveCTE,
+	reservedVars *sqlparser.ReservedVars,
+	vschema plancontext.VSchema,
+	version querypb.ExecuteOptions_PlannerVersion,
+) (logicalPlan, error) {
+	outer := sqlparser.CloneRefOfSelect(sel)
+	outer.With = nil
+	refs, err := replaceCTE(outer.From, cteName, anchor, columns)
+	if err != nil {
+		return nil, err
+	}
+	if referencesTable(outer, cteName) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s used in a subquery", cteName)
+	}
+	if len(refs) == 0 {
+		return newBuildSelectPlan(outer, reservedVars, vschema, version)
+	}
+
+	ksName := ""
+	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	semTable, err := semantics.Analyze(outer, ksName, vschema)
+	if err != nil {
+		return nil, err
+	}
+	vschema.PlannerWarning(semTable.Warning)
+
+	semTable.RecursiveCTEs = map[*sqlparser.DerivedTable]*engine.RecursiveCTE{}
+	for _, ref := range refs {
+		derived := ref.Expr.(*sqlparser.DerivedTable)
+		semTable.RecursiveCTEs[derived] = eCTE
+		useCTEDependencies(semTable, semTable.TableSetFor(ref), derived)
+	}
+	if semTable.NotUnshardedErr != nil {
+		return nil, semTable.NotUnshardedErr
+	}
+	return buildSelectPlanWithSemTable(outer, semTable, reservedVars, vschema, version)
+}
+
+// useCTEDependencies makes the expressions using the columns of a derived table standing for
+// a recursive common table expression depend on the derived table itself, instead of the tables
+// of the anchor, since the rows of the common table expression are computed at the vtgate level.
+func useCTEDependencies(semTable *semantics.SemTable, cteID semantics.TableSet, derived *sqlparser.DerivedTable) {
+	var anchorTables semantics.TableSet
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			anchorTables.MergeInPlace(semTable.TableSetFor(tableExpr))
+		}
+		return true, nil
+	}, derived.Select)
+
+	for expr, deps := range semTable.Recursive {
+		if !semTable.DirectDeps(expr).IsOverlapping(cteID) {
+			continue
+		}
+		var newDeps semantics.TableSet
+		deps.ForEachTable(func(idx int) {
+			if !anchorTables.IsOverlapping(semantics.SingleTableSet(idx)) {
+				newDeps.AddTable(idx)
+			}
+		})
+		semTable.Recursive[expr] = newDeps.Merge(cteID)
+	}
+}
+
+// recursiveCTE is the logicalPlan for engine.RecursiveCTE. It returns the columns
+// of the common table expression, followed by the expressions evaluated on them.
+type recursiveCTE struct {
+	gen4Plan
+	tables semantics.TableSet
+	eCTE   *engine.RecursiveCTE
+
+	// exprs are the expressions evaluated on the rows of the common table expression,
+	// in the same order as eProjection.Exprs
+	exprs       []sqlparser.Expr
+	eProjection *engine.Projection
+}
+
+var _ logicalPlan = (*recursiveCTE)(nil)
+
+// transformRecursiveCTEPlan builds the plan for the rows of a recursive common table expression.
+// Like for derived tables, a simpleProjection returns the columns needed by the other plans.
+func transformRecursiveCTEPlan(op *physical.RecursiveCTE) (logicalPlan, error) {
+	return &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(&recursiveCTE{
+			tables:      op.ID,
+			eCTE:        op.CTE,
+			eProjection: &engine.Projection{},
+		}),
+		eSimpleProj: &engine.SimpleProjection{
+			Cols: op.ColumnsOffset,
+		},
+	}, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (r *recursiveCTE) Primitive() engine.Primitive {
+	if len(r.exprs) == 0 {
+		return r.eCTE
+	}
+	r.eProjection.Input = r.eCTE
+	return r.eProjection
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (r *recursiveCTE) WireupGen4(*semantics.SemTable) error {
+	return nil
+}
+
+// Rewrite implements the logicalPlan interface
+func (r *recursiveCTE) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "recursiveCTE: wrong number of inputs")
+	}
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (r *recursiveCTE) ContainsTables() semantics.TableSet {
+	return r.tables
+}
+
+// Inputs implements the logicalPlan interface
+func (r *recursiveCTE) Inputs() []logicalPlan {
+	return nil
+}
+
+// OutputColumns implements the logicalPlan interface
+func (r *recursiveCTE) OutputColumns() []sqlparser.SelectExpr {
+	columns := make([]sqlparser.SelectExpr, 0, len(r.eCTE.Columns)+len(r.exprs))
+	for _, column := range r.eCTE.Columns {
+		columns = append(columns, &sqlparser.AliasedExpr{Expr: sqlparser.NewColName(column)})
+	}
+	for i, expr := range r.exprs {
+		columns = append(columns, &sqlparser.AliasedExpr{
+			Expr: sqlparser.CloneExpr(expr),
+			As:   sqlparser.NewColIdent(r.eProjection.Cols[i]),
+		})
+	}
+	return columns
+}
+
+// pushProjection returns the offset of a column of the common table expression,
+// or evaluates the expression on the rows of the common table expression.
+func (r *recursiveCTE) pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExpr, reuseCol bool) (offset int, added bool, err error) {
+	if col, isCol := expr.Expr.(*sqlparser.ColName); isCol && (expr.As.IsEmpty() || expr.As.Equal(col.Name)) {
+		offset, err := r.ColumnLookup(col)
+		return offset, false, err
+	}
+
+	name := expr.As.String()
+	if expr.As.IsEmpty() {
+		name = sqlparser.String(expr.Expr)
+	}
+	if reuseCol {
+		for i, e := range r.exprs {
+			if sqlparser.EqualsExpr(e, expr.Expr) && r.eProjection.Cols[i] == name {
+				return len(r.eCTE.Columns) + i, false, nil
+			}
+		}
+	}
+	lookup := &recursiveCTELookup{ctx: ctx, plan: r}
+	evalExpr, err := evalengine.Translate(expr.Expr, lookup)
+	if err != nil {
+		return 0, false, err
+	}
+	r.exprs = append(r.exprs, expr.Expr)
+	r.eProjection.Exprs = append(r.eProjection.Exprs, evalExpr)
+	r.eProjection.Cols = append(r.eProjection.Cols, name)
+	return len(r.eCTE.Columns) + len(r.exprs) - 1, true, nil
+}
+
+// ColumnLookup returns the offset of the given column of the common table expression
+func (r *recursiveCTE) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	offset := columnOffset(r.eCTE.Columns, col)
+	if offset == -1 {
+		return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+	}
+	return offset, nil
+}
+
+// recursiveCTELookup resolves the columns of the expressions evaluated on the rows of a recursive common table expression
+type recursiveCTELookup struct {
+	ctx  *plancontext.PlanningContext
+	plan *recursiveCTE
+}
+
+var _ evalengine.TranslationLookup = (*recursiveCTELookup)(nil)
+
+// ColumnLookup implements the evalengine.TranslationLookup interface
+func (l *recursiveCTELookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	return l.plan.ColumnLookup(col)
+}
+
+// CollationForExpr implements the evalengine.TranslationLookup interface
+func (l *recursiveCTELookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	return l.ctx.SemTable.CollationForExpr(expr)
+}
+
+// DefaultCollation implements the evalengine.TranslationLookup interface
+func (l *recursiveCTELookup) DefaultCollation() collations.ID {
+	return l.ctx.SemTable.Collation
+}
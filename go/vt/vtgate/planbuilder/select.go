@@ -209,6 +209,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 			return err
 		}
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -230,6 +233,36 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 	return setMiscFunc(pb.plan, sel)
 }
 
+// checkWindowFunctions returns an error if the query uses window functions that
+// would need to be evaluated across shards. Window functions can be pushed down
+// if the query goes to a single shard, or if the PARTITION BY of every window
+// references a unique vindex, since all the rows of a partition are then in the same shard.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	if !sqlparser.ContainsWindowFunction(sel.SelectExprs) && !sqlparser.ContainsWindowFunction(sel.OrderBy) {
+		return nil
+	}
+	rb, isRoute := pb.plan.(*route)
+	if !isRoute {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with window functions")
+	}
+	if rb.isSingleShard() {
+		return nil
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		fExpr, ok := node.(*sqlparser.FuncExpr)
+		if !ok || !fExpr.IsWindowFunction() {
+			return true, nil
+		}
+		for _, expr := range fExpr.Over.PartitionBy {
+			vindex := pb.st.Vindex(expr, rb)
+			if vindex != nil && vindex.IsUnique() {
+				return true, nil
+			}
+		}
+		return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with window functions")
+	}, sel.SelectExprs, sel.OrderBy)
+}
+
 func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 	_, err := visit(in, func(plan logicalPlan) (bool, logicalPlan, error) {
 		switch node := plan.(type) {
@@ -28,17 +28,7 @@ import (
 
 func unshardedShortcut(stmt sqlparser.SelectStatement, ks *vindexes.Keyspace, semTable *semantics.SemTable) (logicalPlan, error) {
 	// this method is used when the query we are handling has all tables in the same unsharded keyspace
-	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
-		switch node := cursor.Node().(type) {
-		case sqlparser.SelectExpr:
-			removeKeyspaceFromSelectExpr(node)
-		case sqlparser.TableName:
-			cursor.Replace(sqlparser.TableName{
-				Name: node.Name,
-			})
-		}
-		return true
-	}, nil)
+	removeKeyspacesFromStatement(stmt)
 
 	tableNames, err := getTableNames(semTable)
 	if err != nil {
@@ -60,6 +50,22 @@ func unshardedShortcut(stmt sqlparser.SelectStatement, ks *vindexes.Keyspace, se
 	return plan, nil
 }
 
+// removeKeyspacesFromStatement removes the keyspace qualifiers from all the tables
+// and columns of a statement that is sent as is to an unsharded keyspace
+func removeKeyspacesFromStatement(stmt sqlparser.SelectStatement) {
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case sqlparser.SelectExpr:
+			removeKeyspaceFromSelectExpr(node)
+		case sqlparser.TableName:
+			cursor.Replace(sqlparser.TableName{
+				Name: node.Name,
+			})
+		}
+		return true
+	}, nil)
+}
+
 func getTableNames(semTable *semantics.SemTable) ([]string, error) {
 	tableNameMap := map[string]interface{}{}
 
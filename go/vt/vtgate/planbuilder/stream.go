@@ -41,5 +41,6 @@ func buildStreamPlan(stmt *sqlparser.Stream, vschema plancontext.VSchema) (engin
 		Keyspace:          table.Keyspace,
 		TargetDestination: dest,
 		TableName:         table.Name.CompliantName(),
+		ConsumerGroup:     sqlparser.ExtractCommentDirectives(stmt.Comments).GetString(sqlparser.DirectiveConsumerGroup, ""),
 	}, nil
 }
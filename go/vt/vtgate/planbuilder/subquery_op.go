@@ -59,7 +59,16 @@ func transformCorrelatedSubQueryPlan(ctx *plancontext.PlanningContext, op *physi
 	if err != nil {
 		return nil, err
 	}
-	return newSemiJoin(outer, inner, op.Vars), nil
+	if op.IsSemiJoin() {
+		sj := newSemiJoin(outer, inner, op.Vars)
+		sj.cols = op.Columns
+		return sj, nil
+	}
+	inner, err = planHorizon(ctx, inner, op.Extracted.Subquery.Select)
+	if err != nil {
+		return nil, err
+	}
+	return newCorrelatedSubquery(ctx, op, outer, inner)
 }
 
 func mergeSubQueryOpPlan(ctx *plancontext.PlanningContext, inner, outer logicalPlan, n *physical.SubQueryOp) logicalPlan {
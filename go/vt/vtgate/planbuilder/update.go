@@ -35,7 +35,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if upd.With != nil {
 		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in update statement")
 	}
-	dml, ksidVindex, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dml, ksidVindex, pullouts, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		eupd.KsidVindex = ksidVindex.Vindex
 		eupd.KsidLength = len(ksidVindex.Columns)
 	}
-	return eupd, nil
+	return wrapWithPullouts(eupd, pullouts), nil
 }
 
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// The columns of the input are returned as is,
+// followed by one column per window function.
+type window struct {
+	logicalPlanCommon
+	eWindow *engine.Window
+
+	// windowExprs are the select expressions evaluated by eWindow,
+	// in the same order as eWindow.Funcs
+	windowExprs []*sqlparser.AliasedExpr
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+// OutputColumns implements the logicalPlan interface
+func (w *window) OutputColumns() []sqlparser.SelectExpr {
+	cols := append([]sqlparser.SelectExpr{}, w.input.OutputColumns()...)
+	for _, expr := range w.windowExprs {
+		cols = append(cols, expr)
+	}
+	return cols
+}
+
+// hasWindowFunctions returns true if the select list or the ORDER BY of the query uses window functions.
+func hasWindowFunctions(sel *sqlparser.Select) bool {
+	return sqlparser.ContainsWindowFunction(sel.SelectExprs) || sqlparser.ContainsWindowFunction(sel.OrderBy)
+}
+
+// canPushWindowFunctions returns true if the window functions of the query can be
+// evaluated by the shards. This is the case when the PARTITION BY of every window
+// references a unique vindex, since all the rows of a partition then live in the same shard.
+func canPushWindowFunctions(ctx *plancontext.PlanningContext, plan logicalPlan, sel *sqlparser.Select) bool {
+	if _, isRoute := plan.(*routeGen4); !isRoute {
+		return false
+	}
+	canPush := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		fExpr, ok := node.(*sqlparser.FuncExpr)
+		if !ok || !fExpr.IsWindowFunction() {
+			return true, nil
+		}
+		for _, expr := range fExpr.Over.PartitionBy {
+			if exprHasUniqueVindex(ctx.VSchema, ctx.SemTable, expr) {
+				return true, nil
+			}
+		}
+		canPush = false
+		return false, nil
+	}, sel.SelectExprs, sel.OrderBy)
+	return canPush
+}
+
+// planWindowFunctions plans the window functions of a query whose window partitions can span
+// multiple shards. The input is asked for all the columns needed to evaluate the window
+// functions, and a Window primitive evaluates them at the vtgate level. The ORDER BY of the
+// query, if any, is then applied with a memory sort, and a simple projection returns the
+// columns of the select list.
+func (hp *horizonPlanning) planWindowFunctions(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
+	if hp.qp.NeedsAggregation() || hp.sel.Having != nil || hp.qp.NeedsDistinct() {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions with aggregation or distinct in cross-shard query")
+	}
+
+	w := &window{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eWindow:           &engine.Window{},
+	}
+
+	// the offsets of the window functions are only known once all the
+	// columns needed have been pushed to the input, so until then we
+	// keep the index of the window function instead
+	type projCol struct {
+		offset   int
+		isWindow bool
+	}
+	var cols []projCol
+	for _, e := range hp.qp.SelectExprs {
+		ae, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		fExpr, isFunc := ae.Expr.(*sqlparser.FuncExpr)
+		if !isFunc || !fExpr.IsWindowFunction() {
+			if sqlparser.ContainsWindowFunction(ae.Expr) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function inside an expression in cross-shard query: %s", sqlparser.String(ae.Expr))
+			}
+			offset, _, err := pushProjection(ctx, ae, plan, true, true, false)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, projCol{offset: offset})
+			continue
+		}
+		wf, err := planWindowFunc(ctx, fExpr, plan)
+		if err != nil {
+			return nil, err
+		}
+		if ae.As.IsEmpty() {
+			wf.Alias = sqlparser.String(ae.Expr)
+		} else {
+			wf.Alias = ae.As.String()
+		}
+		cols = append(cols, projCol{offset: len(w.eWindow.Funcs), isWindow: true})
+		w.eWindow.Funcs = append(w.eWindow.Funcs, wf)
+		w.windowExprs = append(w.windowExprs, ae)
+	}
+
+	var orderBy []engine.OrderByParams
+	var orderByWindow []bool
+	for _, order := range hp.qp.OrderExprs {
+		if isSpecialOrderBy(order) {
+			continue
+		}
+		idx := findWindowExpr(order.WeightStrExpr, w.windowExprs)
+		if idx != -1 {
+			orderBy = append(orderBy, engine.OrderByParams{
+				Col:             idx,
+				WeightStringCol: -1,
+				Desc:            order.Inner.Direction == sqlparser.DescOrder,
+				CollationID:     ctx.SemTable.CollationForExpr(order.WeightStrExpr),
+			})
+			orderByWindow = append(orderByWindow, true)
+			continue
+		}
+		if sqlparser.ContainsWindowFunction(order.WeightStrExpr) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in cross-shard query: order by window function that is not in the select list: %s", sqlparser.String(order.Inner))
+		}
+		offset, weightStringOffset, err := wrapAndPushExpr(ctx, order.Inner.Expr, order.WeightStrExpr, plan)
+		if err != nil {
+			return nil, err
+		}
+		orderBy = append(orderBy, engine.OrderByParams{
+			Col:               offset,
+			WeightStringCol:   weightStringOffset,
+			Desc:              order.Inner.Direction == sqlparser.DescOrder,
+			StarColFixedIndex: offset,
+			CollationID:       ctx.SemTable.CollationForExpr(order.Inner.Expr),
+		})
+		orderByWindow = append(orderByWindow, false)
+	}
+
+	// all the columns have been pushed to the input, the window functions
+	// are now known to be evaluated right after the input columns
+	inputColumns := len(plan.OutputColumns())
+	var result logicalPlan = w
+	if len(orderBy) > 0 {
+		for i := range orderBy {
+			if orderByWindow[i] {
+				orderBy[i].Col += inputColumns
+				orderBy[i].StarColFixedIndex = orderBy[i].Col
+			}
+		}
+		ms := &memorySort{
+			resultsBuilder: resultsBuilder{
+				logicalPlanCommon: newBuilderCommon(w),
+				weightStrings:     make(map[*resultColumn]int),
+			},
+			eMemorySort: &engine.MemorySort{OrderBy: orderBy},
+		}
+		ms.truncater = ms.eMemorySort
+		result = ms
+	}
+
+	proj := &engine.SimpleProjection{}
+	for _, col := range cols {
+		offset := col.offset
+		if col.isWindow {
+			offset += inputColumns
+		}
+		proj.Cols = append(proj.Cols, offset)
+	}
+	return &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(result),
+		eSimpleProj:       proj,
+	}, nil
+}
+
+// planWindowFunc creates the engine.WindowFunc for the given window function,
+// pushing the columns it needs to the input plan.
+func planWindowFunc(ctx *plancontext.PlanningContext, fExpr *sqlparser.FuncExpr, plan logicalPlan) (*engine.WindowFunc, error) {
+	opcode, supported := engine.SupportedWindowFunctions[fExpr.Name.Lowered()]
+	if !supported {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function %s in cross-shard query", fExpr.Name.String())
+	}
+	if fExpr.Distinct {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: distinct in window function: %s", sqlparser.String(fExpr))
+	}
+	if fExpr.Over.Frame != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window frame in cross-shard query: %s", sqlparser.String(fExpr.Over.Frame))
+	}
+
+	wf := &engine.WindowFunc{
+		Opcode: opcode,
+		Col:    -1,
+	}
+	switch opcode {
+	case engine.WindowRowNumber, engine.WindowRank, engine.WindowDenseRank:
+		if len(fExpr.Exprs) != 0 {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "window function %s takes no arguments", fExpr.Name.String())
+		}
+	default:
+		if len(fExpr.Exprs) != 1 {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "aggregate functions take a single argument '%s'", sqlparser.String(fExpr))
+		}
+		switch arg := fExpr.Exprs[0].(type) {
+		case *sqlparser.StarExpr:
+			if opcode != engine.WindowCount {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "syntax error: %s", sqlparser.String(fExpr))
+			}
+		case *sqlparser.AliasedExpr:
+			offset, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: arg.Expr}, plan, true, true, false)
+			if err != nil {
+				return nil, err
+			}
+			wf.Col = offset
+			wf.CollationID = ctx.SemTable.CollationForExpr(arg.Expr)
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] %T in window function", arg)
+		}
+	}
+
+	for _, expr := range fExpr.Over.PartitionBy {
+		offset, weightStringOffset, err := wrapAndPushExpr(ctx, expr, expr, plan)
+		if err != nil {
+			return nil, err
+		}
+		wf.PartitionBy = append(wf.PartitionBy, engine.OrderByParams{
+			Col:             offset,
+			WeightStringCol: weightStringOffset,
+			CollationID:     ctx.SemTable.CollationForExpr(expr),
+		})
+	}
+	for _, order := range fExpr.Over.OrderBy {
+		offset, weightStringOffset, err := wrapAndPushExpr(ctx, order.Expr, order.Expr, plan)
+		if err != nil {
+			return nil, err
+		}
+		wf.OrderBy = append(wf.OrderBy, engine.OrderByParams{
+			Col:             offset,
+			WeightStringCol: weightStringOffset,
+			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     ctx.SemTable.CollationForExpr(order.Expr),
+		})
+	}
+	return wf, nil
+}
+
+// findWindowExpr returns the index of the window function equal to the given expression, or -1.
+func findWindowExpr(expr sqlparser.Expr, windowExprs []*sqlparser.AliasedExpr) int {
+	for i, ae := range windowExprs {
+		if sqlparser.EqualsExpr(expr, ae.Expr) {
+			return i
+		}
+	}
+	return -1
+}
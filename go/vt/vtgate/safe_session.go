@@ -187,6 +187,15 @@ func (session *SafeSession) AutocommitApproval() bool {
 	return false
 }
 
+// IsAutocommittable returns true if the transaction was started for the
+// current statement only, and can be committed as soon as it completes.
+// Unlike AutocommitApproval, it does not change the state of the session.
+func (session *SafeSession) IsAutocommittable() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.autocommitState == autocommittable
+}
+
 // SetSavepointState sets the state only once for the complete query execution life.
 // Calling the function multiple times will have no effect, only the first call would be used.
 // Default state is savepointStateNotSet,
@@ -59,8 +59,8 @@ func newAnalyzer(dbName string, si SchemaInformation) *analyzer {
 	return a
 }
 
-// Analyze analyzes the parsed query.
-func Analyze(statement sqlparser.SelectStatement, currentDb string, si SchemaInformation) (*SemTable, error) {
+// Analyze analyzes the parsed query. The statement is a SELECT, a UNION, an UPDATE or a DELETE.
+func Analyze(statement sqlparser.Statement, currentDb string, si SchemaInformation) (*SemTable, error) {
 	analyzer := newAnalyzer(currentDb, si)
 
 	// Analysis for initial scope
@@ -75,7 +75,17 @@ func Analyze(statement sqlparser.SelectStatement, currentDb string, si SchemaInf
 	return semTable, nil
 }
 
-func (a analyzer) newSemTable(statement sqlparser.SelectStatement, coll collations.ID) *SemTable {
+func (a analyzer) newSemTable(statement sqlparser.Statement, coll collations.ID) *SemTable {
+	var comments sqlparser.Comments
+	switch statement := statement.(type) {
+	case sqlparser.SelectStatement:
+		comments = statement.GetComments()
+	case *sqlparser.Update:
+		comments = statement.Comments
+	case *sqlparser.Delete:
+		comments = statement.Comments
+	}
+
 	return &SemTable{
 		Recursive:         a.binder.recursive,
 		Direct:            a.binder.direct,
@@ -85,7 +95,7 @@ func (a analyzer) newSemTable(statement sqlparser.SelectStatement, coll collatio
 		NotSingleRouteErr: a.projErr,
 		NotUnshardedErr:   a.unshardedErr,
 		Warning:           a.warning,
-		Comments:          statement.GetComments(),
+		Comments:          comments,
 		SubqueryMap:       a.binder.subqueryMap,
 		SubqueryRef:       a.binder.subqueryRef,
 		ColumnEqualities:  map[columnName][]sqlparser.Expr{},
@@ -573,6 +573,41 @@ func TestSubqueriesMappingWhereClause(t *testing.T) {
 	}
 }
 
+func TestSubqueriesInDML(t *testing.T) {
+	tcs := []struct {
+		sql    string
+		opCode engine.PulloutOpcode
+		deps   TableSet
+	}{{
+		sql:    "update t1 set id = 1 where id in (select uid from t2 where name = 'a')",
+		opCode: engine.PulloutIn,
+		deps:   T0,
+	}, {
+		// id is not a column of t2, so it is bound to the table of the update
+		sql:    "update t1 set id = 1 where id in (select uid from t2 where uid = id)",
+		opCode: engine.PulloutIn,
+		deps:   T1,
+	}, {
+		sql:    "update t1 set id = (select max(uid) from t2)",
+		opCode: engine.PulloutValue,
+		deps:   T0,
+	}, {
+		sql:    "delete from t1 where exists (select 1 from t2 where name = t1.id)",
+		opCode: engine.PulloutExists,
+		deps:   T1,
+	}}
+
+	for _, tc := range tcs {
+		t.Run(tc.sql, func(t *testing.T) {
+			stmt, semTable := parseAndAnalyze(t, tc.sql, "d")
+			extracted := semTable.SubqueryMap[stmt]
+			require.Len(t, extracted, 1)
+			assert.EqualValues(t, tc.opCode, extracted[0].OpCode)
+			assert.Equal(t, tc.deps, semTable.RecursiveDeps(extracted[0].Subquery))
+		})
+	}
+}
+
 func TestSubqueriesMappingSelectExprs(t *testing.T) {
 	tcs := []struct {
 		sql        string
@@ -1352,7 +1387,7 @@ func parseAndAnalyze(t *testing.T, query, dbName string) (sqlparser.Statement, *
 	parse, err := sqlparser.Parse(query)
 	require.NoError(t, err)
 
-	semTable, err := Analyze(parse.(sqlparser.SelectStatement), dbName, fakeSchemaInfo())
+	semTable, err := Analyze(parse, dbName, fakeSchemaInfo())
 	require.NoError(t, err)
 	return parse, semTable
 }
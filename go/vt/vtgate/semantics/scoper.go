@@ -42,8 +42,10 @@ type (
 	scope struct {
 		parent     *scope
 		selectStmt *sqlparser.Select
-		tables     []TableInfo
-		isUnion    bool
+		// dmlStmt is the UPDATE or DELETE of the scope, if any
+		dmlStmt sqlparser.Statement
+		tables  []TableInfo
+		isUnion bool
 	}
 )
 
@@ -67,6 +69,10 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 
 		s.rScope[node] = currScope
 		s.wScope[node] = newScope(nil)
+	case *sqlparser.Update, *sqlparser.Delete:
+		currScope := newScope(s.currentScope())
+		currScope.dmlStmt = node.(sqlparser.Statement)
+		s.push(currScope)
 	case sqlparser.TableExpr:
 		if isParentSelect(cursor) {
 			// when checking the expressions used in JOIN conditions, special rules apply where the ON expression
@@ -91,6 +97,11 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 		}
 		wScope.tables = []TableInfo{createVTableInfoForExpressions(node, s.currentScope().tables, s.org)}
 	case sqlparser.OrderBy:
+		if isParentOverClause(cursor) || isParentDML(cursor) {
+			// the ORDER BY of a window specification can't see the select expressions,
+			// and the ORDER BY of an UPDATE or a DELETE has no select expressions
+			break
+		}
 		err := s.createSpecialScopePostProjection(cursor.Parent())
 		if err != nil {
 			return err
@@ -139,7 +150,12 @@ func keepIntLiteral(e sqlparser.Expr) *sqlparser.Literal {
 func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	node := cursor.Node()
 	switch node := node.(type) {
-	case *sqlparser.Select, sqlparser.OrderBy, sqlparser.GroupBy:
+	case *sqlparser.Select, sqlparser.GroupBy, *sqlparser.Update, *sqlparser.Delete:
+		s.popScope()
+	case sqlparser.OrderBy:
+		if isParentOverClause(cursor) || isParentDML(cursor) {
+			break
+		}
 		s.popScope()
 	case *sqlparser.Where:
 		if node.Type != sqlparser.HavingClause {
@@ -163,6 +179,19 @@ func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	return nil
 }
 
+func isParentOverClause(cursor *sqlparser.Cursor) bool {
+	_, isOver := cursor.Parent().(*sqlparser.OverClause)
+	return isOver
+}
+
+func isParentDML(cursor *sqlparser.Cursor) bool {
+	switch cursor.Parent().(type) {
+	case *sqlparser.Update, *sqlparser.Delete:
+		return true
+	}
+	return false
+}
+
 func ValidAsMapKey(s sqlparser.SQLNode) bool {
 	return reflect.TypeOf(s).Comparable()
 }
@@ -231,6 +260,14 @@ func newScope(parent *scope) *scope {
 	return &scope{parent: parent}
 }
 
+// statement returns the statement that the subqueries of the scope belong to.
+func (s *scope) statement() sqlparser.Statement {
+	if s.selectStmt != nil {
+		return s.selectStmt
+	}
+	return s.dmlStmt
+}
+
 func (s *scope) addTable(info TableInfo) error {
 	name, err := info.Name()
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -88,7 +89,8 @@ type (
 		ExprTypes   map[sqlparser.Expr]Type
 		selectScope map[*sqlparser.Select]*scope
 		Comments    sqlparser.Comments
-		SubqueryMap map[*sqlparser.Select][]*sqlparser.ExtractedSubquery
+		// SubqueryMap holds the subqueries of each SELECT, UPDATE and DELETE statement
+		SubqueryMap map[sqlparser.Statement][]*sqlparser.ExtractedSubquery
 		SubqueryRef map[*sqlparser.Subquery]*sqlparser.ExtractedSubquery
 
 		// ColumnEqualities is used to enable transitive closures
@@ -100,6 +102,10 @@ type (
 		Collation collations.ID
 
 		Warning string
+
+		// RecursiveCTEs holds the derived tables standing for recursive common table expressions,
+		// along with the primitives computing their rows at the vtgate level.
+		RecursiveCTEs map[*sqlparser.DerivedTable]*engine.RecursiveCTE
 	}
 
 	columnName struct {
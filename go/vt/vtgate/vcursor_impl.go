@@ -197,6 +197,11 @@ func (vc *vcursorImpl) MaxMemoryRows() int {
 	return *maxMemoryRows
 }
 
+// MaxHashAggregateRows returns the hashAggregateMaxMemoryRows flag value.
+func (vc *vcursorImpl) MaxHashAggregateRows() int {
+	return *hashAggregateMaxMemoryRows
+}
+
 // ExceedsMaxMemoryRows returns a boolean indicating whether the maxMemoryRows value has been exceeded.
 // Returns false if the max memory rows override directive is set to true.
 func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
@@ -424,6 +429,38 @@ func (vc *vcursorImpl) StreamExecutePrimitive(primitive engine.Primitive, bindVa
 	return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "upstream shards are not available")
 }
 
+// StreamExecutePrimitiveStandalone is part of the engine.VCursor interface.
+// The primitive is executed with an autocommit session of its own, outside of
+// any transaction of the current session. A missing shard is only retried as
+// long as no rows have reached the callback, since the callback may already
+// have acted on them.
+func (vc *vcursorImpl) StreamExecutePrimitiveStandalone(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	newVC := vc.cloneWithAutocommitSession()
+	sentRows := false
+	for try := 0; try < MaxBufferingRetries; try++ {
+		err := primitive.TryStreamExecute(newVC, bindVars, wantfields, func(qr *sqltypes.Result) error {
+			if len(qr.Rows) > 0 {
+				sentRows = true
+			}
+			return callback(qr)
+		})
+		if err != nil && !sentRows && vterrors.RootCause(err) == buffer.ShardMissingError {
+			continue
+		}
+		return err
+	}
+	return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "upstream shards are not available")
+}
+
+// cloneWithAutocommitSession returns a copy of the vcursor that uses an
+// independent autocommit session.
+func (vc *vcursorImpl) cloneWithAutocommitSession() *vcursorImpl {
+	clone := *vc
+	clone.safeSession = NewAutocommitSession(vc.safeSession.Session)
+	clone.warnings = nil
+	return &clone
+}
+
 // Execute is part of the engine.VCursor interface.
 func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
 	session := vc.safeSession
@@ -500,6 +537,11 @@ func (vc *vcursorImpl) AutocommitApproval() bool {
 	return vc.safeSession.AutocommitApproval()
 }
 
+// IsAutocommittable is part of the engine.VCursor interface.
+func (vc *vcursorImpl) IsAutocommittable() bool {
+	return vc.safeSession.IsAutocommittable()
+}
+
 // ExecuteStandalone is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	rss := []*srvtopo.ResolvedShard{rs}
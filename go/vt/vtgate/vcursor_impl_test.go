@@ -17,6 +17,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -311,3 +316,67 @@ func TestFirstSortedKeyspace(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ks3Schema.Keyspace, ks)
 }
+
+// fakeStreamPrimitive streams one result per try and then fails the try with
+// the corresponding error.
+type fakeStreamPrimitive struct {
+	engine.Primitive
+	results []*sqltypes.Result
+	errs    []error
+	tries   int
+}
+
+func (f *fakeStreamPrimitive) TryStreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	try := f.tries
+	f.tries++
+	if err := callback(f.results[try]); err != nil {
+		return err
+	}
+	return f.errs[try]
+}
+
+func TestStreamExecutePrimitiveStandaloneRetry(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id", "int64")
+	rows := sqltypes.MakeTestResult(fields, "1", "2")
+
+	tests := []struct {
+		name      string
+		results   []*sqltypes.Result
+		errs      []error
+		wantTries int
+		wantRows  int
+		wantErr   string
+	}{{
+		name:      "retry before any rows",
+		results:   []*sqltypes.Result{{Fields: fields}, rows},
+		errs:      []error{buffer.ShardMissingError, nil},
+		wantTries: 2,
+		wantRows:  2,
+	}, {
+		name:      "no retry after rows",
+		results:   []*sqltypes.Result{rows, rows},
+		errs:      []error{buffer.ShardMissingError, nil},
+		wantTries: 1,
+		wantRows:  2,
+		wantErr:   buffer.ShardMissingError.Error(),
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vc, err := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{}), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vschemaWith1KS}, vschemaWith1KS, nil, nil, false)
+			require.NoError(t, err)
+			primitive := &fakeStreamPrimitive{results: tc.results, errs: tc.errs}
+			gotRows := 0
+			err = vc.StreamExecutePrimitiveStandalone(primitive, nil, true, func(qr *sqltypes.Result) error {
+				gotRows += len(qr.Rows)
+				return nil
+			})
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantTries, primitive.tries)
+			require.Equal(t, tc.wantRows, gotRows)
+		})
+	}
+}
@@ -90,6 +90,9 @@ var (
 
 	foreignKeyMode = flag.String("foreign_key_mode", "allow", "This is to provide how to handle foreign key constraint in create/alter table. Valid values are: allow, disallow")
 
+	// hash aggregations spill their groups to disk once this limit is reached
+	hashAggregateMaxMemoryRows = flag.Int("hash_aggregate_max_memory_rows", 100000, "Maximum number of groups a hash aggregation holds in memory. When exceeded, the groups are spilled to temporary files on disk.")
+
 	// flags to enable/disable online and direct DDL statements
 	enableOnlineDDL = flag.Bool("enable_online_ddl", true, "Allow users to submit, review and control Online DDL")
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
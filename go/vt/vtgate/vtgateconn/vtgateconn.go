@@ -85,6 +85,15 @@ type VStreamReader interface {
 	Recv() ([]*binlogdatapb.VEvent, error)
 }
 
+// VStreamChangeEventReader is implemented by the VStreamReaders that
+// receive the change events of the DEBEZIUM format of VStreamFlags.
+type VStreamChangeEventReader interface {
+	// RecvChangeEvents returns the events and the change events of the
+	// next result on the stream.
+	// It will return io.EOF if the stream ended.
+	RecvChangeEvents() ([]*binlogdatapb.VEvent, [][]byte, error)
+}
+
 // VStream streams binlog events.
 func (conn *VTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (VStreamReader, error) {
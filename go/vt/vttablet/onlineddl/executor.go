@@ -158,7 +158,10 @@ type Executor struct {
 	// - be adopted by this executor (possible for vreplication migrations), or
 	// - be terminated (example: pt-osc migration gone rogue, process still running even as the migration failed)
 	// The Executor auto-reviews the map and cleans up migrations thought to be running which are not running.
-	ownedRunningMigrations        sync.Map
+	ownedRunningMigrations sync.Map
+	// cutOverPositions holds the primary positions reported by the hooks of -revertible gh-ost and pt-osc
+	// migrations right before they cut over (consider this a map[string]mysql.Position, by migration UUID)
+	cutOverPositions              sync.Map
 	tickReentranceFlag            int64
 	reviewedRunningMigrationsFlag bool
 
@@ -335,10 +338,35 @@ func (e *Executor) allowConcurrentMigration(onlineDDL *schema.OnlineDDL) bool {
 	return false
 }
 
+// isAtomicContextPeer checks if the two migrations are vreplication ALTERs of the same -atomic-context
+// migration context. Such migrations run concurrently, so that they can all become ready to cut over
+// at the same time.
+func isAtomicContextPeer(onlineDDL *schema.OnlineDDL, other *schema.OnlineDDL) bool {
+	isVReplAlter := func(onlineDDL *schema.OnlineDDL) bool {
+		if !onlineDDL.StrategySetting().IsAtomicContext() {
+			return false
+		}
+		switch onlineDDL.StrategySetting().Strategy {
+		case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
+		default:
+			return false
+		}
+		action, err := onlineDDL.GetAction()
+		if err != nil {
+			return false
+		}
+		return action == sqlparser.AlterDDLAction
+	}
+	if onlineDDL.MigrationContext == "" || onlineDDL.MigrationContext != other.MigrationContext {
+		return false
+	}
+	return isVReplAlter(onlineDDL) && isVReplAlter(other)
+}
+
 // isAnyNonConcurrentMigrationRunning sees if there's any migration running right now
-// that does not have -allow-concurrent.
+// that does not have -allow-concurrent, and which is not an atomic context peer of the given migration.
 // such a running migration will for example prevent a new non-concurrent migration from running.
-func (e *Executor) isAnyNonConcurrentMigrationRunning() bool {
+func (e *Executor) isAnyNonConcurrentMigrationRunning(candidate *schema.OnlineDDL) bool {
 	nonConcurrentMigrationFound := false
 
 	e.ownedRunningMigrations.Range(func(_, val interface{}) bool {
@@ -346,6 +374,9 @@ func (e *Executor) isAnyNonConcurrentMigrationRunning() bool {
 		if !ok {
 			return true
 		}
+		if isAtomicContextPeer(candidate, onlineDDL) {
+			return true
+		}
 		if !e.allowConcurrentMigration(onlineDDL) {
 			// The migratoin may have declared itself to be --allow-concurrent, but our scheduler
 			// reserves the right to say "no, you're NOT in fact allowed to run concurrently"
@@ -381,7 +412,7 @@ func (e *Executor) isAnyMigrationRunningOnTable(tableName string) bool {
 // given migration, such that they can't both run concurrently.
 func (e *Executor) isAnyConflictingMigrationRunning(onlineDDL *schema.OnlineDDL) bool {
 
-	if e.isAnyNonConcurrentMigrationRunning() && !e.allowConcurrentMigration(onlineDDL) {
+	if e.isAnyNonConcurrentMigrationRunning(onlineDDL) && !e.allowConcurrentMigration(onlineDDL) {
 		return true
 	}
 	if e.isAnyMigrationRunningOnTable(onlineDDL.Table) {
@@ -1096,6 +1127,17 @@ exit $exit_code
 		log.Errorf("Error creating script: %+v", err)
 		return err
 	}
+	if onlineDDL.StrategySetting().IsRevertible() {
+		// The position at cut-over is required to make the migration revertible. gh-ost does not cut over
+		// when this hook fails.
+		cutOverHookContent := fmt.Sprintf(`#!/bin/bash
+	curl --max-time 10 -s -f 'http://localhost:%d/schema-migration/report-cutover?uuid=%s'
+			`, *servenv.Port, onlineDDL.UUID)
+		if _, err := createTempScript(tempDir, "gh-ost-on-before-cut-over", cutOverHookContent); err != nil {
+			log.Errorf("Error creating script: %+v", err)
+			return err
+		}
+	}
 	serveSocketFile := path.Join(tempDir, "serve.sock")
 
 	if err := e.deleteGhostPanicFlagFile(onlineDDL.UUID); err != nil {
@@ -1131,14 +1173,17 @@ exit $exit_code
 		return err
 	}
 
+	// oldTableMatch is the name of the table into which gh-ost renames the original table at cut-over
+	var oldTableMatch string
 	runGhost := func(execute bool) error {
 		alterOptions := e.parseAlterOptions(ctx, onlineDDL)
 		forceTableNames := fmt.Sprintf("%s_%s", onlineDDL.UUID, ReadableTimestamp())
+		oldTableMatch = ghostOldTableMatch(forceTableNames)
 
 		if err := e.updateArtifacts(ctx, onlineDDL.UUID,
 			fmt.Sprintf("_%s_gho", forceTableNames),
 			fmt.Sprintf("_%s_ghc", forceTableNames),
-			fmt.Sprintf("_%s_del", forceTableNames),
+			oldTableMatch,
 		); err != nil {
 			return err
 		}
@@ -1197,6 +1242,7 @@ exit $exit_code
 	go func() error {
 		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
 		defer e.deleteGhostPostponeFlagFile(onlineDDL.UUID) // irrespective whether the file was in fact in use or not
+		defer e.cutOverPositions.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -1223,6 +1269,7 @@ exit $exit_code
 		}
 		// Migration successful!
 		successfulMigrations.Add(1)
+		e.makeMigrationRevertible(ctx, onlineDDL, oldTableMatch)
 		log.Infof("+ OK")
 		return nil
 	}()
@@ -1299,6 +1346,14 @@ export MYSQL_PWD
 	  get("http://localhost:{{VTTABLET_PORT}}/schema-migration/report-status?uuid={{MIGRATION_UUID}}&status={{OnlineDDLStatusRunning}}&dryrun={{DRYRUN}}");
 	}
 
+	sub before_swap_tables {
+	  my($self, % args) = @_;
+	  if ({{REVERTIBLE}}) {
+	    # The position at cut-over is required to make the migration revertible
+	    get("http://localhost:{{VTTABLET_PORT}}/schema-migration/report-cutover?uuid={{MIGRATION_UUID}}") or die "cannot report cut-over of migration {{MIGRATION_UUID}}";
+	  }
+	}
+
 	sub before_exit {
 		my($self, % args) = @_;
 		my $exit_status = $args{exit_status};
@@ -1330,6 +1385,11 @@ export MYSQL_PWD
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusRunning}}", string(schema.OnlineDDLStatusRunning))
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusComplete}}", string(schema.OnlineDDLStatusComplete))
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusFailed}}", string(schema.OnlineDDLStatusFailed))
+	if onlineDDL.StrategySetting().IsRevertible() {
+		pluginCode = strings.ReplaceAll(pluginCode, "{{REVERTIBLE}}", "1")
+	} else {
+		pluginCode = strings.ReplaceAll(pluginCode, "{{REVERTIBLE}}", "0")
+	}
 
 	// Validate pt-online-schema-change binary:
 	log.Infof("Will now validate pt-online-schema-change binary")
@@ -1417,6 +1477,7 @@ export MYSQL_PWD
 
 	go func() error {
 		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
+		defer e.cutOverPositions.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -1445,12 +1506,34 @@ export MYSQL_PWD
 		}
 		// Migration successful!
 		successfulMigrations.Add(1)
+		e.makeMigrationRevertible(ctx, onlineDDL, ptoscOldTableMatch(onlineDDL.Table))
 		log.Infof("+ OK")
 		return nil
 	}()
 	return nil
 }
 
+// makeMigrationRevertible creates the reverse vreplication stream of a successful -revertible gh-ost or
+// pt-osc migration, starting at the position its hook reported right before the cut-over. The migration
+// has already completed; failing to create the stream only makes it non-revertible, and is reported in
+// the migration's message.
+func (e *Executor) makeMigrationRevertible(ctx context.Context, onlineDDL *schema.OnlineDDL, oldTableMatch string) {
+	if !onlineDDL.StrategySetting().IsRevertible() {
+		return
+	}
+	val, ok := e.cutOverPositions.Load(onlineDDL.UUID)
+	if !ok {
+		// The tool does not cut over unless the hook reported the position, so this is unexpected
+		log.Errorf("cannot make migration %s revertible: cut-over position was not reported", onlineDDL.UUID)
+		_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, "migration is not revertible: cut-over position was not reported")
+		return
+	}
+	if err := e.createReverseVReplStream(ctx, onlineDDL, oldTableMatch, val.(mysql.Position)); err != nil {
+		log.Errorf("cannot make migration %s revertible: %+v", onlineDDL.UUID, err)
+		_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, fmt.Sprintf("migration is not revertible: %v", err))
+	}
+}
+
 func (e *Executor) readMigration(ctx context.Context, uuid string) (onlineDDL *schema.OnlineDDL, row sqltypes.RowNamedValues, err error) {
 
 	parsed := sqlparser.BuildParsedQuery(sqlSelectMigration, ":migration_uuid")
@@ -1708,9 +1791,78 @@ func (e *Executor) reviewQueuedMigrations(ctx context.Context) error {
 			return err
 		}
 	}
+
+	// Review CREATE migrations of atomic contexts
+	// These migrations are submitted with postponed completion, and a postponed CREATE does not get
+	// scheduled. However, a declarative CREATE TABLE of an existing table turns out to be an ALTER, which
+	// must run before the context can complete. We resolve such migrations while they are queued.
+	r, err = e.execQuery(ctx, sqlSelectQueuedCreateMigrations)
+	if err != nil {
+		return err
+	}
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		onlineDDL, _, err := e.readMigration(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		if err := e.resolveAtomicDeclarativeMigration(ctx, onlineDDL); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// resolveAtomicDeclarativeMigration evaluates a queued, declarative CREATE TABLE migration of an atomic
+// context. If the table exists, the migration is converted into an ALTER, or is implicitly completed
+// when there is no diff. If the table does not exist, the migration remains a CREATE.
+func (e *Executor) resolveAtomicDeclarativeMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	if !onlineDDL.StrategySetting().IsDeclarative() || !onlineDDL.StrategySetting().IsAtomicContext() {
+		return nil
+	}
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return e.failMigration(ctx, onlineDDL, err)
+	}
+	if _, isCreateTable := ddlStmt.(*sqlparser.CreateTable); !isCreateTable {
+		// Views are resolved as they execute, see executeMigration()
+		return nil
+	}
+	if ddlStmt.GetIfNotExists() {
+		return e.failMigration(ctx, onlineDDL, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "strategy is declarative. IF NOT EXISTS does not work in declarative mode for migration %v", onlineDDL.UUID))
+	}
+	// We strip out any VT query comments because our simplified parser doesn't work well with comments
+	ddlStmt.SetComments(sqlparser.Comments{})
+	onlineDDL.SQL = sqlparser.String(ddlStmt)
+
+	exists, err := e.tableExists(ctx, onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// An actual CREATE. It runs once the context completes.
+		return nil
+	}
+	alterClause, err := e.evaluateDeclarativeDiff(ctx, onlineDDL)
+	if err != nil {
+		return e.failMigration(ctx, onlineDDL, err)
+	}
+	if alterClause == "" {
+		// No diff! We mark this CREATE as implicitly sucessful
+		_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow, rowsCopiedUnknown)
+		_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, "no change")
+		return nil
+	}
+	// We convert this migration into an ALTER, which gets scheduled and runs with postponed completion.
+	if err := e.updateMigrationStatement(ctx, onlineDDL.UUID, fmt.Sprintf("ALTER TABLE `%s` %s", onlineDDL.Table, alterClause)); err != nil {
+		return err
+	}
+	if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+		return err
+	}
+	return e.updateMigrationMessage(ctx, onlineDDL.UUID, alterClause)
+}
+
 func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigration *schema.OnlineDDL, revertingMigrationUUID string) (err error) {
 	// Validation: migration to revert exists and is in complete state
 	action, actionStr, err := revertMigration.GetActionStr()
@@ -1719,7 +1871,15 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	}
 	switch action {
 	case sqlparser.AlterDDLAction:
-		if revertMigration.Strategy != schema.DDLStrategyOnline && revertMigration.Strategy != schema.DDLStrategyVitess {
+		switch revertMigration.Strategy {
+		case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
+		case schema.DDLStrategyGhost, schema.DDLStrategyPTOSC:
+			// A -revertible gh-ost or pt-osc migration leaves a reverse vreplication stream, and is then
+			// reverted just like a vitess migration
+			if !revertMigration.StrategySetting().IsRevertible() {
+				return fmt.Errorf("can only revert a %s strategy migration submitted with -revertible. Migration %s was not", revertMigration.Strategy, revertMigration.UUID)
+			}
+		default:
 			return fmt.Errorf("can only revert a %s strategy migration. Migration %s has %s strategy", schema.DDLStrategyOnline, revertMigration.UUID, revertMigration.Strategy)
 		}
 	case sqlparser.RevertDDLAction:
@@ -2418,7 +2578,11 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 		case sqlparser.RevertDDLAction:
 			// No special action. Declarative Revert migrations are handled like any normal Revert migration.
 		case sqlparser.AlterDDLAction:
-			return failMigration(vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "strategy is declarative. ALTER cannot run in declarative mode for migration %v", onlineDDL.UUID))
+			if !onlineDDL.StrategySetting().IsAtomicContext() {
+				return failMigration(vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "strategy is declarative. ALTER cannot run in declarative mode for migration %v", onlineDDL.UUID))
+			}
+			// A declarative CREATE of an atomic context, which was resolved into an ALTER while queued.
+			// See resolveAtomicDeclarativeMigration()
 		case sqlparser.DropDDLAction:
 			// This DROP is declarative, meaning it may:
 			// - actually DROP a table, if that table exists, or
@@ -2831,6 +2995,12 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 							isReady = false
 						}
 					}
+					// ready_to_complete tells an external coordinator, such as a -coordinated-cutover cut-over, that
+					// the migration would cut over if it were not postponed. The coordinator relies on it, which is
+					// why the migration does not cut over unless it is updated.
+					if err := e.updateMigrationReadyToComplete(ctx, uuid, isReady); err != nil {
+						return countRunnning, cancellable, err
+					}
 					if postponeCompletion {
 						// override. Even if migration is ready, we do not complet it.
 						isReady = false
@@ -2908,6 +3078,119 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	return countRunnning, cancellable, nil
 }
 
+// reviewAtomicContexts reviews the migration contexts whose migrations were submitted with -atomic-context.
+// Such migrations are submitted with postponed completion, and complete all together, or not at all.
+// If any migration of the context fails or is cancelled, all pending migrations of the context are cancelled.
+// Once all pending ALTER migrations are running and ready to cut over, all pending migrations of the context
+// are completed. The ALTERs then cut over in the following review of running migrations, followed by the
+// CREATE and DROP migrations, which are scheduled in order of submission.
+// A context submitted with -coordinated-cutover spans all shards of the keyspace: it is completed on all shards
+// together by vtctld, which also reverts the completed migrations if any migration fails on any shard. See
+// wrangler.CoordinatedContextCutOver(). On this shard, such a context is only cancelled.
+// A context where any migration was also submitted with -postpone-completion awaits an explicit COMPLETE.
+func (e *Executor) reviewAtomicContexts(ctx context.Context) (cancellable []*cancellableMigration, err error) {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	r, err := e.execQuery(ctx, sqlSelectPendingMigrationContexts)
+	if err != nil {
+		return cancellable, err
+	}
+	for _, row := range r.Named().Rows {
+		migrationContext := row["migration_context"].ToString()
+		query, err := sqlparser.ParseAndBind(sqlSelectMigrationsByContext,
+			sqltypes.StringBindVariable(migrationContext),
+		)
+		if err != nil {
+			return cancellable, err
+		}
+		rs, err := e.execQuery(ctx, query)
+		if err != nil {
+			return cancellable, err
+		}
+		var pendingUUIDs, postponedUUIDs []string
+		failedUUID := ""
+		isReady := true
+		isCoordinated := false
+		for _, row := range rs.Named().Rows {
+			uuid := row["migration_uuid"].ToString()
+			setting := schema.NewDDLStrategySetting(schema.DDLStrategy(row["strategy"].ToString()), row["options"].ToString())
+			if !setting.IsAtomicContext() {
+				continue
+			}
+			if setting.IsCoordinatedCutOver() {
+				// vtctld completes this context on all shards together
+				isCoordinated = true
+			}
+			if setting.IsPostponeCompletion() {
+				// the user explicitly asked to complete this context
+				isReady = false
+			}
+			status := schema.OnlineDDLStatus(row["migration_status"].ToString())
+			switch status {
+			case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+				failedUUID = uuid
+				continue
+			case schema.OnlineDDLStatusQueued, schema.OnlineDDLStatusReady, schema.OnlineDDLStatusRunning:
+			default:
+				continue
+			}
+			pendingUUIDs = append(pendingUUIDs, uuid)
+			if !row.AsBool("postpone_completion", false) {
+				continue
+			}
+			postponedUUIDs = append(postponedUUIDs, uuid)
+			if row["ddl_action"].ToString() != sqlparser.AlterStr {
+				// CREATE and DROP migrations are not scheduled while postponed, and are always ready to complete
+				continue
+			}
+			if status != schema.OnlineDDLStatusRunning {
+				isReady = false
+				continue
+			}
+			running, s, err := e.isVReplMigrationRunning(ctx, uuid)
+			if err != nil {
+				return cancellable, err
+			}
+			if !running {
+				isReady = false
+				continue
+			}
+			readyToCutOver, err := e.isVReplMigrationReadyToCutOver(ctx, s)
+			if err != nil {
+				return cancellable, err
+			}
+			if !readyToCutOver {
+				isReady = false
+			}
+		}
+		if failedUUID != "" {
+			for _, uuid := range pendingUUIDs {
+				message := fmt.Sprintf("cancelling migration %s because migration %s of atomic context %s did not complete", uuid, failedUUID, migrationContext)
+				cancellable = append(cancellable, newCancellableMigration(uuid, message))
+			}
+			continue
+		}
+		if isCoordinated || !isReady || len(postponedUUIDs) == 0 {
+			continue
+		}
+		log.Infof("reviewAtomicContexts: all migrations of atomic context %s are ready to complete", migrationContext)
+		for _, uuid := range postponedUUIDs {
+			query, err := sqlparser.ParseAndBind(sqlUpdateCompleteMigration,
+				sqltypes.StringBindVariable(uuid),
+			)
+			if err != nil {
+				return cancellable, err
+			}
+			if _, err := e.execQuery(ctx, query); err != nil {
+				return cancellable, err
+			}
+		}
+		e.triggerNextCheckInterval()
+	}
+	return cancellable, nil
+}
+
 // reviewStaleMigrations marks as 'failed' migrations whose status is 'running' but which have
 // shown no liveness in past X minutes. It also attempts to terminate them
 func (e *Executor) reviewStaleMigrations(ctx context.Context) error {
@@ -3117,6 +3400,11 @@ func (e *Executor) onMigrationCheckTick() {
 	if err := e.runNextMigration(ctx); err != nil {
 		log.Error(err)
 	}
+	if cancellable, err := e.reviewAtomicContexts(ctx); err != nil {
+		log.Error(err)
+	} else if err := e.cancelMigrations(ctx, cancellable); err != nil {
+		log.Error(err)
+	}
 	if _, cancellable, err := e.reviewRunningMigrations(ctx); err != nil {
 		log.Error(err)
 	} else if err := e.cancelMigrations(ctx, cancellable); err != nil {
@@ -3252,6 +3540,18 @@ func (e *Executor) updateDDLAction(ctx context.Context, uuid string, actionStr s
 	return err
 }
 
+func (e *Executor) updateMigrationStatement(ctx context.Context, uuid string, statement string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationStatement,
+		sqltypes.StringBindVariable(statement),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationMessage(ctx context.Context, uuid string, message string) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMessage,
 		sqltypes.StringBindVariable(message),
@@ -3378,6 +3678,18 @@ func (e *Executor) updateRowsCopied(ctx context.Context, uuid string, rowsCopied
 	return err
 }
 
+func (e *Executor) updateMigrationReadyToComplete(ctx context.Context, uuid string, isReady bool) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationReadyToComplete,
+		sqltypes.BoolBindVariable(isReady),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationIsView(ctx context.Context, uuid string, isView bool) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationIsView,
 		sqltypes.BoolBindVariable(isView),
@@ -3493,6 +3805,32 @@ func (e *Executor) SubmitMigration(
 		return nil, err
 	}
 	revertedUUID, _ := onlineDDL.GetRevertUUID() // Empty value if the migration is not actually a REVERT. Safe to ignore error.
+	if onlineDDL.StrategySetting().IsAtomicContext() {
+		switch onlineDDL.StrategySetting().Strategy {
+		case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-atomic-context is only supported with 'vitess' strategy, found '%s' in migration %s", onlineDDL.Strategy, onlineDDL.UUID)
+		}
+		if onlineDDL.MigrationContext == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-atomic-context requires a migration context in migration %s", onlineDDL.UUID)
+		}
+	}
+	if onlineDDL.StrategySetting().IsRevertible() {
+		// vitess migrations are always revertible
+		switch onlineDDL.StrategySetting().Strategy {
+		case schema.DDLStrategyGhost, schema.DDLStrategyPTOSC:
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-revertible is only supported with 'gh-ost' and 'pt-osc' strategies, found '%s' in migration %s", onlineDDL.Strategy, onlineDDL.UUID)
+		}
+	}
+	if onlineDDL.StrategySetting().IsCoordinatedCutOver() {
+		// Coordinated cut-overs are rolled back with REVERT, which is only supported by the 'vitess' strategy
+		switch onlineDDL.StrategySetting().Strategy {
+		case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-coordinated-cutover is only supported with 'vitess' strategy, found '%s' in migration %s", onlineDDL.Strategy, onlineDDL.UUID)
+		}
+	}
 
 	retainArtifactsSeconds := int64((*retainOnlineDDLTables).Seconds())
 	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
@@ -3509,7 +3847,7 @@ func (e *Executor) SubmitMigration(
 		sqltypes.StringBindVariable(string(schema.OnlineDDLStatusQueued)),
 		sqltypes.StringBindVariable(e.TabletAliasString()),
 		sqltypes.Int64BindVariable(retainArtifactsSeconds),
-		sqltypes.BoolBindVariable(onlineDDL.StrategySetting().IsPostponeCompletion()),
+		sqltypes.BoolBindVariable(onlineDDL.StrategySetting().IsPostponeCompletion() || onlineDDL.StrategySetting().IsAtomicContext() || onlineDDL.StrategySetting().IsCoordinatedCutOver()),
 		sqltypes.BoolBindVariable(e.allowConcurrentMigration(onlineDDL)),
 		sqltypes.StringBindVariable(revertedUUID),
 		sqltypes.BoolBindVariable(onlineDDL.IsView()),
@@ -3697,6 +4035,22 @@ func (e *Executor) OnSchemaMigrationStatus(ctx context.Context,
 	return e.onSchemaMigrationStatus(ctx, uuidParam, status, dryRun, progressPct, etaSeconds, rowsCopied)
 }
 
+// OnSchemaMigrationCutOver is called by the hooks of a -revertible gh-ost or pt-osc migration right before
+// the migration cuts over. It records the primary position, from which the binary logs are later searched
+// for the cut-over RENAME. An error prevents the tool from cutting over.
+func (e *Executor) OnSchemaMigrationCutOver(ctx context.Context, uuidParam string) error {
+	if _, ok := e.ownedRunningMigrations.Load(uuidParam); !ok {
+		return fmt.Errorf("migration %s is not running on this tablet", uuidParam)
+	}
+	pos, err := e.primaryPosition(ctx)
+	if err != nil {
+		return err
+	}
+	e.cutOverPositions.Store(uuidParam, pos)
+	log.Infof("OnSchemaMigrationCutOver: migration %s cuts over at %s", uuidParam, mysql.EncodePosition(pos))
+	return nil
+}
+
 // VExec is called by a VExec invocation
 // Implements vitess.io/vitess/go/vt/vttablet/vexec.Executor interface
 func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *querypb.QueryResult, err error) {
@@ -19,3 +19,57 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/schema"
+)
+
+func TestIsAtomicContextPeer(t *testing.T) {
+	newOnlineDDL := func(sql string, strategy string, migrationContext string) *schema.OnlineDDL {
+		setting, err := schema.ParseDDLStrategy(strategy)
+		require.NoError(t, err)
+		onlineDDL, err := schema.NewOnlineDDL("ks", "t", sql, setting, migrationContext, "")
+		require.NoError(t, err)
+		return onlineDDL
+	}
+	alter := "alter table t add column i int"
+	tt := []struct {
+		name   string
+		other  *schema.OnlineDDL
+		isPeer bool
+	}{
+		{
+			name:   "same context",
+			other:  newOnlineDDL("alter table t2 add column i int", "vitess -atomic-context", "ctx1"),
+			isPeer: true,
+		},
+		{
+			name:  "other context",
+			other: newOnlineDDL("alter table t2 add column i int", "vitess -atomic-context", "ctx2"),
+		},
+		{
+			name:  "not atomic",
+			other: newOnlineDDL("alter table t2 add column i int", "vitess", "ctx1"),
+		},
+		{
+			name:  "create",
+			other: newOnlineDDL("create table t2 (id int primary key)", "vitess -atomic-context", "ctx1"),
+		},
+		{
+			name:  "gh-ost",
+			other: newOnlineDDL("alter table t2 add column i int", "gh-ost -atomic-context", "ctx1"),
+		},
+	}
+	onlineDDL := newOnlineDDL(alter, "vitess -atomic-context", "ctx1")
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isPeer, isAtomicContextPeer(onlineDDL, tc.other))
+			assert.Equal(t, tc.isPeer, isAtomicContextPeer(tc.other, onlineDDL))
+		})
+	}
+}
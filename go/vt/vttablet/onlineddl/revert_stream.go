@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// findRenameTimeout is the time we allow for streaming the binary logs in search of the cut-over RENAME
+// of a gh-ost or pt-osc migration. The search starts at the position reported right before the cut-over,
+// so that the RENAME is only a few events away.
+var findRenameTimeout = time.Minute
+
+// ghostOldTableMatch returns the vreplication filter match of the table into which gh-ost renames the
+// original table at cut-over, given the value of --force-table-names
+func ghostOldTableMatch(forceTableNames string) string {
+	return fmt.Sprintf("_%s_del", forceTableNames)
+}
+
+// ptoscOldTableMatch returns the vreplication filter match of the table into which pt-online-schema-change
+// renames the original table at cut-over. pt-osc prepends underscores until it finds a free name.
+func ptoscOldTableMatch(table string) string {
+	return fmt.Sprintf("/^_+%s_old$", regexp.QuoteMeta(table))
+}
+
+// renamedTableName returns the name into which the given RENAME TABLE statement renames the given table
+func renamedTableName(ddl string, table string) (string, bool) {
+	stmt, err := sqlparser.Parse(ddl)
+	if err != nil {
+		return "", false
+	}
+	renameTable, ok := stmt.(*sqlparser.RenameTable)
+	if !ok {
+		return "", false
+	}
+	for _, pair := range renameTable.TablePairs {
+		if pair.FromTable.Name.String() == table {
+			return pair.ToTable.Name.String(), true
+		}
+	}
+	return "", false
+}
+
+// findPositionAfterRename streams the binary logs of this tablet, starting at the position reported by the
+// migration's hook right before the cut-over, in search of the RENAME TABLE statement with which a gh-ost or
+// pt-osc migration swapped the migrated table with the original table. It returns the name of the original table and the position right after the RENAME.
+func (e *Executor) findPositionAfterRename(ctx context.Context, tablet *topodatapb.Tablet, table string, oldTableMatch string, startPos string) (oldTableName string, pos string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, findRenameTimeout)
+	defer cancel()
+
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return "", "", err
+	}
+	defer conn.Close(ctx)
+
+	target := &querypb.Target{
+		Keyspace:   e.keyspace,
+		Shard:      e.shard,
+		TabletType: topodatapb.TabletType_PRIMARY,
+	}
+	// We only stream the old table. No rows are written to it before the RENAME, which makes the
+	// RENAME the first event we expect to see.
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: oldTableMatch}},
+	}
+	var lastGTID string
+	err = conn.VStream(ctx, target, startPos, nil, filter, func(events []*binlogdatapb.VEvent) error {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_GTID:
+				// The GTID event precedes the DDL, and holds the position right after the DDL
+				lastGTID = event.Gtid
+			case binlogdatapb.VEventType_DDL:
+				if name, ok := renamedTableName(event.Statement, table); ok {
+					oldTableName = name
+					pos = lastGTID
+					return io.EOF
+				}
+			}
+		}
+		return nil
+	})
+	if oldTableName == "" {
+		if err == nil {
+			err = ctx.Err()
+		}
+		return "", "", fmt.Errorf("cannot find RENAME of table %s in binary logs: %v", table, err)
+	}
+	if pos == "" {
+		return "", "", fmt.Errorf("cannot find position of RENAME of table %s in binary logs", table)
+	}
+	return oldTableName, pos, nil
+}
+
+// createReverseVReplStream makes a completed gh-ost or pt-osc migration revertible. It creates a stopped
+// vreplication stream for the migration, which looks just like the stream of a completed vitess migration:
+// its single rule matches the original table, which the tool renamed away at cut-over, and its position is
+// right after the cut-over. A REVERT then streams changes from the migrated table into the original table,
+// starting at that position, and swaps the two tables, exactly like it reverts a vitess migration.
+func (e *Executor) createReverseVReplStream(ctx context.Context, onlineDDL *schema.OnlineDDL, oldTableMatch string, cutOverPos mysql.Position) error {
+	tmClient := tmclient.NewTabletManagerClient()
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	oldTableName, pos, err := e.findPositionAfterRename(ctx, tablet.Tablet, onlineDDL.Table, oldTableMatch, mysql.EncodePosition(cutOverPos))
+	if err != nil {
+		return err
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: e.keyspace,
+		Shard:    e.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  oldTableName,
+				Filter: fmt.Sprintf("select * from %s", escapeName(onlineDDL.Table)),
+			}},
+		},
+	}
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, e.dbName)
+	ig.AddRow(onlineDDL.UUID, bls, pos, "", "in_order:REPLICA,PRIMARY")
+	if _, err := tmClient.VReplicationExec(ctx, tablet.Tablet, ig.String()); err != nil {
+		return err
+	}
+	log.Infof("created reverse vreplication stream for migration %s: %s into %s at %s", onlineDDL.UUID, onlineDDL.Table, oldTableName, pos)
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenamedTableName(t *testing.T) {
+	tt := []struct {
+		ddl    string
+		table  string
+		expect string
+		ok     bool
+	}{
+		{
+			// gh-ost cut-over
+			ddl:    "rename /* gh-ost */ table `db`.`t` to `db`.`_t_del`, `db`.`_t_gho` to `db`.`t`",
+			table:  "t",
+			expect: "_t_del",
+			ok:     true,
+		},
+		{
+			// pt-osc cut-over
+			ddl:    "RENAME TABLE `db`.`t` TO `db`.`__t_old`, `db`.`_t_new` TO `db`.`t`",
+			table:  "t",
+			expect: "__t_old",
+			ok:     true,
+		},
+		{
+			ddl:   "rename table t1 to t2",
+			table: "t",
+		},
+		{
+			ddl:   "alter table t add column i int",
+			table: "t",
+		},
+		{
+			ddl:   "not a statement",
+			table: "t",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.ddl, func(t *testing.T) {
+			name, ok := renamedTableName(tc.ddl, tc.table)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expect, name)
+		})
+	}
+}
+
+func TestPTOSCOldTableMatch(t *testing.T) {
+	match := ptoscOldTableMatch("t.1")
+	assert.True(t, strings.HasPrefix(match, "/"))
+	re := regexp.MustCompile(match[1:])
+	assert.True(t, re.MatchString("_t.1_old"))
+	assert.True(t, re.MatchString("__t.1_old"))
+	assert.False(t, re.MatchString("_tx1_old"))
+	assert.False(t, re.MatchString("_t.1_new"))
+}
+
+func TestOnSchemaMigrationCutOverNotRunning(t *testing.T) {
+	e := &Executor{}
+	err := e.OnSchemaMigrationCutOver(context.Background(), "6ace8bcb_89a7_11ec_bd3a_0a43f95f28a3")
+	assert.Error(t, err)
+	_, ok := e.cutOverPositions.Load("6ace8bcb_89a7_11ec_bd3a_0a43f95f28a3")
+	assert.False(t, ok)
+}
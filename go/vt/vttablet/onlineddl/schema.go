@@ -72,6 +72,7 @@ const (
 	alterSchemaMigrationsTableRevertedUUID             = "ALTER TABLE _vt.schema_migrations add column reverted_uuid varchar(64) NOT NULL DEFAULT ''"
 	alterSchemaMigrationsTableRevertedUUIDIndex        = "ALTER TABLE _vt.schema_migrations add KEY reverted_uuid_idx (reverted_uuid(64))"
 	alterSchemaMigrationsTableIsView                   = "ALTER TABLE _vt.schema_migrations add column is_view tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableReadyToComplete          = "ALTER TABLE _vt.schema_migrations add column ready_to_complete tinyint unsigned NOT NULL DEFAULT 0"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -138,6 +139,11 @@ const (
 			SET is_view=%a
 		WHERE
 			migration_uuid=%a
+`
+	sqlUpdateMigrationReadyToComplete = `UPDATE _vt.schema_migrations
+			SET ready_to_complete=%a
+		WHERE
+			migration_uuid=%a
 `
 	sqlUpdateMigrationStartedTimestamp = `UPDATE _vt.schema_migrations SET
 			started_timestamp =IFNULL(started_timestamp,  NOW()),
@@ -191,6 +197,11 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateMigrationStatement = `UPDATE _vt.schema_migrations
+			SET migration_statement=%a
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMessage = `UPDATE _vt.schema_migrations
 			SET message=%a
 		WHERE
@@ -319,6 +330,32 @@ const (
 			migration_status='queued'
 			AND ddl_action='revert'
 	`
+	sqlSelectQueuedCreateMigrations = `SELECT
+			migration_uuid
+		FROM _vt.schema_migrations
+		WHERE
+			migration_status='queued'
+			AND ddl_action='create'
+	`
+	sqlSelectPendingMigrationContexts = `SELECT DISTINCT
+			migration_context
+		FROM _vt.schema_migrations
+		WHERE
+			migration_status IN ('queued', 'ready', 'running')
+			AND migration_context != ''
+	`
+	sqlSelectMigrationsByContext = `SELECT
+			migration_uuid,
+			strategy,
+			options,
+			ddl_action,
+			migration_status,
+			postpone_completion
+		FROM _vt.schema_migrations
+		WHERE
+			migration_context=%a
+		ORDER BY id
+	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
 			artifacts,
@@ -571,4 +608,5 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableRevertedUUID,
 	alterSchemaMigrationsTableRevertedUUIDIndex,
 	alterSchemaMigrationsTableIsView,
+	alterSchemaMigrationsTableReadyToComplete,
 }
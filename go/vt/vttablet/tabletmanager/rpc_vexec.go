@@ -21,6 +21,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"context"
@@ -35,6 +36,11 @@ func (tm *TabletManager) VExec(ctx context.Context, query, workflow, keyspace st
 	switch vx.TableName {
 	case fmt.Sprintf("%s.%s", vexec.TableQualifier, schema.SchemaMigrationsTableName):
 		return tm.QueryServiceControl.OnlineDDLExecutor().VExec(ctx, vx)
+	case vdiff.VDiffTableName, vdiff.VDiffTableTableName:
+		if tm.VDiffEngine == nil {
+			return nil, fmt.Errorf("vdiff is not supported by this tablet")
+		}
+		return tm.VDiffEngine.VExec(ctx, vx)
 	default:
 		return nil, fmt.Errorf("table not supported by vexec: %v", vx.TableName)
 	}
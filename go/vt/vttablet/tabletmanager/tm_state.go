@@ -278,7 +278,15 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 	if ts.tm.VREngine != nil {
 		if ts.tablet.Type == topodatapb.TabletType_PRIMARY {
 			ts.tm.VREngine.Open(ts.tm.BatchCtx)
+			// vdiffs run on top of the workflow streams, so their engine opens after
+			// the vreplication engine, and closes before it.
+			if ts.tm.VDiffEngine != nil {
+				ts.tm.VDiffEngine.Open(ts.tm.BatchCtx, ts.tm.VREngine)
+			}
 		} else {
+			if ts.tm.VDiffEngine != nil {
+				ts.tm.VDiffEngine.Close()
+			}
 			ts.tm.VREngine.Close()
 		}
 	}
@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+const (
+	defaultTabletTypes                 = "primary,replica,rdonly"
+	defaultMaxExtraRowsToCompare       = 1000
+	defaultFilteredReplicationWaitTime = 30 * time.Second
+)
+
+// Options are the options of a vdiff. They are set when the vdiff is created,
+// and are persisted as json in _vt.vdiff.
+type Options struct {
+	// SourceCell is the cell to pick the source tablets from. It defaults to the cell of the target primary.
+	SourceCell string `json:"source_cell,omitempty"`
+	// TabletTypes are the types of the source tablets to stream from.
+	TabletTypes string `json:"tablet_types,omitempty"`
+	// Tables restricts the diff to these tables of the workflow.
+	Tables []string `json:"tables,omitempty"`
+	// MaxRows is the max number of rows to compare, over all the tables.
+	MaxRows int64 `json:"max_rows,omitempty"`
+	// MaxExtraRowsToCompare is the max number of extra rows that are compared in a second pass.
+	MaxExtraRowsToCompare int `json:"max_extra_rows_to_compare,omitempty"`
+	// FilteredReplicationWaitTime is how long to wait for the workflow and the sources to catch up.
+	FilteredReplicationWaitTime time.Duration `json:"filtered_replication_wait_time,omitempty"`
+	// DebugQuery adds a query to the samples of the report, to help debugging the differences.
+	DebugQuery bool `json:"debug_query,omitempty"`
+	// OnlyPKs only reports the primary key columns of the samples.
+	OnlyPKs bool `json:"only_pks,omitempty"`
+	// Incremental only diffs the rows that changed on the sources since the tables were last
+	// verified without mismatches by a previous vdiff of the workflow. Tables that were never
+	// verified are diffed in full.
+	Incremental bool `json:"incremental,omitempty"`
+}
+
+func (opts *Options) setDefaults(cell string) {
+	if opts.SourceCell == "" {
+		opts.SourceCell = cell
+	}
+	if opts.TabletTypes == "" {
+		opts.TabletTypes = defaultTabletTypes
+	}
+	if opts.MaxRows <= 0 {
+		opts.MaxRows = math.MaxInt64
+	}
+	if opts.MaxExtraRowsToCompare <= 0 {
+		opts.MaxExtraRowsToCompare = defaultMaxExtraRowsToCompare
+	}
+	if opts.FilteredReplicationWaitTime <= 0 {
+		opts.FilteredReplicationWaitTime = defaultFilteredReplicationWaitTime
+	}
+}
+
+// workflowStream is one stream of the workflow, as read from _vt.vreplication.
+type workflowStream struct {
+	id  int64
+	bls *binlogdatapb.BinlogSource
+	pos string
+}
+
+// controller runs one vdiff. It diffs the tables of the workflow one at a time.
+type controller struct {
+	id       int64
+	uuid     string
+	workflow string
+	// keyspace is the target keyspace of the workflow.
+	keyspace string
+	options  *Options
+
+	vde *Engine
+	vre *vreplication.Engine
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newController creates a new controller for the _vt.vdiff row, and starts it.
+func newController(ctx context.Context, row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	ct := &controller{
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		keyspace: row.AsString("keyspace", ""),
+		options:  &Options{},
+		vde:      vde,
+		vre:      vde.vre,
+		done:     make(chan struct{}),
+	}
+	if options := row.AsBytes("options", nil); len(options) != 0 {
+		if err := json.Unmarshal(options, ct.options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options for vdiff %s", ct.uuid)
+		}
+	}
+	ct.options.setDefaults(vde.thisTablet.Alias.Cell)
+	if ct.vre == nil {
+		return nil, fmt.Errorf("vdiff %s: vreplication engine is not available", ct.uuid)
+	}
+
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+	return ct, nil
+}
+
+// run runs the vdiff, and records its final state. If the vdiff was canceled, its
+// state is left as is, and its progress is kept so that it can resume later.
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+	log.Infof("VDiff %s: starting for workflow %s", ct.uuid, ct.workflow)
+
+	err := ct.runDiff(ctx)
+	var query string
+	switch {
+	case err == nil:
+		log.Infof("VDiff %s: completed", ct.uuid)
+		query = fmt.Sprintf(sqlUpdateVDiffCompleted, ct.id)
+	case ctx.Err() != nil:
+		log.Infof("VDiff %s: interrupted: %v", ct.uuid, err)
+		return
+	default:
+		log.Errorf("VDiff %s: failed: %v", ct.uuid, err)
+		query = fmt.Sprintf(sqlUpdateVDiffState, encodeString(string(ErrorState)), encodeString(err.Error()), ct.id)
+	}
+	if _, err := ct.vde.execWithDDL(context.Background(), query); err != nil {
+		log.Errorf("VDiff %s: could not update state: %v", ct.uuid, err)
+	}
+}
+
+func (ct *controller) runDiff(ctx context.Context) error {
+	vde := ct.vde
+	if _, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateVDiffStarted, ct.id)); err != nil {
+		return err
+	}
+	streams, err := ct.readStreams()
+	if err != nil {
+		return err
+	}
+	if len(streams) == 0 {
+		return fmt.Errorf("workflow %s has no streams on this shard", ct.workflow)
+	}
+	schm, err := vde.mysqld.GetSchema(ctx, vde.dbName, nil, nil, false)
+	if err != nil {
+		return vterrors.Wrap(err, "GetSchema")
+	}
+	plans, err := buildTablePlans(streams[0].bls.Filter, schm, ct.options.Tables)
+	if err != nil {
+		return vterrors.Wrap(err, "buildTablePlans")
+	}
+	if err := ct.insertTables(ctx, plans); err != nil {
+		return err
+	}
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectVDiffTables, encodeString(ct.uuid)))
+	if err != nil {
+		return err
+	}
+	tableRows := make(map[string]sqltypes.RowNamedValues)
+	rowsToCompare := ct.options.MaxRows
+	for _, row := range qr.Named().Rows {
+		tableRows[row.AsString("table_name", "")] = row
+		rowsToCompare -= row.AsInt64("rows_compared", 0)
+	}
+
+	tableNames := make([]string, 0, len(plans))
+	for name := range plans {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+	for _, name := range tableNames {
+		row, ok := tableRows[name]
+		if !ok {
+			return fmt.Errorf("vdiff %s: table %s was not found in %s", ct.uuid, name, VDiffTableTableName)
+		}
+		if State(row.AsString("state", "")) == CompletedState {
+			continue
+		}
+		td, err := newTableDiffer(ct, plans[name], row)
+		if err != nil {
+			return err
+		}
+		if err := td.diff(ctx, &rowsToCompare); err != nil {
+			return vterrors.Wrapf(err, "diff of table %s", name)
+		}
+	}
+	return nil
+}
+
+// insertTables records the tables of the vdiff, with an estimate of their row count.
+// Tables that were recorded already, when resuming, are left as they are.
+func (ct *controller) insertTables(ctx context.Context, plans map[string]*tablePlan) error {
+	qr, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectTableRows, encodeString(ct.vde.dbName)))
+	if err != nil {
+		return err
+	}
+	estimates := make(map[string]int64)
+	for _, row := range qr.Named().Rows {
+		estimates[row.AsString("table_name", "")] = row.AsInt64("table_rows", 0)
+	}
+	for name := range plans {
+		query := fmt.Sprintf(sqlInsertVDiffTable, encodeString(ct.uuid), encodeString(name), estimates[name])
+		if _, err := ct.vde.execWithDDL(ctx, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStreams reads the streams of the workflow, along with their current positions.
+func (ct *controller) readStreams() ([]*workflowStream, error) {
+	qr, err := ct.vre.Exec(fmt.Sprintf(sqlSelectWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	var streams []*workflowStream
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		bls := &binlogdatapb.BinlogSource{}
+		if err := prototext.Unmarshal(row.AsBytes("source", nil), bls); err != nil {
+			return nil, err
+		}
+		if bls.ExternalCluster != "" {
+			return nil, fmt.Errorf("vdiff is not supported for workflows with an external source: %s", ct.workflow)
+		}
+		streams = append(streams, &workflowStream{
+			id:  id,
+			bls: bls,
+			pos: row.AsString("pos", ""),
+		})
+	}
+	return streams, nil
+}
+
+// Stop stops the controller, and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+// isDone returns true if the controller exited.
+func (ct *controller) isDone() bool {
+	select {
+	case <-ct.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package vdiff compares the rows of the tables of a vreplication workflow with the rows of its sources.
+A vdiff runs on the primary tablet of every target shard of the workflow, and compares the
+rows of that shard with the rows the workflow streams into it. The state and progress of every
+vdiff are persisted in sidecar tables, so that a vdiff resumes from the last compared row if the
+tablet restarts or the primary changes. Vdiffs are created, stopped, resumed and inspected
+through VExec queries on the sidecar tables.
+*/
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// State is the state of a vdiff, or of one of its tables
+type State string
+
+const (
+	// PendingState is the state of a vdiff that was created and has not started yet
+	PendingState State = "pending"
+	// StartedState is the state of a running vdiff. A vdiff found in this state when the
+	// engine opens was interrupted, and is resumed.
+	StartedState State = "started"
+	// StoppedState is the state of a vdiff that was stopped by the user. It can be resumed.
+	StoppedState State = "stopped"
+	// CompletedState is the state of a vdiff that compared all its tables
+	CompletedState State = "completed"
+	// ErrorState is the state of a vdiff that failed. It can be resumed.
+	ErrorState State = "error"
+)
+
+var openRetryInterval = sync2.NewAtomicDuration(5 * time.Second)
+
+// Engine runs the vdiffs of the workflows that target this tablet. It is open on primary tablets only.
+type Engine struct {
+	// mu synchronizes isOpen, controllers and vre.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	thisTablet      *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+
+	// vre is the vreplication engine of this tablet. vdiffs stop and synchronize
+	// the workflow streams through it.
+	vre *vreplication.Engine
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		thisTablet:  tablet,
+		mysqld:      mysqld,
+		tmc:         tmclient.NewTabletManagerClient(),
+	}
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, tmc tmclient.TabletManagerClient, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		thisTablet:      tablet,
+		mysqld:          mysqld,
+		tmc:             tmc,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine, and resumes the vdiffs that were pending or running
+// when this tablet last stopped being a primary.
+func (vde *Engine) Open(ctx context.Context, vre *vreplication.Engine) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.vre = vre
+	vde.isOpen = true
+	go vde.resumeAll(vde.ctx)
+}
+
+// resumeAll starts the controllers of the vdiffs that should be running. It retries until it
+// succeeds, or until the engine closes.
+func (vde *Engine) resumeAll(ctx context.Context) {
+	for {
+		err := vde.initControllers(ctx)
+		if err == nil {
+			return
+		}
+		log.Errorf("VDiff Engine: error resuming vdiffs: %v, will keep retrying", err)
+		timer := time.NewTimer(openRetryInterval.Get())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (vde *Engine) initControllers(ctx context.Context) error {
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectVDiffsToRun, encodeString(vde.dbName)))
+	if err != nil {
+		return err
+	}
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil
+	}
+	for _, row := range qr.Named().Rows {
+		if err := vde.startControllerLocked(row); err != nil {
+			log.Errorf("VDiff Engine: controller could not be initialized for vdiff %v: %v", row["vdiff_uuid"].ToString(), err)
+		}
+	}
+	return nil
+}
+
+// startControllerLocked starts a controller for the given _vt.vdiff row, unless one is running already.
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) error {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return err
+	}
+	if ct, ok := vde.controllers[id]; ok {
+		if !ct.isDone() {
+			return nil
+		}
+	}
+	ct, err := newController(vde.ctx, row, vde)
+	if err != nil {
+		return err
+	}
+	vde.controllers[id] = ct
+	return nil
+}
+
+// stopController stops the controller of the given vdiff, if any, and waits for it to exit.
+func (vde *Engine) stopController(uuid string) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	for id, ct := range vde.controllers {
+		if ct.uuid == uuid {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+	}
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops all the running vdiffs. Their progress is persisted, and they are resumed
+// when the engine opens again.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if !vde.isOpen {
+		return
+	}
+
+	vde.cancel()
+	// We still have to wait for all controllers to stop.
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.vre = nil
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// execWithDDL runs a query against the sidecar tables, creating them if needed.
+func (vde *Engine) execWithDDL(ctx context.Context, query string) (*sqltypes.Result, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	return withDDL.Exec(ctx, query, dbClient.ExecuteFetch, dbClient.ExecuteFetch)
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
+
+// encodeNullableString encodes a string like encodeString, except for an empty string,
+// which is encoded as null.
+func encodeNullableString(in string) string {
+	if in == "" {
+		return "null"
+	}
+	return encodeString(in)
+}
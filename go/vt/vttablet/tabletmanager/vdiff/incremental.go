@@ -0,0 +1,368 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// An incremental vdiff only diffs the rows of a table that changed since the table was last
+// verified without mismatches. When the diff of a table completes, the positions of the
+// sources it was verified at are recorded in _vt.vdiff_table. An incremental vdiff streams
+// the binlogs of the sources from these positions, with the filter of the workflow, and
+// collects the values of the leading primary key column of the changed rows. The table is
+// then diffed only for these values, clustered into ranges when too many of them changed,
+// one cluster at a time.
+
+// maxPKClusters is the maximum number of clusters of changed values that are kept for a
+// table. Once more values changed, the two closest clusters are merged, and the unchanged
+// rows between them are diffed as well.
+const maxPKClusters = 100
+
+// pkRange is the set of the values of the leading primary key column of a table that changed,
+// and that are diffed by an incremental vdiff. Close values are clustered into ranges, so that
+// a few rows changed at both ends of a large table don't make the whole table be diffed.
+type pkRange struct {
+	// Changed is set once the value of any row was added. An unchanged table is not diffed.
+	Changed bool `json:"changed,omitempty"`
+	// Clusters are the disjoint ranges of the changed values, sorted by value. A single
+	// changed value is a cluster with the same min and max.
+	Clusters []*pkCluster `json:"clusters,omitempty"`
+}
+
+// pkCluster is a range of changed values, including both ends. The values are integers.
+type pkCluster struct {
+	Min string `json:"min"`
+	Max string `json:"max"`
+}
+
+func (r *pkRange) isEmpty() bool {
+	return !r.Changed
+}
+
+// add adds the value to the set, either in the cluster that contains it or in a new cluster.
+func (r *pkRange) add(value sqltypes.Value) error {
+	v, ok := new(big.Int).SetString(value.ToString(), 10)
+	if !ok {
+		return fmt.Errorf("unexpected value of the leading primary key column: %v", value)
+	}
+	r.Changed = true
+	i := sort.Search(len(r.Clusters), func(i int) bool {
+		return parsePKValue(r.Clusters[i].Max).Cmp(v) >= 0
+	})
+	if i < len(r.Clusters) && parsePKValue(r.Clusters[i].Min).Cmp(v) <= 0 {
+		return nil
+	}
+	r.Clusters = append(r.Clusters, nil)
+	copy(r.Clusters[i+1:], r.Clusters[i:])
+	r.Clusters[i] = &pkCluster{Min: v.String(), Max: v.String()}
+	if len(r.Clusters) > maxPKClusters {
+		r.mergeClosestClusters()
+	}
+	return nil
+}
+
+// mergeClosestClusters merges the two adjacent clusters that have the smallest gap between them.
+func (r *pkRange) mergeClosestClusters() {
+	closest := -1
+	var minGap, gap big.Int
+	for i := 0; i < len(r.Clusters)-1; i++ {
+		gap.Sub(parsePKValue(r.Clusters[i+1].Min), parsePKValue(r.Clusters[i].Max))
+		if closest == -1 || gap.Cmp(&minGap) < 0 {
+			closest = i
+			minGap.Set(&gap)
+		}
+	}
+	r.Clusters[closest].Max = r.Clusters[closest+1].Max
+	r.Clusters = append(r.Clusters[:closest+1], r.Clusters[closest+2:]...)
+}
+
+func parsePKValue(s string) *big.Int {
+	v, _ := new(big.Int).SetString(s, 10)
+	return v
+}
+
+// prepareIncremental finds the positions at which the table was last verified, and collects
+// the range of the rows that changed on the sources since. If the table can't be diffed
+// incrementally, pkRange is left nil, and the whole table is diffed.
+func (td *tableDiffer) prepareIncremental(ctx context.Context) error {
+	ct := td.ct
+	name := td.plan.table.Name
+	switch {
+	case !sqltypes.IsIntegral(td.plan.pkFields[0].Type):
+		log.Infof("VDiff %s: the leading primary key column of table %s is not an integer, diffing the whole table", ct.uuid, name)
+		return nil
+	case td.plan.sourceTable == "" || td.plan.sourcePKColumnNames[0] == "":
+		log.Infof("VDiff %s: the leading primary key column of table %s is not selected from a source column, diffing the whole table", ct.uuid, name)
+		return nil
+	}
+
+	qr, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectLastVerifiedPos, encodeString(ct.vde.dbName), encodeString(ct.workflow), ct.id, encodeString(name)))
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		log.Infof("VDiff %s: table %s was never verified, diffing the whole table", ct.uuid, name)
+		return nil
+	}
+	verifiedPos := make(map[string]string)
+	if err := json.Unmarshal(qr.Named().Row().AsBytes("verified_pos", nil), &verifiedPos); err != nil {
+		return vterrors.Wrapf(err, "invalid verified positions for table %s", name)
+	}
+	streams, err := ct.readStreams()
+	if err != nil {
+		return err
+	}
+	sources := make(map[string]*shardStreamer)
+	for _, stream := range streams {
+		pos, ok := verifiedPos[stream.bls.Shard]
+		if !ok {
+			log.Infof("VDiff %s: table %s was not verified for source shard %s, diffing the whole table", ct.uuid, name, stream.bls.Shard)
+			return nil
+		}
+		sources[stream.bls.Shard] = &shardStreamer{
+			keyspace: stream.bls.Keyspace,
+			shard:    stream.bls.Shard,
+			position: pos,
+		}
+	}
+	if err := td.pickTablets(ctx, sources); err != nil {
+		return vterrors.Wrap(err, "pickTablets")
+	}
+	r, stopPos, err := td.collectChanges(ctx, sources)
+	if err != nil {
+		return vterrors.Wrap(err, "collectChanges")
+	}
+	if r.isEmpty() {
+		log.Infof("VDiff %s: no row of table %s changed since it was last verified", ct.uuid, name)
+	} else {
+		log.Infof("VDiff %s: the rows of table %s changed within %d ranges since it was last verified", ct.uuid, name, len(r.Clusters))
+	}
+
+	// The rows that change after the collected positions are not diffed if they are
+	// outside the range, which is why the table is only verified up to these positions.
+	b, err := json.Marshal(stopPos)
+	if err != nil {
+		return err
+	}
+	td.verifiedPos = string(b)
+	td.pkRange = r
+	return td.saveRange(ctx)
+}
+
+// collectChanges streams the binlogs of each source, from the position the table was last
+// verified at up to the current position of the source. It returns the range of the changed
+// rows, along with the positions the changes were collected up to.
+func (td *tableDiffer) collectChanges(ctx context.Context, sources map[string]*shardStreamer) (*pkRange, map[string]string, error) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  td.plan.sourceTable,
+			Filter: td.plan.sourceQuery,
+		}},
+	}
+	var mu sync.Mutex
+	r := &pkRange{}
+	stopPos := make(map[string]string)
+	err := forAll(sources, func(shard string, participant *shardStreamer) error {
+		pos, err := td.ct.vde.tmc.PrimaryPosition(ctx, participant.tablet)
+		if err != nil {
+			return vterrors.Wrapf(err, "PrimaryPosition for tablet %v", topoproto.TabletAliasString(participant.tablet.Alias))
+		}
+		mu.Lock()
+		stopPos[shard] = pos
+		mu.Unlock()
+		return td.streamChanges(ctx, participant, filter, pos, func(value sqltypes.Value) error {
+			mu.Lock()
+			defer mu.Unlock()
+			return r.add(value)
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, stopPos, nil
+}
+
+// streamChanges streams the binlog of the participant from its position up to stopPos, and
+// calls add with the value of the leading primary key column of every changed row.
+func (td *tableDiffer) streamChanges(ctx context.Context, participant *shardStreamer, filter *binlogdatapb.Filter, stopPos string, add func(sqltypes.Value) error) error {
+	start, err := mysql.DecodePosition(participant.position)
+	if err != nil {
+		return err
+	}
+	stop, err := mysql.DecodePosition(stopPos)
+	if err != nil {
+		return err
+	}
+	if start.AtLeast(stop) {
+		return nil
+	}
+
+	conn, err := tabletconn.GetDialer()(participant.tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	target := &querypb.Target{
+		Keyspace:   participant.keyspace,
+		Shard:      participant.shard,
+		TabletType: participant.tablet.Type,
+	}
+	pkName := td.plan.table.PrimaryKeyColumns[0]
+	var fields []*querypb.Field
+	pkIndex := -1
+	done := false
+	err = conn.VStream(ctx, target, participant.position, nil, filter, func(events []*binlogdatapb.VEvent) error {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_FIELD:
+				fields = event.FieldEvent.Fields
+				pkIndex = -1
+				for i, field := range fields {
+					if strings.EqualFold(field.Name, pkName) {
+						pkIndex = i
+						break
+					}
+				}
+				if pkIndex == -1 {
+					return fmt.Errorf("primary key column %s of table %s is not streamed from source shard %s", pkName, td.plan.table.Name, participant.shard)
+				}
+			case binlogdatapb.VEventType_ROW:
+				if pkIndex == -1 {
+					return fmt.Errorf("unexpected row event before the field event of table %s", td.plan.table.Name)
+				}
+				for _, change := range event.RowEvent.RowChanges {
+					for _, row := range []*querypb.Row{change.Before, change.After} {
+						if row == nil {
+							continue
+						}
+						if err := add(sqltypes.MakeRowTrusted(fields, row)[pkIndex]); err != nil {
+							return err
+						}
+					}
+				}
+			case binlogdatapb.VEventType_GTID:
+				pos, err := mysql.DecodePosition(event.Gtid)
+				if err != nil {
+					return err
+				}
+				if pos.AtLeast(stop) {
+					done = true
+					return io.EOF
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return vterrors.Wrapf(err, "VStream from tablet %v", topoproto.TabletAliasString(participant.tablet.Alias))
+	}
+	if !done {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("VStream from tablet %v ended before reaching position %s", topoproto.TabletAliasString(participant.tablet.Alias), stopPos)
+	}
+	return nil
+}
+
+// saveRange persists the range of an incremental diff and the positions it verifies the table
+// up to, so that a resumed diff uses the same range.
+func (td *tableDiffer) saveRange(ctx context.Context) error {
+	pkRange := "null"
+	if td.pkRange != nil {
+		b, err := json.Marshal(td.pkRange)
+		if err != nil {
+			return err
+		}
+		pkRange = encodeString(string(b))
+	}
+	query := fmt.Sprintf(sqlUpdateVDiffTableRange, encodeNullableString(td.verifiedPos), pkRange, encodeString(td.ct.uuid), encodeString(td.plan.table.Name))
+	_, err := td.ct.vde.execWithDDL(ctx, query)
+	return err
+}
+
+// remainingClusters returns the clusters of the range that still have rows to diff after lastpk.
+// Rows are compared in primary key order, so the clusters that end before the leading value
+// of lastpk were diffed already.
+func (r *pkRange) remainingClusters(lastpk string) ([]*pkCluster, error) {
+	qr, err := decodeLastPK(lastpk)
+	if err != nil || qr == nil {
+		return r.Clusters, err
+	}
+	v, ok := new(big.Int).SetString(sqltypes.Proto3ToResult(qr).Rows[0][0].ToString(), 10)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value of the leading primary key column in lastpk: %v", lastpk)
+	}
+	i := sort.Search(len(r.Clusters), func(i int) bool {
+		return parsePKValue(r.Clusters[i].Max).Cmp(v) >= 0
+	})
+	return r.Clusters[i:], nil
+}
+
+// withPKCluster returns a copy of the plan, with queries that only select the rows within
+// the cluster. The cluster is selected with a range on the leading primary key column,
+// which the row streamers of the source and target tablets push down to mysql.
+func (tp *tablePlan) withPKCluster(c *pkCluster) (*tablePlan, error) {
+	sourceQuery, err := addPKCluster(tp.sourceQuery, tp.sourcePKColumnNames[0], c)
+	if err != nil {
+		return nil, err
+	}
+	targetQuery, err := addPKCluster(tp.targetQuery, tp.table.PrimaryKeyColumns[0], c)
+	if err != nil {
+		return nil, err
+	}
+	clusterPlan := *tp
+	clusterPlan.sourceQuery = sourceQuery
+	clusterPlan.targetQuery = targetQuery
+	return &clusterPlan, nil
+}
+
+func addPKCluster(query, column string, c *pkCluster) (string, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return "", fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	col := sqlparser.NewColName(column)
+	sel.AddWhere(&sqlparser.ComparisonExpr{Operator: sqlparser.GreaterEqualOp, Left: col, Right: sqlparser.NewIntLiteral(c.Min)})
+	sel.AddWhere(&sqlparser.ComparisonExpr{Operator: sqlparser.LessEqualOp, Left: col, Right: sqlparser.NewIntLiteral(c.Max)})
+	return sqlparser.String(sel), nil
+}
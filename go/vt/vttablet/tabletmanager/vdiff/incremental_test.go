@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestPKRange(t *testing.T) {
+	r := &pkRange{}
+	assert.True(t, r.isEmpty())
+
+	for _, v := range []int64{10, 2, 30, -4, 5, 10} {
+		require.NoError(t, r.add(sqltypes.NewInt64(v)))
+	}
+	assert.False(t, r.isEmpty())
+	assert.Equal(t, &pkRange{Changed: true, Clusters: []*pkCluster{
+		{Min: "-4", Max: "-4"}, {Min: "2", Max: "2"}, {Min: "5", Max: "5"}, {Min: "10", Max: "10"}, {Min: "30", Max: "30"},
+	}}, r)
+
+	// Values are compared as numbers, not as strings.
+	r = &pkRange{}
+	for _, v := range []uint64{18446744073709551615, 100, 9} {
+		require.NoError(t, r.add(sqltypes.NewUint64(v)))
+	}
+	assert.Equal(t, &pkRange{Changed: true, Clusters: []*pkCluster{
+		{Min: "9", Max: "9"}, {Min: "100", Max: "100"}, {Min: "18446744073709551615", Max: "18446744073709551615"},
+	}}, r)
+
+	// Once there are too many clusters, the closest ones are merged, and values within a
+	// cluster don't add a cluster.
+	r = &pkRange{}
+	for i := 0; i < maxPKClusters; i++ {
+		require.NoError(t, r.add(sqltypes.NewInt64(int64(i*1000))))
+	}
+	require.NoError(t, r.add(sqltypes.NewInt64(5001)))
+	assert.Len(t, r.Clusters, maxPKClusters)
+	assert.Equal(t, &pkCluster{Min: "5000", Max: "5001"}, r.Clusters[5])
+	require.NoError(t, r.add(sqltypes.NewInt64(5000)))
+	assert.Len(t, r.Clusters, maxPKClusters)
+}
+
+func TestRemainingClusters(t *testing.T) {
+	r := &pkRange{Changed: true, Clusters: []*pkCluster{
+		{Min: "-4", Max: "30"}, {Min: "42", Max: "42"}, {Min: "100", Max: "100"},
+	}}
+	clusters, err := r.remainingClusters("")
+	require.NoError(t, err)
+	assert.Equal(t, r.Clusters, clusters)
+
+	fields := sqltypes.MakeTestFields("c1", "int64")
+	for _, tcase := range []struct {
+		lastpk int64
+		want   []*pkCluster
+	}{
+		{lastpk: 10, want: r.Clusters},
+		{lastpk: 30, want: r.Clusters},
+		{lastpk: 31, want: r.Clusters[1:]},
+		{lastpk: 42, want: r.Clusters[1:]},
+		{lastpk: 100, want: r.Clusters[2:]},
+		{lastpk: 101, want: []*pkCluster{}},
+	} {
+		lastpk, err := encodeLastPK(fields, []sqltypes.Value{sqltypes.NewInt64(tcase.lastpk)})
+		require.NoError(t, err)
+		clusters, err := r.remainingClusters(lastpk)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, clusters, "lastpk %d", tcase.lastpk)
+	}
+}
+
+var pkClusterTestcases = []struct {
+	rule        *binlogdatapb.Rule
+	sourceQuery string
+	targetQuery string
+}{{
+	rule:        &binlogdatapb.Rule{Match: "t1"},
+	sourceQuery: "select c1, c2 from t1 where c1 >= -4 and c1 <= 30",
+	targetQuery: "select c1, c2 from t1 where c1 >= -4 and c1 <= 30",
+}, {
+	rule:        &binlogdatapb.Rule{Match: "t1", Filter: "-80"},
+	sourceQuery: "select c1, c2 from t1 where in_keyrange('-80') and c1 >= -4 and c1 <= 30",
+	targetQuery: "select c1, c2 from t1 where c1 >= -4 and c1 <= 30",
+}, {
+	rule:        &binlogdatapb.Rule{Match: "t1", Filter: "select a as c1, b as c2 from src"},
+	sourceQuery: "select a as c1, b as c2 from src where a >= -4 and a <= 30",
+	targetQuery: "select c1, c2 from t1 where c1 >= -4 and c1 <= 30",
+}}
+
+func TestPlanWithPKCluster(t *testing.T) {
+	for _, tcase := range pkClusterTestcases {
+		t.Run(tcase.rule.Filter, func(t *testing.T) {
+			filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.rule}}
+			plans, err := buildTablePlans(filter, testSchema, nil)
+			require.NoError(t, err)
+			tp := plans["t1"]
+			clusterPlan, err := tp.withPKCluster(&pkCluster{Min: "-4", Max: "30"})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.sourceQuery, clusterPlan.sourceQuery)
+			assert.Equal(t, tcase.targetQuery, clusterPlan.targetQuery)
+			// The original plan is not modified.
+			assert.NotEqual(t, tp.sourceQuery, clusterPlan.sourceQuery)
+		})
+	}
+}
+
+// TestPKClusterRowStreams verifies that the queries of a cluster can be streamed by VStreamRows,
+// and that the range of the cluster is filtered on the leading primary key column, which the
+// row streamer pushes down to mysql.
+func TestPKClusterRowStreams(t *testing.T) {
+	vschema := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}}},
+				},
+			},
+		},
+	})
+	tables := map[string]*vstreamer.Table{
+		"t1":  {Name: "t1", Fields: sqltypes.MakeTestFields("c1|c2", "int64|int64")},
+		"src": {Name: "src", Fields: sqltypes.MakeTestFields("a|b", "int64|int64")},
+	}
+	wantFilters := []vstreamer.Filter{
+		{Opcode: vstreamer.GreaterThanEqual, ColNum: 0, Value: sqltypes.NewInt64(-4)},
+		{Opcode: vstreamer.LessThanEqual, ColNum: 0, Value: sqltypes.NewInt64(30)},
+	}
+	for _, tcase := range pkClusterTestcases {
+		t.Run(tcase.rule.Filter, func(t *testing.T) {
+			for _, query := range []string{tcase.sourceQuery, tcase.targetQuery} {
+				table := "t1"
+				if strings.Contains(query, "from src") {
+					table = "src"
+				}
+				plan, err := vstreamer.BuildRowsPlan(tables[table], "ks", vschema, query)
+				require.NoError(t, err, query)
+				filters := plan.Filters
+				if strings.Contains(query, "in_keyrange") {
+					require.Equal(t, vstreamer.VindexMatch, filters[0].Opcode)
+					filters = filters[1:]
+				}
+				assert.Equal(t, wantFilters, filters, query)
+			}
+		})
+	}
+}
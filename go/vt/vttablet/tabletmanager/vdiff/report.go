@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"reflect"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// At most how many samples we should show for row differences in the final report
+const maxVDiffReportSampleRows = 10
+
+// DiffReport is the summary of differences for one table. It is persisted as json in
+// the report column of _vt.vdiff_table, and has the same layout as the report of wrangler.VDiff.
+type DiffReport struct {
+	ProcessedRows        int
+	MatchingRows         int
+	MismatchedRows       int
+	ExtraRowsSource      int
+	ExtraRowsSourceDiffs []*RowDiff
+	ExtraRowsTarget      int
+	ExtraRowsTargetDiffs []*RowDiff
+	MismatchedRowsSample []*DiffMismatch
+	TableName            string
+}
+
+// DiffMismatch is a sample of row diffs between source and target.
+type DiffMismatch struct {
+	Source *RowDiff
+	Target *RowDiff
+}
+
+// RowDiff is a row that didn't match as part of the comparison.
+type RowDiff struct {
+	Row   map[string]sqltypes.Value
+	Query string
+}
+
+// hasMismatch returns true if the report has any difference between source and target.
+func (dr *DiffReport) hasMismatch() bool {
+	return dr.MismatchedRows > 0 || dr.ExtraRowsSource > 0 || dr.ExtraRowsTarget > 0
+}
+
+// reconcileExtraRows does a second pass on the extra rows of both sides.
+// If the only difference is the order in which the rows were returned
+// by MySQL on each side then we'll have the same number of extras on
+// both sides. If that's the case, then let's see if the extra rows on
+// both sides are actually different.
+func (dr *DiffReport) reconcileExtraRows(maxExtraRowsToCompare int) {
+	if (dr.ExtraRowsSource == dr.ExtraRowsTarget) && (dr.ExtraRowsSource <= maxExtraRowsToCompare) {
+		for i := 0; i < len(dr.ExtraRowsSourceDiffs); {
+			foundMatch := false
+			for j := range dr.ExtraRowsTargetDiffs {
+				if reflect.DeepEqual(dr.ExtraRowsSourceDiffs[i], dr.ExtraRowsTargetDiffs[j]) {
+					dr.ExtraRowsSourceDiffs = append(dr.ExtraRowsSourceDiffs[:i], dr.ExtraRowsSourceDiffs[i+1:]...)
+					dr.ExtraRowsSource--
+					dr.ExtraRowsTargetDiffs = append(dr.ExtraRowsTargetDiffs[:j], dr.ExtraRowsTargetDiffs[j+1:]...)
+					dr.ExtraRowsTarget--
+					dr.ProcessedRows--
+					dr.MatchingRows++
+					foundMatch = true
+					break
+				}
+			}
+			// If we didn't find a match then the tables are in fact different and we can short circuit the second pass
+			if !foundMatch {
+				break
+			}
+		}
+	}
+	// We can now trim the extra rows diffs on both sides to the maxVDiffReportSampleRows value
+	if len(dr.ExtraRowsSourceDiffs) > maxVDiffReportSampleRows {
+		dr.ExtraRowsSourceDiffs = dr.ExtraRowsSourceDiffs[:maxVDiffReportSampleRows]
+	}
+	if len(dr.ExtraRowsTargetDiffs) > maxVDiffReportSampleRows {
+		dr.ExtraRowsTargetDiffs = dr.ExtraRowsTargetDiffs[:maxVDiffReportSampleRows]
+	}
+}
+
+// genRowDiff generates the sample of a row that didn't match, as selected by queryStmt.
+func (tp *tablePlan) genRowDiff(queryStmt string, row []sqltypes.Value, debug, onlyPks bool) (*RowDiff, error) {
+	drp := &RowDiff{}
+	drp.Row = make(map[string]sqltypes.Value)
+	statement, err := sqlparser.Parse(queryStmt)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+
+	if debug {
+		drp.Query = tp.genDebugQueryDiff(sel, row, onlyPks)
+	}
+
+	if onlyPks {
+		for _, pkI := range tp.pkCols {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			sel.SelectExprs[pkI].Format(buf)
+			col := buf.String()
+			drp.Row[col] = row[pkI]
+		}
+		return drp, nil
+	}
+
+	for i := range sel.SelectExprs {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		sel.SelectExprs[i].Format(buf)
+		col := buf.String()
+		drp.Row[col] = row[i]
+	}
+
+	return drp, nil
+}
+
+func (tp *tablePlan) genDebugQueryDiff(sel *sqlparser.Select, row []sqltypes.Value, onlyPks bool) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+
+	if onlyPks {
+		for i, pkI := range tp.pkCols {
+			pk := sel.SelectExprs[pkI]
+			pk.Format(buf)
+			if i != len(tp.pkCols)-1 {
+				buf.Myprintf(", ")
+			}
+		}
+	} else {
+		sel.SelectExprs.Format(buf)
+	}
+	buf.Myprintf(" from ")
+	buf.Myprintf(sqlparser.ToString(sel.From))
+	buf.Myprintf(" where ")
+	for i, pkI := range tp.pkCols {
+		sel.SelectExprs[pkI].Format(buf)
+		buf.Myprintf("=")
+		row[pkI].EncodeSQL(buf)
+		if i != len(tp.pkCols)-1 {
+			buf.Myprintf(" AND ")
+		}
+	}
+	buf.Myprintf(";")
+	return buf.String()
+}
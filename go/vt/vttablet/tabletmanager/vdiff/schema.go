@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import "vitess.io/vitess/go/vt/withddl"
+
+const (
+	// VDiffTableName is the sidecar table holding one row per vdiff of a workflow on this shard.
+	VDiffTableName = "_vt.vdiff"
+	// VDiffTableTableName is the sidecar table holding the progress of each table of a vdiff.
+	VDiffTableTableName = "_vt.vdiff_table"
+
+	sqlCreateVDiffTable = `CREATE TABLE IF NOT EXISTS _vt.vdiff (
+	id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+	vdiff_uuid varchar(64) NOT NULL,
+	workflow varbinary(1024) NOT NULL,
+	keyspace varbinary(256) NOT NULL,
+	shard varchar(255) NOT NULL,
+	db_name varbinary(1024) NOT NULL,
+	state varbinary(64) NOT NULL,
+	options json,
+	last_error varbinary(1024) NOT NULL DEFAULT '',
+	created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	started_at timestamp NULL DEFAULT NULL,
+	liveness_timestamp timestamp NULL DEFAULT NULL,
+	completed_at timestamp NULL DEFAULT NULL,
+	PRIMARY KEY (id),
+	UNIQUE KEY uuid_idx (vdiff_uuid),
+	KEY state_idx (state),
+	KEY workflow_idx (db_name(64), workflow(64))
+) ENGINE=InnoDB`
+
+	sqlCreateVDiffTableTable = `CREATE TABLE IF NOT EXISTS _vt.vdiff_table (
+	vdiff_uuid varchar(64) NOT NULL,
+	table_name varbinary(128) NOT NULL,
+	state varbinary(64) NOT NULL,
+	lastpk varbinary(2000),
+	table_rows bigint(20) NOT NULL DEFAULT 0,
+	rows_compared bigint(20) NOT NULL DEFAULT 0,
+	mismatch tinyint(1) NOT NULL DEFAULT 0,
+	report json,
+	created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	PRIMARY KEY (vdiff_uuid, table_name)
+) ENGINE=InnoDB`
+
+	sqlSelectVDiffsToRun        = `select * from _vt.vdiff where db_name = %s and state in ('pending', 'started')`
+	sqlSelectVDiffByUUID        = `select * from _vt.vdiff where vdiff_uuid = %s`
+	sqlUpdateVDiffStarted       = `update _vt.vdiff set state = 'started', started_at = ifnull(started_at, now()), liveness_timestamp = now(), last_error = '' where id = %d`
+	sqlUpdateVDiffState         = `update _vt.vdiff set state = %s, last_error = %s where id = %d`
+	sqlUpdateVDiffCompleted     = `update _vt.vdiff set state = 'completed', completed_at = now(), last_error = '' where id = %d`
+	sqlUpdateVDiffLiveness      = `update _vt.vdiff set liveness_timestamp = now() where id = %d`
+	sqlStopVDiff                = `update _vt.vdiff set state = 'stopped' where db_name = %s and vdiff_uuid = %s and state in ('pending', 'started')`
+	sqlResumeVDiff              = `update _vt.vdiff set state = 'pending', last_error = '' where db_name = %s and vdiff_uuid = %s and state in ('stopped', 'error')`
+	sqlDeleteVDiffByUUID        = `delete from _vt.vdiff where db_name = %s and vdiff_uuid = %s`
+	sqlDeleteVDiffTablesByUUID  = `delete from _vt.vdiff_table where vdiff_uuid = %s`
+	sqlSelectVDiffTables        = `select * from _vt.vdiff_table where vdiff_uuid = %s`
+	sqlInsertVDiffTable         = `insert ignore into _vt.vdiff_table (vdiff_uuid, table_name, state, table_rows) values (%s, %s, 'pending', %d)`
+	sqlUpdateVDiffTableStarted  = `update _vt.vdiff_table set state = 'started' where vdiff_uuid = %s and table_name = %s`
+	sqlUpdateVDiffTableProgress = `update _vt.vdiff_table set lastpk = %s, rows_compared = %d, mismatch = %d, report = %s where vdiff_uuid = %s and table_name = %s`
+	sqlUpdateVDiffTableDone     = `update _vt.vdiff_table set state = 'completed', lastpk = %s, rows_compared = %d, mismatch = %d, report = %s, verified_pos = %s where vdiff_uuid = %s and table_name = %s`
+	sqlUpdateVDiffTableRange    = `update _vt.vdiff_table set verified_pos = %s, pk_range = %s where vdiff_uuid = %s and table_name = %s`
+	sqlSelectTableRows          = `select table_name, table_rows from information_schema.tables where table_schema = %s`
+
+	// sqlSelectLastVerifiedPos selects the positions at which a table was last verified without
+	// mismatches by a previous vdiff of the workflow.
+	sqlSelectLastVerifiedPos = `select vt.verified_pos from _vt.vdiff as v join _vt.vdiff_table as vt on v.vdiff_uuid = vt.vdiff_uuid where v.db_name = %s and v.workflow = %s and v.id < %d and vt.table_name = %s and vt.state = 'completed' and vt.mismatch = 0 and vt.verified_pos is not null order by v.id desc limit 1`
+
+	sqlSelectWorkflowStreams = `select id, source, pos from _vt.vreplication where db_name = %s and workflow = %s`
+	sqlStopWorkflow          = `update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where db_name = %s and workflow = %s`
+	sqlSyncStream            = `update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d`
+	sqlRestartWorkflow       = `update _vt.vreplication set state = 'Running', message = '', stop_pos = '' where db_name = %s and workflow = %s`
+)
+
+var withDDL = withddl.New([]string{
+	sqlCreateVDiffTable,
+	sqlCreateVDiffTableTable,
+	"ALTER TABLE _vt.vdiff_table ADD COLUMN verified_pos json",
+	"ALTER TABLE _vt.vdiff_table ADD COLUMN pk_range json",
+})
@@ -0,0 +1,597 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// progressUpdateInterval is how often the progress of a table diff is persisted.
+var progressUpdateInterval = sync2.NewAtomicDuration(10 * time.Second)
+
+// tableDiffer diffs one table of a vdiff. The table is diffed from its lastpk, so that
+// an interrupted diff resumes where it stopped.
+type tableDiffer struct {
+	ct   *controller
+	plan *tablePlan
+
+	// The key for sources is the source shard name.
+	sources map[string]*shardStreamer
+	target  *shardStreamer
+
+	// lastpk is the encoded pk of the last row that was compared on both sides.
+	lastpk       string
+	rowsCompared int64
+	report       *DiffReport
+
+	// verifiedPos are the positions of the sources, by source shard and encoded as json, up to
+	// which the table is verified once its diff completes. They are recorded when the diff of
+	// the table starts, and kept when it resumes.
+	verifiedPos string
+	// pkRange is the range of rows diffed by an incremental vdiff. It is nil for a full diff.
+	pkRange *pkRange
+	// complete is set once all the rows to diff were compared.
+	complete bool
+}
+
+func newTableDiffer(ct *controller, plan *tablePlan, row sqltypes.RowNamedValues) (*tableDiffer, error) {
+	td := &tableDiffer{
+		ct:           ct,
+		plan:         plan,
+		sources:      make(map[string]*shardStreamer),
+		lastpk:       row.AsString("lastpk", ""),
+		rowsCompared: row.AsInt64("rows_compared", 0),
+		report:       &DiffReport{TableName: plan.table.Name},
+		verifiedPos:  row.AsString("verified_pos", ""),
+	}
+	if report := row.AsBytes("report", nil); len(report) != 0 {
+		if err := json.Unmarshal(report, td.report); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid report for table %s", plan.table.Name)
+		}
+	}
+	if r := row.AsBytes("pk_range", nil); len(r) != 0 {
+		td.pkRange = &pkRange{}
+		if err := json.Unmarshal(r, td.pkRange); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid pk range for table %s", plan.table.Name)
+		}
+	}
+	return td, nil
+}
+
+// diff runs the diff of the table, starting from its lastpk.
+func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64) (err error) {
+	log.Infof("VDiff %s: starting diff of table %s", td.ct.uuid, td.plan.table.Name)
+	if _, err := td.ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateVDiffTableStarted, encodeString(td.ct.uuid), encodeString(td.plan.table.Name))); err != nil {
+		return err
+	}
+
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	firstRun := td.lastpk == "" && td.verifiedPos == ""
+	if firstRun && td.ct.options.Incremental {
+		if err := td.prepareIncremental(ctx); err != nil {
+			return err
+		}
+	}
+	// A full diff compares the whole table with a single plan. An incremental diff
+	// compares each cluster of its range in turn, with a plan of its own.
+	plans := []*tablePlan{td.plan}
+	if td.pkRange != nil {
+		if td.pkRange.isEmpty() {
+			td.complete = true
+			return td.saveProgress(ctx, true)
+		}
+		clusters, err := td.pkRange.remainingClusters(td.lastpk)
+		if err != nil {
+			return err
+		}
+		plans = plans[:0]
+		for _, cluster := range clusters {
+			plan, err := td.plan.withPKCluster(cluster)
+			if err != nil {
+				return err
+			}
+			plans = append(plans, plan)
+		}
+	}
+
+	defer func() {
+		// The progress is saved even if the diff was interrupted, so that it resumes
+		// from the last compared row. The context may be canceled already.
+		if saveErr := td.saveProgress(context.Background(), err == nil); saveErr != nil {
+			log.Errorf("VDiff %s: could not save the progress of table %s: %v", td.ct.uuid, td.plan.table.Name, saveErr)
+			if err == nil {
+				err = saveErr
+			}
+		}
+	}()
+	for _, plan := range plans {
+		td.plan = plan
+		if err := td.initialize(ctx); err != nil {
+			return err
+		}
+		if firstRun && td.pkRange == nil {
+			// A full diff verifies the table up to the positions of the source snapshots.
+			if td.verifiedPos, err = td.snapshotPositions(); err != nil {
+				return err
+			}
+			if err := td.saveRange(ctx); err != nil {
+				return err
+			}
+		}
+		exhausted, err := td.compareRows(ctx, rowsToCompare)
+		if err != nil || !exhausted {
+			return err
+		}
+	}
+	td.report.reconcileExtraRows(td.ct.options.MaxExtraRowsToCompare)
+	td.complete = true
+	return nil
+}
+
+// initialize stops the workflow, waits for the sources to catch up with it, starts
+// consistent snapshots on the sources, fast-forwards the workflow to the snapshot positions,
+// and then starts a snapshot on this tablet. Once the target snapshot is started,
+// the workflow is restarted.
+func (td *tableDiffer) initialize(ctx context.Context) (err error) {
+	ct := td.ct
+	lockCtx, unlock, lockErr := ct.vde.ts.LockKeyspace(ctx, ct.keyspace, "vdiff")
+	if lockErr != nil {
+		return vterrors.Wrapf(lockErr, "LockKeyspace %s", ct.keyspace)
+	}
+	defer unlock(&err)
+
+	defer func() {
+		if err := td.restartWorkflow(); err != nil {
+			log.Errorf("VDiff %s: could not restart workflow %s: %v, please restart it manually", ct.uuid, ct.workflow, err)
+		}
+	}()
+
+	lastpk, err := decodeLastPK(td.lastpk)
+	if err != nil {
+		return err
+	}
+
+	// Stop the workflow and record the source positions of its streams.
+	if _, err := ct.vre.Exec(fmt.Sprintf(sqlStopWorkflow, encodeString(ct.vde.dbName), encodeString(ct.workflow))); err != nil {
+		return vterrors.Wrap(err, "stopWorkflow")
+	}
+	streams, err := ct.readStreams()
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		td.sources[stream.bls.Shard] = &shardStreamer{
+			keyspace: stream.bls.Keyspace,
+			shard:    stream.bls.Shard,
+			position: stream.pos,
+		}
+	}
+	if err := td.pickTablets(lockCtx, td.sources); err != nil {
+		return vterrors.Wrap(err, "pickTablets")
+	}
+	// Make sure all sources are past the workflow's positions and start a query stream that records the current source positions.
+	if err := td.startSourceStreams(lockCtx, lastpk); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	// Fast forward the workflow to the newly recorded source positions.
+	if err := td.syncWorkflow(lockCtx, streams); err != nil {
+		return vterrors.Wrap(err, "syncWorkflow")
+	}
+	// Sources and target are in sync. Start the query stream on this tablet, which is the primary.
+	thisTablet := proto.Clone(ct.vde.thisTablet).(*topodatapb.Tablet)
+	thisTablet.Type = topodatapb.TabletType_PRIMARY
+	td.target = &shardStreamer{
+		tablet:   thisTablet,
+		keyspace: ct.vde.thisTablet.Keyspace,
+		shard:    ct.vde.thisTablet.Shard,
+	}
+	if err := td.startStream(lockCtx, td.target, td.plan.targetQuery, lastpk); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(target)")
+	}
+	// Now that the queries are running, the workflow can be restarted by the deferred call.
+	return nil
+}
+
+// pickTablets picks the source tablets to stream from.
+func (td *tableDiffer) pickTablets(ctx context.Context, sources map[string]*shardStreamer) error {
+	opts := td.ct.options
+	return forAll(sources, func(shard string, participant *shardStreamer) error {
+		tp, err := discovery.NewTabletPicker(td.ct.vde.ts, []string{opts.SourceCell}, participant.keyspace, participant.shard, opts.TabletTypes)
+		if err != nil {
+			return err
+		}
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return err
+		}
+		participant.tablet = tablet
+		return nil
+	})
+}
+
+func (td *tableDiffer) startSourceStreams(ctx context.Context, lastpk *querypb.QueryResult) error {
+	waitCtx, cancel := context.WithTimeout(ctx, td.ct.options.FilteredReplicationWaitTime)
+	defer cancel()
+	return forAll(td.sources, func(shard string, participant *shardStreamer) error {
+		if participant.position == "" {
+			return fmt.Errorf("workflow %s.%s: stream has not started for source shard %s", td.ct.keyspace, td.ct.workflow, shard)
+		}
+		log.Infof("WaitForPosition: tablet %s should reach position %s", topoproto.TabletAliasString(participant.tablet.Alias), participant.position)
+		if err := td.ct.vde.tmc.WaitForPosition(waitCtx, participant.tablet, participant.position); err != nil {
+			return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(participant.tablet.Alias))
+		}
+		return td.startStream(ctx, participant, td.plan.sourceQuery, lastpk)
+	})
+}
+
+// syncWorkflow fast-forwards the workflow streams to the source snapshot positions
+// and waits for them to catch up to that point.
+func (td *tableDiffer) syncWorkflow(ctx context.Context, streams []*workflowStream) error {
+	waitCtx, cancel := context.WithTimeout(ctx, td.ct.options.FilteredReplicationWaitTime)
+	defer cancel()
+	for _, stream := range streams {
+		pos := td.sources[stream.bls.Shard].snapshotPosition
+		if _, err := td.ct.vre.Exec(fmt.Sprintf(sqlSyncStream, encodeString(pos), stream.id)); err != nil {
+			return err
+		}
+		if err := td.ct.vre.WaitForPos(waitCtx, int(stream.id), pos); err != nil {
+			return vterrors.Wrapf(err, "WaitForPos for stream %d", stream.id)
+		}
+	}
+	return nil
+}
+
+// snapshotPositions returns the positions of the source snapshots, by source shard, encoded as json.
+func (td *tableDiffer) snapshotPositions() (string, error) {
+	positions := make(map[string]string)
+	for shard, participant := range td.sources {
+		positions[shard] = participant.snapshotPosition
+	}
+	b, err := json.Marshal(positions)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// restartWorkflow restarts the stopped workflow streams.
+func (td *tableDiffer) restartWorkflow() error {
+	_, err := td.ct.vre.Exec(fmt.Sprintf(sqlRestartWorkflow, encodeString(td.ct.vde.dbName), encodeString(td.ct.workflow)))
+	return err
+}
+
+// startStream starts a row stream on the participant, from lastpk onwards, and records its snapshot position.
+// It creates a result channel which StreamExecute will use to serve rows.
+func (td *tableDiffer) startStream(ctx context.Context, participant *shardStreamer, query string, lastpk *querypb.QueryResult) error {
+	participant.result = make(chan *sqltypes.Result, 1)
+	gtidch := make(chan string, 1)
+
+	// Start the stream in a separate goroutine.
+	go td.streamOne(ctx, participant, query, lastpk, gtidch)
+
+	// Wait for the gtid to be sent. If it's not received, there was an error
+	// which would be stored in participant.err.
+	gtid, ok := <-gtidch
+	if !ok {
+		return participant.err
+	}
+	// Save the new position, as of when the query executed.
+	participant.snapshotPosition = gtid
+	return nil
+}
+
+// streamOne is called as a goroutine, and communicates its results through channels.
+// It first sends the snapshot gtid to gtidch.
+// Then it streams results to participant.result.
+// Before returning, it sets participant.err, and closes all channels.
+// If any channel is closed, then participant.err can be checked if there was an error.
+// The shardStreamer's StreamExecute consumes the result channel.
+func (td *tableDiffer) streamOne(ctx context.Context, participant *shardStreamer, query string, lastpk *querypb.QueryResult, gtidch chan string) {
+	defer close(participant.result)
+	defer close(gtidch)
+
+	// Wrap the streaming in a separate function so we can capture the error.
+	// This shows that the error will be set before the channels are closed.
+	participant.err = func() error {
+		conn, err := tabletconn.GetDialer()(participant.tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		target := &querypb.Target{
+			Keyspace:   participant.keyspace,
+			Shard:      participant.shard,
+			TabletType: participant.tablet.Type,
+		}
+		var fields []*querypb.Field
+		return conn.VStreamRows(ctx, target, query, lastpk, func(vrs *binlogdatapb.VStreamRowsResponse) error {
+			var result *sqltypes.Result
+			if vrs.Fields != nil {
+				if err := td.checkPKFields(vrs.Pkfields); err != nil {
+					return err
+				}
+				fields = vrs.Fields
+				gtidch <- vrs.Gtid
+				// Fields should be received only once, and sent only once.
+				result = &sqltypes.Result{Fields: fields}
+			} else {
+				result = sqltypes.CustomProto3ToResult(fields, &querypb.QueryResult{Rows: vrs.Rows})
+				result.Fields = nil
+			}
+			select {
+			case participant.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamRows")
+			}
+			return nil
+		})
+	}()
+}
+
+// checkPKFields verifies that a stream is ordered by the primary key the rows are compared by.
+// Rows are streamed in the order of the primary key of the streamed table, and lastpk is
+// expressed in these columns, which only works if they match the primary key of the target.
+func (td *tableDiffer) checkPKFields(pkfields []*querypb.Field) error {
+	if len(pkfields) != len(td.plan.pkCols) {
+		return fmt.Errorf("primary key of table %s does not match between source and target: %v", td.plan.table.Name, pkfields)
+	}
+	for i, pkfield := range pkfields {
+		if !strings.EqualFold(pkfield.Name, td.plan.sourcePKColumnNames[i]) {
+			return fmt.Errorf("primary key of table %s does not match between source and target: %v", td.plan.table.Name, pkfields)
+		}
+	}
+	return nil
+}
+
+// compareRows compares the rows of the sources with the rows of the target, in primary key order.
+// It returns true once all the streamed rows were compared, and false if the limit of rows to
+// compare was reached first.
+func (td *tableDiffer) compareRows(ctx context.Context, rowsToCompare *int64) (bool, error) {
+	opts := td.ct.options
+	sourceExecutor := newPrimitiveExecutor(ctx, newMergeSorter(td.sources, td.plan.comparePKs))
+	targetExecutor := newPrimitiveExecutor(ctx, newMergeSorter(map[string]*shardStreamer{td.target.shard: td.target}, td.plan.comparePKs))
+	dr := td.report
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	lastSave := time.Now()
+	for {
+		if dr.ProcessedRows%1e7 == 0 { // log progress every 10 million rows
+			log.Infof("VDiff progress:: table %s: %s rows", td.plan.table.Name, humanInt(int64(dr.ProcessedRows)))
+		}
+		if time.Since(lastSave) > progressUpdateInterval.Get() {
+			if err := td.saveProgress(ctx, false); err != nil {
+				return false, err
+			}
+			lastSave = time.Now()
+		}
+		*rowsToCompare--
+		if *rowsToCompare < 0 {
+			log.Infof("Stopping vdiff, specified limit reached")
+			return false, nil
+		}
+		if advanceSource {
+			sourceRow, err = sourceExecutor.next()
+			if err != nil {
+				return false, err
+			}
+		}
+		if advanceTarget {
+			targetRow, err = targetExecutor.next()
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if sourceRow == nil && targetRow == nil {
+			return true, nil
+		}
+
+		advanceSource = true
+		advanceTarget = true
+
+		// Compare pk values. A side that has no more rows sorts after the other one.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			c, err = td.plan.compare(sourceRow, targetRow, td.plan.comparePKs, false)
+			if err != nil {
+				return false, err
+			}
+		}
+		dr.ProcessedRows++
+		td.rowsCompared++
+		switch {
+		case c < 0:
+			if dr.ExtraRowsSource < opts.MaxExtraRowsToCompare {
+				diffRow, err := td.plan.genRowDiff(td.plan.sourceQuery, sourceRow, opts.DebugQuery, opts.OnlyPKs)
+				if err != nil {
+					return false, vterrors.Wrap(err, "unexpected error generating diff")
+				}
+				dr.ExtraRowsSourceDiffs = append(dr.ExtraRowsSourceDiffs, diffRow)
+			}
+			dr.ExtraRowsSource++
+			advanceTarget = false
+			if err := td.updateLastPK(sourceRow); err != nil {
+				return false, err
+			}
+			continue
+		case c > 0:
+			if dr.ExtraRowsTarget < opts.MaxExtraRowsToCompare {
+				diffRow, err := td.plan.genRowDiff(td.plan.targetQuery, targetRow, opts.DebugQuery, opts.OnlyPKs)
+				if err != nil {
+					return false, vterrors.Wrap(err, "unexpected error generating diff")
+				}
+				dr.ExtraRowsTargetDiffs = append(dr.ExtraRowsTargetDiffs, diffRow)
+			}
+			dr.ExtraRowsTarget++
+			advanceSource = false
+			if err := td.updateLastPK(targetRow); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		// c == 0
+		// Compare the non-pk values.
+		c, err = td.plan.compare(sourceRow, targetRow, td.plan.compareCols, true)
+		switch {
+		case err != nil:
+			return false, err
+		case c != 0:
+			// We don't do a second pass to compare mismatched rows so we can cap the slice here
+			if dr.MismatchedRows < maxVDiffReportSampleRows {
+				sourceDiffRow, err := td.plan.genRowDiff(td.plan.targetQuery, sourceRow, opts.DebugQuery, opts.OnlyPKs)
+				if err != nil {
+					return false, vterrors.Wrap(err, "unexpected error generating diff")
+				}
+				targetDiffRow, err := td.plan.genRowDiff(td.plan.targetQuery, targetRow, opts.DebugQuery, opts.OnlyPKs)
+				if err != nil {
+					return false, vterrors.Wrap(err, "unexpected error generating diff")
+				}
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{Source: sourceDiffRow, Target: targetDiffRow})
+			}
+			dr.MismatchedRows++
+		default:
+			dr.MatchingRows++
+		}
+		if err := td.updateLastPK(sourceRow); err != nil {
+			return false, err
+		}
+	}
+}
+
+// updateLastPK records the pk of a row after it was compared. All rows with a smaller
+// or equal pk were compared on both sides, which is why a diff can resume from there.
+func (td *tableDiffer) updateLastPK(row []sqltypes.Value) error {
+	pkValues := make([]sqltypes.Value, 0, len(td.plan.pkCols))
+	for _, pkI := range td.plan.pkCols {
+		pkValues = append(pkValues, row[pkI])
+	}
+	lastpk, err := encodeLastPK(td.plan.pkFields, pkValues)
+	if err != nil {
+		return err
+	}
+	td.lastpk = lastpk
+	return nil
+}
+
+// saveProgress persists the lastpk and the report of the table. If done is true, the table
+// is also marked as completed, and if all its rows were compared, it is recorded as verified
+// up to its verified positions.
+func (td *tableDiffer) saveProgress(ctx context.Context, done bool) error {
+	report, err := json.Marshal(td.report)
+	if err != nil {
+		return err
+	}
+	mismatch := 0
+	if td.report.hasMismatch() {
+		mismatch = 1
+	}
+	var query string
+	if done {
+		verifiedPos := ""
+		if td.complete {
+			verifiedPos = td.verifiedPos
+		}
+		query = fmt.Sprintf(sqlUpdateVDiffTableDone, encodeString(td.lastpk), td.rowsCompared, mismatch, encodeString(string(report)),
+			encodeNullableString(verifiedPos), encodeString(td.ct.uuid), encodeString(td.plan.table.Name))
+	} else {
+		query = fmt.Sprintf(sqlUpdateVDiffTableProgress, encodeString(td.lastpk), td.rowsCompared, mismatch, encodeString(string(report)),
+			encodeString(td.ct.uuid), encodeString(td.plan.table.Name))
+	}
+	if _, err := td.ct.vde.execWithDDL(ctx, query); err != nil {
+		return err
+	}
+	_, err = td.ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateVDiffLiveness, td.ct.id))
+	return err
+}
+
+// compare compares the given columns of two rows.
+func (tp *tablePlan) compare(sourceRow, targetRow []sqltypes.Value, cols []compareColInfo, compareOnlyNonPKs bool) (int, error) {
+	for _, col := range cols {
+		if col.isPK && compareOnlyNonPKs {
+			continue
+		}
+		compareIndex := col.colIndex
+		var collationID collations.ID
+		// if the collation is nil or unknown, use binary collation to compare as bytes
+		if col.collation == nil {
+			collationID = collations.CollationBinaryID
+		} else {
+			collationID = col.collation.ID()
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[compareIndex], targetRow[compareIndex], collationID)
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+func forAll(participants map[string]*shardStreamer, f func(string, *shardStreamer) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for shard, participant := range participants {
+		wg.Add(1)
+		go func(shard string, participant *shardStreamer) {
+			defer wg.Done()
+
+			if err := f(shard, participant); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(shard, participant)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// compareColInfo contains the metadata for a column of the table being diffed
+type compareColInfo struct {
+	colIndex  int                  // index of the column in the filter's select
+	collation collations.Collation // is the collation of the column, if any
+	isPK      bool                 // is this column part of the primary key
+}
+
+// tablePlan is the plan to diff one table of the workflow.
+type tablePlan struct {
+	table *tabletmanagerdatapb.TableDefinition
+	// sourceQuery and targetQuery are the queries streamed from the sources and the target.
+	// The source query keeps the keyrange filter of the workflow, so that the sources only
+	// stream the rows that belong to this target shard.
+	sourceQuery string
+	targetQuery string
+	// sourceTable is the name of the table the source query selects from.
+	sourceTable string
+
+	// compareCols is the list of all the columns to compare.
+	compareCols []compareColInfo
+	// comparePKs is the list of pk columns to compare. The logic
+	// for comparing pk columns is different from compareCols
+	comparePKs []compareColInfo
+	// pkCols has the indices of PK cols in the select list
+	pkCols []int
+	// pkFields are the fields of the PK columns, used to build the lastpk of the streams
+	pkFields []*querypb.Field
+	// sourcePKColumnNames are the names of the source columns the PK columns are selected from
+	sourcePKColumnNames []string
+}
+
+// buildTablePlans builds the plans of all the tables of the workflow, as defined by its filter.
+// If tablesToInclude is not empty, only these tables are diffed.
+func buildTablePlans(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) (map[string]*tablePlan, error) {
+	plans := make(map[string]*tablePlan)
+	for _, table := range schm.TableDefinitions {
+		// Skip internal operation tables for vdiff
+		if schema.IsInternalOperationTableName(table.Name) {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		if len(tablesToInclude) > 0 {
+			include := false
+			for _, t := range tablesToInclude {
+				if t == table.Name {
+					include = true
+					break
+				}
+			}
+			if !include {
+				continue
+			}
+		}
+		query := rule.Filter
+		switch {
+		case rule.Filter == "":
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			query = buf.String()
+		case key.IsKeyRange(rule.Filter):
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table.Name), sqlparser.NewStrLiteral(rule.Filter))
+			query = buf.String()
+		}
+		plans[table.Name], err = buildTablePlan(table, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(tablesToInclude) > 0 && len(tablesToInclude) != len(plans) {
+		var found []string
+		for name := range plans {
+			found = append(found, name)
+		}
+		sort.Strings(found)
+		return nil, fmt.Errorf("one or more tables provided are not present in the workflow: %v, found: %v", tablesToInclude, found)
+	}
+	return plans, nil
+}
+
+// buildTablePlan builds the plan of one table, given the query of its vreplication rule.
+func buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*tablePlan, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if len(sel.GroupBy) > 0 {
+		return nil, fmt.Errorf("group by is not supported by vdiff for table %v: %v", table.Name, sqlparser.String(sel))
+	}
+	tp := &tablePlan{
+		table: table,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok && expr.IsAggregate() {
+				return nil, fmt.Errorf("aggregates are not supported by vdiff for table %v: %v", table.Name, sqlparser.String(selExpr))
+			}
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+
+	// Start with adding all columns for comparison.
+	tp.compareCols = make([]compareColInfo, len(sourceSelect.SelectExprs))
+	for i := range tp.compareCols {
+		tp.compareCols[i].colIndex = i
+		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		if _, ok := fields[colname]; !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
+		}
+	}
+
+	// The source keeps the filter of the workflow, so that only the rows that belong to this shard are streamed.
+	sourceSelect.From = sel.From
+	sourceSelect.Where = sel.Where
+	if len(sel.From) == 1 {
+		if from, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+			tp.sourceTable = sqlparser.GetTableName(from.Expr).String()
+		}
+	}
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+
+	if err := tp.findPKs(sourceSelect, targetSelect, fields); err != nil {
+		return nil, err
+	}
+	// The rows are streamed in the order of the primary key, which is how they are compared.
+	// The queries must not have an order by of their own.
+	tp.sourceQuery = sqlparser.String(sourceSelect)
+	tp.targetQuery = sqlparser.String(targetSelect)
+	return tp, nil
+}
+
+// findPKs identifies the PK columns in the select list of the target.
+func (tp *tablePlan) findPKs(sourceSelect, targetSelect *sqlparser.Select, fields map[string]*querypb.Field) error {
+	if len(tp.table.PrimaryKeyColumns) == 0 {
+		return fmt.Errorf("table %v has no primary key", tp.table.Name)
+	}
+	for _, pk := range tp.table.PrimaryKeyColumns {
+		found := false
+		for i, selExpr := range targetSelect.SelectExprs {
+			colname := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.String()
+			if strings.EqualFold(pk, colname) {
+				tp.compareCols[i].isPK = true
+				tp.comparePKs = append(tp.comparePKs, tp.compareCols[i])
+				tp.pkCols = append(tp.pkCols, i)
+				sourceColName := ""
+				if col, ok := sourceSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName); ok {
+					sourceColName = col.Name.String()
+				}
+				tp.sourcePKColumnNames = append(tp.sourcePKColumnNames, sourceColName)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("primary key column %v of table %v is not part of the workflow filter", pk, tp.table.Name)
+		}
+		field := fields[strings.ToLower(pk)]
+		tp.pkFields = append(tp.pkFields, &querypb.Field{Name: field.Name, Type: field.Type})
+	}
+	return nil
+}
+
+// newMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
+func newMergeSorter(participants map[string]*shardStreamer, comparePKs []compareColInfo) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderByParams, 0, len(comparePKs))
+	for _, cpk := range comparePKs {
+		weightStringCol := -1
+		// if the collation is nil or unknown, use binary collation to compare as bytes
+		if cpk.collation == nil {
+			ob = append(ob, engine.OrderByParams{Col: cpk.colIndex, WeightStringCol: weightStringCol, CollationID: collations.CollationBinaryID})
+		} else {
+			ob = append(ob, engine.OrderByParams{Col: cpk.colIndex, WeightStringCol: weightStringCol, CollationID: cpk.collation.ID()})
+		}
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
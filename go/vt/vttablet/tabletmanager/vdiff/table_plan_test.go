@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testSchema = &tabletmanagerdatapb.SchemaDefinition{
+	TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+		Name:              "t1",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+	}, {
+		Name:              "t2",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c2", "c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+	}, {
+		Name:    "nopk",
+		Columns: []string{"c1"},
+		Fields:  sqltypes.MakeTestFields("c1", "int64"),
+	}, {
+		Name:              "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+		Columns:           []string{"c1"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1", "int64"),
+	}},
+}
+
+func TestBuildTablePlans(t *testing.T) {
+	testcases := []struct {
+		name        string
+		rule        *binlogdatapb.Rule
+		table       string
+		sourceQuery string
+		targetQuery string
+		pkCols      []int
+		sourcePKs   []string
+		err         string
+	}{{
+		name:        "empty filter",
+		rule:        &binlogdatapb.Rule{Match: "t1"},
+		table:       "t1",
+		sourceQuery: "select c1, c2 from t1",
+		targetQuery: "select c1, c2 from t1",
+		pkCols:      []int{0},
+		sourcePKs:   []string{"c1"},
+	}, {
+		name:        "keyrange filter",
+		rule:        &binlogdatapb.Rule{Match: "t1", Filter: "-80"},
+		table:       "t1",
+		sourceQuery: "select c1, c2 from t1 where in_keyrange('-80')",
+		targetQuery: "select c1, c2 from t1",
+		pkCols:      []int{0},
+		sourcePKs:   []string{"c1"},
+	}, {
+		name:        "in_keyrange in select filter",
+		rule:        &binlogdatapb.Rule{Match: "t1", Filter: "select * from t1 where in_keyrange(c1, 'hash', '-80')"},
+		table:       "t1",
+		sourceQuery: "select c1, c2 from t1 where in_keyrange(c1, 'hash', '-80')",
+		targetQuery: "select c1, c2 from t1",
+		pkCols:      []int{0},
+		sourcePKs:   []string{"c1"},
+	}, {
+		name:        "renamed columns",
+		rule:        &binlogdatapb.Rule{Match: "t1", Filter: "select a as c1, b as c2 from src"},
+		table:       "t1",
+		sourceQuery: "select a as c1, b as c2 from src",
+		targetQuery: "select c1, c2 from t1",
+		pkCols:      []int{0},
+		sourcePKs:   []string{"a"},
+	}, {
+		name:        "composite pk",
+		rule:        &binlogdatapb.Rule{Match: "t2"},
+		table:       "t2",
+		sourceQuery: "select c1, c2 from t2",
+		targetQuery: "select c1, c2 from t2",
+		pkCols:      []int{1, 0},
+		sourcePKs:   []string{"c2", "c1"},
+	}, {
+		name:  "group by",
+		rule:  &binlogdatapb.Rule{Match: "t1", Filter: "select c1, count(*) as c2 from t1 group by c1"},
+		table: "t1",
+		err:   "group by is not supported by vdiff for table t1",
+	}, {
+		name:  "no primary key",
+		rule:  &binlogdatapb.Rule{Match: "nopk"},
+		table: "nopk",
+		err:   "table nopk has no primary key",
+	}, {
+		name:  "missing pk column",
+		rule:  &binlogdatapb.Rule{Match: "t1", Filter: "select c2 from t1"},
+		table: "t1",
+		err:   "primary key column c1 of table t1 is not part of the workflow filter",
+	}, {
+		name:  "unaliased expression",
+		rule:  &binlogdatapb.Rule{Match: "t1", Filter: "select c1, c2+1 from t1"},
+		table: "t1",
+		err:   "expression needs an alias",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.rule}}
+			plans, err := buildTablePlans(filter, testSchema, nil)
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, len(plans))
+			tp := plans[tcase.table]
+			require.NotNil(t, tp)
+			assert.Equal(t, tcase.sourceQuery, tp.sourceQuery)
+			assert.Equal(t, tcase.targetQuery, tp.targetQuery)
+			assert.Equal(t, tcase.pkCols, tp.pkCols)
+			assert.Equal(t, tcase.sourcePKs, tp.sourcePKColumnNames)
+		})
+	}
+}
+
+func TestBuildTablePlansTables(t *testing.T) {
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/t.*"}}}
+	plans, err := buildTablePlans(filter, testSchema, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(plans))
+
+	plans, err = buildTablePlans(filter, testSchema, []string{"t2"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(plans))
+	assert.NotNil(t, plans["t2"])
+
+	_, err = buildTablePlans(filter, testSchema, []string{"t2", "t3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "one or more tables provided are not present in the workflow")
+
+	// Internal operation tables and excluded tables are skipped.
+	filter = &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "nopk", Filter: "exclude"}, {Match: "/.*"}}}
+	plans, err = buildTablePlans(filter, testSchema, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(plans))
+}
+
+func TestLastPK(t *testing.T) {
+	fields := []*querypb.Field{{Name: "c2", Type: querypb.Type_VARCHAR}, {Name: "c1", Type: querypb.Type_INT64}}
+	encoded, err := encodeLastPK(fields, []sqltypes.Value{sqltypes.NewVarChar("a'b"), sqltypes.NewInt64(10)})
+	require.NoError(t, err)
+
+	lastpk, err := decodeLastPK(encoded)
+	require.NoError(t, err)
+	result := sqltypes.Proto3ToResult(lastpk)
+	assert.Equal(t, fields, result.Fields)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewVarChar("a'b"), sqltypes.NewInt64(10)}}, result.Rows)
+
+	lastpk, err = decodeLastPK("")
+	require.NoError(t, err)
+	assert.Nil(t, lastpk)
+}
+
+func TestReconcileExtraRows(t *testing.T) {
+	row := func(id int64) *RowDiff {
+		return &RowDiff{Row: map[string]sqltypes.Value{"c1": sqltypes.NewInt64(id)}}
+	}
+	dr := &DiffReport{
+		ProcessedRows:        4,
+		ExtraRowsSource:      2,
+		ExtraRowsSourceDiffs: []*RowDiff{row(1), row(2)},
+		ExtraRowsTarget:      2,
+		ExtraRowsTargetDiffs: []*RowDiff{row(2), row(1)},
+	}
+	dr.reconcileExtraRows(1000)
+	assert.Equal(t, &DiffReport{
+		ProcessedRows:        2,
+		MatchingRows:         2,
+		ExtraRowsSourceDiffs: []*RowDiff{},
+		ExtraRowsTargetDiffs: []*RowDiff{},
+	}, dr)
+	assert.False(t, dr.hasMismatch())
+
+	dr = &DiffReport{
+		ProcessedRows:        2,
+		ExtraRowsSource:      1,
+		ExtraRowsSourceDiffs: []*RowDiff{row(1)},
+		ExtraRowsTarget:      1,
+		ExtraRowsTargetDiffs: []*RowDiff{row(3)},
+	}
+	dr.reconcileExtraRows(1000)
+	assert.Equal(t, 1, dr.ExtraRowsSource)
+	assert.Equal(t, 1, dr.ExtraRowsTarget)
+	assert.True(t, dr.hasMismatch())
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// shardStreamer streams rows from one shard. This works for
+// the source as well as the target.
+// shardStreamer satisfies engine.StreamExecutor, and can be
+// added to Primitives of engine.MergeSort.
+type shardStreamer struct {
+	tablet           *topodatapb.Tablet
+	keyspace         string
+	shard            string
+	position         string
+	snapshotPosition string
+	result           chan *sqltypes.Result
+	err              error
+}
+
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = vcursor.StreamExecutePrimitive(pe.prim, make(map[string]*querypb.BindVariable), true, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+// next returns the next row, or nil once all rows were consumed.
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) ConnCollation() collations.ID {
+	return collations.CollationBinaryID
+}
+
+func (vc *contextVCursor) ExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return primitive.TryExecute(vc, bindVars, wantfields)
+}
+
+func (vc *contextVCursor) StreamExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return primitive.TryStreamExecute(vc, bindVars, wantfields, callback)
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+//-----------------------------------------------------------------
+// Utility functions
+
+// encodeLastPK encodes the pk values of a row as the text form of a single row QueryResult,
+// which is how VStreamRows expects the position it has to resume from.
+func encodeLastPK(fields []*querypb.Field, row []sqltypes.Value) (string, error) {
+	qr := &querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(row)},
+	}
+	b, err := prototext.Marshal(qr)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// decodeLastPK decodes a lastpk encoded by encodeLastPK. An empty lastpk decodes to nil.
+func decodeLastPK(lastpk string) (*querypb.QueryResult, error) {
+	if lastpk == "" {
+		return nil, nil
+	}
+	qr := &querypb.QueryResult{}
+	if err := prototext.Unmarshal([]byte(lastpk), qr); err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 {
+		return nil, fmt.Errorf("invalid lastpk, expecting a single row: %v", lastpk)
+	}
+	return qr, nil
+}
+
+// humanInt formats large integers to a value easier to the eye: 100000=100k 1e12=1b 234000000=234m ...
+func humanInt(n int64) string {
+	var val float64
+	var unit string
+	switch true {
+	case n < 1000:
+		val = float64(n)
+	case n < 1e6:
+		val = float64(n) / 1000
+		unit = "k"
+	case n < 1e9:
+		val = float64(n) / 1e6
+		unit = "m"
+	default:
+		val = float64(n) / 1e9
+		unit = "b"
+	}
+	s := fmt.Sprintf("%0.3f", val)
+	s = strings.Replace(s, ".000", "", -1)
+
+	return fmt.Sprintf("%s%s", s, unit)
+}
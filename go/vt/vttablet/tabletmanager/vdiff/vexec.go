@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// VExecInsertTemplate is the template of the query that creates a vdiff. The shard, db_name
+// and state columns are filled in by the tablet.
+const VExecInsertTemplate = `insert into _vt.vdiff (vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('val', 'val', 'val', 'val', 'val', 'val', 'val')`
+
+var vexecInsertTemplates = []string{VExecInsertTemplate}
+
+// VExec handles the VExec queries on the vdiff sidecar tables:
+// an INSERT into _vt.vdiff creates a vdiff and starts it,
+// an UPDATE of the state of a vdiff to 'stopped' or 'pending' stops or resumes it,
+// a DELETE removes a vdiff and its progress, and
+// SELECTs on _vt.vdiff and _vt.vdiff_table report the state and progress of vdiffs.
+func (vde *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	response := func(result *sqltypes.Result, err error) (*querypb.QueryResult, error) {
+		if err != nil {
+			return nil, err
+		}
+		return sqltypes.ResultToProto3(result), nil
+	}
+	if vde.dbClientFactory == nil {
+		return nil, fmt.Errorf("vdiff engine is not initialized")
+	}
+
+	switch vx.Stmt.(type) {
+	case *sqlparser.Select:
+		return response(vde.execWithDDL(ctx, vx.Query))
+	case *sqlparser.Insert:
+		if vx.TableName != VDiffTableName {
+			return nil, fmt.Errorf("query not supported by vexec: %s", vx.Query)
+		}
+		match, err := sqlparser.QueryMatchesTemplates(vx.Query, vexecInsertTemplates)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, fmt.Errorf("Query must match one of these templates: %s", strings.Join(vexecInsertTemplates, "; "))
+		}
+		uuid, err := vx.ColumnStringVal(vx.InsertCols, "vdiff_uuid")
+		if err != nil {
+			return nil, err
+		}
+		workflow, err := vx.ColumnStringVal(vx.InsertCols, "workflow")
+		if err != nil {
+			return nil, err
+		}
+		// VExec runs on all the shards of the keyspace. Shards that are not a target
+		// of the workflow have nothing to diff.
+		streams, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectWorkflowStreams, encodeString(vde.dbName), encodeString(workflow)))
+		if err != nil {
+			return nil, err
+		}
+		if len(streams.Rows) == 0 {
+			return response(&sqltypes.Result{}, nil)
+		}
+		// Vexec naturally runs outside shard/schema context. It does not supply values for those columns.
+		// We can fill them in.
+		if err := vx.ReplaceInsertColumnVal("shard", vx.ToStringVal(vde.thisTablet.Shard)); err != nil {
+			return nil, err
+		}
+		if err := vx.ReplaceInsertColumnVal("db_name", vx.ToStringVal(vde.dbName)); err != nil {
+			return nil, err
+		}
+		if err := vx.ReplaceInsertColumnVal("state", vx.ToStringVal(string(PendingState))); err != nil {
+			return nil, err
+		}
+		qr, err := vde.execWithDDL(ctx, vx.Query)
+		if err != nil {
+			return nil, err
+		}
+		if err := vde.startVDiff(ctx, uuid); err != nil {
+			return nil, err
+		}
+		return response(qr, nil)
+	case *sqlparser.Update:
+		if vx.TableName != VDiffTableName {
+			return nil, fmt.Errorf("query not supported by vexec: %s", vx.Query)
+		}
+		if len(vx.UpdateCols) != 1 {
+			return nil, fmt.Errorf("only the state of a vdiff can be updated: %s", vx.Query)
+		}
+		state, err := vx.ColumnStringVal(vx.UpdateCols, "state")
+		if err != nil {
+			return nil, err
+		}
+		uuid, err := vx.ColumnStringVal(vx.WhereCols, "vdiff_uuid")
+		if err != nil {
+			return nil, err
+		}
+		switch State(state) {
+		case StoppedState:
+			vde.stopController(uuid)
+			return response(vde.execWithDDL(ctx, fmt.Sprintf(sqlStopVDiff, encodeString(vde.dbName), encodeString(uuid))))
+		case PendingState:
+			qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlResumeVDiff, encodeString(vde.dbName), encodeString(uuid)))
+			if err != nil {
+				return nil, err
+			}
+			if qr.RowsAffected != 0 {
+				if err := vde.startVDiff(ctx, uuid); err != nil {
+					return nil, err
+				}
+			}
+			return response(qr, nil)
+		default:
+			return nil, fmt.Errorf("unexpected value for state: %v. Supported values are: %s, %s", state, StoppedState, PendingState)
+		}
+	case *sqlparser.Delete:
+		if vx.TableName != VDiffTableName {
+			return nil, fmt.Errorf("query not supported by vexec: %s", vx.Query)
+		}
+		uuid, err := vx.ColumnStringVal(vx.WhereCols, "vdiff_uuid")
+		if err != nil {
+			return nil, err
+		}
+		vde.stopController(uuid)
+		qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlDeleteVDiffByUUID, encodeString(vde.dbName), encodeString(uuid)))
+		if err != nil {
+			return nil, err
+		}
+		if qr.RowsAffected != 0 {
+			if _, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlDeleteVDiffTablesByUUID, encodeString(uuid))); err != nil {
+				return nil, err
+			}
+		}
+		return response(qr, nil)
+	default:
+		return nil, fmt.Errorf("query not supported by vexec: %s", vx.Query)
+	}
+}
+
+// startVDiff starts the controller of the given vdiff, if the engine is open.
+// Otherwise the vdiff starts when the engine opens.
+func (vde *Engine) startVDiff(ctx context.Context, uuid string) error {
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlSelectVDiffByUUID, encodeString(uuid)))
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("vdiff %s not found", uuid)
+	}
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		log.Infof("VDiff Engine: engine is closed, vdiff %s will start when it opens", uuid)
+		return nil
+	}
+	return vde.startControllerLocked(qr.Named().Row())
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestVDiffEngine(t *testing.T) (*Engine, *binlogplayer.MockDBClient) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "-80",
+	}
+	vde := NewTestEngine(nil, tablet, nil, nil, func() binlogplayer.DBClient { return dbClient }, "vt_ks")
+	return vde, dbClient
+}
+
+func runVExec(t *testing.T, vde *Engine, query string) (*sqltypes.Result, error) {
+	ctx := context.Background()
+	vx := vexec.NewTabletVExec("wf", "ks")
+	require.NoError(t, vx.AnalyzeQuery(ctx, query))
+	qr, err := vde.VExec(ctx, vx)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+func TestVExecInsert(t *testing.T) {
+	vde, dbClient := newTestVDiffEngine(t)
+	query := "insert into _vt.vdiff (vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('u1', 'wf', 'ks', '', '', '', '{}')"
+
+	// A shard that is not a target of the workflow skips the vdiff.
+	dbClient.ExpectRequest("select id, source, pos from _vt.vreplication where db_name = 'vt_ks' and workflow = 'wf'", &sqltypes.Result{}, nil)
+	qr, err := runVExec(t, vde, query)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("select id, source, pos from _vt.vreplication where db_name = 'vt_ks' and workflow = 'wf'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|source|pos", "int64|varchar|varchar"),
+		"1|keyspace:\"src\" shard:\"0\"|MariaDB/0-1-1",
+	), nil)
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('u1', 'wf', 'ks', '-80', 'vt_ks', 'pending', '{}')", &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'u1'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|vdiff_uuid|workflow|keyspace|state", "int64|varchar|varbinary|varbinary|varbinary"),
+		"1|u1|wf|ks|pending",
+	), nil)
+	qr, err = runVExec(t, vde, query)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.RowsAffected)
+	dbClient.Wait()
+
+	_, err = runVExec(t, vde, "insert into _vt.vdiff (vdiff_uuid, workflow) values ('u1', 'wf')")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Query must match one of these templates")
+}
+
+func TestVExecUpdate(t *testing.T) {
+	vde, dbClient := newTestVDiffEngine(t)
+
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped' where db_name = 'vt_ks' and vdiff_uuid = 'u1' and state in ('pending', 'started')", &sqltypes.Result{RowsAffected: 1}, nil)
+	_, err := runVExec(t, vde, "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	// The engine is closed: the vdiff is resumed when it opens.
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'pending', last_error = '' where db_name = 'vt_ks' and vdiff_uuid = 'u1' and state in ('stopped', 'error')", &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'u1'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|vdiff_uuid|workflow|keyspace|state", "int64|varchar|varbinary|varbinary|varbinary"),
+		"1|u1|wf|ks|pending",
+	), nil)
+	_, err = runVExec(t, vde, "update _vt.vdiff set state = 'pending' where vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	// Resuming a vdiff that is not stopped is a no-op.
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'pending', last_error = '' where db_name = 'vt_ks' and vdiff_uuid = 'u1' and state in ('stopped', 'error')", &sqltypes.Result{}, nil)
+	_, err = runVExec(t, vde, "update _vt.vdiff set state = 'pending' where vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	_, err = runVExec(t, vde, "update _vt.vdiff set state = 'completed' where vdiff_uuid = 'u1'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected value for state")
+
+	_, err = runVExec(t, vde, "update _vt.vdiff set state = 'stopped', options = '' where vdiff_uuid = 'u1'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only the state of a vdiff can be updated")
+
+	_, err = runVExec(t, vde, "update _vt.vdiff set state = 'stopped'")
+	require.Error(t, err)
+}
+
+func TestVExecDelete(t *testing.T) {
+	vde, dbClient := newTestVDiffEngine(t)
+
+	dbClient.ExpectRequest("delete from _vt.vdiff where db_name = 'vt_ks' and vdiff_uuid = 'u1'", &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where vdiff_uuid = 'u1'", &sqltypes.Result{RowsAffected: 2}, nil)
+	qr, err := runVExec(t, vde, "delete from _vt.vdiff where vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.RowsAffected)
+	dbClient.Wait()
+
+	_, err = runVExec(t, vde, "delete from _vt.vdiff_table where vdiff_uuid = 'u1'")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query not supported by vexec")
+}
@@ -62,6 +62,7 @@ type controller struct {
 	workflow     string
 	source       *binlogdatapb.BinlogSource
 	stopPos      string
+	sink         string
 	tabletPicker *discovery.TabletPicker
 
 	cancel context.CancelFunc
@@ -108,6 +109,7 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		return nil, err
 	}
 	ct.stopPos = params["stop_pos"]
+	ct.sink = params["sink"]
 
 	if ct.source.GetExternalMysql() == "" {
 		// tabletPicker
@@ -267,7 +269,15 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		}
 		defer vsClient.Close(ctx)
 
-		vr := newVReplicator(ct.id, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		var sink Sink
+		if ct.sink != "" {
+			if sink, err = openSink(ct.sink, ct.workflow, ct.id); err != nil {
+				return err
+			}
+			defer sink.Close()
+		}
+
+		vr := newVReplicator(ct.id, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre, sink)
 		return vr.Replicate(ctx)
 	}
 	ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
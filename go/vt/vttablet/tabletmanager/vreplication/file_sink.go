@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var sinkFileDir = flag.String("vreplication_sink_file_dir", "", "Directory of the log files of the vreplication workflows that write to the 'file' sink")
+
+func init() {
+	RegisterSink("file", newFileSink)
+}
+
+const (
+	// fileSinkSegment is the name of the log file. Kafka names a segment
+	// after the offset of its first record.
+	fileSinkSegment = "00000000000000000000.log"
+	// batchHeaderLen is the length of a record batch header up to and
+	// including the record count.
+	batchHeaderLen = 61
+	// batchLengthEnd is the offset of the first byte after the length
+	// of a record batch. The length counts the bytes after it.
+	batchLengthEnd = 12
+	// batchCRCStart is the offset of the first byte covered by the CRC.
+	batchCRCStart = 21
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// fileSink appends records to a local file in the format of a Kafka log
+// segment. Every Write appends a record batch, with offsets that continue
+// from the last batch of the file. The file of a workflow stream is
+// <vreplication_sink_file_dir>/<workflow>-<id>/00000000000000000000.log,
+// like the first segment of a Kafka topic partition.
+type fileSink struct {
+	file       *os.File
+	nextOffset int64
+}
+
+func newFileSink(workflow string, id uint32) (Sink, error) {
+	if *sinkFileDir == "" {
+		return nil, fmt.Errorf("vreplication_sink_file_dir must be set to write workflow %s to a file", workflow)
+	}
+	if workflow == "" || filepath.Base(workflow) != workflow || workflow == ".." {
+		return nil, fmt.Errorf("workflow name %q cannot be used as a directory name", workflow)
+	}
+	dir := filepath.Join(*sinkFileDir, fmt.Sprintf("%s-%d", workflow, id))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return openFileSink(filepath.Join(dir, fileSinkSegment))
+}
+
+// openFileSink opens the log file, and truncates the batch that a failed
+// write may have left incomplete at its end.
+func openFileSink(name string) (*fileSink, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	fs := &fileSink{file: file}
+	end, err := fs.scan()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := file.Truncate(end); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, err := file.Seek(end, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return fs, nil
+}
+
+// scan reads the batch headers of the file to find the next offset, and
+// returns the end of the last complete batch.
+func (fs *fileSink) scan() (int64, error) {
+	info, err := fs.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	var pos int64
+	header := make([]byte, batchHeaderLen)
+	for {
+		if pos+batchHeaderLen > info.Size() {
+			return pos, nil
+		}
+		if _, err := fs.file.ReadAt(header, pos); err != nil {
+			return 0, err
+		}
+		end := pos + batchLengthEnd + int64(binary.BigEndian.Uint32(header[8:12]))
+		if end > info.Size() {
+			return pos, nil
+		}
+		baseOffset := int64(binary.BigEndian.Uint64(header[0:8]))
+		lastOffsetDelta := int64(binary.BigEndian.Uint32(header[23:27]))
+		fs.nextOffset = baseOffset + lastOffsetDelta + 1
+		pos = end
+	}
+}
+
+// Write appends the records as one batch, and syncs the file.
+func (fs *fileSink) Write(records []*SinkRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if _, err := fs.file.Write(encodeRecordBatch(fs.nextOffset, records)); err != nil {
+		return err
+	}
+	if err := fs.file.Sync(); err != nil {
+		return err
+	}
+	fs.nextOffset += int64(len(records))
+	return nil
+}
+
+func (fs *fileSink) Close() error {
+	return fs.file.Close()
+}
+
+// encodeRecordBatch encodes records as a Kafka record batch of version 2
+// (magic 2): uncompressed, non-transactional, and with the create time
+// of the records as their timestamps.
+func encodeRecordBatch(baseOffset int64, records []*SinkRecord) []byte {
+	baseTimestamp := records[0].Timestamp.UnixMilli()
+	maxTimestamp := baseTimestamp
+	for _, record := range records {
+		if ts := record.Timestamp.UnixMilli(); ts > maxTimestamp {
+			maxTimestamp = ts
+		}
+	}
+
+	buf := make([]byte, batchHeaderLen, 1024)
+	binary.BigEndian.PutUint64(buf[0:], uint64(baseOffset))
+	// The batch length is set below.
+	binary.BigEndian.PutUint32(buf[12:], 0) // partition leader epoch
+	buf[16] = 2                             // magic
+	// The CRC is set below.
+	binary.BigEndian.PutUint16(buf[21:], 0) // attributes
+	binary.BigEndian.PutUint32(buf[23:], uint32(len(records)-1))
+	binary.BigEndian.PutUint64(buf[27:], uint64(baseTimestamp))
+	binary.BigEndian.PutUint64(buf[35:], uint64(maxTimestamp))
+	binary.BigEndian.PutUint64(buf[43:], ^uint64(0)) // producer id: -1
+	binary.BigEndian.PutUint16(buf[51:], ^uint16(0)) // producer epoch: -1
+	binary.BigEndian.PutUint32(buf[53:], ^uint32(0)) // base sequence: -1
+	binary.BigEndian.PutUint32(buf[57:], uint32(len(records)))
+
+	var record []byte
+	for i, r := range records {
+		record = record[:0]
+		record = append(record, 0) // attributes
+		record = appendVarint(record, r.Timestamp.UnixMilli()-baseTimestamp)
+		record = appendVarint(record, int64(i))
+		record = appendVarbytes(record, r.Key)
+		record = appendVarbytes(record, r.Value)
+		record = appendVarint(record, int64(len(r.Headers)))
+		for _, h := range r.Headers {
+			record = appendVarbytes(record, []byte(h.Key))
+			record = appendVarbytes(record, h.Value)
+		}
+		buf = appendVarint(buf, int64(len(record)))
+		buf = append(buf, record...)
+	}
+
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(buf)-batchLengthEnd))
+	binary.BigEndian.PutUint32(buf[17:], crc32.Checksum(buf[batchCRCStart:], castagnoli))
+	return buf
+}
+
+// appendVarint appends a zigzag encoded varint, as used by Kafka records.
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// appendVarbytes appends the length of b followed by b. A nil b is
+// encoded as a length of -1.
+func appendVarbytes(buf, b []byte) []byte {
+	if b == nil {
+		return appendVarint(buf, -1)
+	}
+	buf = appendVarint(buf, int64(len(b)))
+	return append(buf, b...)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type decodedRecord struct {
+	offset    int64
+	timestamp int64
+	key       []byte
+	value     []byte
+	headers   map[string]string
+}
+
+// decodeRecordBatches decodes a log file, and verifies the batch headers.
+func decodeRecordBatches(t *testing.T, data []byte) []decodedRecord {
+	var records []decodedRecord
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), batchHeaderLen)
+		end := batchLengthEnd + int(binary.BigEndian.Uint32(data[8:12]))
+		require.GreaterOrEqual(t, len(data), end)
+		batch := data[:end]
+		data = data[end:]
+
+		assert.EqualValues(t, 2, batch[16])
+		assert.Equal(t, crc32.Checksum(batch[batchCRCStart:], castagnoli), binary.BigEndian.Uint32(batch[17:21]))
+		baseOffset := int64(binary.BigEndian.Uint64(batch[0:8]))
+		lastOffsetDelta := int64(binary.BigEndian.Uint32(batch[23:27]))
+		baseTimestamp := int64(binary.BigEndian.Uint64(batch[27:35]))
+		count := int(binary.BigEndian.Uint32(batch[57:61]))
+		assert.EqualValues(t, count-1, lastOffsetDelta)
+
+		buf := batch[batchHeaderLen:]
+		varint := func() int64 {
+			v, n := binary.Varint(buf)
+			require.Greater(t, n, 0)
+			buf = buf[n:]
+			return v
+		}
+		varbytes := func() []byte {
+			n := varint()
+			if n < 0 {
+				return nil
+			}
+			b := buf[:n]
+			buf = buf[n:]
+			return b
+		}
+		for i := 0; i < count; i++ {
+			length := varint()
+			rest := len(buf)
+			assert.EqualValues(t, 0, buf[0])
+			buf = buf[1:]
+			record := decodedRecord{timestamp: baseTimestamp + varint()}
+			record.offset = baseOffset + varint()
+			record.key = varbytes()
+			record.value = varbytes()
+			record.headers = make(map[string]string)
+			for n := varint(); n > 0; n-- {
+				k := varbytes()
+				record.headers[string(k)] = string(varbytes())
+			}
+			assert.EqualValues(t, length, rest-len(buf))
+			records = append(records, record)
+		}
+		assert.Empty(t, buf)
+	}
+	return records
+}
+
+func TestFileSink(t *testing.T) {
+	defer func(dir string) { *sinkFileDir = dir }(*sinkFileDir)
+	*sinkFileDir = t.TempDir()
+
+	sink, err := openSink("file", "wf", 1)
+	require.NoError(t, err)
+	ts := time.Unix(1600000000, 0)
+	require.NoError(t, sink.Write([]*SinkRecord{{
+		Key:       []byte(`{"id":1}`),
+		Value:     []byte(`{"id":1,"val":"a"}`),
+		Headers:   []SinkHeader{{Key: "table", Value: []byte("t1")}, {Key: "gtid", Value: []byte("MySQL56/uuid:1-5")}},
+		Timestamp: ts,
+	}, {
+		Key:       []byte(`{"id":2}`),
+		Timestamp: ts.Add(time.Second),
+	}}))
+	require.NoError(t, sink.Write(nil))
+	require.NoError(t, sink.Close())
+
+	// A reopened sink continues from the last offset, and a trailing
+	// incomplete batch is dropped.
+	name := filepath.Join(*sinkFileDir, "wf-1", fileSinkSegment)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.Write(encodeRecordBatch(2, []*SinkRecord{{Timestamp: ts}})[:30])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sink, err = openSink("file", "wf", 1)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write([]*SinkRecord{{Key: []byte(`{"id":3}`), Value: []byte(`{"id":3,"val":"c"}`), Timestamp: ts}}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	want := []decodedRecord{{
+		offset:    0,
+		timestamp: 1600000000000,
+		key:       []byte(`{"id":1}`),
+		value:     []byte(`{"id":1,"val":"a"}`),
+		headers:   map[string]string{"table": "t1", "gtid": "MySQL56/uuid:1-5"},
+	}, {
+		offset:    1,
+		timestamp: 1600000001000,
+		key:       []byte(`{"id":2}`),
+		headers:   map[string]string{},
+	}, {
+		offset:    2,
+		timestamp: 1600000000000,
+		key:       []byte(`{"id":3}`),
+		value:     []byte(`{"id":3,"val":"c"}`),
+		headers:   map[string]string{},
+	}}
+	assert.Equal(t, want, decodeRecordBatches(t, data))
+}
+
+func TestFileSinkErrors(t *testing.T) {
+	defer func(dir string) { *sinkFileDir = dir }(*sinkFileDir)
+
+	*sinkFileDir = ""
+	_, err := openSink("file", "wf", 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vreplication_sink_file_dir must be set")
+
+	*sinkFileDir = t.TempDir()
+	_, err = openSink("file", "../wf", 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be used as a directory name")
+
+	_, err = openSink("kafka", "wf", 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown sink kafka")
+}
@@ -32,24 +32,37 @@ type InsertGenerator struct {
 
 	state  string
 	dbname string
+	sink   string
 	now    int64
 }
 
 // NewInsertGenerator creates a new InsertGenerator.
 func NewInsertGenerator(state, dbname string) *InsertGenerator {
+	return NewSinkInsertGenerator(state, dbname, "")
+}
+
+// NewSinkInsertGenerator creates a new InsertGenerator for streams that
+// write to the named sink instead of the local database. If sink is
+// empty, it is equivalent to NewInsertGenerator.
+func NewSinkInsertGenerator(state, dbname, sink string) *InsertGenerator {
 	buf := &strings.Builder{}
-	buf.WriteString("insert into _vt.vreplication(workflow, source, pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, db_name) values ")
+	buf.WriteString("insert into _vt.vreplication(workflow, source, pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, db_name")
+	if sink != "" {
+		buf.WriteString(", sink")
+	}
+	buf.WriteString(") values ")
 	return &InsertGenerator{
 		buf:    buf,
 		state:  state,
 		dbname: dbname,
+		sink:   sink,
 		now:    time.Now().Unix(),
 	}
 }
 
 // AddRow adds a row to the insert statement.
 func (ig *InsertGenerator) AddRow(workflow string, bls *binlogdatapb.BinlogSource, pos, cell, tabletTypes string) {
-	fmt.Fprintf(ig.buf, "%s(%v, %v, %v, %v, %v, %v, %v, %v, 0, '%v', %v)",
+	fmt.Fprintf(ig.buf, "%s(%v, %v, %v, %v, %v, %v, %v, %v, 0, '%v', %v",
 		ig.prefix,
 		encodeString(workflow),
 		encodeString(bls.String()),
@@ -62,6 +75,10 @@ func (ig *InsertGenerator) AddRow(workflow string, bls *binlogdatapb.BinlogSourc
 		ig.state,
 		encodeString(ig.dbname),
 	)
+	if ig.sink != "" {
+		fmt.Fprintf(ig.buf, ", %v", encodeString(ig.sink))
+	}
+	ig.buf.WriteString(")")
 	ig.prefix = ", "
 }
 
@@ -37,3 +37,12 @@ func TestInsertGenerator(t *testing.T) {
 	want += `, ('g', 'keyspace:\"h\"', 'i', 9223372036854775807, 9223372036854775807, 'j', 'k', 111, 0, 'Stopped', 'a')`
 	assert.Equal(t, ig.String(), want)
 }
+
+func TestSinkInsertGenerator(t *testing.T) {
+	ig := NewSinkInsertGenerator(binlogplayer.BlpStopped, "a", "kafka")
+	ig.now = 111
+	ig.AddRow("b", &binlogdatapb.BinlogSource{Keyspace: "c"}, "d", "e", "f")
+	want := `insert into _vt.vreplication(workflow, source, pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, db_name, sink) values ` +
+		`('b', 'keyspace:\"c\"', 'd', 9223372036854775807, 9223372036854775807, 'e', 'f', 111, 0, 'Stopped', 'a', 'kafka')`
+	assert.Equal(t, ig.String(), want)
+}
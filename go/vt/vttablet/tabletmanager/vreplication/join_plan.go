@@ -0,0 +1,512 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// joinPlan materializes an inner join between two tables of the source, like
+// "select o.id, o.amount, c.name from orders o join customers c on o.cid = c.id".
+// Every row of the driving table (orders) produces at most one row of the
+// target table: the join column of the joined table (customers.id) is expected
+// to be unique. Consequently, the primary key of the target table must be
+// computed from the columns of the driving table.
+//
+// The source streams the two tables separately. Each table has its own
+// TablePlan, which share the statements of the target table. The join is
+// re-evaluated for the target rows affected by every row change:
+// A change in the driving table replaces its target row with the row joined
+// to the current matching row of the joined table.
+// A change in the joined table replaces the target rows of all the rows of the
+// driving table that match its old or new values.
+// Matching rows are streamed from the source by filtering on the value of the
+// join column. The source looks up these rows with an index, and fails the
+// stream if the join column of either table doesn't lead an index, instead of
+// scanning the table for every row. Such joins are rejected by
+// ValidateSourceIndexes before the workflow runs. The source may be ahead of
+// the change being applied, in which case the later changes re-evaluate the
+// join again.
+//
+// The columns of the joined table are bound with names prefixed by the name of
+// the table, like "a_customers__name", so they can't clash with the columns
+// of the driving table.
+type joinPlan struct {
+	// lookup is set in the TablePlan of the joined table.
+	lookup     bool
+	leftTable  sqlparser.TableIdent
+	rightTable sqlparser.TableIdent
+	leftCol    sqlparser.ColIdent
+	rightCol   sqlparser.ColIdent
+	// leftSelect and rightSelect stream the columns of the driving
+	// and joined tables.
+	leftSelect  *sqlparser.Select
+	rightSelect *sqlparser.Select
+	// rightRefs maps the bind names of the columns of the joined
+	// table to their names.
+	rightRefs map[string]sqlparser.ColIdent
+}
+
+// analyzeJoin validates the join of sel, and rewrites the select expressions
+// and where clause of sel to reference the columns of the joined table by
+// their bind names, and the columns of the driving table by their names.
+func analyzeJoin(sel *sqlparser.Select, join *sqlparser.JoinTableExpr) (*joinPlan, error) {
+	if join.Join != sqlparser.NormalJoinType {
+		return nil, fmt.Errorf("unsupported join, only inner joins are supported: %v", sqlparser.String(join))
+	}
+	left, ok := join.LeftExpr.(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, fmt.Errorf("unsupported join, only joins of two tables are supported: %v", sqlparser.String(join))
+	}
+	right, ok := join.RightExpr.(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, fmt.Errorf("unsupported join, only joins of two tables are supported: %v", sqlparser.String(join))
+	}
+	jp := &joinPlan{
+		leftTable:  sqlparser.GetTableName(left.Expr),
+		rightTable: sqlparser.GetTableName(right.Expr),
+		rightRefs:  make(map[string]sqlparser.ColIdent),
+	}
+	if jp.leftTable.IsEmpty() || jp.rightTable.IsEmpty() {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(join))
+	}
+	if jp.leftTable == jp.rightTable {
+		return nil, fmt.Errorf("unsupported join of table %v with itself", jp.leftTable)
+	}
+	if sel.GroupBy != nil {
+		return nil, fmt.Errorf("unsupported group by in a join: %v", sqlparser.String(sel.GroupBy))
+	}
+	leftName, rightName := left.As, right.As
+	if leftName.IsEmpty() {
+		leftName = jp.leftTable
+	}
+	if rightName.IsEmpty() {
+		rightName = jp.rightTable
+	}
+	// isRight returns whether a column belongs to the joined table.
+	isRight := func(col *sqlparser.ColName) (bool, error) {
+		if col.Qualifier.IsEmpty() || !col.Qualifier.Qualifier.IsEmpty() {
+			return false, fmt.Errorf("column of a join must be qualified with a table name: %v", sqlparser.String(col))
+		}
+		switch {
+		case col.Qualifier.Name == leftName:
+			return false, nil
+		case col.Qualifier.Name == rightName:
+			return true, nil
+		}
+		return false, fmt.Errorf("column references an unknown table: %v", sqlparser.String(col))
+	}
+
+	var cond sqlparser.Expr
+	if join.Condition != nil {
+		cond = join.Condition.On
+	}
+	cmp, ok := cond.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, fmt.Errorf("join condition must be an equality between a column of %v and a column of %v: %v", jp.leftTable, jp.rightTable, sqlparser.String(join))
+	}
+	leftCol, lok := cmp.Left.(*sqlparser.ColName)
+	rightCol, rok := cmp.Right.(*sqlparser.ColName)
+	if !lok || !rok {
+		return nil, fmt.Errorf("join condition must be an equality between a column of %v and a column of %v: %v", jp.leftTable, jp.rightTable, sqlparser.String(join))
+	}
+	leftIsRight, err := isRight(leftCol)
+	if err != nil {
+		return nil, err
+	}
+	rightIsRight, err := isRight(rightCol)
+	if err != nil {
+		return nil, err
+	}
+	if leftIsRight == rightIsRight {
+		return nil, fmt.Errorf("join condition must be an equality between a column of %v and a column of %v: %v", jp.leftTable, jp.rightTable, sqlparser.String(join))
+	}
+	if leftIsRight {
+		leftCol, rightCol = rightCol, leftCol
+	}
+	jp.leftCol, jp.rightCol = leftCol.Name, rightCol.Name
+
+	leftRefs := make(map[string]bool)
+	// rewrite returns a copy of expr where the columns are replaced by
+	// their bind names, and whether it references the joined table.
+	rewrite := func(expr sqlparser.Expr) (sqlparser.Expr, bool, error) {
+		var err error
+		var hasRight bool
+		result := sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+			col, ok := cursor.Node().(*sqlparser.ColName)
+			if !ok || err != nil {
+				return err == nil
+			}
+			var right bool
+			if right, err = isRight(col); err != nil {
+				return false
+			}
+			name := col.Name
+			if right {
+				hasRight = true
+				name = sqlparser.NewColIdent(jp.rightBindName(col.Name.String()))
+				jp.rightRefs[name.Lowered()] = col.Name
+			} else {
+				leftRefs[name.Lowered()] = true
+			}
+			cursor.Replace(&sqlparser.ColName{Name: name})
+			return true
+		}, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		return result.(sqlparser.Expr), hasRight, nil
+	}
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported expression in a join: %v", sqlparser.String(selExpr))
+		}
+		if col, ok := aliased.Expr.(*sqlparser.ColName); ok && aliased.As.IsEmpty() {
+			aliased.As = col.Name
+		}
+		if aliased.Expr, _, err = rewrite(aliased.Expr); err != nil {
+			return nil, err
+		}
+	}
+	if sel.Where != nil {
+		expr, hasRight, err := rewrite(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if hasRight {
+			return nil, fmt.Errorf("where clause of a join can only reference columns of %v: %v", jp.leftTable, sqlparser.String(sel.Where.Expr))
+		}
+		sel.Where = sqlparser.NewWhere(sqlparser.WhereClause, expr)
+	}
+	for name := range jp.rightRefs {
+		if leftRefs[name] {
+			return nil, fmt.Errorf("column %v of %v conflicts with the bind name of a column of %v", name, jp.leftTable, jp.rightTable)
+		}
+	}
+	return jp, nil
+}
+
+// checkSourceIndexes returns an error if the join column of either table
+// doesn't lead an index of its table, which the source needs to look up the
+// matching rows.
+func (jp *joinPlan) checkSourceIndexes(indexColumns func(sqlparser.TableIdent) (map[string]bool, error)) error {
+	for _, join := range []struct {
+		table sqlparser.TableIdent
+		col   sqlparser.ColIdent
+	}{{jp.leftTable, jp.leftCol}, {jp.rightTable, jp.rightCol}} {
+		cols, err := indexColumns(join.table)
+		if err != nil {
+			return err
+		}
+		if !cols[join.col.Lowered()] {
+			return fmt.Errorf("join column %v doesn't lead an index of source table %v", join.col, join.table)
+		}
+	}
+	return nil
+}
+
+// analyzeJoinCols moves the columns of the joined table from the send select
+// to the select of the joined table. Both selects include their join column.
+// It must be called after all the columns were added to the send select.
+func (tpb *tablePlanBuilder) analyzeJoinCols(jp *joinPlan) error {
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation != opExpr {
+			return fmt.Errorf("unsupported aggregate in a join: %v", cexpr.colName)
+		}
+	}
+	rightExprs := sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: jp.rightCol}}}
+	rightSeen := map[string]bool{jp.rightCol.Lowered(): true}
+	var leftExprs sqlparser.SelectExprs
+	hasLeftCol := false
+	for _, selExpr := range tpb.sendSelect.SelectExprs {
+		if aliased, ok := selExpr.(*sqlparser.AliasedExpr); ok {
+			if col, ok := aliased.Expr.(*sqlparser.ColName); ok {
+				if name, ok := jp.rightRefs[col.Name.Lowered()]; ok {
+					if !rightSeen[name.Lowered()] {
+						rightSeen[name.Lowered()] = true
+						rightExprs = append(rightExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: name}})
+					}
+					continue
+				}
+				hasLeftCol = hasLeftCol || col.Name.Equal(jp.leftCol)
+			}
+		}
+		leftExprs = append(leftExprs, selExpr)
+	}
+	if !hasLeftCol {
+		leftExprs = append(leftExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: jp.leftCol}})
+	}
+	if _, ok := jp.rightRefs[jp.leftCol.Lowered()]; ok {
+		return fmt.Errorf("column %v of %v conflicts with the bind name of a column of %v", jp.leftCol, jp.leftTable, jp.rightTable)
+	}
+	if tpb.lastpk != nil {
+		for _, f := range tpb.lastpk.Fields {
+			if _, ok := jp.rightRefs[strings.ToLower(f.Name)]; ok {
+				return fmt.Errorf("column %v of %v conflicts with the bind name of a column of %v", f.Name, jp.leftTable, jp.rightTable)
+			}
+		}
+	}
+	tpb.sendSelect.SelectExprs = leftExprs
+	jp.leftSelect = tpb.sendSelect
+	jp.rightSelect = &sqlparser.Select{
+		SelectExprs: rightExprs,
+		From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: jp.rightTable}}},
+	}
+	return nil
+}
+
+// analyzeJoinPK verifies that the primary key of the target table
+// is computed from the columns of the driving table.
+func (tpb *tablePlanBuilder) analyzeJoinPK(jp *joinPlan) error {
+	for _, cexpr := range tpb.pkCols {
+		for ref := range cexpr.references {
+			if _, ok := jp.rightRefs[ref]; ok {
+				return fmt.Errorf("primary key column %v cannot reference columns of the joined table %v", cexpr.colName, jp.rightTable)
+			}
+		}
+	}
+	return nil
+}
+
+// rightBindName returns the bind name of a column of the joined table.
+func (jp *joinPlan) rightBindName(name string) string {
+	return jp.rightTable.String() + "__" + name
+}
+
+// lookupTablePlan returns the TablePlan of the joined table. It shares the
+// statements of the TablePlan of the driving table.
+func (tp *TablePlan) lookupTablePlan() *TablePlan {
+	jp := *tp.Join
+	jp.lookup = true
+	lookup := *tp
+	lookup.Join = &jp
+	lookup.SendRule = &binlogdatapb.Rule{
+		Match:  jp.rightTable.String(),
+		Filter: sqlparser.String(jp.rightSelect),
+	}
+	return &lookup
+}
+
+// applyJoinChange re-evaluates the join for the target rows affected by a
+// row change of the driving or the joined table.
+func (tp *TablePlan) applyJoinChange(rowChange *binlogdatapb.RowChange, streamRows rowStreamer, executor func(string) (*sqltypes.Result, error)) error {
+	jp := tp.Join
+	var before, after []sqltypes.Value
+	if rowChange.Before != nil {
+		before = sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+	}
+	if rowChange.After != nil {
+		after = sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+	}
+	if !jp.lookup {
+		if before != nil {
+			if err := tp.deleteJoinRow(tp.Fields, before, executor); err != nil {
+				return err
+			}
+		}
+		if after == nil {
+			return nil
+		}
+		val := joinValue(tp.Fields, after, jp.leftCol)
+		rightFields, rightRows, err := streamJoinRows(jp.rightSelect, jp.rightCol, val, streamRows)
+		if err != nil {
+			return err
+		}
+		switch len(rightRows) {
+		case 0:
+			return nil
+		case 1:
+			return tp.insertJoinRow(tp.Fields, after, rightFields, rightRows[0], executor)
+		}
+		return jp.notUniqueError(val)
+	}
+
+	var beforeVal, afterVal sqltypes.Value
+	if before != nil {
+		beforeVal = joinValue(tp.Fields, before, jp.rightCol)
+	}
+	if after != nil {
+		afterVal = joinValue(tp.Fields, after, jp.rightCol)
+	}
+	if before != nil && (after == nil || !valsEqual(beforeVal, afterVal)) {
+		leftFields, leftRows, err := streamJoinRows(jp.leftSelect, jp.leftCol, beforeVal, streamRows)
+		if err != nil {
+			return err
+		}
+		for _, leftRow := range leftRows {
+			if err := tp.deleteJoinRow(leftFields, leftRow, executor); err != nil {
+				return err
+			}
+		}
+	}
+	if after == nil {
+		return nil
+	}
+	leftFields, leftRows, err := streamJoinRows(jp.leftSelect, jp.leftCol, afterVal, streamRows)
+	if err != nil {
+		return err
+	}
+	for _, leftRow := range leftRows {
+		if err := tp.deleteJoinRow(leftFields, leftRow, executor); err != nil {
+			return err
+		}
+		if err := tp.insertJoinRow(leftFields, leftRow, tp.Fields, after, executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyJoinBulkInsert inserts the rows of the driving table copied from the
+// source, joined to the rows of the joined table. The matching rows of the
+// joined table are looked up once per distinct value of the join column.
+func (tp *TablePlan) applyJoinBulkInsert(rows *binlogdatapb.VStreamRowsResponse, streamRows rowStreamer, executor func(string) (*sqltypes.Result, error)) error {
+	jp := tp.Join
+	type match struct {
+		fields []*querypb.Field
+		rows   [][]sqltypes.Value
+	}
+	matches := make(map[string]*match)
+	for _, row := range rows.Rows {
+		leftRow := sqltypes.MakeRowTrusted(tp.Fields, row)
+		val := joinValue(tp.Fields, leftRow, jp.leftCol)
+		if val.IsNull() {
+			continue
+		}
+		m, ok := matches[val.ToString()]
+		if !ok {
+			fields, rightRows, err := streamJoinRows(jp.rightSelect, jp.rightCol, val, streamRows)
+			if err != nil {
+				return err
+			}
+			m = &match{fields: fields, rows: rightRows}
+			matches[val.ToString()] = m
+		}
+		switch len(m.rows) {
+		case 0:
+			continue
+		case 1:
+		default:
+			return jp.notUniqueError(val)
+		}
+		if err := tp.insertJoinRow(tp.Fields, leftRow, m.fields, m.rows[0], executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (jp *joinPlan) notUniqueError(val sqltypes.Value) error {
+	return fmt.Errorf("join column %v of %v is not unique: more than one row matches %v", jp.rightCol, jp.rightTable, val.ToString())
+}
+
+// deleteJoinRow deletes the target row of a row of the driving table.
+func (tp *TablePlan) deleteJoinRow(leftFields []*querypb.Field, leftRow []sqltypes.Value, executor func(string) (*sqltypes.Result, error)) error {
+	if tp.Delete == nil {
+		return nil
+	}
+	bindvars := make(map[string]*querypb.BindVariable, len(leftFields))
+	if err := tp.bindJoinRow(bindvars, "b_", leftFields, leftRow, false); err != nil {
+		return err
+	}
+	_, err := execParsedQuery(tp.Delete, bindvars, executor)
+	return err
+}
+
+// insertJoinRow inserts the target row of a row of the driving table joined
+// to a row of the joined table.
+func (tp *TablePlan) insertJoinRow(leftFields []*querypb.Field, leftRow []sqltypes.Value, rightFields []*querypb.Field, rightRow []sqltypes.Value, executor func(string) (*sqltypes.Result, error)) error {
+	bindvars := make(map[string]*querypb.BindVariable, len(leftFields)+len(rightFields))
+	if err := tp.bindJoinRow(bindvars, "a_", leftFields, leftRow, false); err != nil {
+		return err
+	}
+	if err := tp.bindJoinRow(bindvars, "a_", rightFields, rightRow, true); err != nil {
+		return err
+	}
+	_, err := execParsedQuery(tp.Insert, bindvars, executor)
+	return err
+}
+
+// bindJoinRow binds the values of a row of the driving table or, if right
+// is set, of the joined table.
+func (tp *TablePlan) bindJoinRow(bindvars map[string]*querypb.BindVariable, prefix string, fields []*querypb.Field, row []sqltypes.Value, right bool) error {
+	for i, field := range fields {
+		name := strings.Trim(field.Name, "`")
+		if right {
+			bindvars[prefix+tp.Join.rightBindName(name)] = sqltypes.ValueBindVariable(row[i])
+			continue
+		}
+		bindVar, err := tp.bindFieldVal(field, &row[i])
+		if err != nil {
+			return err
+		}
+		bindvars[prefix+name] = bindVar
+	}
+	return nil
+}
+
+// joinValue returns the value of the join column col in row.
+func joinValue(fields []*querypb.Field, row []sqltypes.Value, col sqlparser.ColIdent) sqltypes.Value {
+	for i, field := range fields {
+		if col.EqualString(strings.Trim(field.Name, "`")) {
+			return row[i]
+		}
+	}
+	return sqltypes.NULL
+}
+
+// streamJoinRows streams the rows of sel whose column col is equal to val,
+// which the source looks up with an index on col. A NULL value doesn't match
+// any row.
+func streamJoinRows(sel *sqlparser.Select, col sqlparser.ColIdent, val sqltypes.Value, streamRows rowStreamer) ([]*querypb.Field, [][]sqltypes.Value, error) {
+	if val.IsNull() {
+		return nil, nil, nil
+	}
+	var filters []sqlparser.Expr
+	if sel.Where != nil {
+		filters = append(filters, sel.Where.Expr)
+	}
+	filters = append(filters, &sqlparser.ComparisonExpr{
+		Operator: sqlparser.EqualOp,
+		Left:     &sqlparser.ColName{Name: col},
+		Right:    valueLiteral(val),
+	})
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select /*vt+ requireIndex=1 */ %v from %v where %v", sel.SelectExprs, sel.From[0], sqlparser.AndExpressions(filters...))
+	var fields []*querypb.Field
+	var result [][]sqltypes.Value
+	err := streamRows(buf.String(), func(rows *binlogdatapb.VStreamRowsResponse) error {
+		if len(rows.Fields) != 0 {
+			fields = rows.Fields
+		}
+		for _, row := range rows.Rows {
+			result = append(result, sqltypes.MakeRowTrusted(fields, row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fields, result, nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func buildTestJoinPlans(t *testing.T) (orders, customers *TablePlan) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {{Name: "id", IsPK: true}, {Name: "amount"}, {Name: "cname"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select o.id, o.amount, c.name as cname from orders o join customers c on o.cid = c.id",
+		}},
+	}
+	rp, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	orders, err = rp.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "orders",
+		Fields:    sqltypes.MakeTestFields("id|amount|cid", "int64|int64|int64"),
+	})
+	require.NoError(t, err)
+	customers, err = rp.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "customers",
+		Fields:    sqltypes.MakeTestFields("id|name", "int64|varchar"),
+	})
+	require.NoError(t, err)
+	return orders, customers
+}
+
+// testSource returns a rowStreamer that responds to the source queries of
+// the join, and records them.
+func testSource(sourceQueries *[]string) rowStreamer {
+	results := map[string]*sqltypes.Result{
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 1":    sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|alice"),
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 2":    sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "2|bob", "2|carol"),
+		"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|amount|cid", "int64|int64|int64"), "10|100|1", "11|110|1"),
+		"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 3": sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|amount|cid", "int64|int64|int64"), "12|120|3"),
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 3":    sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "3|dave"),
+	}
+	return func(query string, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		*sourceQueries = append(*sourceQueries, query)
+		result := results[query]
+		if result == nil {
+			result = &sqltypes.Result{Fields: sqltypes.MakeTestFields("id", "int64")}
+		}
+		return send(&binlogdatapb.VStreamRowsResponse{
+			Fields: result.Fields,
+			Rows:   sqltypes.ResultToProto3(result).Rows,
+		})
+	}
+}
+
+func TestApplyJoinChange(t *testing.T) {
+	orders, customers := buildTestJoinPlans(t)
+
+	var queries []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		queries = append(queries, sql)
+		return &sqltypes.Result{}, nil
+	}
+	var sourceQueries []string
+	streamRows := testSource(&sourceQueries)
+	order := func(id, amount, cid string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar(id), sqltypes.NewVarChar(amount), sqltypes.NewVarChar(cid)})
+	}
+	customer := func(id, name string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar(id), sqltypes.NewVarChar(name)})
+	}
+
+	testcases := []struct {
+		name          string
+		plan          *TablePlan
+		change        *binlogdatapb.RowChange
+		queries       []string
+		sourceQueries []string
+		err           string
+	}{{
+		name:          "insert an order",
+		plan:          orders,
+		change:        &binlogdatapb.RowChange{After: order("10", "100", "1")},
+		queries:       []string{"insert into t1(id,amount,cname) values (10,100,'alice')"},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 1"},
+	}, {
+		name:   "update an order to a missing customer",
+		plan:   orders,
+		change: &binlogdatapb.RowChange{Before: order("10", "100", "1"), After: order("10", "100", "4")},
+		queries: []string{
+			"delete from t1 where id=10",
+		},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 4"},
+	}, {
+		name:    "delete an order",
+		plan:    orders,
+		change:  &binlogdatapb.RowChange{Before: order("10", "100", "1")},
+		queries: []string{"delete from t1 where id=10"},
+	}, {
+		name:   "order with a null customer",
+		plan:   orders,
+		change: &binlogdatapb.RowChange{After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar("10"), sqltypes.NewVarChar("100"), sqltypes.NULL})},
+	}, {
+		name:          "order of a duplicate customer",
+		plan:          orders,
+		change:        &binlogdatapb.RowChange{After: order("10", "100", "2")},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 2"},
+		err:           "join column id of customers is not unique: more than one row matches 2",
+	}, {
+		name:   "rename a customer",
+		plan:   customers,
+		change: &binlogdatapb.RowChange{Before: customer("1", "alice"), After: customer("1", "alicia")},
+		queries: []string{
+			"delete from t1 where id=10",
+			"insert into t1(id,amount,cname) values (10,100,'alicia')",
+			"delete from t1 where id=11",
+			"insert into t1(id,amount,cname) values (11,110,'alicia')",
+		},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 1"},
+	}, {
+		name:   "change the id of a customer",
+		plan:   customers,
+		change: &binlogdatapb.RowChange{Before: customer("1", "alice"), After: customer("3", "alice")},
+		queries: []string{
+			"delete from t1 where id=10",
+			"delete from t1 where id=11",
+			"delete from t1 where id=12",
+			"insert into t1(id,amount,cname) values (12,120,'alice')",
+		},
+		sourceQueries: []string{
+			"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 1",
+			"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 3",
+		},
+	}, {
+		name:   "delete a customer",
+		plan:   customers,
+		change: &binlogdatapb.RowChange{Before: customer("1", "alice")},
+		queries: []string{
+			"delete from t1 where id=10",
+			"delete from t1 where id=11",
+		},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ id, amount, cid from orders where cid = 1"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			queries = nil
+			sourceQueries = nil
+			err := tcase.plan.applyJoinChange(tcase.change, streamRows, executor)
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tcase.queries, queries)
+			assert.Equal(t, tcase.sourceQueries, sourceQueries)
+		})
+	}
+}
+
+func TestApplyJoinBulkInsert(t *testing.T) {
+	orders, _ := buildTestJoinPlans(t)
+
+	var queries []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		queries = append(queries, sql)
+		return &sqltypes.Result{}, nil
+	}
+	var sourceQueries []string
+	result := sqltypes.MakeTestResult(orders.Fields, "10|100|1", "11|110|4", "12|120|3", "13|130|null", "14|140|1")
+	err := orders.applyJoinBulkInsert(&binlogdatapb.VStreamRowsResponse{
+		Fields: result.Fields,
+		Rows:   sqltypes.ResultToProto3(result).Rows,
+	}, testSource(&sourceQueries), executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 1",
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 4",
+		"select /*vt+ requireIndex=1 */ id, `name` from customers where id = 3",
+	}, sourceQueries)
+	assert.Equal(t, []string{
+		"insert into t1(id,amount,cname) values (10,100,'alice')",
+		"insert into t1(id,amount,cname) values (12,120,'dave')",
+		"insert into t1(id,amount,cname) values (14,140,'alice')",
+	}, queries)
+}
+
+func TestValidateJoinSourceIndexes(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select o.id, o.amount, c.name as cname from orders o join customers c on o.cid = c.id",
+		}},
+	}
+	testcases := []struct {
+		name string
+		ddls map[string]string
+		err  string
+	}{{
+		name: "both join columns lead an index",
+		ddls: map[string]string{
+			"orders":    "create table orders (id int, amount int, cid int, primary key (id), key cid_id (cid, id))",
+			"customers": "create table customers (id int, name varchar(128), primary key (id))",
+		},
+	}, {
+		name: "driving table without an index",
+		ddls: map[string]string{
+			"orders":    "create table orders (id int, amount int, cid int, primary key (id), key id_cid (id, cid))",
+			"customers": "create table customers (id int, name varchar(128), primary key (id))",
+		},
+		err: "join column cid doesn't lead an index of source table orders",
+	}, {
+		name: "joined table without an index",
+		ddls: map[string]string{
+			"orders":    "create table orders (id int, amount int, cid int, primary key (id), key cid_id (cid, id))",
+			"customers": "create table customers (id int, name varchar(128), key name_id (name, id))",
+		},
+		err: "join column id doesn't lead an index of source table customers",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			err := ValidateSourceIndexes(filter, func() (map[string]string, error) {
+				return tcase.ddls, nil
+			})
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
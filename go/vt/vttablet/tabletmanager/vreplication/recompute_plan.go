@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// recomputePlan recomputes the aggregates that cannot be maintained
+// incrementally: count(distinct) is recomputed after every change to
+// its group, and min or max are recomputed after their current value
+// is removed from the group. The rows of a group are streamed from the
+// source by filtering on the values of the group by columns, including
+// NULL values. The source looks up these rows with an index, and fails
+// the stream if none of the group by columns leads an index of the
+// source table, instead of scanning the table for every change. Such
+// rules are rejected by ValidateSourceIndexes before the workflow runs.
+// The source may be ahead of the change being applied. If so, the
+// recomputed value already reflects later changes. This is harmless:
+// applying the later changes on top of min or max doesn't change them,
+// and count(distinct) is recomputed again.
+type recomputePlan struct {
+	name        sqlparser.TableIdent
+	sourceTable sqlparser.TableIdent
+	where       *sqlparser.Where
+	groupCols   []*colExpr
+	aggCols     []*colExpr
+}
+
+// checkSourceIndex returns an error if none of the group by columns leads
+// an index of the source table, which the source needs to look up the rows
+// of a group.
+func (rp *recomputePlan) checkSourceIndex(indexColumns func(sqlparser.TableIdent) (map[string]bool, error)) error {
+	cols, err := indexColumns(rp.sourceTable)
+	if err != nil {
+		return err
+	}
+	for _, cexpr := range rp.groupCols {
+		if cols[cexpr.expr.(*sqlparser.ColName).Name.Lowered()] {
+			return nil
+		}
+	}
+	return fmt.Errorf("none of the group by columns leads an index of source table %v, which is required to recompute %v", rp.sourceTable, rp.aggCols[0].colName)
+}
+
+// rowStreamer streams the rows of a query from the source.
+type rowStreamer func(query string, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+// recomputeChange recomputes the aggregates of the groups affected by
+// a row change. It must be called after the change was applied.
+func (tp *TablePlan) recomputeChange(rowChange *binlogdatapb.RowChange, streamRows rowStreamer, executor func(string) (*sqltypes.Result, error)) error {
+	rp := tp.Recompute
+	if rp == nil {
+		return nil
+	}
+	var before, after, beforeGroup, afterGroup []sqltypes.Value
+	if rowChange.Before != nil {
+		before = sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+		beforeGroup = tp.sourceValues(before, rp.groupCols)
+	}
+	if rowChange.After != nil {
+		after = sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+		afterGroup = tp.sourceValues(after, rp.groupCols)
+	}
+	sameGroup := before != nil && after != nil && rowValsEqual(beforeGroup, afterGroup)
+
+	if before != nil {
+		var cols, extremumCols []*colExpr
+		var extremums []sqltypes.Value
+		beforeVals := tp.sourceValues(before, rp.aggCols)
+		var afterVals []sqltypes.Value
+		if sameGroup {
+			afterVals = tp.sourceValues(after, rp.aggCols)
+		}
+		for i, cexpr := range rp.aggCols {
+			if sameGroup && valsEqual(beforeVals[i], afterVals[i]) {
+				// The aggregate is unaffected.
+				continue
+			}
+			switch cexpr.operation {
+			case opCountDistinct:
+				cols = append(cols, cexpr)
+			case opMin, opMax:
+				if !beforeVals[i].IsNull() {
+					extremumCols = append(extremumCols, cexpr)
+					extremums = append(extremums, beforeVals[i])
+				}
+			}
+		}
+		removed, err := rp.removedExtremums(beforeGroup, extremumCols, extremums, executor)
+		if err != nil {
+			return err
+		}
+		if err := rp.recomputeGroup(beforeGroup, append(cols, removed...), streamRows, executor); err != nil {
+			return err
+		}
+	}
+	if after != nil && !sameGroup {
+		// min and max are maintained by the insert.
+		if err := rp.recomputeGroup(afterGroup, rp.distinctCols(), streamRows, executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recomputeBulkInsert recomputes the aggregates of the groups affected by a
+// bulk insert. It must be called after the rows were inserted.
+func (tp *TablePlan) recomputeBulkInsert(rows *binlogdatapb.VStreamRowsResponse, streamRows rowStreamer, executor func(string) (*sqltypes.Result, error)) error {
+	rp := tp.Recompute
+	if rp == nil {
+		return nil
+	}
+	// min and max are maintained by the insert.
+	cols := rp.distinctCols()
+	if len(cols) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, row := range rows.Rows {
+		group := tp.sourceValues(sqltypes.MakeRowTrusted(tp.Fields, row), rp.groupCols)
+		buf := sqlparser.NewTrackedBuffer(nil)
+		for _, val := range group {
+			val.EncodeSQL(buf)
+			buf.WriteString(",")
+		}
+		if seen[buf.String()] {
+			continue
+		}
+		seen[buf.String()] = true
+		if err := rp.recomputeGroup(group, cols, streamRows, executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceValues returns the values of the source columns of cexprs in row.
+func (tp *TablePlan) sourceValues(row []sqltypes.Value, cexprs []*colExpr) []sqltypes.Value {
+	vals := make([]sqltypes.Value, len(cexprs))
+	for i, cexpr := range cexprs {
+		name := cexpr.expr.(*sqlparser.ColName).Name
+		for j, field := range tp.Fields {
+			if name.EqualString(field.Name) {
+				vals[i] = row[j]
+				break
+			}
+		}
+	}
+	return vals
+}
+
+func (rp *recomputePlan) distinctCols() []*colExpr {
+	var cols []*colExpr
+	for _, cexpr := range rp.aggCols {
+		if cexpr.operation == opCountDistinct {
+			cols = append(cols, cexpr)
+		}
+	}
+	return cols
+}
+
+// removedExtremums returns the min and max columns whose current value in the
+// target table is the value that was removed from the group.
+func (rp *recomputePlan) removedExtremums(group []sqltypes.Value, cols []*colExpr, removedVals []sqltypes.Value, executor func(string) (*sqltypes.Result, error)) ([]*colExpr, error) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("select ")
+	for i, cexpr := range cols {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", cexpr.colName)
+	}
+	buf.Myprintf(" from %v", rp.name)
+	rp.generateGroupWhere(buf, group)
+	qr, err := executor(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	var removed []*colExpr
+	for i, cexpr := range cols {
+		// If the values can't be compared, play it safe and recompute.
+		if cmp, err := evalengine.NullsafeCompare(qr.Rows[0][i], removedVals[i], collations.Unknown); err != nil || cmp == 0 {
+			removed = append(removed, cexpr)
+		}
+	}
+	return removed, nil
+}
+
+// recomputeGroup streams the rows of a group from the source, and updates the
+// specified aggregates of the group in the target table.
+func (rp *recomputePlan) recomputeGroup(group []sqltypes.Value, cols []*colExpr, streamRows rowStreamer, executor func(string) (*sqltypes.Result, error)) error {
+	if len(cols) == 0 {
+		return nil
+	}
+	var fields []*querypb.Field
+	vals := make([][]sqltypes.Value, len(cols))
+	err := streamRows(rp.generateSourceQuery(group, cols), func(rows *binlogdatapb.VStreamRowsResponse) error {
+		if len(rows.Fields) != 0 {
+			fields = rows.Fields
+		}
+		for _, row := range rows.Rows {
+			for i, val := range sqltypes.MakeRowTrusted(fields, row) {
+				if i < len(vals) {
+					vals[i] = append(vals[i], val)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("update %v set ", rp.name)
+	for i, cexpr := range cols {
+		var collationID collations.ID
+		if i < len(fields) {
+			collationID = collations.ID(fields[i].Charset)
+		}
+		result, err := aggregateValues(cexpr.operation, vals[i], collationID)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v=", cexpr.colName)
+		result.EncodeSQL(buf)
+	}
+	rp.generateGroupWhere(buf, group)
+	_, err = executor(buf.String())
+	return err
+}
+
+// generateSourceQuery generates the query that streams the source columns of
+// the specified aggregates for the rows of a group.
+func (rp *recomputePlan) generateSourceQuery(group []sqltypes.Value, cols []*colExpr) string {
+	var filters []sqlparser.Expr
+	if rp.where != nil {
+		filters = append(filters, rp.where.Expr)
+	}
+	for i, cexpr := range rp.groupCols {
+		if group[i].IsNull() {
+			filters = append(filters, &sqlparser.IsExpr{
+				Left:  cexpr.expr,
+				Right: sqlparser.IsNullOp,
+			})
+			continue
+		}
+		filters = append(filters, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     cexpr.expr,
+			Right:    valueLiteral(group[i]),
+		})
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("select /*vt+ requireIndex=1 */ ")
+	for i, cexpr := range cols {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", cexpr.expr)
+	}
+	buf.Myprintf(" from %v where %v", rp.sourceTable, sqlparser.AndExpressions(filters...))
+	return buf.String()
+}
+
+// valueLiteral returns the literal for a value in a source filter. The
+// vstreamer only accepts integer and string literals in filters.
+func valueLiteral(val sqltypes.Value) sqlparser.Expr {
+	if val.IsIntegral() {
+		return sqlparser.NewIntLiteral(val.ToString())
+	}
+	return sqlparser.NewStrLiteral(val.ToString())
+}
+
+// generateGroupWhere generates the where clause that selects a group in the target table.
+func (rp *recomputePlan) generateGroupWhere(buf *sqlparser.TrackedBuffer, group []sqltypes.Value) {
+	separator := " where "
+	for i, cexpr := range rp.groupCols {
+		if group[i].IsNull() {
+			buf.Myprintf("%s%v is null", separator, cexpr.colName)
+		} else {
+			buf.Myprintf("%s%v=", separator, cexpr.colName)
+			group[i].EncodeSQL(buf)
+		}
+		separator = " and "
+	}
+}
+
+// aggregateValues computes an opCountDistinct, opMin or opMax over the values of a group.
+func aggregateValues(op operation, vals []sqltypes.Value, collationID collations.ID) (sqltypes.Value, error) {
+	var nonNull []sqltypes.Value
+	for _, val := range vals {
+		if !val.IsNull() {
+			nonNull = append(nonNull, val)
+		}
+	}
+	var err error
+	compare := func(v1, v2 sqltypes.Value) int {
+		cmp, cmpErr := evalengine.NullsafeCompare(v1, v2, collationID)
+		if cmpErr != nil && err == nil {
+			err = cmpErr
+		}
+		return cmp
+	}
+	switch op {
+	case opCountDistinct:
+		sort.Slice(nonNull, func(i, j int) bool {
+			return compare(nonNull[i], nonNull[j]) < 0
+		})
+		count := 0
+		for i := range nonNull {
+			if i == 0 || compare(nonNull[i-1], nonNull[i]) != 0 {
+				count++
+			}
+		}
+		return sqltypes.NewInt64(int64(count)), err
+	default:
+		result := sqltypes.NULL
+		for _, val := range nonNull {
+			if result.IsNull() {
+				result = val
+				continue
+			}
+			cmp := compare(val, result)
+			if (op == opMin && cmp < 0) || (op == opMax && cmp > 0) {
+				result = val
+			}
+		}
+		return result, err
+	}
+}
+
+func rowValsEqual(r1, r2 []sqltypes.Value) bool {
+	for i := range r1 {
+		if !valsEqual(r1[i], r2[i]) {
+			return false
+		}
+	}
+	return true
+}
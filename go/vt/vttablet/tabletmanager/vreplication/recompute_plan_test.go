@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestRecomputeChange(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {{Name: "c1", IsPK: true}, {Name: "cd"}, {Name: "mn"}, {Name: "mx"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, count(distinct c2) as cd, min(c2) as mn, max(c2) as mx from t2 where in_keyrange('-80') group by c1",
+		}},
+	}
+	rp, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	tp, err := rp.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t2", Fields: fields})
+	require.NoError(t, err)
+
+	// The target has min=5 and max=9 for the group c1=1.
+	var queries []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		queries = append(queries, sql)
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("mn|mx", "int64|int64"), "5|9"), nil
+	}
+	var sourceQueries []string
+	streamRows := func(query string, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		sourceQueries = append(sourceQueries, query)
+		result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("c2|c2|c2", "int64|int64|int64"), "7|7|7", "9|9|9", "7|7|7")
+		return send(&binlogdatapb.VStreamRowsResponse{
+			Fields: result.Fields,
+			Rows:   sqltypes.ResultToProto3(result).Rows,
+		})
+	}
+	row := func(c1, c2 string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar(c1), sqltypes.NewVarChar(c2)})
+	}
+
+	testcases := []struct {
+		name          string
+		change        *binlogdatapb.RowChange
+		queries       []string
+		sourceQueries []string
+	}{{
+		name:   "delete the min",
+		change: &binlogdatapb.RowChange{Before: row("1", "5")},
+		queries: []string{
+			"select mn, mx from t1 where c1=1",
+			"update t1 set cd=2, mn=7 where c1=1",
+		},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ c2, c2 from t2 where in_keyrange('-80') and c1 = 1"},
+	}, {
+		name:   "delete a value between the min and the max",
+		change: &binlogdatapb.RowChange{Before: row("1", "6")},
+		queries: []string{
+			"select mn, mx from t1 where c1=1",
+			"update t1 set cd=2 where c1=1",
+		},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ c2 from t2 where in_keyrange('-80') and c1 = 1"},
+	}, {
+		name:          "insert",
+		change:        &binlogdatapb.RowChange{After: row("2", "5")},
+		queries:       []string{"update t1 set cd=2 where c1=2"},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ c2 from t2 where in_keyrange('-80') and c1 = 2"},
+	}, {
+		name:   "update that moves the max to another group",
+		change: &binlogdatapb.RowChange{Before: row("1", "9"), After: row("2", "9")},
+		queries: []string{
+			"select mn, mx from t1 where c1=1",
+			"update t1 set cd=2, mx=9 where c1=1",
+			"update t1 set cd=2 where c1=2",
+		},
+		sourceQueries: []string{
+			"select /*vt+ requireIndex=1 */ c2, c2 from t2 where in_keyrange('-80') and c1 = 1",
+			"select /*vt+ requireIndex=1 */ c2 from t2 where in_keyrange('-80') and c1 = 2",
+		},
+	}, {
+		name:   "update of an unrelated column",
+		change: &binlogdatapb.RowChange{Before: row("1", "5"), After: row("1", "5")},
+	}, {
+		name:          "null group",
+		change:        &binlogdatapb.RowChange{After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NULL, sqltypes.NewVarChar("5")})},
+		queries:       []string{"update t1 set cd=2 where c1 is null"},
+		sourceQueries: []string{"select /*vt+ requireIndex=1 */ c2 from t2 where in_keyrange('-80') and c1 is null"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			queries = nil
+			sourceQueries = nil
+			err := tp.recomputeChange(tcase.change, streamRows, executor)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.queries, queries)
+			assert.Equal(t, tcase.sourceQueries, sourceQueries)
+		})
+	}
+}
+
+func TestAggregateValues(t *testing.T) {
+	vals := []sqltypes.Value{
+		sqltypes.NewVarChar("b"),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("a"),
+		sqltypes.NewVarChar("B"),
+		sqltypes.NewVarChar("b"),
+	}
+	binary := collations.ID(collations.CollationBinaryID)
+	caseInsensitive := collations.Local().LookupByName("utf8mb4_general_ci").ID()
+
+	testcases := []struct {
+		op          operation
+		collationID collations.ID
+		want        sqltypes.Value
+	}{
+		{opCountDistinct, binary, sqltypes.NewInt64(3)},
+		{opCountDistinct, caseInsensitive, sqltypes.NewInt64(2)},
+		{opMin, binary, sqltypes.NewVarChar("B")},
+		{opMax, binary, sqltypes.NewVarChar("b")},
+	}
+	for _, tcase := range testcases {
+		got, err := aggregateValues(tcase.op, vals, tcase.collationID)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got)
+	}
+
+	got, err := aggregateValues(opMin, []sqltypes.Value{sqltypes.NULL}, binary)
+	require.NoError(t, err)
+	assert.True(t, got.IsNull())
+}
+
+func TestValidateSourceIndexes(t *testing.T) {
+	ddls := map[string]string{
+		"t2": "create table t2 (id int, c1 int, c2 int, c3 int, primary key (id), key c3_c1 (c3, c1))",
+	}
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, c2 from t2",
+	}, {
+		filter: "select c1, count(*) as cnt from t2 group by c1",
+	}, {
+		filter: "select c3, count(distinct c2) as cd from t2 group by c3",
+	}, {
+		filter: "select c1, c3, max(c2) as mx from t2 group by c1, c3",
+	}, {
+		filter: "select c1, min(c2) as mn from t2 group by c1",
+		err:    "none of the group by columns leads an index of source table t2, which is required to recompute mn",
+	}, {
+		filter: "select c1, min(c2) as mn from t3 group by c1",
+		err:    "source table t3 not found",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			filter := &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+			}
+			err := ValidateSourceIndexes(filter, func() (map[string]string, error) {
+				return ddls, nil
+			})
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -56,6 +56,8 @@ type ReplicatorPlan struct {
 	TablePlans    map[string]*TablePlan
 	ColInfoMap    map[string][]*ColumnInfo
 	stats         *binlogplayer.Stats
+	// sink is set if the plan was built by buildSinkReplicatorPlan.
+	sink bool
 }
 
 // buildExecution plan uses the field info as input and the partially built
@@ -67,8 +69,9 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return nil, fmt.Errorf("plan not found for %s", fieldEvent.TableName)
 	}
 	// If Insert is initialized, then it means that we knew the column
-	// names and have already built most of the plan.
-	if prelim.Insert != nil {
+	// names and have already built most of the plan. Sink plans don't
+	// need statements, and are complete with the field info.
+	if prelim.Insert != nil || rp.sink {
 		tplanv := *prelim
 		// We know that we sent only column names, but they may be backticked.
 		// If so, we have to strip them out to allow them to match the expected
@@ -201,6 +204,11 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// Recompute is set if the table has aggregates that have to be
+	// recomputed from the source.
+	Recompute *recomputePlan
+	// Join is set if the table is materialized from a join.
+	Join *joinPlan
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -551,14 +552,14 @@ func TestBuildPlayerPlan(t *testing.T) {
 		},
 		err: "unexpected: select * from t1, t2",
 	}, {
-		// no join
+		// join without a condition
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
 				Filter: "select * from t1 join t2",
 			}},
 		},
-		err: "unexpected: select * from t1 join t2",
+		err: "join condition must be an equality between a column of t1 and a column of t2: t1 join t2",
 	}, {
 		// no subqueries
 		input: &binlogdatapb.Filter{
@@ -605,14 +606,14 @@ func TestBuildPlayerPlan(t *testing.T) {
 		},
 		err: "expression needs an alias: hour(c1)",
 	}, {
-		// only count(*)
+		// count(distinct) needs a group by
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
-				Filter: "select count(c1) as c from t1",
+				Filter: "select count(distinct c1) as c from t1",
 			}},
 		},
-		err: "only count(*) is supported: count(c1)",
+		err: "group by is required to compute c",
 	}, {
 		// no sum(*)
 		input: &binlogdatapb.Filter{
@@ -710,6 +711,184 @@ func TestBuildPlayerPlan(t *testing.T) {
 	}
 }
 
+func TestBuildPlayerPlanAggregates(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			{Name: "c1", IsPK: true},
+			{Name: "cnt"},
+			{Name: "cd"},
+			{Name: "mn"},
+			{Name: "mx"},
+			{Name: "av"},
+			{Name: "av_sum"},
+			{Name: "av_count"},
+		},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, count(c2) as cnt, count(distinct c2) as cd, min(c2) as mn, max(c2) as mx, avg(c2) as av from t2 group by c1",
+		}},
+	}
+	want := &TestReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t2",
+				Filter: "select c1, c2 from t2",
+			}},
+		},
+		TargetTables: []string{"t1"},
+		TablePlans: map[string]*TestTablePlan{
+			"t2": {
+				TargetName:   "t1",
+				SendRule:     "t2",
+				InsertFront:  "insert into t1(c1,cnt,cd,mn,mx,av_sum,av_count,av)",
+				InsertValues: "(:a_c1,if(:a_c2 is null, 0, 1),if(:a_c2 is null, 0, 1),:a_c2,:a_c2,ifnull(:a_c2, 0),if(:a_c2 is null, 0, 1),:a_c2)",
+				InsertOnDup:  "on duplicate key update cnt=cnt+values(cnt), cd=cd, mn=coalesce(least(mn, values(mn)), mn, values(mn)), mx=coalesce(greatest(mx, values(mx)), mx, values(mx)), av_sum=av_sum+ifnull(values(av_sum), 0), av_count=av_count+values(av_count), av=av_sum/nullif(av_count, 0)",
+				Insert:       "insert into t1(c1,cnt,cd,mn,mx,av_sum,av_count,av) values (:a_c1,if(:a_c2 is null, 0, 1),if(:a_c2 is null, 0, 1),:a_c2,:a_c2,ifnull(:a_c2, 0),if(:a_c2 is null, 0, 1),:a_c2) on duplicate key update cnt=cnt+values(cnt), cd=cd, mn=coalesce(least(mn, values(mn)), mn, values(mn)), mx=coalesce(greatest(mx, values(mx)), mx, values(mx)), av_sum=av_sum+ifnull(values(av_sum), 0), av_count=av_count+values(av_count), av=av_sum/nullif(av_count, 0)",
+				Update:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), cd=cd, mn=coalesce(least(mn, :a_c2), mn, :a_c2), mx=coalesce(greatest(mx, :a_c2), mx, :a_c2), av_sum=av_sum-ifnull(:b_c2, 0)+ifnull(:a_c2, 0), av_count=av_count-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), av=av_sum/nullif(av_count, 0) where c1=:b_c1",
+				Delete:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1), cd=cd, mn=mn, mx=mx, av_sum=av_sum-ifnull(:b_c2, 0), av_count=av_count-if(:b_c2 is null, 0, 1), av=av_sum/nullif(av_count, 0) where c1=:b_c1",
+				PKReferences: []string{"c1"},
+			},
+		},
+	}
+	plan, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	gotPlan, _ := json.Marshal(plan)
+	wantPlan, _ := json.Marshal(want)
+	assert.Equal(t, string(wantPlan), string(gotPlan))
+	require.NotNil(t, plan.TargetTables["t1"].Recompute)
+
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select c1, avg(c2) as average from t2 group by c1",
+		err:    "column average_sum is required in table t1 to compute average",
+	}, {
+		filter: "select c1 + 1 as c1, min(c2) as mn from t2 group by c1",
+		err:    "group by expression must be a column to compute mn: c1 + 1",
+	}, {
+		filter: "select c1, max(distinct c2) as mx from t2 group by c1",
+		err:    "unexpected: max(distinct c2)",
+	}}
+	for _, tcase := range testcases {
+		input := &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: tcase.filter,
+			}},
+		}
+		_, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+		require.Error(t, err, tcase.filter)
+		assert.Contains(t, err.Error(), tcase.err, tcase.filter)
+	}
+}
+
+func TestBuildPlayerPlanJoin(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			{Name: "id", IsPK: true},
+			{Name: "amount"},
+			{Name: "cname"},
+		},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select o.id, o.amount, c.name as cname from orders as o join customers as c on c.id = o.cid where in_keyrange(o.id, 'hash', '-80')",
+		}},
+	}
+	want := &TestReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "orders",
+				Filter: "select id, amount, cid from orders where in_keyrange(id, 'hash', '-80')",
+			}, {
+				Match:  "customers",
+				Filter: "select id, `name` from customers",
+			}},
+		},
+		TargetTables: []string{"t1"},
+		TablePlans: map[string]*TestTablePlan{
+			"orders": {
+				TargetName:   "t1",
+				SendRule:     "orders",
+				InsertFront:  "insert into t1(id,amount,cname)",
+				InsertValues: "(:a_id,:a_amount,:a_customers__name)",
+				Insert:       "insert into t1(id,amount,cname) values (:a_id,:a_amount,:a_customers__name)",
+				Update:       "update t1 set amount=:a_amount, cname=:a_customers__name where id=:b_id",
+				Delete:       "delete from t1 where id=:b_id",
+				PKReferences: []string{"id"},
+			},
+			"customers": {
+				TargetName:   "t1",
+				SendRule:     "customers",
+				InsertFront:  "insert into t1(id,amount,cname)",
+				InsertValues: "(:a_id,:a_amount,:a_customers__name)",
+				Insert:       "insert into t1(id,amount,cname) values (:a_id,:a_amount,:a_customers__name)",
+				Update:       "update t1 set amount=:a_amount, cname=:a_customers__name where id=:b_id",
+				Delete:       "delete from t1 where id=:b_id",
+				PKReferences: []string{"id"},
+			},
+		},
+	}
+	plan, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	gotPlan, _ := json.Marshal(plan)
+	wantPlan, _ := json.Marshal(want)
+	assert.Equal(t, string(wantPlan), string(gotPlan))
+	require.NotNil(t, plan.TablePlans["orders"].Join)
+	assert.False(t, plan.TablePlans["orders"].Join.lookup)
+	assert.True(t, plan.TablePlans["customers"].Join.lookup)
+
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select o.id, c.name as cname from orders as o left join customers as c on o.cid = c.id",
+		err:    "unsupported join, only inner joins are supported",
+	}, {
+		filter: "select o.id, c.name as cname from orders as o join customers as c on o.cid > c.id",
+		err:    "join condition must be an equality between a column of orders and a column of customers",
+	}, {
+		filter: "select o.id, c.name as cname from orders as o join customers as c on o.cid = o.id",
+		err:    "join condition must be an equality between a column of orders and a column of customers",
+	}, {
+		filter: "select o.id, name as cname from orders as o join customers as c on o.cid = c.id",
+		err:    "column of a join must be qualified with a table name: `name`",
+	}, {
+		filter: "select o.id, x.name as cname from orders as o join customers as c on o.cid = c.id",
+		err:    "column references an unknown table: x.`name`",
+	}, {
+		filter: "select o.id, c.name as cname from orders as o join customers as c on o.cid = c.id where c.id = 1",
+		err:    "where clause of a join can only reference columns of orders: c.id = 1",
+	}, {
+		filter: "select c.id, o.amount, c.name as cname from orders as o join customers as c on o.cid = c.id",
+		err:    "primary key column id cannot reference columns of the joined table customers",
+	}, {
+		filter: "select o.id, count(*) as amount from orders as o join customers as c on o.cid = c.id group by o.id",
+		err:    "unsupported group by in a join",
+	}, {
+		filter: "select * from orders as o join customers as c on o.cid = c.id",
+		err:    "unsupported expression in a join: *",
+	}, {
+		filter: "select o.id, c.name as cname from orders as o join orders as c on o.cid = c.id",
+		err:    "unsupported join of table orders with itself",
+	}}
+	for _, tcase := range testcases {
+		input := &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: tcase.filter,
+			}},
+		}
+		_, err := buildReplicatorPlan(input, colInfoMap, nil, binlogplayer.NewStats())
+		require.Error(t, err, tcase.filter)
+		assert.Contains(t, err.Error(), tcase.err, tcase.filter)
+	}
+}
+
 func TestBuildPlayerPlanNoDup(t *testing.T) {
 	PrimaryKeyInfos := map[string][]*ColumnInfo{
 		"t1": {&ColumnInfo{Name: "c1"}},
@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// Sink is a target of a workflow other than the local MySQL. A workflow
+// writes to a sink if the sink column of its _vt.vreplication row is
+// set to the name of a registered sink. The rows of the copy phase and
+// the row changes of the replication phase are then converted to
+// SinkRecords and written to the sink, while the local MySQL only
+// keeps the state of the workflow.
+// The records of a transaction are written before its position is
+// saved. After a restart, the records written since the last saved
+// position are written again: a sink gets every change at least once.
+type Sink interface {
+	// Write writes the records of a transaction. The records must be
+	// durable when Write returns.
+	Write(records []*SinkRecord) error
+	Close() error
+}
+
+// SinkRecord is a row change written to a Sink. It follows the layout of
+// a Kafka record: Key is a JSON object of the primary key columns of the
+// row, and Value is a JSON object of all its columns. Value is nil if
+// the row was deleted. The "table" header is the name of the source
+// table, and the "gtid" header is the position of the transaction that
+// changed the row, or of the snapshot the row was copied from.
+type SinkRecord struct {
+	Key       []byte
+	Value     []byte
+	Headers   []SinkHeader
+	Timestamp time.Time
+}
+
+// SinkHeader is a header of a SinkRecord.
+type SinkHeader struct {
+	Key   string
+	Value []byte
+}
+
+// SinkFactory opens the sink of a workflow stream.
+type SinkFactory func(workflow string, id uint32) (Sink, error)
+
+var (
+	sinksMu sync.Mutex
+	sinks   = make(map[string]SinkFactory)
+)
+
+// RegisterSink registers a sink by name.
+func RegisterSink(name string, factory SinkFactory) {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	if _, ok := sinks[name]; ok {
+		log.Fatalf("Sink %s already exists", name)
+	}
+	sinks[name] = factory
+}
+
+func openSink(name, workflow string, id uint32) (Sink, error) {
+	sinksMu.Lock()
+	factory, ok := sinks[name]
+	sinksMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown sink %s", name)
+	}
+	return factory(workflow, id)
+}
+
+// buildSinkReplicatorPlan builds the plan of a workflow that writes to a
+// sink. There is no target table: the rules of the filter are sent to
+// the source as is, and the rows they stream are written to the sink.
+// This requires every rule to match a single table by its name.
+func buildSinkReplicatorPlan(filter *binlogdatapb.Filter, copyState map[string]*sqltypes.Result, stats *binlogplayer.Stats) (*ReplicatorPlan, error) {
+	plan := &ReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
+		TargetTables:  make(map[string]*TablePlan),
+		TablePlans:    make(map[string]*TablePlan),
+		stats:         stats,
+		sink:          true,
+	}
+	for _, rule := range filter.Rules {
+		if strings.HasPrefix(rule.Match, "/") {
+			return nil, fmt.Errorf("rule %s must match a table by its name when replicating to a sink", rule.Match)
+		}
+		tableName := rule.Match
+		lastpk, ok := copyState[tableName]
+		if ok && lastpk == nil {
+			// Don't replicate uncopied tables.
+			continue
+		}
+		query := rule.Filter
+		switch {
+		case query == "":
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(tableName))
+			query = buf.String()
+		case key.IsKeyRange(query):
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(tableName), sqlparser.NewStrLiteral(query))
+			query = buf.String()
+		case query == ExcludeStr:
+			continue
+		}
+		sel, fromTable, err := analyzeSelectFrom(query)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := sel.From[0].(*sqlparser.AliasedTableExpr); !ok || fromTable != tableName {
+			return nil, fmt.Errorf("filter of rule %s must select from table %s when replicating to a sink: %v", rule.Match, tableName, sqlparser.String(sel))
+		}
+		if _, ok := plan.TablePlans[tableName]; ok {
+			return nil, fmt.Errorf("more than one rule for table %s", tableName)
+		}
+		tablePlan := &TablePlan{
+			TargetName: tableName,
+			SendRule:   &binlogdatapb.Rule{Match: tableName, Filter: query},
+			Lastpk:     lastpk,
+			Stats:      stats,
+		}
+		plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, tablePlan.SendRule)
+		plan.TargetTables[tableName] = tablePlan
+		plan.TablePlans[tableName] = tablePlan
+	}
+	return plan, nil
+}
+
+// sinkRecords returns the records of a row change. If the primary key of
+// the row changed, a record deleting the old key precedes the record of
+// the new one. While the table is being copied, the changes to rows
+// beyond the last copied primary key are left out.
+func (tp *TablePlan) sinkRecords(rowChange *binlogdatapb.RowChange, ts time.Time) ([]*SinkRecord, error) {
+	var before, after []sqltypes.Value
+	if rowChange.Before != nil {
+		row := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+		copied, err := tp.isCopied(row)
+		if err != nil {
+			return nil, err
+		}
+		if copied {
+			before = row
+		}
+	}
+	if rowChange.After != nil {
+		row := sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+		copied, err := tp.isCopied(row)
+		if err != nil {
+			return nil, err
+		}
+		if copied {
+			after = row
+		}
+	}
+	var records []*SinkRecord
+	switch {
+	case after != nil:
+		key := tp.sinkKey(after)
+		if before != nil {
+			if beforeKey := tp.sinkKey(before); !bytes.Equal(beforeKey, key) {
+				records = append(records, tp.sinkRecord(beforeKey, nil, ts))
+			}
+		}
+		records = append(records, tp.sinkRecord(key, sinkJSON(tp.Fields, after, false), ts))
+	case before != nil:
+		records = append(records, tp.sinkRecord(tp.sinkKey(before), nil, ts))
+	}
+	return records, nil
+}
+
+// sinkCopyRecords returns the records of rows streamed by the copy phase.
+func (tp *TablePlan) sinkCopyRecords(rows []*querypb.Row, ts time.Time) []*SinkRecord {
+	records := make([]*SinkRecord, 0, len(rows))
+	for _, row := range rows {
+		vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+		records = append(records, tp.sinkRecord(tp.sinkKey(vals), sinkJSON(tp.Fields, vals, false), ts))
+	}
+	return records
+}
+
+func (tp *TablePlan) sinkRecord(key, value []byte, ts time.Time) *SinkRecord {
+	return &SinkRecord{
+		Key:       key,
+		Value:     value,
+		Headers:   []SinkHeader{{Key: "table", Value: []byte(tp.TargetName)}},
+		Timestamp: ts,
+	}
+}
+
+// sinkKey returns the primary key columns of a row as a JSON object,
+// or nil if the table has no primary key.
+func (tp *TablePlan) sinkKey(row []sqltypes.Value) []byte {
+	return sinkJSON(tp.Fields, row, true)
+}
+
+// isCopied returns true if the row is at or before the last primary key
+// copied, which is the case for all rows once the table is copied.
+func (tp *TablePlan) isCopied(row []sqltypes.Value) (bool, error) {
+	if tp.Lastpk == nil || len(tp.Lastpk.Rows) == 0 {
+		return true, nil
+	}
+	for i, pkField := range tp.Lastpk.Fields {
+		j := fieldIndex(tp.Fields, pkField.Name)
+		if j == -1 {
+			return false, fmt.Errorf("primary key column %s of table %s must be streamed to the sink while the table is copied", pkField.Name, tp.TargetName)
+		}
+		cmp, err := evalengine.NullsafeCompare(row[j], tp.Lastpk.Rows[0][i], collations.ID(tp.Fields[j].Charset))
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return cmp < 0, nil
+		}
+	}
+	return true, nil
+}
+
+func fieldIndex(fields []*querypb.Field, name string) int {
+	for i, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sinkJSON returns the columns of a row as a JSON object in column order,
+// or only its primary key columns if pkOnly is set. Numbers other than
+// decimals are rendered as JSON numbers, binary values are base64
+// encoded, and other values are rendered as strings.
+func sinkJSON(fields []*querypb.Field, row []sqltypes.Value, pkOnly bool) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	n := 0
+	for i, field := range fields {
+		if pkOnly && field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+			continue
+		}
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		n++
+		name, _ := json.Marshal(field.Name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		val := row[i]
+		switch {
+		case val.IsNull():
+			buf.WriteString("null")
+		case val.IsIntegral() || val.IsFloat():
+			buf.Write(val.Raw())
+		case val.IsBinary():
+			b, _ := json.Marshal(val.Raw())
+			buf.Write(b)
+		default:
+			b, _ := json.Marshal(val.ToString())
+			buf.Write(b)
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBuildSinkReplicatorPlan(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+		}, {
+			Match:  "t2",
+			Filter: "-80",
+		}, {
+			Match:  "t3",
+			Filter: "select id, val from t3 where id > 10",
+		}, {
+			Match:  "t4",
+			Filter: ExcludeStr,
+		}},
+	}
+	copyState := map[string]*sqltypes.Result{
+		"t1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "5"),
+		"t3": nil,
+	}
+	plan, err := buildSinkReplicatorPlan(filter, copyState, binlogplayer.NewStats())
+	require.NoError(t, err)
+	assert.Equal(t, []*binlogdatapb.Rule{{
+		Match:  "t1",
+		Filter: "select * from t1",
+	}, {
+		Match:  "t2",
+		Filter: "select * from t2 where in_keyrange('-80')",
+	}}, plan.VStreamFilter.Rules)
+	assert.Equal(t, copyState["t1"], plan.TargetTables["t1"].Lastpk)
+
+	testcases := []struct {
+		rule *binlogdatapb.Rule
+		err  string
+	}{{
+		rule: &binlogdatapb.Rule{Match: "/.*"},
+		err:  "rule /.* must match a table by its name when replicating to a sink",
+	}, {
+		rule: &binlogdatapb.Rule{Match: "t1", Filter: "select * from t2"},
+		err:  "filter of rule t1 must select from table t1 when replicating to a sink: select * from t2",
+	}, {
+		rule: &binlogdatapb.Rule{Match: "t1", Filter: "select * from t1 join t2 on t1.id = t2.id"},
+		err:  "filter of rule t1 must select from table t1 when replicating to a sink",
+	}}
+	for _, tcase := range testcases {
+		_, err := buildSinkReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.rule}}, nil, binlogplayer.NewStats())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tcase.err)
+	}
+}
+
+func TestSinkRecords(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name|price|data|amount", "int64|varchar|decimal|varbinary|float64")
+	fields[0].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	tp := &TablePlan{
+		TargetName: "t1",
+		Fields:     fields,
+		Lastpk:     sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "10"),
+	}
+	withID := func(id int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{
+			sqltypes.NewInt64(id),
+			sqltypes.NewVarChar(`a "b"`),
+			sqltypes.NewDecimal("1.50"),
+			sqltypes.NewVarBinary("\x00\x01"),
+			sqltypes.NULL,
+		})
+	}
+	value := func(id string) string {
+		return `{"id":` + id + `,"name":"a \"b\"","price":"1.50","data":"AAE=","amount":null}`
+	}
+	ts := time.Unix(1600000000, 0)
+
+	type record struct {
+		key, value string
+	}
+	testcases := []struct {
+		name   string
+		change *binlogdatapb.RowChange
+		want   []record
+	}{{
+		name:   "insert",
+		change: &binlogdatapb.RowChange{After: withID(1)},
+		want:   []record{{`{"id":1}`, value("1")}},
+	}, {
+		name:   "update",
+		change: &binlogdatapb.RowChange{Before: withID(1), After: withID(1)},
+		want:   []record{{`{"id":1}`, value("1")}},
+	}, {
+		name:   "update of the primary key",
+		change: &binlogdatapb.RowChange{Before: withID(1), After: withID(2)},
+		want:   []record{{`{"id":1}`, ""}, {`{"id":2}`, value("2")}},
+	}, {
+		name:   "delete",
+		change: &binlogdatapb.RowChange{Before: withID(10)},
+		want:   []record{{`{"id":10}`, ""}},
+	}, {
+		name:   "insert of a row that is not copied yet",
+		change: &binlogdatapb.RowChange{After: withID(11)},
+	}, {
+		name:   "move of a row beyond the last copied primary key",
+		change: &binlogdatapb.RowChange{Before: withID(1), After: withID(11)},
+		want:   []record{{`{"id":1}`, ""}},
+	}, {
+		name:   "move of a row to the copied primary keys",
+		change: &binlogdatapb.RowChange{Before: withID(11), After: withID(1)},
+		want:   []record{{`{"id":1}`, value("1")}},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			records, err := tp.sinkRecords(tcase.change, ts)
+			require.NoError(t, err)
+			var got []record
+			for _, r := range records {
+				assert.Equal(t, []SinkHeader{{Key: "table", Value: []byte("t1")}}, r.Headers)
+				assert.Equal(t, ts, r.Timestamp)
+				got = append(got, record{string(r.Key), string(r.Value)})
+			}
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+
+	records := tp.sinkCopyRecords([]*querypb.Row{withID(20)}, ts)
+	require.Len(t, records, 1)
+	assert.Equal(t, `{"id":20}`, string(records[0].Key))
+	assert.Equal(t, value("20"), string(records[0].Value))
+
+	tp.Fields = fields[1:]
+	_, err := tp.sinkRecords(&binlogdatapb.RowChange{After: sqltypes.RowToProto3(sqltypes.MakeRowTrusted(fields, withID(1))[1:])}, ts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "primary key column id of table t1 must be streamed to the sink while the table is copied")
+}
+
+func TestVDBClientRecords(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	sink := &testSink{}
+	vc := newVDBClient(dbClient, binlogplayer.NewStats())
+	vc.sink = sink
+
+	dbClient.ExpectRequest("begin", nil, nil)
+	require.NoError(t, vc.Begin())
+	vc.AddRecords(&SinkRecord{Key: []byte("1")})
+	require.Error(t, vc.Commit())
+	require.NoError(t, vc.WriteRecords("pos"))
+	dbClient.ExpectRequest("commit", nil, nil)
+	require.NoError(t, vc.Commit())
+	assert.Equal(t, [][]*SinkRecord{{{
+		Key:     []byte("1"),
+		Headers: []SinkHeader{{Key: "gtid", Value: []byte("pos")}},
+	}}}, sink.writes)
+
+	// Rolled back records are not written.
+	dbClient.ExpectRequest("begin", nil, nil)
+	require.NoError(t, vc.Begin())
+	vc.AddRecords(&SinkRecord{Key: []byte("2")})
+	dbClient.ExpectRequest("rollback", nil, nil)
+	require.NoError(t, vc.Rollback())
+	require.NoError(t, vc.WriteRecords("pos"))
+	assert.Len(t, sink.writes, 1)
+	dbClient.Wait()
+}
+
+type testSink struct {
+	writes [][]*SinkRecord
+}
+
+func (ts *testSink) Write(records []*SinkRecord) error {
+	ts.writes = append(ts.writes, records)
+	return nil
+}
+
+func (ts *testSink) Close() error {
+	return nil
+}
@@ -54,6 +54,7 @@ type tablePlanBuilder struct {
 	lastpk            *sqltypes.Result
 	colInfos          []*ColumnInfo
 	stats             *binlogplayer.Stats
+	recompute         *recomputePlan
 }
 
 // colExpr describes the processing to be performed to
@@ -64,6 +65,8 @@ type colExpr struct {
 	// operation==opExpr: full expression is set
 	// operation==opCount: nothing is set.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opCountCol, opCountDistinct, opMin, opMax and opAvg:
+	// same as opSum.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -71,6 +74,11 @@ type colExpr struct {
 	// references contains all the column names referenced in the expression.
 	references map[string]bool
 
+	// avgSum and avgCount are the names of the hidden columns
+	// that maintain the sum and count of an opAvg.
+	avgSum   sqlparser.ColIdent
+	avgCount sqlparser.ColIdent
+
 	isGrouped  bool
 	isPK       bool
 	dataType   string
@@ -85,6 +93,17 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	// opCountCol is for 'count(a)', which skips NULL values.
+	opCountCol
+	// opCountDistinct, opMin and opMax cannot be maintained incrementally
+	// in all cases. They are recomputed from the source when needed.
+	// See recomputePlan.
+	opCountDistinct
+	opMin
+	opMax
+	// opAvg is computed from two hidden columns of the target table,
+	// which are maintained as an opSum and an opCountCol.
+	opAvg
 )
 
 // insertType describes the type of insert statement to generate.
@@ -162,10 +181,86 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 		plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, tablePlan.SendRule)
 		plan.TargetTables[tableName] = tablePlan
 		plan.TablePlans[tablePlan.SendRule.Match] = tablePlan
+		if tablePlan.Join == nil {
+			continue
+		}
+		// The joined table is streamed with its own rule.
+		lookupPlan := tablePlan.lookupTablePlan()
+		if dup, ok := plan.TablePlans[lookupPlan.SendRule.Match]; ok {
+			return nil, fmt.Errorf("more than one target for source table %s: %s and %s", lookupPlan.SendRule.Match, dup.TargetName, tableName)
+		}
+		plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, lookupPlan.SendRule)
+		plan.TablePlans[lookupPlan.SendRule.Match] = lookupPlan
 	}
 	return plan, nil
 }
 
+// ValidateSourceIndexes builds the table plans of the rules of filter, and returns an
+// error if a plan looks up the rows of a source table by columns that don't lead an
+// index of the table. The source fails such lookups instead of scanning the table,
+// which would otherwise only be found once the workflow is running. Other errors are
+// left to the streams, which build the plans with the target schema. sourceDDLs returns
+// the create statements of the source tables by name. It's only called if a plan looks
+// up source rows.
+func ValidateSourceIndexes(filter *binlogdatapb.Filter, sourceDDLs func() (map[string]string, error)) error {
+	var ddls map[string]string
+	indexColumns := func(table sqlparser.TableIdent) (map[string]bool, error) {
+		if ddls == nil {
+			var err error
+			if ddls, err = sourceDDLs(); err != nil {
+				return nil, err
+			}
+		}
+		return sourceIndexColumns(table, ddls)
+	}
+	for _, rule := range filter.Rules {
+		tablePlan, err := buildTablePlan(rule.Match, rule, nil, nil, nil)
+		if err != nil {
+			// The plan is built without the target schema, which the stream
+			// has. So, the stream reports the rules it can't build.
+			continue
+		}
+		if tablePlan == nil {
+			continue
+		}
+		if tablePlan.Recompute != nil {
+			if err := tablePlan.Recompute.checkSourceIndex(indexColumns); err != nil {
+				return err
+			}
+		}
+		if tablePlan.Join != nil {
+			if err := tablePlan.Join.checkSourceIndexes(indexColumns); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sourceIndexColumns returns the lowered names of the columns that lead an index
+// of a source table, according to its create statement in ddls.
+func sourceIndexColumns(table sqlparser.TableIdent, ddls map[string]string) (map[string]bool, error) {
+	ddl, ok := ddls[table.String()]
+	if !ok {
+		return nil, fmt.Errorf("source table %v not found", table)
+	}
+	stmt, err := sqlparser.Parse(ddl)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return nil, fmt.Errorf("unexpected create statement for source table %v: %v", table, ddl)
+	}
+	cols := make(map[string]bool)
+	for _, index := range create.TableSpec.Indexes {
+		if len(index.Columns) != 0 {
+			cols[index.Columns[0].Column.Lowered()] = true
+		}
+	}
+	return cols, nil
+}
+
 // MatchTable is similar to tableMatches and buildPlan defined in vstreamer/planbuilder.go.
 func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Rule, error) {
 	for _, rule := range filter.Rules {
@@ -217,6 +312,14 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 		enumValuesMap[k] = tokensMap
 	}
 
+	var join *joinPlan
+	if joinExpr, ok := sel.From[0].(*sqlparser.JoinTableExpr); ok {
+		if join, err = analyzeJoin(sel, joinExpr); err != nil {
+			return nil, err
+		}
+		sel.From = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: join.leftTable}}}
+	}
+
 	if expr, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
 		// If it's a "select *", we return a partial plan, and complete
 		// it when we get back field info from the stream.
@@ -268,6 +371,14 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeRecompute(fromTable, sel.Where); err != nil {
+		return nil, err
+	}
+	if join != nil {
+		if err := tpb.analyzeJoinCols(join); err != nil {
+			return nil, err
+		}
+	}
 	targetKeyColumnNames, err := textutil.SplitUnescape(rule.TargetUniqueKeyColumns, ",")
 	if err != nil {
 		return nil, err
@@ -276,6 +387,11 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	if err := tpb.analyzePK(pkColsInfo); err != nil {
 		return nil, err
 	}
+	if join != nil {
+		if err := tpb.analyzeJoinPK(join); err != nil {
+			return nil, err
+		}
+	}
 
 	sourceKeyTargetColumnNames, err := textutil.SplitUnescape(rule.SourceUniqueKeyTargetColumns, ",")
 	if err != nil {
@@ -310,6 +426,7 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	tablePlan.SendRule = sendRule
 	tablePlan.EnumValuesMap = enumValuesMap
 	tablePlan.ConvertCharset = rule.ConvertCharset
+	tablePlan.Join = join
 	return tablePlan, nil
 }
 
@@ -353,6 +470,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Stats:                   tpb.stats,
 		FieldsToSkip:            fieldsToSkip,
 		HasExtraSourcePkColumns: (len(tpb.extraSourcePkCols) > 0),
+		Recompute:               tpb.recompute,
 	}
 }
 
@@ -372,6 +490,11 @@ func analyzeSelectFrom(query string) (sel *sqlparser.Select, from string, err er
 		return nil, "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
 	}
 	node, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if join, isJoin := sel.From[0].(*sqlparser.JoinTableExpr); isJoin {
+		// The driving table of a join is the table on its left.
+		// The join itself is validated by analyzeJoin.
+		node, ok = join.LeftExpr.(*sqlparser.AliasedTableExpr)
+	}
 	if !ok {
 		return nil, "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
 	}
@@ -388,6 +511,15 @@ func (tpb *tablePlanBuilder) analyzeExprs(selExprs sqlparser.SelectExprs) error
 		if err != nil {
 			return err
 		}
+		if cexpr.operation == opAvg {
+			// The hidden columns must precede the average, because
+			// the average is computed from their updated values.
+			hiddenCols, err := tpb.analyzeAvg(cexpr)
+			if err != nil {
+				return err
+			}
+			tpb.colExprs = append(tpb.colExprs, hiddenCols...)
+		}
 		tpb.colExprs = append(tpb.colExprs, cexpr)
 	}
 	return nil
@@ -423,35 +555,41 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		return cexpr, nil
 	}
 	if expr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok {
-		if expr.Distinct {
+		fname := expr.Name.Lowered()
+		if expr.Distinct && fname != "count" {
 			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 		}
-		switch fname := expr.Name.Lowered(); fname {
+		switch fname {
 		case "count":
-			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); !ok {
-				return nil, fmt.Errorf("only count(*) is supported: %v", sqlparser.String(expr))
+			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); ok {
+				if expr.Distinct {
+					return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				}
+				cexpr.operation = opCount
+				return cexpr, nil
 			}
-			cexpr.operation = opCount
-			return cexpr, nil
-		case "sum":
-			if len(expr.Exprs) != 1 {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			if err := tpb.analyzeAggregateArg(expr, cexpr); err != nil {
+				return nil, err
 			}
-			aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			cexpr.operation = opCountCol
+			if expr.Distinct {
+				cexpr.operation = opCountDistinct
 			}
-			innerCol, ok := aInner.Expr.(*sqlparser.ColName)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			return cexpr, nil
+		case "sum", "min", "max", "avg":
+			if err := tpb.analyzeAggregateArg(expr, cexpr); err != nil {
+				return nil, err
 			}
-			if !innerCol.Qualifier.IsEmpty() {
-				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+			switch fname {
+			case "sum":
+				cexpr.operation = opSum
+			case "min":
+				cexpr.operation = opMin
+			case "max":
+				cexpr.operation = opMax
+			case "avg":
+				cexpr.operation = opAvg
 			}
-			cexpr.operation = opSum
-			cexpr.expr = innerCol
-			tpb.addCol(innerCol.Name)
-			cexpr.references[innerCol.Name.Lowered()] = true
 			return cexpr, nil
 		case "keyspace_id":
 			if len(expr.Exprs) != 0 {
@@ -488,6 +626,79 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 	return cexpr, nil
 }
 
+// analyzeAggregateArg validates the argument of an aggregate function, which
+// must be a single column, and sets it as the expression of cexpr.
+func (tpb *tablePlanBuilder) analyzeAggregateArg(expr *sqlparser.FuncExpr, cexpr *colExpr) error {
+	if len(expr.Exprs) != 1 {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	innerCol, ok := aInner.Expr.(*sqlparser.ColName)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if !innerCol.Qualifier.IsEmpty() {
+		return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+	}
+	cexpr.expr = innerCol
+	if !tpb.hasSendCol(innerCol.Name) {
+		tpb.addCol(innerCol.Name)
+	}
+	cexpr.references[innerCol.Name.Lowered()] = true
+	return nil
+}
+
+// hasSendCol returns true if the send query already selects the specified column.
+func (tpb *tablePlanBuilder) hasSendCol(ident sqlparser.ColIdent) bool {
+	for _, selExpr := range tpb.sendSelect.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok || !aliased.As.IsEmpty() {
+			continue
+		}
+		if col, ok := aliased.Expr.(*sqlparser.ColName); ok && col.Name.Equal(ident) {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeAvg returns the hidden columns that maintain the sum and count of
+// an average. For 'avg(a) as c', the target table must have the columns
+// 'c_sum' and 'c_count'.
+func (tpb *tablePlanBuilder) analyzeAvg(cexpr *colExpr) ([]*colExpr, error) {
+	cexpr.avgSum = sqlparser.NewColIdent(cexpr.colName.String() + "_sum")
+	cexpr.avgCount = sqlparser.NewColIdent(cexpr.colName.String() + "_count")
+	var hiddenCols []*colExpr
+	for _, hidden := range []struct {
+		name      sqlparser.ColIdent
+		operation operation
+	}{{cexpr.avgSum, opSum}, {cexpr.avgCount, opCountCol}} {
+		if !tpb.hasColumn(hidden.name) {
+			return nil, fmt.Errorf("column %v is required in table %v to compute %v", hidden.name, tpb.name, cexpr.colName)
+		}
+		hiddenCols = append(hiddenCols, &colExpr{
+			colName:    hidden.name,
+			operation:  hidden.operation,
+			expr:       cexpr.expr,
+			references: cexpr.references,
+		})
+	}
+	return hiddenCols, nil
+}
+
+// hasColumn returns true if the target table has the specified column.
+func (tpb *tablePlanBuilder) hasColumn(col sqlparser.ColIdent) bool {
+	for _, colInfo := range tpb.colInfos {
+		if col.EqualString(colInfo.Name) {
+			return true
+		}
+	}
+	return false
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -527,6 +738,52 @@ func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 	return nil
 }
 
+// analyzeRecompute builds tpb.recompute if there are aggregates that
+// need to be recomputed from the source. The groups to recompute are
+// read from the source by their values, which is why the group by
+// columns must be plain source columns.
+func (tpb *tablePlanBuilder) analyzeRecompute(fromTable string, where *sqlparser.Where) error {
+	var aggCols []*colExpr
+	for _, cexpr := range tpb.colExprs {
+		switch cexpr.operation {
+		case opCountDistinct, opMin, opMax:
+			aggCols = append(aggCols, cexpr)
+		}
+	}
+	if len(aggCols) == 0 {
+		return nil
+	}
+	if tpb.onInsert != insertOnDup {
+		return fmt.Errorf("group by is required to compute %v", aggCols[0].colName)
+	}
+	var groupCols []*colExpr
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.isGrouped {
+			continue
+		}
+		if _, ok := cexpr.expr.(*sqlparser.ColName); !ok {
+			return fmt.Errorf("group by expression must be a column to compute %v: %v", aggCols[0].colName, sqlparser.String(cexpr.expr))
+		}
+		groupCols = append(groupCols, cexpr)
+	}
+	tpb.recompute = &recomputePlan{
+		name:        tpb.name,
+		sourceTable: sqlparser.NewTableIdent(fromTable),
+		where:       where,
+		groupCols:   groupCols,
+		aggCols:     aggCols,
+	}
+	return nil
+}
+
+// extremumFunc returns the function that combines two values of an opMin or opMax.
+func extremumFunc(op operation) string {
+	if op == opMin {
+		return "least"
+	}
+	return "greatest"
+}
+
 func (tpb *tablePlanBuilder) getPKColsInfo(uniqueKeyColumns []string, colInfos []*ColumnInfo) (pkColsInfo []*ColumnInfo) {
 	if len(uniqueKeyColumns) == 0 {
 		// No PK override
@@ -679,6 +936,10 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opCountCol, opCountDistinct:
+			buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.Myprintf(")")
@@ -702,6 +963,10 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.WriteString("1")
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opCountCol, opCountDistinct:
+			buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -739,6 +1004,15 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		case opCountCol:
+			buf.Myprintf("%v+values(%v)", cexpr.colName, cexpr.colName)
+		case opCountDistinct:
+			// Recomputed from the source.
+			buf.Myprintf("%v", cexpr.colName)
+		case opMin, opMax:
+			buf.Myprintf("coalesce(%s(%v, values(%v)), %v, values(%v))", extremumFunc(cexpr.operation), cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
+		case opAvg:
+			buf.Myprintf("%v/nullif(%v, 0)", cexpr.avgSum, cexpr.avgCount)
 		}
 	}
 	return buf.ParsedQuery()
@@ -777,6 +1051,21 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("-ifnull(%v, 0)", cexpr.expr)
 			bvf.mode = bvAfter
 			buf.Myprintf("+ifnull(%v, 0)", cexpr.expr)
+		case opCountCol:
+			buf.Myprintf("%v", cexpr.colName)
+			bvf.mode = bvBefore
+			buf.Myprintf("-if(%v is null, 0, 1)", cexpr.expr)
+			bvf.mode = bvAfter
+			buf.Myprintf("+if(%v is null, 0, 1)", cexpr.expr)
+		case opCountDistinct:
+			// Recomputed from the source.
+			buf.Myprintf("%v", cexpr.colName)
+		case opMin, opMax:
+			// If the old value was the extremum, it's recomputed from the source.
+			bvf.mode = bvAfter
+			buf.Myprintf("coalesce(%s(%v, %v), %v, %v)", extremumFunc(cexpr.operation), cexpr.colName, cexpr.expr, cexpr.colName, cexpr.expr)
+		case opAvg:
+			buf.Myprintf("%v/nullif(%v, 0)", cexpr.avgSum, cexpr.avgCount)
 		}
 	}
 	tpb.generateWhere(buf, bvf)
@@ -807,6 +1096,13 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 				buf.Myprintf("%v-1", cexpr.colName)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			case opCountCol:
+				buf.Myprintf("%v-if(%v is null, 0, 1)", cexpr.colName, cexpr.expr)
+			case opCountDistinct, opMin, opMax:
+				// Recomputed from the source.
+				buf.Myprintf("%v", cexpr.colName)
+			case opAvg:
+				buf.Myprintf("%v/nullif(%v, 0)", cexpr.avgSum, cexpr.avgCount)
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -57,7 +57,7 @@ func newVCopier(vr *vreplicator) *vcopier {
 func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	defer vc.vr.dbClient.Rollback()
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	plan, err := vc.vr.buildReplicatorPlan(nil)
 	if err != nil {
 		return err
 	}
@@ -200,7 +200,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	plan, err := vc.vr.buildReplicatorPlan(nil)
 	if err != nil {
 		return err
 	}
@@ -222,6 +222,8 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	defer rowsCopiedTicker.Stop()
 
 	var pkfields []*querypb.Field
+	// gtid is the position of the snapshot the rows are copied from.
+	var gtid string
 	var updateCopyState *sqlparser.ParsedQuery
 	var bv map[string]*querypb.BindVariable
 	var sqlbuffer bytes2.Buffer
@@ -256,6 +258,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 				return err
 			}
 			pkfields = append(pkfields, rows.Pkfields...)
+			gtid = rows.Gtid
 			buf := sqlparser.NewTrackedBuffer(nil)
 			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
 			updateCopyState = buf.ParsedQuery()
@@ -272,7 +275,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if err := vc.vr.dbClient.Begin(); err != nil {
 			return err
 		}
-		_, err = vc.tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
+		executor := func(sql string) (*sqltypes.Result, error) {
 			start := time.Now()
 
 			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
@@ -283,6 +286,24 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
 			vc.vr.stats.QueryCount.Add("copy", 1)
 			return qr, err
+		}
+		streamRows := func(query string, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+			return vc.vr.sourceVStreamer.VStreamRows(ctx, query, nil, send)
+		}
+		switch {
+		case vc.vr.sink != nil:
+			vc.vr.dbClient.AddRecords(vc.tablePlan.sinkCopyRecords(rows.Rows, time.Now())...)
+			vc.vr.stats.CopyRowCount.Add(int64(len(rows.Rows)))
+		case vc.tablePlan.Join != nil:
+			err = vc.tablePlan.applyJoinBulkInsert(rows, streamRows, executor)
+		default:
+			_, err = vc.tablePlan.applyBulkInsert(&sqlbuffer, rows, executor)
+		}
+		if err != nil {
+			return err
+		}
+		err = vc.tablePlan.recomputeBulkInsert(rows, streamRows, func(sql string) (*sqltypes.Result, error) {
+			return vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
 		})
 		if err != nil {
 			return err
@@ -310,6 +331,9 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			return err
 		}
 
+		if err := vc.vr.dbClient.WriteRecords(gtid); err != nil {
+			return err
+		}
 		if err := vc.vr.dbClient.Commit(); err != nil {
 			return err
 		}
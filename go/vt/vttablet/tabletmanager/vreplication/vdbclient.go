@@ -17,6 +17,7 @@ limitations under the License.
 package vreplication
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -36,6 +37,10 @@ type vdbClient struct {
 	InTransaction bool
 	startTime     time.Time
 	queries       []string
+	// sink is set if the workflow writes to a Sink. The records
+	// added during a transaction are written by WriteRecords.
+	sink    Sink
+	records []*SinkRecord
 }
 
 func newVDBClient(dbclient binlogplayer.DBClient, stats *binlogplayer.Stats) *vdbClient {
@@ -59,6 +64,9 @@ func (vc *vdbClient) Begin() error {
 }
 
 func (vc *vdbClient) Commit() error {
+	if len(vc.records) != 0 {
+		return fmt.Errorf("%d records were not written to the sink", len(vc.records))
+	}
 	if err := vc.DBClient.Commit(); err != nil {
 		return err
 	}
@@ -69,6 +77,7 @@ func (vc *vdbClient) Commit() error {
 }
 
 func (vc *vdbClient) Rollback() error {
+	vc.records = nil
 	if !vc.InTransaction {
 		return nil
 	}
@@ -80,6 +89,29 @@ func (vc *vdbClient) Rollback() error {
 	return nil
 }
 
+// AddRecords adds records to be written to the sink by WriteRecords.
+func (vc *vdbClient) AddRecords(records ...*SinkRecord) {
+	vc.records = append(vc.records, records...)
+}
+
+// WriteRecords writes the records added during the current transaction
+// to the sink, with the position of the transaction. It must be called
+// before the transaction is committed: the position is saved only if
+// the records were written. It's a no-op if no records were added.
+func (vc *vdbClient) WriteRecords(pos string) error {
+	if len(vc.records) == 0 {
+		return nil
+	}
+	for _, record := range vc.records {
+		record.Headers = append(record.Headers, SinkHeader{Key: "gtid", Value: []byte(pos)})
+	}
+	if err := vc.sink.Write(vc.records); err != nil {
+		return err
+	}
+	vc.records = nil
+	return nil
+}
+
 func (vc *vdbClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
 	defer vc.stats.Timings.Record(binlogplayer.BlplQuery, time.Now())
 
@@ -102,9 +134,12 @@ func (vc *vdbClient) ExecuteWithRetry(ctx context.Context, query string) (*sqlty
 	for err != nil {
 		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERLockDeadlock || sqlErr.Number() == mysql.ERLockWaitTimeout {
 			log.Infof("retryable error: %v, waiting for %v and retrying", sqlErr, dbLockRetryDelay)
+			// The records are kept, like the queries.
+			records := vc.records
 			if err := vc.Rollback(); err != nil {
 				return nil, err
 			}
+			vc.records = records
 			time.Sleep(dbLockRetryDelay)
 			// Check context here. Otherwise this can become an infinite loop.
 			select {
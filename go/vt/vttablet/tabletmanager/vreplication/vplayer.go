@@ -106,7 +106,7 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.colInfoMap, vp.copyState, vp.vr.stats)
+	plan, err := vp.vr.buildReplicatorPlan(vp.copyState)
 	if err != nil {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
@@ -213,17 +213,40 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	if vp.vr.sink != nil {
+		ts := time.Unix(0, vp.lastTimestampNs)
+		for _, change := range rowEvent.RowChanges {
+			records, err := tplan.sinkRecords(change, ts)
+			if err != nil {
+				return err
+			}
+			vp.vr.dbClient.AddRecords(records...)
+		}
+		return nil
+	}
+	executor := func(sql string) (*sqltypes.Result, error) {
+		stats := NewVrLogStats("ROWCHANGE")
+		start := time.Now()
+		qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+		vp.vr.stats.QueryCount.Add(vp.phase, 1)
+		vp.vr.stats.QueryTimings.Record(vp.phase, start)
+		stats.Send(sql)
+		return qr, err
+	}
+	streamRows := func(query string, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		return vp.vr.sourceVStreamer.VStreamRows(ctx, query, nil, send)
+	}
 	for _, change := range rowEvent.RowChanges {
-		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
-			stats := NewVrLogStats("ROWCHANGE")
-			start := time.Now()
-			qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
-			vp.vr.stats.QueryCount.Add(vp.phase, 1)
-			vp.vr.stats.QueryTimings.Record(vp.phase, start)
-			stats.Send(sql)
-			return qr, err
-		})
-		if err != nil {
+		if tplan.Join != nil {
+			if err := tplan.applyJoinChange(change, streamRows, executor); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tplan.applyChange(change, executor); err != nil {
+			return err
+		}
+		if err := tplan.recomputeChange(change, streamRows, executor); err != nil {
 			return err
 		}
 	}
@@ -461,6 +484,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err != nil {
 			return err
 		}
+		if err := vp.vr.dbClient.WriteRecords(mysql.EncodePosition(vp.pos)); err != nil {
+			return err
+		}
 		if err := vp.vr.dbClient.Commit(); err != nil {
 			return err
 		}
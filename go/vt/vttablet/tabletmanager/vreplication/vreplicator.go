@@ -98,6 +98,8 @@ type vreplicator struct {
 	// mysqld is used to fetch the local schema.
 	mysqld     mysqlctl.MysqlDaemon
 	colInfoMap map[string][]*ColumnInfo
+	// sink is set if the workflow writes to a Sink instead of the local MySQL.
+	sink Sink
 
 	originalFKCheckSetting int64
 	originalSQLMode        string
@@ -124,12 +126,15 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+// If sink is not nil, the rows are written to it instead of the local MySQL.
+// Each Rule must then match a table by its name, and its Filter must select
+// from that table.
+func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine, sink Sink) *vreplicator {
 	if *vreplicationHeartbeatUpdateInterval > vreplicationMinimumHeartbeatUpdateInterval {
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
 	}
-	return &vreplicator{
+	vr := &vreplicator{
 		vre:             vre,
 		id:              id,
 		source:          source,
@@ -137,7 +142,10 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		stats:           stats,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
+		sink:            sink,
 	}
+	vr.dbClient.sink = sink
+	return vr
 }
 
 // Replicate starts a vreplication stream. It can be in one of three phases:
@@ -172,6 +180,12 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
+	if vr.sink != nil {
+		switch vr.source.OnDdl {
+		case binlogdatapb.OnDDLAction_EXEC, binlogdatapb.OnDDLAction_EXEC_IGNORE:
+			return fmt.Errorf("on_ddl %v is not supported when replicating to a sink", vr.source.OnDdl)
+		}
+	}
 	// Manage SQL_MODE in the same way that mysqldump does.
 	// Save the original sql_mode, set it to a permissive mode,
 	// and then reset it back to the original value at the end.
@@ -262,6 +276,14 @@ type ColumnInfo struct {
 	IsGenerated bool
 }
 
+// buildReplicatorPlan builds the plan of the workflow for a copy state.
+func (vr *vreplicator) buildReplicatorPlan(copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
+	if vr.sink != nil {
+		return buildSinkReplicatorPlan(vr.source.Filter, copyState, vr.stats)
+	}
+	return buildReplicatorPlan(vr.source.Filter, vr.colInfoMap, copyState, vr.stats)
+}
+
 func (vr *vreplicator) buildColInfoMap(ctx context.Context) (map[string][]*ColumnInfo, error) {
 	schema, err := vr.mysqld.GetSchema(ctx, vr.dbClient.DBName(), []string{"/.*/"}, nil, false)
 	if err != nil {
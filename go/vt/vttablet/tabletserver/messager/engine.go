@@ -23,6 +23,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/log"
+	vtschema "vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -38,6 +39,7 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, querygen QueryGenerator, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, querygen QueryGenerator, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, querygen QueryGenerator, ids []string) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -49,8 +51,11 @@ type VStreamer interface {
 
 // Engine is the engine for handling messages.
 type Engine struct {
-	mu       sync.Mutex
-	isOpen   bool
+	mu     sync.Mutex
+	isOpen bool
+	// managers are keyed by stream name: there is a manager for the
+	// default consumer group of each message table, keyed by the table
+	// name, and a manager for each named consumer group.
 	managers map[string]*messageManager
 
 	tsv          TabletService
@@ -142,6 +147,13 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		log.Infof("Stopping messager for dropped/updated table: %v", name)
 		mm.Close()
 		delete(me.managers, name)
+		for _, consumerGroup := range mm.consumerGroups {
+			streamName := vtschema.MessageStreamName(name, consumerGroup)
+			if mm := me.managers[streamName]; mm != nil {
+				mm.Close()
+				delete(me.managers, streamName)
+			}
+		}
 	}
 
 	for _, name := range append(created, altered...) {
@@ -158,5 +170,12 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		me.managers[name] = mm
 		log.Infof("Starting messager for table: %v", name)
 		mm.Open()
+		for _, consumerGroup := range mm.consumerGroups {
+			streamName := vtschema.MessageStreamName(name, consumerGroup)
+			mm := newConsumerGroupManager(me.tsv, me.vs, t, consumerGroup, me.postponeSema)
+			me.managers[streamName] = mm
+			log.Infof("Starting messager for consumer group: %v", streamName)
+			mm.Open()
+		}
 	}
 }
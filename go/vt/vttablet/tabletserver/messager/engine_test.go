@@ -93,6 +93,42 @@ func TestEngineSchemaChanged(t *testing.T) {
 	}
 }
 
+func TestEngineSchemaChangedConsumerGroups(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	engine := newTestEngine(db)
+	defer engine.Close()
+	groupInfo := *newMMTable().MessageInfo
+	groupInfo.ConsumerGroups = []string{"g1", "g2"}
+	groupTable := &schema.Table{
+		Type:        schema.Message,
+		MessageInfo: &groupInfo,
+	}
+	tables := map[string]*schema.Table{
+		"t1": groupTable,
+	}
+	engine.schemaChanged(tables, []string{"t1"}, nil, nil)
+	got := extractManagerNames(engine.managers)
+	want := map[string]bool{"t1": true, "t1@g1": true, "t1@g2": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want %+v", got, want)
+	}
+	if got, want := engine.managers["t1@g2"].consumerGroup, "g2"; got != want {
+		t.Errorf("consumerGroup: %s, want %s", got, want)
+	}
+
+	// Altering the table drops a consumer group
+	tables = map[string]*schema.Table{
+		"t1": meTable,
+	}
+	engine.schemaChanged(tables, nil, []string{"t1"}, nil)
+	got = extractManagerNames(engine.managers)
+	want = map[string]bool{"t1": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want %+v", got, want)
+	}
+}
+
 func extractManagerNames(in map[string]*messageManager) map[string]bool {
 	out := make(map[string]bool)
 	for k := range in {
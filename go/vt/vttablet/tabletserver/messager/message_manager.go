@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtschema "vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -51,6 +53,7 @@ type QueryGenerator interface {
 	GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable)
 	GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable)
 	GeneratePurgeQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable)
+	GenerateDeadLetterQueries(ids []string) ([]string, map[string]*querypb.BindVariable)
 }
 
 type messageReceiver struct {
@@ -164,16 +167,48 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Dead messages
+// If the table specifies vt_max_retries, a message whose epoch exceeds
+// it is dead, and is not sent again. Instead, it's moved to the dead letter
+// table if one was specified, or otherwise kept in the message table with
+// a null time_next. A message that is neither acked nor dead, but has a
+// null time_next, is sent right away.
+// If the table has consumer groups, a consumer group only marks its dead
+// messages with a null time_next of its own. The message is moved to the
+// dead letter table once every consumer group acked it or marked it dead.
+// This is done by the default consumer group, when it marks a message dead,
+// and by its purge thread for the messages marked dead by the other groups.
+//
+// Ordered delivery
+// If the table specifies vt_ordering_key, a message is not sent while
+// an earlier message (by id) with the same key is pending, which means that
+// it's neither acked nor dead. The poller only loads the earliest pending
+// message of every key. Row events don't add ordered messages to the
+// cache. Instead, they trigger the poller whenever a message becomes
+// due, or stops being pending. Messages with a null key are not ordered.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
 
-	name         sqlparser.TableIdent
-	fieldResult  *sqltypes.Result
+	name        sqlparser.TableIdent
+	fieldResult *sqltypes.Result
+
+	// consumerGroup is the consumer group served by this manager. The
+	// default consumer group is "". streamName is the name by which the
+	// consumer group is streamed.
+	consumerGroup string
+	streamName    string
+	// consumerGroups lists all the named consumer groups of the table.
+	consumerGroups []string
+	// orderingKey is the column by which messages are ordered, if any.
+	orderingKey sqlparser.ColIdent
+
 	ackWaitTime  time.Duration
 	purgeAfter   time.Duration
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
+	maxRetries   int64
 	batchSize    int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
@@ -217,16 +252,34 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterQueries         []*sqlparser.ParsedQuery
+	// readDeadForAllGroups reads the messages to move to the dead letter
+	// table because every consumer group is done with them. It's only
+	// set for the default consumer group of a table with consumer groups.
+	readDeadForAllGroups *sqlparser.ParsedQuery
 }
 
-// newMessageManager creates a new message manager.
+// newMessageManager creates a new message manager for the default
+// consumer group of a message table.
 // Calls into tsv have to be made asynchronously. Otherwise,
 // it can lead to deadlocks.
 func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, postponeSema *sync2.Semaphore) *messageManager {
+	return newConsumerGroupManager(tsv, vs, table, "", postponeSema)
+}
+
+// newConsumerGroupManager creates a new message manager for the given
+// consumer group of a message table. Each consumer group receives every
+// message, and keeps its own delivery state in its own columns of the
+// message row.
+func newConsumerGroupManager(tsv TabletService, vs VStreamer, table *schema.Table, consumerGroup string, postponeSema *sync2.Semaphore) *messageManager {
 	mm := &messageManager{
-		tsv:  tsv,
-		vs:   vs,
-		name: table.Name,
+		tsv:            tsv,
+		vs:             vs,
+		name:           table.Name,
+		consumerGroup:  consumerGroup,
+		consumerGroups: table.MessageInfo.ConsumerGroups,
+		orderingKey:    table.MessageInfo.OrderingKey,
+		streamName:     vtschema.MessageStreamName(table.Name.String(), consumerGroup),
 		fieldResult: &sqltypes.Result{
 			Fields: table.MessageInfo.Fields,
 		},
@@ -234,6 +287,7 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
+		maxRetries:      int64(table.MessageInfo.MaxRetries),
 		batchSize:       table.MessageInfo.BatchSize,
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
@@ -243,38 +297,143 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 	}
 	mm.cond.L = &mm.mu
 
+	timeNext, epoch, timeAcked := schema.MessageStateColumns(consumerGroup)
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	vsQuery := fmt.Sprintf("select priority, %s, %s, %s, %s from %v", timeNext, epoch, timeAcked, columnList, mm.name)
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
 			Filter: vsQuery,
 		}},
 	}
-	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+	// A message with a null time_next is due, unless it's acked or dead.
+	due := "(%s < %a or %s is null and %s is null"
+	dueArgs := []interface{}{timeNext, ":time_next", timeNext, timeAcked}
+	if mm.maxRetries > 0 {
+		due += " and ifnull(%s, 0) <= %a"
+		dueArgs = append(dueArgs, epoch, ":max_retries")
+	}
+	due += ")"
+	if mm.orderingKey.IsEmpty() {
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, %s, %s, %s, %s from %v where "+due+" order by priority, %s desc limit %a",
+			append(append([]interface{}{timeNext, epoch, timeAcked, columnList, mm.name}, dueArgs...), timeNext, ":max")...)
+	} else {
+		// Only read messages that have no earlier pending message with the same key.
+		// An earlier message is pending unless it's acked or dead.
+		pending := "earlier.%s is null"
+		pendingArgs := []interface{}{timeAcked}
+		if mm.maxRetries > 0 {
+			pending += " and (earlier.%s is not null or ifnull(earlier.%s, 0) <= %a)"
+			pendingArgs = append(pendingArgs, timeNext, epoch, ":max_retries")
+		}
+		args := append([]interface{}{timeNext, epoch, timeAcked, columnList, mm.name}, dueArgs...)
+		args = append(args, mm.name, mm.orderingKey, mm.name, mm.orderingKey)
+		args = append(args, pendingArgs...)
+		args = append(args, mm.name, timeNext, ":max")
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, %s, %s, %s, %s from %v where "+due+" and not exists (select 1 from %v as earlier where earlier.%v = %v.%v and "+pending+" and earlier.id < %v.id) order by priority, %s desc limit %a",
+			args...)
+	}
 	mm.ackQuery = sqlparser.BuildParsedQuery(
-		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
-		mm.name, ":time_acked", "::ids")
-	mm.purgeQuery = sqlparser.BuildParsedQuery(
-		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+		"update %v set %s = %a, %s = null where id in %a and %s is null",
+		mm.name, timeAcked, ":time_acked", timeNext, "::ids", timeAcked)
+	if consumerGroup == "" {
+		// Rows are purged by the default consumer group, once acked by all consumer groups.
+		mm.purgeQuery = buildPurgeQuery(mm.name, table.MessageInfo.ConsumerGroups)
+	}
 
-	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
+	mm.postponeQuery = buildPostponeQuery(mm.name, consumerGroup, mm.minBackoff, mm.maxBackoff)
+	mm.deadLetterQueries = buildDeadLetterQueries(mm.name, consumerGroup, table.MessageInfo.ConsumerGroups, table.MessageInfo.DeadLetterTable)
+	if consumerGroup == "" && len(table.MessageInfo.ConsumerGroups) != 0 && !table.MessageInfo.DeadLetterTable.IsEmpty() && mm.maxRetries > 0 {
+		cond, args := buildDeadForAllGroupsCondition(table.MessageInfo.ConsumerGroups)
+		mm.readDeadForAllGroups = sqlparser.BuildParsedQuery(
+			"select id from %v where time_next is null and "+cond+" limit 500",
+			append([]interface{}{mm.name}, args...)...)
+	}
 
 	return mm
 }
 
-func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.Duration) *sqlparser.ParsedQuery {
+// buildPurgeQuery builds the query that purges messages acked by the default consumer
+// group as well as by all the named consumer groups.
+func buildPurgeQuery(name sqlparser.TableIdent, consumerGroups []string) *sqlparser.ParsedQuery {
+	buf := bytes.NewBufferString("delete from %v where time_acked < %a")
+	args := []interface{}{name, ":time_acked"}
+	for _, consumerGroup := range consumerGroups {
+		_, _, timeAcked := schema.MessageStateColumns(consumerGroup)
+		buf.WriteString(" and %s < %a")
+		args = append(args, timeAcked, ":time_acked")
+	}
+	buf.WriteString(" limit 500")
+	return sqlparser.BuildParsedQuery(buf.String(), args...)
+}
+
+// buildDeadLetterQueries builds the queries that get rid of dead messages. The messages
+// are moved to the dead letter table, if specified. Otherwise, their time_next is set to
+// null, which prevents them from being sent again.
+// If the table has consumer groups, the time_next of the consumer group is set to null,
+// and the default consumer group also moves the messages that every consumer group is
+// done with to the dead letter table.
+func buildDeadLetterQueries(name sqlparser.TableIdent, consumerGroup string, consumerGroups []string, deadLetterTable sqlparser.TableIdent) []*sqlparser.ParsedQuery {
+	timeNext, epoch, timeAcked := schema.MessageStateColumns(consumerGroup)
+	if len(consumerGroups) == 0 && !deadLetterTable.IsEmpty() {
+		return []*sqlparser.ParsedQuery{
+			sqlparser.BuildParsedQuery(
+				"insert into %v select * from %v where id in %a and %s is null and %s > %a",
+				deadLetterTable, name, "::ids", timeAcked, epoch, ":max_retries"),
+			sqlparser.BuildParsedQuery(
+				"delete from %v where id in %a and %s is null and %s > %a",
+				name, "::ids", timeAcked, epoch, ":max_retries"),
+		}
+	}
+	queries := []*sqlparser.ParsedQuery{
+		sqlparser.BuildParsedQuery(
+			"update %v set %s = null where id in %a and %s is null and %s > %a",
+			name, timeNext, "::ids", timeAcked, epoch, ":max_retries"),
+	}
+	if consumerGroup != "" || deadLetterTable.IsEmpty() {
+		return queries
+	}
+	cond, args := buildDeadForAllGroupsCondition(consumerGroups)
+	return append(queries,
+		sqlparser.BuildParsedQuery(
+			"insert into %v select * from %v where id in %a and "+cond,
+			append([]interface{}{deadLetterTable, name, "::ids"}, args...)...),
+		sqlparser.BuildParsedQuery(
+			"delete from %v where id in %a and "+cond,
+			append([]interface{}{name, "::ids"}, args...)...),
+	)
+}
+
+// buildDeadForAllGroupsCondition builds the condition that matches the messages that every
+// consumer group, including the default one, either acked or marked dead, and that at least
+// one consumer group marked dead.
+func buildDeadForAllGroupsCondition(consumerGroups []string) (string, []interface{}) {
+	var done, dead []string
+	var doneArgs, deadArgs []interface{}
+	for _, consumerGroup := range append([]string{""}, consumerGroups...) {
+		timeNext, epoch, timeAcked := schema.MessageStateColumns(consumerGroup)
+		done = append(done, "(%s is not null or %s is null and %s > %a)")
+		doneArgs = append(doneArgs, timeAcked, timeNext, epoch, ":max_retries")
+		dead = append(dead, "%s is null and %s is null and %s > %a")
+		deadArgs = append(deadArgs, timeAcked, timeNext, epoch, ":max_retries")
+	}
+	cond := strings.Join(done, " and ") + " and (" + strings.Join(dead, " or ") + ")"
+	return cond, append(doneArgs, deadArgs...)
+}
+
+func buildPostponeQuery(name sqlparser.TableIdent, consumerGroup string, minBackoff, maxBackoff time.Duration) *sqlparser.ParsedQuery {
 	var args []interface{}
+	timeNext, epoch, timeAcked := schema.MessageStateColumns(consumerGroup)
 
 	// since messages are immediately postponed upon sending, we need to add exponential backoff on top
 	// of the ackWaitTime, otherwise messages will be resent too quickly.
-	buf := bytes.NewBufferString("update %v set time_next = %a + %a + ")
+	buf := bytes.NewBufferString(fmt.Sprintf("update %%v set %s = %%a + %%a + ", timeNext))
 	args = append(args, name, ":time_now", ":wait_time")
 
 	// have backoff be +/- 33%, whenever this is injected, append (:min_backoff, :jitter)
-	jitteredBackoff := "FLOOR((%a<<ifnull(epoch, 0)) * %a)"
+	jitteredBackoff := fmt.Sprintf("FLOOR((%%a<<ifnull(%s, 0)) * %%a)", epoch)
 
 	//
 	// if the jittered backoff is less than min_backoff, just set it to :min_backoff
@@ -305,7 +464,7 @@ func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.D
 	buf.WriteString(")")
 
 	// now that we've identified time_next, finish the statement
-	buf.WriteString(", epoch = ifnull(epoch, 0)+1 where id in %a and time_acked is null")
+	buf.WriteString(fmt.Sprintf(", %s = ifnull(%s, 0)+1 where id in %%a and %s is null", epoch, epoch, timeAcked))
 	args = append(args, "::ids")
 
 	return sqlparser.BuildParsedQuery(buf.String(), args...)
@@ -340,7 +499,9 @@ func (mm *messageManager) Open() {
 	go mm.runSend() // calls the offsetting mm.wg.Done()
 	// TODO(sougou): improve ticks to add randomness.
 	mm.pollerTicks.Start(mm.runPoller)
-	mm.purgeTicks.Start(mm.runPurge)
+	if mm.purgeQuery != nil {
+		mm.purgeTicks.Start(mm.runPurge)
+	}
 }
 
 // Close stops the messageManager service.
@@ -358,7 +519,7 @@ func (mm *messageManager) Close() {
 		rcvr.receiver.cancel()
 	}
 	mm.receivers = nil
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, 0)
+	MessageStats.Set([]string{mm.streamName, "ClientCount"}, 0)
 	mm.cache.Clear()
 	// This broadcast will cause runSend to exit.
 	mm.cond.Broadcast()
@@ -395,7 +556,7 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 		mm.startVStream()
 	}
 	mm.receivers = append(mm.receivers, withStatus)
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+	MessageStats.Set([]string{mm.streamName, "ClientCount"}, int64(len(mm.receivers)))
 	if mm.curReceiver == -1 {
 		mm.rescanReceivers(-1)
 	}
@@ -419,7 +580,7 @@ func (mm *messageManager) unsubscribe(receiver *messageReceiver) {
 		n := len(mm.receivers)
 		copy(mm.receivers[i:n-1], mm.receivers[i+1:n])
 		mm.receivers = mm.receivers[0 : n-1]
-		MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+		MessageStats.Set([]string{mm.streamName, "ClientCount"}, int64(len(mm.receivers)))
 		break
 	}
 	// curReceiver is obsolete. Recompute.
@@ -518,24 +679,33 @@ func (mm *messageManager) runSend() {
 
 			// Fetch rows from cache.
 			lateCount := int64(0)
+			var deadIDs []string
 			for i := 0; i < mm.batchSize; i++ {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
 				}
+				if mm.maxRetries > 0 && mr.Epoch > mm.maxRetries {
+					deadIDs = append(deadIDs, mr.Row[0].ToString())
+					continue
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
 				rows = append(rows, mr.Row)
 			}
-			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
+			MessageStats.Add([]string{mm.streamName, "Delayed"}, lateCount)
+			if deadIDs != nil {
+				mm.wg.Add(1)
+				go mm.deadLetter(deadIDs) // calls the offsetting mm.wg.Done()
+			}
 
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
 				break
 			}
 		}
-		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
+		MessageStats.Add([]string{mm.streamName, "Sent"}, int64(len(rows)))
 		// If we're here, there is a current receiver, and messages
 		// to send. Reserve the receiver and find the next one.
 		receiver := mm.receivers[mm.curReceiver]
@@ -601,10 +771,42 @@ func (mm *messageManager) postpone(tsv TabletService, ackWaitTime time.Duration,
 	defer cancel()
 	if _, err := tsv.PostponeMessages(ctx, nil, mm, ids); err != nil {
 		// This can happen during spikes. Record the incident for monitoring.
-		MessageStats.Add([]string{mm.name.String(), "PostponeFailed"}, 1)
+		MessageStats.Add([]string{mm.streamName, "PostponeFailed"}, 1)
 	}
 }
 
+// deadLetter gets rid of messages that exceeded the max retries.
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	defer func() {
+		// Hold streamMu for the same reason as in send.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	// Use the semaphore to limit parallelism.
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
+	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm, ids)
+	if err != nil {
+		// The messages remain due, and will be dead-lettered again once reloaded.
+		MessageStats.Add([]string{mm.streamName, "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to dead letter messages: %v", err)
+		return
+	}
+	MessageStats.Add([]string{mm.streamName, "DeadLettered"}, count)
+}
+
 func (mm *messageManager) startVStream() {
 	mm.streamMu.Lock()
 	defer mm.streamMu.Unlock()
@@ -634,7 +836,7 @@ func (mm *messageManager) runVStream(ctx context.Context) {
 			return
 		default:
 		}
-		MessageStats.Add([]string{mm.name.String(), "VStreamFailed"}, 1)
+		MessageStats.Add([]string{mm.streamName, "VStreamFailed"}, 1)
 		log.Infof("VStream ended: %v, retrying in 5 seconds", err)
 		time.Sleep(5 * time.Second)
 	}
@@ -717,9 +919,13 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		return fmt.Errorf("internal error: unexpected rows without fields")
 	}
 
+	ordered := !mm.orderingKey.IsEmpty()
+	mustPoll := false
 	now := time.Now().UnixNano()
 	for _, rc := range rowEvent.RowChanges {
 		if rc.After == nil {
+			// A deleted message is no longer pending.
+			mustPoll = mustPoll || ordered
 			continue
 		}
 		row := sqltypes.MakeRowTrusted(fields, rc.After)
@@ -727,11 +933,29 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if err != nil {
 			return err
 		}
-		if mr.TimeAcked != 0 || mr.TimeNext > now {
+		// A message with a null time_next is due right away, unless
+		// it's acked or dead.
+		if mr.TimeAcked != 0 || mm.maxRetries > 0 && mr.Epoch > mm.maxRetries {
+			// The next message with the same key may now be sent.
+			mustPoll = mustPoll || ordered
+			continue
+		}
+		if mr.TimeNext > now {
+			continue
+		}
+		if ordered {
+			// Only the poller knows if there's an earlier pending message
+			// with the same key.
+			mustPoll = true
 			continue
 		}
 		mm.Add(mr)
 	}
+	if mustPoll {
+		// The poller needs streamMu, which the caller holds. So,
+		// trigger it asynchronously.
+		go mm.pollerTicks.Trigger()
+	}
 	return nil
 }
 
@@ -752,8 +976,9 @@ func (mm *messageManager) runPoller() {
 
 	size := mm.cache.Size()
 	bindVars := map[string]*querypb.BindVariable{
-		"time_next": sqltypes.Int64BindVariable(time.Now().UnixNano()),
-		"max":       sqltypes.Int64BindVariable(int64(size)),
+		"time_next":   sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"max":         sqltypes.Int64BindVariable(int64(size)),
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
 	}
 	qr, err := mm.readPending(ctx, bindVars)
 	if err != nil {
@@ -801,19 +1026,61 @@ func (mm *messageManager) runPurge() {
 		for {
 			count, err := mm.tsv.PurgeMessages(ctx, nil, mm, time.Now().Add(-mm.purgeAfter).UnixNano())
 			if err != nil {
-				MessageStats.Add([]string{mm.name.String(), "PurgeFailed"}, 1)
+				MessageStats.Add([]string{mm.streamName, "PurgeFailed"}, 1)
 				log.Errorf("Unable to delete messages: %v", err)
 			} else {
-				MessageStats.Add([]string{mm.name.String(), "Purged"}, count)
+				MessageStats.Add([]string{mm.streamName, "Purged"}, count)
 			}
 			// If deleted 500 or more, we should continue.
 			if count < 500 {
-				return
+				break
 			}
 		}
+		if mm.readDeadForAllGroups != nil {
+			mm.deadLetterForAllGroups(ctx)
+		}
 	}()
 }
 
+// deadLetterForAllGroups moves the messages that every consumer group is done with, and
+// that some consumer group marked dead, to the dead letter table.
+func (mm *messageManager) deadLetterForAllGroups(ctx context.Context) {
+	query, err := mm.readDeadForAllGroups.GenerateQuery(map[string]*querypb.BindVariable{
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
+	}, nil)
+	if err != nil {
+		mm.tsv.Stats().InternalErrors.Add("Messages", 1)
+		log.Errorf("Error reading dead messages: %v", err)
+		return
+	}
+	var fields []*querypb.Field
+	var ids []string
+	err = mm.vs.StreamResults(ctx, query, func(response *binlogdatapb.VStreamResultsResponse) error {
+		if response.Fields != nil {
+			fields = response.Fields
+		}
+		for _, row := range response.Rows {
+			ids = append(ids, sqltypes.MakeRowTrusted(fields, row)[0].ToString())
+		}
+		return nil
+	})
+	if err != nil {
+		MessageStats.Add([]string{mm.streamName, "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to read dead messages: %v", err)
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm, ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.streamName, "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to dead letter messages: %v", err)
+		return
+	}
+	MessageStats.Add([]string{mm.streamName, "DeadLettered"}, count)
+}
+
 // GenerateAckQuery returns the query and bind vars for acking a message.
 func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	idbvs := &querypb.BindVariable{
@@ -867,6 +1134,28 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for getting rid of dead messages.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) ([]string, map[string]*querypb.BindVariable) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	queries := make([]string, 0, len(mm.deadLetterQueries))
+	for _, query := range mm.deadLetterQueries {
+		queries = append(queries, query.Query)
+	}
+	return queries, map[string]*querypb.BindVariable{
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
+		"ids":         idbvs,
+	}
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
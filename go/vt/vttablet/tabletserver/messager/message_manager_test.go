@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
@@ -486,6 +487,70 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	}
 }
 
+func TestMessageManagerStreamerNullTimeNext(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxRetries = 3
+	nullTimeNextRow := func(id, epoch int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.NULL,
+			sqltypes.NewInt64(epoch),
+			sqltypes.NULL,
+			sqltypes.NewInt64(id),
+			sqltypes.NewVarBinary(fmt.Sprintf("%v", id)),
+		})
+	}
+	fvs := newFakeVStreamer()
+	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}, {
+		Type: binlogdatapb.VEventType_OTHER,
+	}}, {{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "foo",
+			Fields:    testDBFields,
+		},
+	}}, {{
+		// A message inserted without a time_next is delivered,
+		// but a dead message is not.
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "foo",
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: nullTimeNextRow(1, 4),
+			}, {
+				After: nullTimeNextRow(2, 0),
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-101",
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}})
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarBinary("2"),
+	}}
+	qr := <-r1.ch
+	assert.Equal(t, want, qr.Rows)
+	select {
+	case qr := <-r1.ch:
+		t.Errorf("Expecting no value, got: %v", qr)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 func TestMessageManagerStreamerAndPoller(t *testing.T) {
 	fvs := newFakeVStreamer()
 	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
@@ -639,6 +704,117 @@ func TestMessageManagerPoller(t *testing.T) {
 	}
 }
 
+func TestMessageManagerOrdered(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	ti.MessageInfo.OrderingKey = sqlparser.NewColIdent("message")
+	fvs := newFakeVStreamer()
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+	// Wait for the initial poll, which finds nothing.
+	for fvs.resultsInvocations.Get() == 0 {
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Message 1 is inserted, but the database says that message 2 is the
+	// one that's next in line.
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+		Rows:   []*querypb.Row{newMMRow(2)},
+	}})
+	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "foo",
+			Fields:    testDBFields,
+		},
+	}, {
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "foo",
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: newMMRow(1),
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}})
+
+	// The row event must trigger the poller instead of sending message 1.
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(2),
+			sqltypes.NewVarBinary("2"),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+}
+
+func TestMessageManagerDeadLetter(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.BatchSize = 2
+	ti.MessageInfo.PollInterval = 20 * time.Second
+	ti.MessageInfo.MaxRetries = 3
+	deadRow := func(id int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(4),
+			sqltypes.NULL,
+			sqltypes.NewInt64(id),
+			sqltypes.NewVarBinary(fmt.Sprintf("%v", id)),
+		})
+	}
+	fvs := newFakeVStreamer()
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}, {
+		Rows: []*querypb.Row{
+			deadRow(1),
+			newMMRow(2),
+			deadRow(3),
+		},
+	}})
+	tsv := newFakeTabletServer()
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	mm := newMessageManager(tsv, fvs, ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	// Only the live message is sent.
+	qr := <-r1.ch
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarBinary("2"),
+	}}
+	assert.Equal(t, want, qr.Rows)
+
+	// Both dead messages are dead-lettered, possibly in a single batch.
+	for tsv.deadLetterCount.Get() < 2 {
+		<-ch
+	}
+	assert.EqualValues(t, 2, tsv.deadLetterCount.Get())
+	select {
+	case qr := <-r1.ch:
+		t.Errorf("Expecting no value, got: %v", qr)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 // TestMessagesPending1 tests for the case where you can't
 // add items because the cache is full.
 func TestMessagesPending1(t *testing.T) {
@@ -789,6 +965,132 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGenerateDeadLetter(t *testing.T) {
+	wantids := sqltypes.TestBindVariable([]interface{}{[]byte{'1'}, []byte{'2'}})
+	wantbv := map[string]*querypb.BindVariable{
+		"max_retries": sqltypes.Int64BindVariable(5),
+		"ids":         wantids,
+	}
+
+	ti := newMMTable()
+	ti.MessageInfo.MaxRetries = 5
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	queries, bv := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	assert.Equal(t, []string{
+		"update foo set time_next = null where id in ::ids and time_acked is null and epoch > :max_retries",
+	}, queries)
+	utils.MustMatch(t, wantbv, bv, "did not match")
+
+	ti.MessageInfo.DeadLetterTable = sqlparser.NewTableIdent("foo_dead")
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	queries, bv = mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	assert.Equal(t, []string{
+		"insert into foo_dead select * from foo where id in ::ids and time_acked is null and epoch > :max_retries",
+		"delete from foo where id in ::ids and time_acked is null and epoch > :max_retries",
+	}, queries)
+	utils.MustMatch(t, wantbv, bv, "did not match")
+}
+
+func TestMMGenerateConsumerGroup(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.ConsumerGroups = []string{"g1", "g2"}
+	ti.MessageInfo.DeadLetterTable = sqlparser.NewTableIdent("foo_dead")
+	mm := newConsumerGroupManager(newFakeTabletServer(), newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+	assert.Equal(t, "foo@g1", mm.streamName)
+	assert.Equal(t, "select priority, time_next_g1, epoch_g1, time_acked_g1, id, message from foo", mm.vsFilter.Rules[0].Filter)
+	assert.Equal(t, "select priority, time_next_g1, epoch_g1, time_acked_g1, id, message from foo where (time_next_g1 < :time_next or time_next_g1 is null and time_acked_g1 is null) order by priority, time_next_g1 desc limit :max", mm.readByPriorityAndTimeNext.Query)
+
+	query, _ := mm.GenerateAckQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_acked_g1 = :time_acked, time_next_g1 = null where id in ::ids and time_acked_g1 is null", query)
+	query, _ = mm.GeneratePostponeQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_next_g1 = :time_now + :wait_time + IF(FLOOR((:min_backoff<<ifnull(epoch_g1, 0)) * :jitter) < :min_backoff, :min_backoff, FLOOR((:min_backoff<<ifnull(epoch_g1, 0)) * :jitter)), epoch_g1 = ifnull(epoch_g1, 0)+1 where id in ::ids and time_acked_g1 is null", query)
+	// Messages of consumer groups are never moved to the dead letter table
+	queries, _ := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	assert.Equal(t, []string{"update foo set time_next_g1 = null where id in ::ids and time_acked_g1 is null and epoch_g1 > :max_retries"}, queries)
+	// Only the default consumer group purges
+	assert.Nil(t, mm.purgeQuery)
+
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	query, _ = mm.GeneratePurgeQuery(3)
+	assert.Equal(t, "delete from foo where time_acked < :time_acked and time_acked_g1 < :time_acked and time_acked_g2 < :time_acked limit 500", query)
+	// The default consumer group only moves a message to the dead letter table once every
+	// consumer group acked it or marked it dead.
+	allDone := "(time_acked is not null or time_next is null and epoch > :max_retries) and " +
+		"(time_acked_g1 is not null or time_next_g1 is null and epoch_g1 > :max_retries) and " +
+		"(time_acked_g2 is not null or time_next_g2 is null and epoch_g2 > :max_retries) and " +
+		"(time_acked is null and time_next is null and epoch > :max_retries or " +
+		"time_acked_g1 is null and time_next_g1 is null and epoch_g1 > :max_retries or " +
+		"time_acked_g2 is null and time_next_g2 is null and epoch_g2 > :max_retries)"
+	queries, _ = mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	assert.Equal(t, []string{
+		"update foo set time_next = null where id in ::ids and time_acked is null and epoch > :max_retries",
+		"insert into foo_dead select * from foo where id in ::ids and " + allDone,
+		"delete from foo where id in ::ids and " + allDone,
+	}, queries)
+	// Without max retries, no message is ever dead.
+	assert.Nil(t, mm.readDeadForAllGroups)
+
+	ti.MessageInfo.MaxRetries = 3
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	assert.Equal(t, "select id from foo where time_next is null and "+allDone+" limit 500", mm.readDeadForAllGroups.Query)
+	// The queries must be parsable.
+	for _, query := range append(mm.deadLetterQueries, mm.readDeadForAllGroups) {
+		_, err := sqlparser.Parse(query.Query)
+		require.NoError(t, err)
+	}
+}
+
+func TestMessageManagerDeadLetterForAllGroups(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.ConsumerGroups = []string{"g1"}
+	ti.MessageInfo.DeadLetterTable = sqlparser.NewTableIdent("foo_dead")
+	ti.MessageInfo.MaxRetries = 3
+	fts := newFakeTabletServer()
+	fvs := newFakeVStreamer()
+	mm := newMessageManager(fts, fvs, ti, sync2.NewSemaphore(1, 0))
+
+	// No message is done for all consumer groups.
+	mm.deadLetterForAllGroups(context.Background())
+	assert.EqualValues(t, 1, fvs.resultsInvocations.Get())
+	assert.EqualValues(t, 0, fts.deadLetterCount.Get())
+
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		Rows:   []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})},
+	}, {
+		Rows: []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2)})},
+	}})
+	mm.deadLetterForAllGroups(context.Background())
+	assert.EqualValues(t, 2, fts.deadLetterCount.Get())
+
+	// A named consumer group never moves messages to the dead letter table.
+	mm = newConsumerGroupManager(fts, fvs, ti, "g1", sync2.NewSemaphore(1, 0))
+	assert.Nil(t, mm.readDeadForAllGroups)
+}
+
+func TestMMGenerateOrdered(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.OrderingKey = sqlparser.NewColIdent("message")
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	wantQuery := "select priority, time_next, epoch, time_acked, id, message from foo where (time_next < :time_next or time_next is null and time_acked is null) and not exists (select 1 from foo as earlier where earlier.message = foo.message and earlier.time_acked is null and earlier.id < foo.id) order by priority, time_next desc limit :max"
+	assert.Equal(t, wantQuery, mm.readByPriorityAndTimeNext.Query)
+	// The query must be parsable by the vstreamer.
+	_, err := sqlparser.Parse(wantQuery)
+	require.NoError(t, err)
+
+	// Dead messages are neither due nor pending.
+	ti.MessageInfo.MaxRetries = 3
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	wantQuery = "select priority, time_next, epoch, time_acked, id, message from foo where (time_next < :time_next or time_next is null and time_acked is null and ifnull(epoch, 0) <= :max_retries) and not exists (select 1 from foo as earlier where earlier.message = foo.message and earlier.time_acked is null and (earlier.time_next is not null or ifnull(earlier.epoch, 0) <= :max_retries) and earlier.id < foo.id) order by priority, time_next desc limit :max"
+	assert.Equal(t, wantQuery, mm.readByPriorityAndTimeNext.Query)
+	_, err = sqlparser.Parse(wantQuery)
+	require.NoError(t, err)
+	ti.MessageInfo.MaxRetries = 0
+
+	mm = newConsumerGroupManager(newFakeTabletServer(), newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+	assert.Equal(t, "select priority, time_next_g1, epoch_g1, time_acked_g1, id, message from foo where (time_next_g1 < :time_next or time_next_g1 is null and time_acked_g1 is null) and not exists (select 1 from foo as earlier where earlier.message = foo.message and earlier.time_acked_g1 is null and earlier.id < foo.id) order by priority, time_next_g1 desc limit :max", mm.readByPriorityAndTimeNext.Query)
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -826,8 +1128,9 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 
 type fakeTabletServer struct {
 	tabletenv.Env
-	postponeCount sync2.AtomicInt64
-	purgeCount    sync2.AtomicInt64
+	postponeCount   sync2.AtomicInt64
+	purgeCount      sync2.AtomicInt64
+	deadLetterCount sync2.AtomicInt64
 
 	mu sync.Mutex
 	ch chan string
@@ -870,11 +1173,23 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, gen QueryGenerator, ids []string) (count int64, err error) {
+	fts.deadLetterCount.Add(int64(len(ids)))
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
 type fakeVStreamer struct {
-	streamInvocations sync2.AtomicInt64
-	mu                sync.Mutex
-	streamerResponse  [][]*binlogdatapb.VEvent
-	pollerResponse    []*binlogdatapb.VStreamResultsResponse
+	streamInvocations  sync2.AtomicInt64
+	resultsInvocations sync2.AtomicInt64
+	mu                 sync.Mutex
+	streamerResponse   [][]*binlogdatapb.VEvent
+	pollerResponse     []*binlogdatapb.VStreamResultsResponse
 }
 
 func newFakeVStreamer() *fakeVStreamer { return &fakeVStreamer{} }
@@ -916,6 +1231,7 @@ func (fv *fakeVStreamer) Stream(ctx context.Context, startPos string, tablePKs [
 func (fv *fakeVStreamer) StreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
 	fv.mu.Lock()
 	defer fv.mu.Unlock()
+	defer fv.resultsInvocations.Add(1)
 	for _, r := range fv.pollerResponse {
 		if err := send(r); err != nil {
 			return err
@@ -25,7 +25,7 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(176)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -34,6 +34,17 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field DeadLetterTable vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.DeadLetterTable.CachedSize(false)
+	// field ConsumerGroups []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ConsumerGroups)) * int64(16))
+		for _, elem := range cached.ConsumerGroups {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field OrderingKey vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.OrderingKey.CachedSize(false)
 	return size
 }
 func (cached *Table) CachedSize(alloc bool) int64 {
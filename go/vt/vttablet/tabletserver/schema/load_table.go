@@ -18,6 +18,7 @@ package schema
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,8 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
+var consumerGroupRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
 // LoadTable creates a Table from the schema info in the database.
 func LoadTable(conn *connpool.DBConn, tableName string, comment string) (*Table, error) {
 	ta := NewTable(tableName)
@@ -110,6 +113,47 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	// vt_max_retries is optional. Without it, messages are retried forever
+	if _, ok := keyvals["vt_max_retries"]; ok {
+		if ta.MessageInfo.MaxRetries, err = getNum(keyvals, "vt_max_retries"); err != nil {
+			return err
+		}
+	}
+	if deadLetterTable := keyvals["vt_dead_letter_table"]; deadLetterTable != "" {
+		if ta.MessageInfo.MaxRetries == 0 {
+			return fmt.Errorf("vt_dead_letter_table requires vt_max_retries for message table: %s", ta.Name.String())
+		}
+		ta.MessageInfo.DeadLetterTable = sqlparser.NewTableIdent(deadLetterTable)
+	}
+
+	// vt_consumer_groups is optional. Each consumer group keeps its own
+	// delivery state in its own columns.
+	if consumerGroups := keyvals["vt_consumer_groups"]; consumerGroups != "" {
+		for _, group := range strings.Split(consumerGroups, ";") {
+			if !consumerGroupRegexp.MatchString(group) {
+				return fmt.Errorf("invalid consumer group '%s' for message table: %s", group, ta.Name.String())
+			}
+			ta.MessageInfo.ConsumerGroups = append(ta.MessageInfo.ConsumerGroups, group)
+			timeNext, epoch, timeAcked := MessageStateColumns(group)
+			for _, col := range []string{timeNext, epoch, timeAcked} {
+				if _, ok := hiddenCols[strings.ToLower(col)]; ok {
+					return fmt.Errorf("duplicate consumer group '%s' for message table: %s", group, ta.Name.String())
+				}
+				hiddenCols[strings.ToLower(col)] = struct{}{}
+				requiredCols = append(requiredCols, col)
+			}
+		}
+	}
+
+	// vt_ordering_key is optional. It must name a user-defined column.
+	if orderingKey := keyvals["vt_ordering_key"]; orderingKey != "" {
+		if _, ok := hiddenCols[strings.ToLower(orderingKey)]; ok || strings.EqualFold(orderingKey, "id") {
+			return fmt.Errorf("invalid ordering key '%s' for message table: %s", orderingKey, ta.Name.String())
+		}
+		ta.MessageInfo.OrderingKey = sqlparser.NewColIdent(orderingKey)
+		requiredCols = append(requiredCols, orderingKey)
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
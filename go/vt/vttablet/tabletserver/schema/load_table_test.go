@@ -141,6 +141,20 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max retries and dead letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_retries=5,vt_dead_letter_table=test_dead", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxRetries = 5
+	want.MessageInfo.DeadLetterTable = sqlparser.NewTableIdent("test_dead")
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_retries=a", db)
+	require.Error(t, err)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_dead_letter_table=test_dead", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vt_dead_letter_table requires vt_max_retries")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -158,6 +172,62 @@ func TestLoadTableMessage(t *testing.T) {
 	}
 }
 
+func TestLoadTableMessageConsumerGroups(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "priority", Type: sqltypes.Int64},
+			{Name: "time_next", Type: sqltypes.Int64},
+			{Name: "epoch", Type: sqltypes.Int64},
+			{Name: "time_acked", Type: sqltypes.Int64},
+			{Name: "time_next_billing", Type: sqltypes.Int64},
+			{Name: "epoch_billing", Type: sqltypes.Int64},
+			{Name: "time_acked_billing", Type: sqltypes.Int64},
+			{Name: "message", Type: sqltypes.VarBinary},
+		},
+	})
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=billing", db)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"billing"}, table.MessageInfo.ConsumerGroups)
+	assert.Equal(t, []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "message", Type: sqltypes.VarBinary},
+	}, table.MessageInfo.Fields)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=billing;audit", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "time_next_audit missing from message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=billing;billing", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate consumer group 'billing'")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=bill-ing", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid consumer group 'bill-ing'")
+}
+
+func TestLoadTableMessageOrderingKey(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_ordering_key=message", db)
+	require.NoError(t, err)
+	assert.Equal(t, "message", table.MessageInfo.OrderingKey.String())
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_ordering_key=entity", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity missing from message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_ordering_key=time_next", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ordering key 'time_next'")
+}
+
 func newTestLoadTable(tableType string, comment string, db *fakesqldb.DB) (*Table, error) {
 	ctx := context.Background()
 	appParams := db.ConnParams()
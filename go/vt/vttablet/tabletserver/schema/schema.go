@@ -104,6 +104,35 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxRetries specifies how many times a message is resent
+	// before it's considered dead. Zero means no limit.
+	MaxRetries int
+
+	// DeadLetterTable specifies the table into which dead
+	// messages are moved. If empty, dead messages are kept
+	// in the message table, and are never sent again.
+	DeadLetterTable sqlparser.TableIdent
+
+	// ConsumerGroups lists the named consumer groups of the
+	// table. Each group receives every message, and keeps
+	// its own delivery state. See MessageStateColumns.
+	ConsumerGroups []string
+
+	// OrderingKey specifies the column by which messages are
+	// ordered. If set, a message is not sent while an earlier
+	// message with the same key is pending.
+	OrderingKey sqlparser.ColIdent
+}
+
+// MessageStateColumns returns the names of the columns that hold the
+// delivery state of messages for the given consumer group. The default
+// consumer group, named "", uses time_next, epoch and time_acked.
+func MessageStateColumns(consumerGroup string) (timeNext, epoch, timeAcked string) {
+	if consumerGroup == "" {
+		return "time_next", "epoch", "time_acked"
+	}
+	return "time_next_" + consumerGroup, "epoch_" + consumerGroup, "time_acked_" + consumerGroup
 }
 
 // NewTable creates a new Table.
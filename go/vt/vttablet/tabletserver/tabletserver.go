@@ -1007,14 +1007,32 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages gets rid of messages that exceeded the max retries of a given message table.
+// It returns the number of messages successfully dead-lettered.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, querygen messager.QueryGenerator, ids []string) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		queries, bv := querygen.GenerateDeadLetterQueries(ids)
+		return queries, bv, nil
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		query, bv, err := queryGenerator()
+		return []string{query}, bv, err
+	})
+}
+
+// execDMLs executes the generated queries in a single transaction. It returns the number of
+// rows affected by the last query.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, bv, err := queryGenerator()
 	if err != nil {
 		return 0, err
 	}
@@ -1030,9 +1048,11 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
-	if err != nil {
-		return 0, err
+	qr := &sqltypes.Result{}
+	for _, query := range queries {
+		if qr, err = tsv.Execute(ctx, target, query, bv, transactionID, 0, nil); err != nil {
+			return 0, err
+		}
 	}
 	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
@@ -1625,6 +1645,14 @@ func (tsv *TabletServer) registerMigrationStatusHandler() {
 		}
 		w.Write([]byte("ok"))
 	})
+	tsv.exporter.HandleFunc("/schema-migration/report-cutover", func(w http.ResponseWriter, r *http.Request) {
+		ctx := tabletenv.LocalContext()
+		if err := tsv.onlineDDLExecutor.OnSchemaMigrationCutOver(ctx, r.URL.Query().Get("uuid")); err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
 }
 
 // registerThrottlerCheckHandlers registers throttler "check" requests
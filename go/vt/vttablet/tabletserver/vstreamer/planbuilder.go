@@ -22,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
 	"vitess.io/vitess/go/mysql/collations"
@@ -56,6 +58,11 @@ type Plan struct {
 	// Filters is the list of filters to be applied to the columns
 	// of the table.
 	Filters []Filter
+
+	// ChangeFilters is the list of conditions on the before and after
+	// images of a row change, which must all be true for the change to
+	// be sent. See imageLookup for how the images are evaluated.
+	ChangeFilters []evalengine.Expr
 }
 
 // Opcode enumerates the operators supported in a where clause
@@ -76,6 +83,8 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// IsNull is used to filter a column if it is null
+	IsNull
 )
 
 // Filter contains opcodes for filtering.
@@ -109,6 +118,11 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// ImageExpr, if set, is an expression over the before and after
+	// images of a row change. Its value is sent in both images.
+	// If so, ColNum is -1.
+	ImageExpr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -221,6 +235,10 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case IsNull:
+			if !values[filter.ColNum].IsNull() {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value, charsets[filter.ColNum])
 			if err != nil {
@@ -252,6 +270,66 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 	return true, nil
 }
 
+// hasImageExprs returns true if the plan has expressions over the before
+// and after images of row changes.
+func (plan *Plan) hasImageExprs() bool {
+	if len(plan.ChangeFilters) != 0 {
+		return true
+	}
+	for _, colExpr := range plan.ColExprs {
+		if colExpr.ImageExpr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// filterImages evaluates the expressions of the plan over the before and
+// after images of a row change. before and after are rows of the table,
+// and are nil if the change has no such image. It returns false if the
+// change does not match the change filters. Otherwise, the values of the
+// image expressions are set in beforeResult and afterResult, which are
+// the outputs of filter for each image, or nil if the image is not sent.
+func (plan *Plan) filterImages(before, after, beforeResult, afterResult []sqltypes.Value) (bool, error) {
+	if !plan.hasImageExprs() {
+		return true, nil
+	}
+	env := evalengine.EmptyExpressionEnv()
+	env.Row = make([]sqltypes.Value, 2*len(plan.Table.Fields))
+	copy(env.Row, before)
+	copy(env.Row[len(plan.Table.Fields):], after)
+	for _, filter := range plan.ChangeFilters {
+		result, err := env.Evaluate(filter)
+		if err != nil {
+			return false, err
+		}
+		// The filter is wrapped with "is true".
+		match, err := result.ToBooleanStrict()
+		if err != nil {
+			return false, err
+		}
+		if !match {
+			return false, nil
+		}
+	}
+	for i, colExpr := range plan.ColExprs {
+		if colExpr.ImageExpr == nil {
+			continue
+		}
+		result, err := env.Evaluate(colExpr.ImageExpr)
+		if err != nil {
+			return false, err
+		}
+		if beforeResult != nil {
+			beforeResult[i] = result.Value()
+		}
+		if afterResult != nil {
+			afterResult[i] = result.Value()
+		}
+	}
+	return true, nil
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int, fields []*querypb.Field) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -433,6 +511,13 @@ func buildTablePlan(ti *Table, vschema *localVSchema, query string) (*Plan, erro
 	return plan, nil
 }
 
+// BuildRowsPlan builds the plan with which VStreamRows streams the rows of the table selected
+// by the query. The vschema is the one of the keyspace of the table, which in_keyrange needs.
+// It lets the callers that generate the queries of a row stream verify that they are supported.
+func BuildRowsPlan(ti *Table, keyspace string, vschema *vindexes.VSchema, query string) (*Plan, error) {
+	return buildTablePlan(ti, &localVSchema{keyspace: keyspace, vschema: vschema}, query)
+}
+
 func analyzeSelect(query string) (sel *sqlparser.Select, fromTable sqlparser.TableIdent, err error) {
 	statement, err := sqlparser.Parse(query)
 	if err != nil {
@@ -480,6 +565,18 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	}
 	exprs := splitAndExpression(nil, where.Expr)
 	for _, expr := range exprs {
+		images, err := referencesImages(expr)
+		if err != nil {
+			return err
+		}
+		if images {
+			filter, err := plan.translateImageExpr(&sqlparser.IsExpr{Left: expr, Right: sqlparser.IsTrueOp})
+			if err != nil {
+				return err
+			}
+			plan.ChangeFilters = append(plan.ChangeFilters, filter)
+			continue
+		}
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
 			opcode, err := getOpcode(expr)
@@ -497,7 +594,7 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
+			val, ok := filterLiteral(expr.Right)
 			if !ok {
 				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
@@ -505,7 +602,7 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
 				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
-			pv, err := evalengine.Translate(val, semantics.EmptySemTable())
+			pv, err := evalengine.Translate(expr.Right, semantics.EmptySemTable())
 			if err != nil {
 				return err
 			}
@@ -519,6 +616,22 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 				ColNum: colnum,
 				Value:  resolved.Value(),
 			})
+		case *sqlparser.IsExpr:
+			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+			if !ok || expr.Right != sqlparser.IsNullOp {
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
+			if !qualifiedName.Qualifier.IsEmpty() {
+				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+			}
+			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			if err != nil {
+				return err
+			}
+			plan.Filters = append(plan.Filters, Filter{
+				Opcode: IsNull,
+				ColNum: colnum,
+			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
 				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
@@ -533,6 +646,17 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	return nil
 }
 
+// filterLiteral returns the literal of the value of a filter. A negative integer is
+// parsed as the negation of a literal, which is returned if it is an integer.
+func filterLiteral(expr sqlparser.Expr) (*sqlparser.Literal, bool) {
+	if unary, ok := expr.(*sqlparser.UnaryExpr); ok && unary.Operator == sqlparser.UMinusOp {
+		val, ok := unary.Expr.(*sqlparser.Literal)
+		return val, ok && val.Type == sqlparser.IntVal
+	}
+	val, ok := expr.(*sqlparser.Literal)
+	return val, ok
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -575,6 +699,13 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 	if !ok {
 		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(selExpr))
 	}
+	images, err := referencesImages(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	if images {
+		return plan.analyzeImageExpr(aliased)
+	}
 	switch inner := aliased.Expr.(type) {
 	case *sqlparser.ColName:
 		if !inner.Qualifier.IsEmpty() {
@@ -652,6 +783,132 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 	}
 }
 
+// analyzeImageExpr analyzes a select expression over the before and after
+// images of a row change, like "after.price - before.price as delta".
+// The expression must have an alias, which is the name of its column.
+func (plan *Plan) analyzeImageExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	if aliased.As.IsEmpty() {
+		return ColExpr{}, fmt.Errorf("expression needs an alias: %v", sqlparser.String(aliased.Expr))
+	}
+	expr, err := plan.translateImageExpr(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	var field *querypb.Field
+	if col, ok := aliased.Expr.(*sqlparser.ColName); ok {
+		colnum, err := findColumn(plan.Table, col.Name)
+		if err != nil {
+			return ColExpr{}, err
+		}
+		field = proto.Clone(plan.Table.Fields[colnum]).(*querypb.Field)
+	} else {
+		// The type of the expression is the type it has for non-null
+		// values of the columns.
+		env := evalengine.EmptyExpressionEnv()
+		env.Row = make([]sqltypes.Value, 0, 2*len(plan.Table.Fields))
+		for i := 0; i < 2; i++ {
+			for _, f := range plan.Table.Fields {
+				env.Row = append(env.Row, sqltypes.MakeTrusted(f.Type, nil))
+			}
+		}
+		typ, err := env.TypeOf(expr)
+		if err != nil {
+			return ColExpr{}, err
+		}
+		field = &querypb.Field{Type: typ}
+	}
+	field.Name = aliased.As.String()
+	return ColExpr{
+		ColNum:    -1,
+		Field:     field,
+		ImageExpr: expr,
+	}, nil
+}
+
+func (plan *Plan) translateImageExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	return evalengine.Translate(expr, &imageLookup{table: plan.Table})
+}
+
+// imageLookup resolves the columns of expressions over the before and
+// after images of a row change. Such columns are qualified with "before"
+// or "after", like "before.status". The expressions are evaluated with a
+// row that has the columns of the before image followed by the columns of
+// the after image. The columns of a missing image are NULL: the before
+// image of an insert, the after image of a delete, and the before image
+// of a row of the copy phase.
+type imageLookup struct {
+	table *Table
+}
+
+var _ evalengine.TranslationLookup = (*imageLookup)(nil)
+
+// ColumnLookup implements evalengine.TranslationLookup.
+func (il *imageLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	colnum, err := findColumn(il.table, col.Name)
+	if err != nil {
+		return 0, err
+	}
+	switch imageQualifier(col) {
+	case "before":
+		return colnum, nil
+	case "after":
+		return len(il.table.Fields) + colnum, nil
+	}
+	return 0, fmt.Errorf("column must be qualified with before or after: %v", sqlparser.String(col))
+}
+
+// CollationForExpr implements evalengine.TranslationLookup.
+func (il *imageLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return collations.Unknown
+	}
+	colnum, err := findColumn(il.table, col.Name)
+	if err != nil {
+		return collations.Unknown
+	}
+	return collations.ID(il.table.Fields[colnum].Charset)
+}
+
+// DefaultCollation implements evalengine.TranslationLookup.
+func (il *imageLookup) DefaultCollation() collations.ID {
+	return collations.Default()
+}
+
+// imageQualifier returns "before" or "after" if the column refers to an
+// image of a row change, and "" otherwise.
+func imageQualifier(col *sqlparser.ColName) string {
+	if !col.Qualifier.Qualifier.IsEmpty() {
+		return ""
+	}
+	switch qualifier := strings.ToLower(col.Qualifier.Name.String()); qualifier {
+	case "before", "after":
+		return qualifier
+	}
+	return ""
+}
+
+// referencesImages returns true if the expression refers to the before or
+// after images of a row change. Such an expression cannot refer to other
+// columns.
+func referencesImages(expr sqlparser.Expr) (bool, error) {
+	var images, others bool
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if imageQualifier(col) != "" {
+				images = true
+			} else {
+				others = true
+			}
+		}
+		return true, nil
+	}, expr)
+	if images && others {
+		return false, fmt.Errorf("all columns must be qualified with before or after: %v", sqlparser.String(expr))
+	}
+	return images, nil
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
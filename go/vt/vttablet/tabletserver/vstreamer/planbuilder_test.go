@@ -620,12 +620,24 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 		name:       "greater-than",
 		inFilter:   "select * from t1 where id >= 1",
 		outFilters: []Filter{{Opcode: GreaterThanEqual, ColNum: 0, Value: sqltypes.NewInt64(1)}},
+	}, {
+		name:       "negative",
+		inFilter:   "select * from t1 where id >= -4",
+		outFilters: []Filter{{Opcode: GreaterThanEqual, ColNum: 0, Value: sqltypes.NewInt64(-4)}},
 	}, {
 		name:     "less-than-with-and",
 		inFilter: "select * from t1 where id < 2 and val <= 'xyz'",
 		outFilters: []Filter{{Opcode: LessThan, ColNum: 0, Value: sqltypes.NewInt64(2)},
 			{Opcode: LessThanEqual, ColNum: 1, Value: sqltypes.NewVarChar("xyz")},
 		},
+	}, {
+		name:       "is-null",
+		inFilter:   "select * from t1 where val is null",
+		outFilters: []Filter{{Opcode: IsNull, ColNum: 1}},
+	}, {
+		name:     "is-not-null",
+		inFilter: "select * from t1 where val is not null",
+		outErr:   "unsupported constraint: val is not null",
 	}, {
 		name:     "vindex-and-operators",
 		inFilter: "select * from t1 where in_keyrange(id, 'hash', '-80') and id = 2 and val <> 'xyz'",
@@ -698,3 +710,100 @@ func TestCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanBuilderImageExprs(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.Int64,
+		}},
+	}
+	plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, before.val as old_val, after.val - before.val as delta from t1 where id > 1 and before.val != after.val",
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.ColExprs, 3)
+	assert.Equal(t, "old_val", plan.ColExprs[1].Field.Name)
+	assert.Equal(t, sqltypes.Int64, plan.ColExprs[1].Field.Type)
+	assert.Equal(t, "delta", plan.ColExprs[2].Field.Name)
+	assert.Equal(t, sqltypes.Int64, plan.ColExprs[2].Field.Type)
+	assert.Equal(t, []Filter{{Opcode: GreaterThan, ColNum: 0, Value: sqltypes.NewInt64(1)}}, plan.Filters)
+	require.Len(t, plan.ChangeFilters, 1)
+
+	row := func(id, val int64) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewInt64(val)}
+	}
+	testcases := []struct {
+		name                string
+		before, after       []sqltypes.Value
+		match               bool
+		outBefore, outAfter []sqltypes.Value
+	}{{
+		name:      "update",
+		before:    row(2, 10),
+		after:     row(2, 15),
+		match:     true,
+		outBefore: []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(10), sqltypes.NewInt64(5)},
+		outAfter:  []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(10), sqltypes.NewInt64(5)},
+	}, {
+		name:   "update without a change of val",
+		before: row(2, 10),
+		after:  row(2, 10),
+	}, {
+		// The before image of an insert is NULL.
+		name:  "insert",
+		after: row(2, 10),
+	}}
+	charsets := []collations.ID{collations.Unknown, collations.Unknown}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			var beforeResult, afterResult []sqltypes.Value
+			if tcase.before != nil {
+				beforeResult = make([]sqltypes.Value, len(plan.ColExprs))
+				_, err := plan.filter(tcase.before, beforeResult, charsets)
+				require.NoError(t, err)
+			}
+			if tcase.after != nil {
+				afterResult = make([]sqltypes.Value, len(plan.ColExprs))
+				_, err := plan.filter(tcase.after, afterResult, charsets)
+				require.NoError(t, err)
+			}
+			match, err := plan.filterImages(tcase.before, tcase.after, beforeResult, afterResult)
+			require.NoError(t, err)
+			require.Equal(t, tcase.match, match)
+			if match {
+				assert.Equal(t, tcase.outBefore, beforeResult)
+				assert.Equal(t, tcase.outAfter, afterResult)
+			}
+		})
+	}
+
+	errcases := []struct {
+		inFilter string
+		outErr   string
+	}{{
+		inFilter: "select id, after.val - before.val from t1",
+		outErr:   "expression needs an alias: `after`.val - `before`.val",
+	}, {
+		inFilter: "select id, val from t1 where after.val > id",
+		outErr:   "all columns must be qualified with before or after: `after`.val > id",
+	}, {
+		inFilter: "select id, after.none as v from t1",
+		outErr:   "column `none` not found in table t1",
+	}}
+	for _, tcase := range errcases {
+		t.Run(tcase.inFilter, func(t *testing.T) {
+			_, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.inFilter}},
+			})
+			assert.EqualError(t, err, tcase.outErr)
+		})
+	}
+}
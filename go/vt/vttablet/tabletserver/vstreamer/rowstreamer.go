@@ -18,6 +18,7 @@ package vstreamer
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -68,6 +69,8 @@ type rowStreamer struct {
 	plan          *Plan
 	pkColumns     []int
 	ukColumnNames []string
+	requireIndex  bool
+	indexColumns  map[int]bool
 	sendQuery     string
 	vse           *Engine
 	pktsize       PacketSizer
@@ -111,6 +114,14 @@ func (rs *rowStreamer) Stream() error {
 	if _, err := conn.ExecuteFetch("set names binary", 1, false); err != nil {
 		return err
 	}
+	if rs.requireIndex {
+		if err := rs.loadIndexColumns(conn); err != nil {
+			return err
+		}
+	}
+	if rs.sendQuery, err = rs.buildSelect(); err != nil {
+		return err
+	}
 	return rs.streamQuery(conn, rs.send)
 }
 
@@ -146,16 +157,28 @@ func (rs *rowStreamer) buildPlan() error {
 			return err
 		}
 	}
+	rs.requireIndex = directives.IsSet("requireIndex")
 
 	rs.pkColumns, err = rs.buildPKColumns(st)
+	return err
+}
+
+// loadIndexColumns loads the columns that lead an index of the table.
+func (rs *rowStreamer) loadIndexColumns(conn *snapshotConn) error {
+	qr, err := conn.ExecuteFetch(sqlparser.BuildParsedQuery("show index from %a", sqlparser.String(sqlparser.NewTableIdent(rs.plan.Table.Name))).Query, 10000, true)
 	if err != nil {
 		return err
 	}
-	rs.sendQuery, err = rs.buildSelect()
-	if err != nil {
-		return err
+	rs.indexColumns = make(map[int]bool)
+	for _, row := range qr.Named().Rows {
+		if row.AsInt64("Seq_in_index", 0) != 1 {
+			continue
+		}
+		if index := rs.plan.Table.FindColumn(sqlparser.NewColIdent(row.AsString("Column_name", ""))); index >= 0 {
+			rs.indexColumns[index] = true
+		}
 	}
-	return err
+	return nil
 }
 
 // buildPKColumnsFromUniqueKey assumes a unique key is indicated,
@@ -208,11 +231,22 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 		prefix = ", "
 	}
 	buf.Myprintf(" from %v", sqlparser.NewTableIdent(rs.plan.Table.Name))
+	pkFilters := rs.pkFilters()
+	indexFilters, err := rs.indexFilters()
+	if err != nil {
+		return "", err
+	}
+	hasFilters := len(pkFilters) != 0 || len(indexFilters) != 0
+	if len(rs.lastpk) != 0 || hasFilters {
+		buf.WriteString(" where ")
+	}
 	if len(rs.lastpk) != 0 {
 		if len(rs.lastpk) != len(rs.pkColumns) {
 			return "", fmt.Errorf("primary key values don't match length: %v vs %v", rs.lastpk, rs.pkColumns)
 		}
-		buf.WriteString(" where ")
+		if hasFilters {
+			buf.WriteString("(")
+		}
 		prefix := ""
 		// This loop handles the case for composite pks. For example,
 		// if lastpk was (1,2), the where clause would be:
@@ -231,6 +265,20 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 			rs.lastpk[lastcol].EncodeSQL(buf)
 			buf.Myprintf(")")
 		}
+		if hasFilters {
+			buf.WriteString(") and ")
+		}
+	}
+	prefix = ""
+	for _, filter := range pkFilters {
+		buf.Myprintf("%s%v %s ", prefix, sqlparser.NewColIdent(rs.plan.Table.Fields[filter.ColNum].Name), pkFilterOperators[filter.Opcode])
+		filter.Value.EncodeSQL(buf)
+		prefix = " and "
+	}
+	for _, filter := range indexFilters {
+		buf.Myprintf("%s", prefix)
+		rs.encodeIndexFilter(buf, filter)
+		prefix = " and "
 	}
 	buf.Myprintf(" order by ", sqlparser.NewTableIdent(rs.plan.Table.Name))
 	prefix = ""
@@ -241,6 +289,93 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 	return buf.String(), nil
 }
 
+var pkFilterOperators = map[Opcode]string{
+	Equal:            "=",
+	LessThan:         "<",
+	LessThanEqual:    "<=",
+	GreaterThan:      ">",
+	GreaterThanEqual: ">=",
+}
+
+// pkFilters returns the filters on the leading primary key column that can be added
+// to the query, so that mysql only reads the rows of the requested range. This is only
+// done for integral columns, for which mysql compares values exactly like the filters do.
+// The filters are still applied to every streamed row.
+func (rs *rowStreamer) pkFilters() []Filter {
+	if len(rs.pkColumns) == 0 {
+		return nil
+	}
+	pkColumn := rs.pkColumns[0]
+	if !sqltypes.IsIntegral(rs.plan.Table.Fields[pkColumn].Type) {
+		return nil
+	}
+	var filters []Filter
+	for _, filter := range rs.plan.Filters {
+		if _, ok := pkFilterOperators[filter.Opcode]; !ok || filter.ColNum != pkColumn || !filter.Value.IsIntegral() {
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// indexFilters returns the equality and null filters on columns that lead an index of
+// the table. They are only added to the query of streams with the requireIndex directive,
+// which look up the rows of specific values, and the stream fails if there are none, so
+// that such a lookup never scans the table. Values are compared using the collation of
+// their column, like the filters do.
+func (rs *rowStreamer) indexFilters() ([]Filter, error) {
+	if !rs.requireIndex {
+		return nil, nil
+	}
+	var filters []Filter
+	for _, filter := range rs.plan.Filters {
+		if filter.Opcode != Equal && filter.Opcode != IsNull {
+			continue
+		}
+		if !rs.indexColumns[filter.ColNum] {
+			continue
+		}
+		field := rs.plan.Table.Fields[filter.ColNum]
+		if filter.Opcode == Equal {
+			switch {
+			case sqltypes.IsIntegral(field.Type):
+				if !filter.Value.IsIntegral() {
+					continue
+				}
+			case sqltypes.IsText(field.Type):
+				if rs.plan.isConvertColumnUsingUTF8(field.Name) || collations.Local().LookupByID(collations.ID(field.Charset)) == nil {
+					continue
+				}
+			case !sqltypes.IsBinary(field.Type):
+				continue
+			}
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "query %v requires an index, but none of its filtered columns lead an index of table %v", rs.query, rs.plan.Table.Name)
+	}
+	return filters, nil
+}
+
+// encodeIndexFilter encodes a filter returned by indexFilters.
+func (rs *rowStreamer) encodeIndexFilter(buf *sqlparser.TrackedBuffer, filter Filter) {
+	field := rs.plan.Table.Fields[filter.ColNum]
+	buf.Myprintf("%v ", sqlparser.NewColIdent(field.Name))
+	if filter.Opcode == IsNull {
+		buf.WriteString("is null")
+		return
+	}
+	buf.WriteString("= ")
+	if !sqltypes.IsText(field.Type) {
+		filter.Value.EncodeSQL(buf)
+		return
+	}
+	collation := collations.Local().LookupByID(collations.ID(field.Charset))
+	buf.Myprintf("_%s X'%s' collate %s", collation.Charset().Name(), hex.EncodeToString(filter.Value.Raw()), collation.Name())
+}
+
 func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	log.Infof("Streaming query: %v\n", rs.sendQuery)
 	gtid, err := conn.streamWithSnapshot(rs.ctx, rs.plan.Table.Name, rs.sendQuery)
@@ -310,11 +445,18 @@ func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.V
 		for i, pk := range rs.pkColumns {
 			lastpk[i] = mysqlrow[pk]
 		}
-		// Reuse the vstreamer's filter.
+		// Reuse the vstreamer's filter. A row is a change without
+		// a before image.
 		ok, err := rs.plan.filter(mysqlrow, filtered, charsets)
 		if err != nil {
 			return err
 		}
+		if ok {
+			ok, err = rs.plan.filterImages(nil, mysqlrow, nil, filtered)
+			if err != nil {
+				return err
+			}
+		}
 		if ok {
 			if rowCount >= len(rows) {
 				rows = append(rows, &querypb.Row{})
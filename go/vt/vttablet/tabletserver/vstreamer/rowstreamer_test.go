@@ -110,6 +110,18 @@ func TestStreamRowsScan(t *testing.T) {
 	wantQuery = "select id, val from t1 where (id > 1) order by id"
 	checkStream(t, "select * from t1", []sqltypes.Value{sqltypes.NewInt64(1)}, wantQuery, wantStream)
 
+	// t1: range filters on the pk are added to the query
+	wantStream = []string{
+		`fields:{name:"id" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id" type:INT32}`,
+		`rows:{lengths:1 lengths:3 values:"2bbb"} lastpk:{lengths:1 values:"2"}`,
+	}
+	wantQuery = "select id, val from t1 where id >= 2 and id <= 5 order by id"
+	checkStream(t, "select * from t1 where id >= 2 and id <= 5", nil, wantQuery, wantStream)
+
+	// t1: range filters on the pk, with lastpk=1
+	wantQuery = "select id, val from t1 where ((id > 1)) and id <= 5 order by id"
+	checkStream(t, "select * from t1 where id <= 5", []sqltypes.Value{sqltypes.NewInt64(1)}, wantQuery, wantStream)
+
 	// t1: different column ordering
 	wantStream = []string{
 		`fields:{name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63} fields:{name:"id" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id" column_length:11 charset:63} pkfields:{name:"id" type:INT32}`,
@@ -134,6 +146,10 @@ func TestStreamRowsScan(t *testing.T) {
 	wantQuery = "select id1, id2, val from t2 where (id1 = 1 and id2 > 2) or (id1 > 1) order by id1, id2"
 	checkStream(t, "select * from t2", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, wantQuery, wantStream)
 
+	// t2: only filters on the leading pk column are added to the query
+	wantQuery = "select id1, id2, val from t2 where id1 = 1 order by id1, id2"
+	checkStream(t, "select * from t2 where id1 = 1 and id2 > 2", nil, wantQuery, wantStream)
+
 	// t3: all rows
 	wantStream = []string{
 		`fields:{name:"id" type:INT32 table:"t3" org_table:"t3" database:"vttest" org_name:"id" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t3" org_table:"t3" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id" type:INT32} pkfields:{name:"val" type:VARBINARY}`,
@@ -327,6 +343,42 @@ func TestStreamRowsFilterVarBinary(t *testing.T) {
 	checkStream(t, "select id1, val from t1 where val = 'newton'", nil, wantQuery, wantStream)
 }
 
+func TestStreamRowsRequireIndex(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), primary key(id1), key(val))",
+		"insert into t1 values (1, 10, 'kepler'), (2, 20, 'newton'), (3, 30, null), (4, 40, 'newton')",
+	})
+
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	// t1: equality filters on indexed columns are added to the query
+	wantStream := []string{
+		`fields:{name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id1" type:INT32}`,
+		`rows:{lengths:1 lengths:6 values:"2newton"} rows:{lengths:1 lengths:6 values:"4newton"} lastpk:{lengths:1 values:"4"}`,
+	}
+	wantQuery := "select id1, id2, val from t1 where val = 'newton' order by id1"
+	checkStream(t, "select /*vt+ requireIndex=1 */ id1, val from t1 where val = 'newton' and id2 > 0", nil, wantQuery, wantStream)
+
+	// t1: null filters on indexed columns are added to the query
+	wantStream = []string{
+		`fields:{name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63} pkfields:{name:"id1" type:INT32}`,
+		`rows:{lengths:1 values:"3"} lastpk:{lengths:1 values:"3"}`,
+	}
+	wantQuery = "select id1, id2, val from t1 where val is null order by id1"
+	checkStream(t, "select /*vt+ requireIndex=1 */ id1 from t1 where val is null", nil, wantQuery, wantStream)
+
+	// t1: no filtered column leads an index
+	wantError := "query select /*vt+ requireIndex=1 */ id1 from t1 where id2 = 20 requires an index, but none of its filtered columns lead an index of table t1"
+	expectStreamError(t, "select /*vt+ requireIndex=1 */ id1 from t1 where id2 = 20", wantError)
+}
+
 func TestStreamRowsMultiPacket(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -94,15 +94,18 @@ type streamerPlan struct {
 // startPos: a flavor compliant position to stream from. This can also contain the special
 //   value "current", which means start from the current position.
 // filter: the list of filtering rules. If a rule has a select expression for its filter,
-//   the select list can only reference direct columns. No other expressions are allowed.
+//   the select list can only reference direct columns, or expressions over the before and
+//   after images of a row change, which must have an alias, like "after.c - before.c as delta".
 //   The select expression is allowed to contain the special 'keyspace_id()' function which
 //   will return the keyspace id of the row. Examples:
 //   "select * from t", same as an empty Filter,
 //   "select * from t where in_keyrange('-80')", same as "-80",
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
-//   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" and limited comparison operators (see enum Opcode in planbuilder.go) are supported in the where clause.
+//   "select col1, keyspace_id() from t where...",
+//   "select col1, after.col2 - before.col2 as delta from t where before.col2 != after.col2".
+//   Only "in_keyrange" and limited comparison operators (see enum Opcode in planbuilder.go) are supported in the where clause,
+//   except for conditions that only reference the before and after images, which are evaluated with evalengine.
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
@@ -786,7 +789,7 @@ func (vs *vstreamer) processJournalEvent(vevents []*binlogdatapb.VEvent, plan *s
 	}
 nextrow:
 	for _, row := range rows.Rows {
-		afterOK, afterValues, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
+		afterOK, afterValues, _, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
 		if err != nil {
 			return nil, err
 		}
@@ -824,17 +827,30 @@ nextrow:
 func (vs *vstreamer) processRowEvent(vevents []*binlogdatapb.VEvent, plan *streamerPlan, rows mysql.Rows) ([]*binlogdatapb.VEvent, error) {
 	rowChanges := make([]*binlogdatapb.RowChange, 0, len(rows.Rows))
 	for _, row := range rows.Rows {
-		beforeOK, beforeValues, err := vs.extractRowAndFilter(plan, row.Identify, rows.IdentifyColumns, row.NullIdentifyColumns)
+		beforeOK, beforeValues, beforeRow, err := vs.extractRowAndFilter(plan, row.Identify, rows.IdentifyColumns, row.NullIdentifyColumns)
 		if err != nil {
 			return nil, err
 		}
-		afterOK, afterValues, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
+		afterOK, afterValues, afterRow, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
 		if err != nil {
 			return nil, err
 		}
 		if !beforeOK && !afterOK {
 			continue
 		}
+		if !beforeOK {
+			beforeValues = nil
+		}
+		if !afterOK {
+			afterValues = nil
+		}
+		ok, err := plan.filterImages(beforeRow, afterRow, beforeValues, afterValues)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
 		rowChange := &binlogdatapb.RowChange{}
 		if beforeOK {
 			rowChange.Before = sqltypes.RowToProto3(beforeValues)
@@ -880,9 +896,11 @@ func (vs *vstreamer) rebuildPlans() error {
 	return nil
 }
 
-func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataColumns, nullColumns mysql.Bitmap) (bool, []sqltypes.Value, error) {
+// extractRowAndFilter extracts a row image, and filters it against the plan.
+// It returns the filtered values, and the row of the table.
+func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataColumns, nullColumns mysql.Bitmap) (bool, []sqltypes.Value, []sqltypes.Value, error) {
 	if len(data) == 0 {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 	values := make([]sqltypes.Value, dataColumns.Count())
 	charsets := make([]collations.ID, len(values))
@@ -890,7 +908,7 @@ func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataCo
 	pos := 0
 	for colNum := 0; colNum < dataColumns.Count(); colNum++ {
 		if !dataColumns.Bit(colNum) {
-			return false, nil, fmt.Errorf("partial row image encountered: ensure binlog_row_image is set to 'full'")
+			return false, nil, nil, fmt.Errorf("partial row image encountered: ensure binlog_row_image is set to 'full'")
 		}
 		if nullColumns.Bit(valueIndex) {
 			valueIndex++
@@ -900,7 +918,7 @@ func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataCo
 		if err != nil {
 			log.Errorf("extractRowAndFilter: %s, table: %s, colNum: %d, fields: %+v, current values: %+v",
 				err, plan.Table.Name, colNum, plan.Table.Fields, values)
-			return false, nil, err
+			return false, nil, nil, err
 		}
 		pos += l
 
@@ -910,7 +928,7 @@ func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataCo
 	}
 	filtered := make([]sqltypes.Value, len(plan.ColExprs))
 	ok, err := plan.filter(values, filtered, charsets)
-	return ok, filtered, err
+	return ok, filtered, values, err
 }
 
 func wrapError(err error, stopPos mysql.Position, vse *Engine) error {
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// ReadDeclarativeSchemaDir reads the desired schema of a keyspace from the .sql files in the given
+// directory. The files are read in lexical order, and may only contain CREATE TABLE and CREATE VIEW
+// statements, which must make up a valid schema.
+func ReadDeclarativeSchemaDir(dir string) (*schemadiff.Schema, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var queries []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		pieces, err := sqlparser.SplitStatementToPieces(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", entry.Name(), err)
+		}
+		for _, piece := range pieces {
+			if strings.TrimSpace(piece) != "" {
+				queries = append(queries, piece)
+			}
+		}
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no CREATE statements found in %s", dir)
+	}
+	desired, err := schemadiff.NewSchemaFromQueries(queries)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema in %s: %v", dir, err)
+	}
+	return desired, nil
+}
+
+// DeclarativeSchemaChanges diffs the desired schema with the schema of the primary of each shard of the
+// keyspace. It returns the declarative statements that bring all shards to the desired schema: a CREATE
+// statement for every table or view that is missing or different on any shard, and a DROP statement for
+// every table or view that exists on any shard but is not desired. The statements are ordered so that
+// they may be applied one by one.
+func (wr *Wrangler) DeclarativeSchemaChanges(ctx context.Context, keyspace string, desired *schemadiff.Schema) ([]string, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var current []*schemadiff.Schema
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if !si.HasPrimary() {
+			return nil, fmt.Errorf("no primary in shard %v/%v", keyspace, shard)
+		}
+		ti, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+		sd, err := wr.tmc.GetSchema(ctx, ti.Tablet, nil, nil, true /* includeViews */)
+		if err != nil {
+			return nil, fmt.Errorf("GetSchema(%v) failed: %v", topoproto.TabletAliasString(si.PrimaryAlias), err)
+		}
+		shardSchema, err := schemaFromDefinition(sd)
+		if err != nil {
+			return nil, fmt.Errorf("cannot analyze schema of %v/%v: %v", keyspace, shard, err)
+		}
+		current = append(current, shardSchema)
+	}
+	return declarativeStatements(desired, current)
+}
+
+// schemaFromDefinition builds a schemadiff schema out of a schema definition read from a tablet.
+// Internal operation tables, such as online DDL artifacts, are ignored.
+func schemaFromDefinition(sd *tabletmanagerdatapb.SchemaDefinition) (*schemadiff.Schema, error) {
+	var queries []string
+	for _, td := range sd.TableDefinitions {
+		if schema.IsInternalOperationTableName(td.Name) {
+			continue
+		}
+		// Views are read qualified with a {{.DatabaseName}} placeholder
+		queries = append(queries, strings.ReplaceAll(td.Schema, "{{.DatabaseName}}.", ""))
+	}
+	return schemadiff.NewSchemaFromQueries(queries)
+}
+
+// declarativeStatements computes the declarative statements that turn each of the current schemas into
+// the desired schema. Views are dropped first, then tables and views are created in dependency order,
+// and finally tables are dropped.
+func declarativeStatements(desired *schemadiff.Schema, current []*schemadiff.Schema) ([]string, error) {
+	changed := map[string]bool{}
+	droppedTables := map[string]bool{}
+	droppedViews := map[string]bool{}
+	for _, from := range current {
+		diffs, err := from.Diff(desired, &schemadiff.DiffHints{})
+		if err != nil {
+			return nil, err
+		}
+		for _, diff := range diffs {
+			switch stmt := diff.Statement().(type) {
+			case *sqlparser.DropTable:
+				for _, table := range stmt.FromTables {
+					droppedTables[table.Name.String()] = true
+				}
+			case *sqlparser.DropView:
+				for _, view := range stmt.FromTables {
+					droppedViews[view.Name.String()] = true
+				}
+			case sqlparser.DDLStatement:
+				changed[stmt.GetTable().Name.String()] = true
+			}
+		}
+	}
+
+	var statements []string
+	for _, from := range current {
+		for _, view := range from.Views() {
+			if droppedViews[view.Name()] {
+				statements = append(statements, fmt.Sprintf("DROP VIEW %s", sqlescape.EscapeID(view.Name())))
+				delete(droppedViews, view.Name())
+			}
+		}
+	}
+	for _, entity := range desired.Entities() {
+		if !changed[entity.Name()] {
+			continue
+		}
+		switch entity := entity.(type) {
+		case *schemadiff.CreateTableEntity:
+			statements = append(statements, sqlparser.String(&entity.CreateTable))
+		case *schemadiff.CreateViewEntity:
+			statements = append(statements, sqlparser.String(&entity.CreateView))
+		}
+	}
+	for _, from := range current {
+		for _, table := range from.Tables() {
+			if droppedTables[table.Name()] {
+				statements = append(statements, fmt.Sprintf("DROP TABLE %s", sqlescape.EscapeID(table.Name())))
+				delete(droppedTables, table.Name())
+			}
+		}
+	}
+	return statements, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/schemadiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestReadDeclarativeSchemaDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2_views.sql"), []byte("create view v1 as select id from t1;\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1_tables.sql"), []byte("create table t1 (id int primary key);\ncreate table t2 (id int primary key);\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a schema file"), 0644))
+
+	desired, err := ReadDeclarativeSchemaDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "v1"}, desired.EntityNames())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "3_other.sql"), []byte("alter table t1 add column i int"), 0644))
+	_, err = ReadDeclarativeSchemaDir(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported entity type")
+
+	_, err = ReadDeclarativeSchemaDir(t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no CREATE statements found")
+}
+
+func TestDeclarativeStatements(t *testing.T) {
+	desired, err := schemadiff.NewSchemaFromQueries([]string{
+		"create table t1 (id int primary key, i int)",
+		"create table t2 (id int primary key)",
+		"create table t4 (id int primary key)",
+		"create view v1 as select id from t1",
+	})
+	require.NoError(t, err)
+
+	shard1, err := schemaFromDefinition(&tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "create table t1 (id int primary key)"},
+			{Name: "t2", Schema: "create table t2 (id int primary key)"},
+			{Name: "t3", Schema: "create table t3 (id int primary key)"},
+			{Name: "v1", Schema: "create view {{.DatabaseName}}.v1 as select id from {{.DatabaseName}}.t1", Type: "VIEW"},
+			{Name: "v2", Schema: "create view {{.DatabaseName}}.v2 as select id from {{.DatabaseName}}.t3", Type: "VIEW"},
+			{Name: "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", Schema: "create table _vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 (id int primary key)"},
+		},
+	})
+	require.NoError(t, err)
+	// the second shard already has the new column, but is missing a table
+	shard2, err := schemaFromDefinition(&tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "create table t1 (id int primary key, i int)"},
+			{Name: "t4", Schema: "create table t4 (id int primary key)"},
+			{Name: "v1", Schema: "create view {{.DatabaseName}}.v1 as select id from {{.DatabaseName}}.t1", Type: "VIEW"},
+		},
+	})
+	require.NoError(t, err)
+
+	statements, err := declarativeStatements(desired, []*schemadiff.Schema{shard1, shard2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"DROP VIEW `v2`",
+		"create table t1 (\n\tid int primary key,\n\ti int\n)",
+		"create table t2 (\n\tid int primary key\n)",
+		"create table t4 (\n\tid int primary key\n)",
+		"DROP TABLE `t3`",
+	}, statements)
+
+	statements, err = declarativeStatements(desired, []*schemadiff.Schema{desired})
+	require.NoError(t, err)
+	assert.Empty(t, statements)
+}
@@ -876,9 +876,15 @@ func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldat
 	if err != nil {
 		return nil, err
 	}
-	if err := mz.deploySchema(ctx); err != nil {
+	if err := mz.validateSourceIndexes(ctx); err != nil {
 		return nil, err
 	}
+	// A workflow that writes to a sink has no target tables.
+	if ms.Sink == "" {
+		if err := mz.deploySchema(ctx); err != nil {
+			return nil, err
+		}
+	}
 	insertMap := make(map[string]string, len(mz.targetShards))
 	for _, targetShard := range mz.targetShards {
 		inserts, err := mz.generateInserts(ctx, targetShard)
@@ -960,6 +966,21 @@ func (mz *materializer) getSourceTableDDLs(ctx context.Context) (map[string]stri
 	return sourceDDLs, nil
 }
 
+// validateSourceIndexes checks that the source tables have the indexes with which
+// the streams look up source rows.
+func (mz *materializer) validateSourceIndexes(ctx context.Context) error {
+	filter := &binlogdatapb.Filter{}
+	for _, ts := range mz.ms.TableSettings {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{
+			Match:  ts.TargetTable,
+			Filter: ts.SourceExpression,
+		})
+	}
+	return vreplication.ValidateSourceIndexes(filter, func() (map[string]string, error) {
+		return mz.getSourceTableDDLs(ctx)
+	})
+}
+
 func (mz *materializer) deploySchema(ctx context.Context) error {
 	var sourceDDLs map[string]string
 	var mu sync.Mutex
@@ -1080,7 +1101,7 @@ func stripTableConstraints(ddl string) (string, error) {
 }
 
 func (mz *materializer) generateInserts(ctx context.Context, targetShard *topo.ShardInfo) (string, error) {
-	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, "{{.dbname}}")
+	ig := vreplication.NewSinkInsertGenerator(binlogplayer.BlpStopped, "{{.dbname}}", mz.ms.Sink)
 
 	for _, sourceShard := range mz.sourceShards {
 		// Don't create streams from sources which won't contain data for the target shard.
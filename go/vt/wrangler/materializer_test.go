@@ -1792,6 +1792,46 @@ func TestMaterializerOneToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerSink(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{
+			{
+				TargetTable:      "t1",
+				SourceExpression: "select * from t1",
+			},
+		},
+		Sink: "file",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	// The target tables are not created.
+	delete(env.tmc.schema, "targetks.t1")
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		`/insert into _vt.vreplication\(workflow, source, pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, db_name, sink\) values `+
+			`\(`+
+			`'workflow', `+
+			(`'keyspace:\\"sourceks\\" shard:\\"0\\" `+
+				`filter:{`+
+				`rules:{match:\\"t1\\" filter:\\"select.*t1\\"}`+
+				`}', `)+
+			`'', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks', 'file'`+
+			`\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
 func TestMaterializerManyToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -2722,3 +2762,23 @@ func TestMaterializerManyToManySomeUnreachable(t *testing.T) {
 		})
 	}
 }
+
+func TestMaterializerRecomputeWithoutSourceIndex(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t2",
+			SourceExpression: "select c1, max(c2) as mx from t1 group by c1",
+			CreateDdl:        "t2ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+	env.tmc.schema["sourceks.t1"].TableDefinitions[0].Schema = "create table t1 (id int, c1 int, c2 int, primary key (id))"
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "none of the group by columns leads an index of source table t1, which is required to recompute mx")
+}
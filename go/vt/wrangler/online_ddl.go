@@ -0,0 +1,275 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+// coordinatedCutOverCheckInterval is the interval at which a coordinated cut-over reviews the migration on all shards
+var coordinatedCutOverCheckInterval = 2 * time.Second
+
+// shardMigrationState is the state of an online DDL migration on the primary of a single shard
+type shardMigrationState struct {
+	uuid             string
+	tablet           *topo.TabletInfo
+	status           schema.OnlineDDLStatus
+	readyToComplete  bool
+	migrationContext string
+	message          string
+}
+
+func (s *shardMigrationState) isPending() bool {
+	switch s.status {
+	case schema.OnlineDDLStatusQueued, schema.OnlineDDLStatusReady, schema.OnlineDDLStatusRunning:
+		return true
+	}
+	return false
+}
+
+type cutOverAction int
+
+const (
+	// cutOverWait means some shard is not yet ready to complete the migration
+	cutOverWait cutOverAction = iota
+	// cutOverComplete means all shards are ready to complete the migration
+	cutOverComplete
+	// cutOverDone means the migration completed on all shards
+	cutOverDone
+	// cutOverRollback means the migration failed or was cancelled on some shard
+	cutOverRollback
+)
+
+// evaluateCutOver decides the next step of a coordinated cut-over, given the state of the migration on all shards
+func evaluateCutOver(states []*shardMigrationState) cutOverAction {
+	allComplete := true
+	allReady := true
+	for _, state := range states {
+		switch state.status {
+		case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+			return cutOverRollback
+		case schema.OnlineDDLStatusComplete:
+			continue
+		}
+		allComplete = false
+		if !state.readyToComplete {
+			allReady = false
+		}
+	}
+	switch {
+	case allComplete:
+		return cutOverDone
+	case allReady:
+		return cutOverComplete
+	}
+	return cutOverWait
+}
+
+// readShardMigrationStates reads the state of a migration on the primaries of all shards of the keyspace
+func (wr *Wrangler) readShardMigrationStates(ctx context.Context, keyspace, uuid string) ([]*shardMigrationState, error) {
+	query, err := sqlparser.ParseAndBind(`select migration_status, ready_to_complete, ddl_action, migration_context, message from _vt.schema_migrations where migration_uuid=%a`,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	results, err := wr.VExec(ctx, uuid, keyspace, query, false)
+	if err != nil {
+		return nil, err
+	}
+	var states []*shardMigrationState
+	for tablet, qr := range results {
+		row := qr.Named().Row()
+		if row == nil {
+			return nil, fmt.Errorf("migration %s not found on shard %s/%s", uuid, keyspace, tablet.Shard)
+		}
+		state := &shardMigrationState{
+			uuid:             uuid,
+			tablet:           tablet,
+			status:           schema.OnlineDDLStatus(row["migration_status"].ToString()),
+			migrationContext: row["migration_context"].ToString(),
+			message:          row["message"].ToString(),
+		}
+		switch state.status {
+		case schema.OnlineDDLStatusRunning:
+			state.readyToComplete = row.AsBool("ready_to_complete", false)
+		case schema.OnlineDDLStatusQueued:
+			// A postponed CREATE or DROP is not scheduled, and is always ready to complete
+			state.readyToComplete = row["ddl_action"].ToString() != sqlparser.AlterStr
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].tablet.Shard < states[j].tablet.Shard
+	})
+	return states, nil
+}
+
+// readMigrationStates reads the state of the given migrations on the primaries of all shards of the keyspace.
+// The states are ordered by migration, in the given order, and then by shard.
+func (wr *Wrangler) readMigrationStates(ctx context.Context, keyspace string, uuids []string) ([]*shardMigrationState, error) {
+	var states []*shardMigrationState
+	for _, uuid := range uuids {
+		shardStates, err := wr.readShardMigrationStates(ctx, keyspace, uuid)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, shardStates...)
+	}
+	return states, nil
+}
+
+// updateShardMigrationStatus runs a VExec status update of the migration on a single shard
+func (wr *Wrangler) updateShardMigrationStatus(ctx context.Context, keyspace, uuid, shard, status string) error {
+	query, err := sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status=%a where migration_uuid=%a and shard=%a`,
+		sqltypes.StringBindVariable(status),
+		sqltypes.StringBindVariable(uuid),
+		sqltypes.StringBindVariable(shard),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = wr.VExec(ctx, uuid, keyspace, query, false)
+	return err
+}
+
+// rollbackCoordinatedCutOver cancels the migrations on the shards where they are still pending, and reverts them on
+// the shards where they already completed. Pending migrations are cancelled first, so that they do not hold back the
+// reverts, and completed migrations are reverted in the reverse order of their submission.
+func (wr *Wrangler) rollbackCoordinatedCutOver(ctx context.Context, keyspace string, states []*shardMigrationState) error {
+	for _, state := range states {
+		if state.isPending() {
+			wr.Logger().Infof("Cancelling migration %s on shard %s", state.uuid, state.tablet.Shard)
+			if err := wr.updateShardMigrationStatus(ctx, keyspace, state.uuid, state.tablet.Shard, "cancel"); err != nil {
+				return err
+			}
+		}
+	}
+	for i := len(states) - 1; i >= 0; i-- {
+		state := states[i]
+		if state.status != schema.OnlineDDLStatusComplete {
+			continue
+		}
+		onlineDDL, err := schema.NewOnlineDDL(keyspace, "", fmt.Sprintf("revert vitess_migration '%s'", state.uuid), schema.NewDDLStrategySetting(schema.DDLStrategyVitess, ""), state.migrationContext, "")
+		if err != nil {
+			return err
+		}
+		wr.Logger().Infof("Reverting migration %s on shard %s with migration %s", state.uuid, state.tablet.Shard, onlineDDL.UUID)
+		if _, err := wr.tmc.ExecuteQuery(ctx, state.tablet.Tablet, []byte(onlineDDL.SQL), 10); err != nil {
+			return fmt.Errorf("cannot revert migration %s on tablet %v: %v", state.uuid, topoproto.TabletAliasString(state.tablet.Alias), err)
+		}
+	}
+	return nil
+}
+
+// abortCoordinatedCutOver rolls back the migrations on all shards after the cut-over failed with the given error,
+// which it returns
+func (wr *Wrangler) abortCoordinatedCutOver(ctx context.Context, keyspace string, uuids []string, cause error) error {
+	wr.Logger().Errorf("Aborting the cut-over of migration %s: %v\n", strings.Join(uuids, ", "), cause)
+	states, err := wr.readMigrationStates(ctx, keyspace, uuids)
+	if err != nil {
+		return fmt.Errorf("%v; cannot roll back: %v", cause, err)
+	}
+	if err := wr.rollbackCoordinatedCutOver(ctx, keyspace, states); err != nil {
+		return fmt.Errorf("%v; cannot roll back: %v", cause, err)
+	}
+	return fmt.Errorf("migration %s was rolled back on all shards: %v", strings.Join(uuids, ", "), cause)
+}
+
+// CoordinatedCutOver completes a migration submitted with -coordinated-cutover on all shards of the keyspace
+// at the same time. It waits until the migration is ready to complete on all shards, and then completes it on
+// all shards together. If the migration fails or is cancelled on any shard, it is rolled back on all shards:
+// it is cancelled where it is still pending, and reverted where it already completed.
+func (wr *Wrangler) CoordinatedCutOver(ctx context.Context, keyspace, uuid string) error {
+	return wr.coordinateCutOver(ctx, keyspace, []string{uuid})
+}
+
+// CoordinatedContextCutOver completes the migrations of a migration context submitted with -atomic-context and
+// -coordinated-cutover, all together, on all shards of the keyspace. It waits until all the migrations are ready
+// to complete on all shards, and then completes all of them on all shards. If any of the migrations fails or is
+// cancelled on any shard, all the migrations of the context are rolled back on all shards.
+func (wr *Wrangler) CoordinatedContextCutOver(ctx context.Context, keyspace string, uuids []string) error {
+	return wr.coordinateCutOver(ctx, keyspace, uuids)
+}
+
+// coordinateCutOver completes the given migrations all together on all shards of the keyspace, or rolls all of
+// them back on all shards
+func (wr *Wrangler) coordinateCutOver(ctx context.Context, keyspace string, uuids []string) error {
+	for _, uuid := range uuids {
+		if !schema.IsOnlineDDLUUID(uuid) {
+			return fmt.Errorf("not an online DDL UUID: %s", uuid)
+		}
+	}
+	migrations := strings.Join(uuids, ", ")
+	completing := false
+	ticker := time.NewTicker(coordinatedCutOverCheckInterval)
+	defer ticker.Stop()
+	for {
+		states, err := wr.readMigrationStates(ctx, keyspace, uuids)
+		if err != nil {
+			return err
+		}
+		switch evaluateCutOver(states) {
+		case cutOverDone:
+			wr.Logger().Printf("Migration %s completed on all shards\n", migrations)
+			return nil
+		case cutOverRollback:
+			var reasons []string
+			for _, state := range states {
+				if state.status == schema.OnlineDDLStatusFailed || state.status == schema.OnlineDDLStatusCancelled {
+					reasons = append(reasons, fmt.Sprintf("%s %s on shard %s: %s", state.uuid, state.status, state.tablet.Shard, state.message))
+				}
+			}
+			if err := wr.rollbackCoordinatedCutOver(ctx, keyspace, states); err != nil {
+				return err
+			}
+			return fmt.Errorf("migration %s was rolled back on all shards: %s", migrations, strings.Join(reasons, "; "))
+		case cutOverComplete:
+			if !completing {
+				wr.Logger().Printf("Migration %s is ready to complete on all shards, completing\n", migrations)
+				for _, uuid := range uuids {
+					query, err := sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='complete' where migration_uuid=%a`,
+						sqltypes.StringBindVariable(uuid),
+					)
+					if err != nil {
+						return err
+					}
+					if _, err := wr.VExec(ctx, uuid, keyspace, query, false); err != nil {
+						// Some shards may be completing already
+						return wr.abortCoordinatedCutOver(ctx, keyspace, uuids, fmt.Errorf("cannot complete migration %s: %v", uuid, err))
+					}
+				}
+				completing = true
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
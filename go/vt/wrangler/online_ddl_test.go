@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/schema"
+)
+
+func TestEvaluateCutOver(t *testing.T) {
+	state := func(status schema.OnlineDDLStatus, readyToComplete bool) *shardMigrationState {
+		return &shardMigrationState{status: status, readyToComplete: readyToComplete}
+	}
+	tt := []struct {
+		name   string
+		states []*shardMigrationState
+		action cutOverAction
+	}{
+		{
+			name:   "not ready",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusRunning, true), state(schema.OnlineDDLStatusRunning, false)},
+			action: cutOverWait,
+		},
+		{
+			name:   "queued",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusRunning, true), state(schema.OnlineDDLStatusQueued, false)},
+			action: cutOverWait,
+		},
+		{
+			name:   "ready",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusRunning, true), state(schema.OnlineDDLStatusRunning, true)},
+			action: cutOverComplete,
+		},
+		{
+			name:   "partially complete",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusComplete, false), state(schema.OnlineDDLStatusRunning, true)},
+			action: cutOverComplete,
+		},
+		{
+			name:   "complete",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusComplete, false), state(schema.OnlineDDLStatusComplete, false)},
+			action: cutOverDone,
+		},
+		{
+			name:   "failed",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusComplete, false), state(schema.OnlineDDLStatusFailed, false)},
+			action: cutOverRollback,
+		},
+		{
+			name: "context with a queued create",
+			states: []*shardMigrationState{
+				state(schema.OnlineDDLStatusRunning, true), state(schema.OnlineDDLStatusRunning, true),
+				state(schema.OnlineDDLStatusQueued, true), state(schema.OnlineDDLStatusComplete, false),
+			},
+			action: cutOverComplete,
+		},
+		{
+			name: "context with a failed migration",
+			states: []*shardMigrationState{
+				state(schema.OnlineDDLStatusComplete, false), state(schema.OnlineDDLStatusComplete, false),
+				state(schema.OnlineDDLStatusComplete, false), state(schema.OnlineDDLStatusFailed, false),
+			},
+			action: cutOverRollback,
+		},
+		{
+			name:   "cancelled",
+			states: []*shardMigrationState{state(schema.OnlineDDLStatusCancelled, false), state(schema.OnlineDDLStatusRunning, true)},
+			action: cutOverRollback,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.action, evaluateCutOver(tc.states))
+		})
+	}
+}
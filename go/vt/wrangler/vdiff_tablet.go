@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/schema"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff" // renamed to avoid a collision with the vdiff struct in this package
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The functions in this file manage the vdiffs that run on the primary tablets of the
+// target shards of a workflow, as opposed to VDiff, which runs the diff in this process.
+// The tablets persist the progress of their vdiffs, so these functions only send
+// requests to the tablets, and report the state of the vdiffs.
+
+// VDiffShardSummary is the state of a vdiff on one target shard.
+type VDiffShardSummary struct {
+	State       string
+	LastError   string `json:",omitempty"`
+	StartedAt   string `json:",omitempty"`
+	CompletedAt string `json:",omitempty"`
+	// Tables is the progress of each table of the vdiff on this shard.
+	Tables []*VDiffTableProgress
+}
+
+// VDiffTableProgress is the progress of the diff of one table on one target shard.
+type VDiffTableProgress struct {
+	TableName    string
+	State        string
+	TableRows    int64
+	RowsCompared int64
+	HasMismatch  bool
+}
+
+// VDiffSummary is the state of a vdiff, aggregated over all the target shards.
+type VDiffSummary struct {
+	UUID         string
+	Keyspace     string
+	Workflow     string
+	State        string
+	HasMismatch  bool
+	RowsCompared int64
+	Shards       map[string]*VDiffShardSummary
+	// Reports are the diff reports of the tables, aggregated over all the target shards.
+	Reports map[string]*DiffReport
+}
+
+// VDiffCreate creates a vdiff of the workflow on all its target shards, and returns its UUID.
+// The vdiff starts right away on the primary tablets of the target shards.
+func (wr *Wrangler) VDiffCreate(ctx context.Context, keyspace, workflow string, options *tabletvdiff.Options) (string, error) {
+	uuid, err := schema.CreateUUID()
+	if err != nil {
+		return "", err
+	}
+	if options == nil {
+		options = &tabletvdiff.Options{}
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf("insert into _vt.vdiff (vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values (%s, %s, %s, '', '', '', %s)",
+		encodeString(uuid), encodeString(workflow), encodeString(keyspace), encodeString(string(optionsJSON)))
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return "", err
+	}
+	var created uint64
+	for _, result := range results {
+		created += result.RowsAffected
+	}
+	if created == 0 {
+		return "", fmt.Errorf("workflow %s.%s was not found on any shard", keyspace, workflow)
+	}
+	return uuid, nil
+}
+
+// VDiffStop stops a running vdiff. Its progress is kept, and it can be resumed with VDiffResume.
+func (wr *Wrangler) VDiffStop(ctx context.Context, keyspace, workflow, uuid string) error {
+	return wr.vdiffUpdateState(ctx, keyspace, workflow, uuid, tabletvdiff.StoppedState)
+}
+
+// VDiffResume resumes a stopped or failed vdiff from where it stopped.
+func (wr *Wrangler) VDiffResume(ctx context.Context, keyspace, workflow, uuid string) error {
+	return wr.vdiffUpdateState(ctx, keyspace, workflow, uuid, tabletvdiff.PendingState)
+}
+
+func (wr *Wrangler) vdiffUpdateState(ctx context.Context, keyspace, workflow, uuid string, state tabletvdiff.State) error {
+	query := fmt.Sprintf("update _vt.vdiff set state=%s where vdiff_uuid=%s", encodeString(string(state)), encodeString(uuid))
+	_, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	return err
+}
+
+// VDiffDelete stops a vdiff if it is running, and deletes it along with its progress.
+func (wr *Wrangler) VDiffDelete(ctx context.Context, keyspace, workflow, uuid string) error {
+	query := fmt.Sprintf("delete from _vt.vdiff where vdiff_uuid=%s", encodeString(uuid))
+	_, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	return err
+}
+
+// VDiffShow returns the state of a vdiff on all the target shards. If uuid is "last", the
+// most recently created vdiff of the workflow is shown. The state is read by vtctld's
+// VDiffShow rpc, and the diff reports of the shards are merged by table.
+func (wr *Wrangler) VDiffShow(ctx context.Context, keyspace, workflow, uuid string) (*VDiffSummary, error) {
+	resp, err := wr.vtctld.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vdiffSummaryFromProto(keyspace, workflow, resp)
+}
+
+func vdiffSummaryFromProto(keyspace, workflow string, resp *vtctldatapb.VDiffShowResponse) (*VDiffSummary, error) {
+	summary := &VDiffSummary{
+		UUID:         resp.Uuid,
+		Keyspace:     keyspace,
+		Workflow:     workflow,
+		State:        resp.State,
+		HasMismatch:  resp.HasMismatch,
+		RowsCompared: resp.RowsCompared,
+		Shards:       make(map[string]*VDiffShardSummary, len(resp.ShardSummaries)),
+		Reports:      make(map[string]*DiffReport),
+	}
+	for shard, shardSummary := range resp.ShardSummaries {
+		ss := &VDiffShardSummary{
+			State:       shardSummary.State,
+			LastError:   shardSummary.LastError,
+			StartedAt:   shardSummary.StartedAt,
+			CompletedAt: shardSummary.CompletedAt,
+		}
+		for _, table := range shardSummary.Tables {
+			ss.Tables = append(ss.Tables, &VDiffTableProgress{
+				TableName:    table.TableName,
+				State:        table.State,
+				TableRows:    table.TableRows,
+				RowsCompared: table.RowsCompared,
+				HasMismatch:  table.HasMismatch,
+			})
+			if table.Report == "" {
+				continue
+			}
+			dr := &DiffReport{}
+			if err := json.Unmarshal([]byte(table.Report), dr); err != nil {
+				return nil, fmt.Errorf("invalid report for table %s on shard %s: %v", table.TableName, shard, err)
+			}
+			summary.Reports[table.TableName] = mergeDiffReports(summary.Reports[table.TableName], dr)
+		}
+		summary.Shards[shard] = ss
+	}
+	return summary, nil
+}
+
+// mergeDiffReports adds the counts and samples of a shard's report to the aggregated report of a table.
+func mergeDiffReports(total, dr *DiffReport) *DiffReport {
+	if total == nil {
+		return dr
+	}
+	total.ProcessedRows += dr.ProcessedRows
+	total.MatchingRows += dr.MatchingRows
+	total.MismatchedRows += dr.MismatchedRows
+	total.ExtraRowsSource += dr.ExtraRowsSource
+	total.ExtraRowsTarget += dr.ExtraRowsTarget
+	total.ExtraRowsSourceDiffs = appendSamples(total.ExtraRowsSourceDiffs, dr.ExtraRowsSourceDiffs)
+	total.ExtraRowsTargetDiffs = appendSamples(total.ExtraRowsTargetDiffs, dr.ExtraRowsTargetDiffs)
+	for _, sample := range dr.MismatchedRowsSample {
+		if len(total.MismatchedRowsSample) >= maxVDiffReportSampleRows {
+			break
+		}
+		total.MismatchedRowsSample = append(total.MismatchedRowsSample, sample)
+	}
+	return total
+}
+
+func appendSamples(samples, more []*RowDiff) []*RowDiff {
+	for _, sample := range more {
+		if len(samples) >= maxVDiffReportSampleRows {
+			break
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
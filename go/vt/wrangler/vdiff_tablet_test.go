@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestVDiffSummaryFromProto(t *testing.T) {
+	resp := &vtctldatapb.VDiffShowResponse{
+		Uuid:         "uuid1",
+		State:        "started",
+		HasMismatch:  true,
+		RowsCompared: 18,
+		ShardSummaries: map[string]*vtctldatapb.VDiffShardSummary{
+			"-80": {
+				State: "completed",
+				Tables: []*vtctldatapb.VDiffTableProgress{{
+					TableName: "t1", State: "completed", TableRows: 10, RowsCompared: 10,
+					Report: `{"ProcessedRows":10,"MatchingRows":10}`,
+				}},
+			},
+			"80-": {
+				State: "started",
+				Tables: []*vtctldatapb.VDiffTableProgress{{
+					TableName: "t1", State: "started", TableRows: 20, RowsCompared: 8, HasMismatch: true,
+					Report: `{"ProcessedRows":8,"MatchingRows":7,"MismatchedRows":1}`,
+				}},
+			},
+		},
+	}
+	summary, err := vdiffSummaryFromProto("ks", "wf", resp)
+	require.NoError(t, err)
+	assert.Equal(t, "uuid1", summary.UUID)
+	assert.Equal(t, "started", summary.State)
+	assert.Equal(t, int64(18), summary.RowsCompared)
+	assert.True(t, summary.HasMismatch)
+	require.Len(t, summary.Shards, 2)
+	assert.Equal(t, int64(8), summary.Shards["80-"].Tables[0].RowsCompared)
+	require.NotNil(t, summary.Reports["t1"])
+	assert.Equal(t, 18, summary.Reports["t1"].ProcessedRows)
+	assert.Equal(t, 17, summary.Reports["t1"].MatchingRows)
+	assert.Equal(t, 1, summary.Reports["t1"].MismatchedRows)
+
+	resp.ShardSummaries["80-"].Tables[0].Report = "not json"
+	_, err = vdiffSummaryFromProto("ks", "wf", resp)
+	assert.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff" // renamed to avoid a collision with the vdiff struct in this package
 
 	"github.com/olekukonko/tablewriter"
 )
@@ -157,9 +158,57 @@ func (p schemaMigrationsPlanner) exec(ctx context.Context, primaryAlias *topodat
 }
 func (p schemaMigrationsPlanner) dryRun(ctx context.Context) error { return nil }
 
+// vdiffPlanner is a vexecPlanner implementation, specific to _vt.vdiff table
+type vdiffPlanner struct {
+	vx *vexec
+	d  *vexecPlannerParams
+}
+
+func newVDiffPlanner(vx *vexec) vexecPlanner {
+	return &vdiffPlanner{
+		vx: vx,
+		d: &vexecPlannerParams{
+			dbNameColumn:         "db_name",
+			workflowColumn:       "workflow",
+			immutableColumnNames: []string{"id", "vdiff_uuid"},
+			updatableColumnNames: []string{"state"},
+			updateTemplates: []string{
+				`update _vt.vdiff set state='val1' where vdiff_uuid='val2'`,
+			},
+			insertTemplates: []string{tabletvdiff.VExecInsertTemplate},
+		},
+	}
+}
+func (p vdiffPlanner) params() *vexecPlannerParams { return p.d }
+func (p vdiffPlanner) exec(ctx context.Context, primaryAlias *topodatapb.TabletAlias, query string) (*querypb.QueryResult, error) {
+	return p.vx.wr.GenericVExec(ctx, primaryAlias, query, p.vx.workflow, p.vx.keyspace)
+}
+func (p vdiffPlanner) dryRun(ctx context.Context) error { return nil }
+
+// vdiffTablePlanner is a vexecPlanner implementation, specific to _vt.vdiff_table table.
+// The table has no db name and workflow columns: its rows are selected by vdiff_uuid.
+type vdiffTablePlanner struct {
+	vx *vexec
+	d  *vexecPlannerParams
+}
+
+func newVDiffTablePlanner(vx *vexec) vexecPlanner {
+	return &vdiffTablePlanner{
+		vx: vx,
+		d:  &vexecPlannerParams{},
+	}
+}
+func (p vdiffTablePlanner) params() *vexecPlannerParams { return p.d }
+func (p vdiffTablePlanner) exec(ctx context.Context, primaryAlias *topodatapb.TabletAlias, query string) (*querypb.QueryResult, error) {
+	return p.vx.wr.GenericVExec(ctx, primaryAlias, query, p.vx.workflow, p.vx.keyspace)
+}
+func (p vdiffTablePlanner) dryRun(ctx context.Context) error { return nil }
+
 // make sure these planners implement vexecPlanner interface
 var _ vexecPlanner = vreplicationPlanner{}
 var _ vexecPlanner = schemaMigrationsPlanner{}
+var _ vexecPlanner = vdiffPlanner{}
+var _ vexecPlanner = vdiffTablePlanner{}
 
 const (
 	updateQuery = iota
@@ -195,6 +244,10 @@ func (vx *vexec) getPlanner(ctx context.Context) error {
 		vx.planner = newSchemaMigrationsPlanner(vx)
 	case qualifiedTableName(vreplicationTableName):
 		vx.planner = newVReplicationPlanner(vx)
+	case tabletvdiff.VDiffTableName:
+		vx.planner = newVDiffPlanner(vx)
+	case tabletvdiff.VDiffTableTableName:
+		vx.planner = newVDiffTablePlanner(vx)
 	default:
 		return fmt.Errorf("table not supported by vexec: %v", vx.tableName)
 	}
@@ -251,12 +304,7 @@ func (vx *vexec) addDefaultWheres(planner vexecPlanner, where *sqlparser.Where)
 		}
 	}
 	newWhere := where
-	if !hasDBName {
-		expr := &sqlparser.ComparisonExpr{
-			Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(plannerParams.dbNameColumn)},
-			Operator: sqlparser.EqualOp,
-			Right:    sqlparser.NewStrLiteral(vx.primaries[0].DbName()),
-		}
+	addExpr := func(expr sqlparser.Expr) {
 		if newWhere == nil {
 			newWhere = &sqlparser.Where{
 				Type: sqlparser.WhereClause,
@@ -269,16 +317,19 @@ func (vx *vexec) addDefaultWheres(planner vexecPlanner, where *sqlparser.Where)
 			}
 		}
 	}
-	if !hasWorkflow && vx.workflow != "" {
-		expr := &sqlparser.ComparisonExpr{
+	if !hasDBName && plannerParams.dbNameColumn != "" {
+		addExpr(&sqlparser.ComparisonExpr{
+			Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(plannerParams.dbNameColumn)},
+			Operator: sqlparser.EqualOp,
+			Right:    sqlparser.NewStrLiteral(vx.primaries[0].DbName()),
+		})
+	}
+	if !hasWorkflow && vx.workflow != "" && plannerParams.workflowColumn != "" {
+		addExpr(&sqlparser.ComparisonExpr{
 			Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(plannerParams.workflowColumn)},
 			Operator: sqlparser.EqualOp,
 			Right:    sqlparser.NewStrLiteral(vx.workflow),
-		}
-		newWhere.Expr = &sqlparser.AndExpr{
-			Left:  newWhere.Expr,
-			Right: expr,
-		}
+		})
 	}
 	return newWhere
 }
@@ -28460,6 +28460,9 @@ export namespace vtctldata {
 
         /** MaterializeSettings materialization_intent */
         materialization_intent?: (vtctldata.MaterializationIntent|null);
+
+        /** MaterializeSettings sink */
+        sink?: (string|null);
     }
 
     /** Represents a MaterializeSettings. */
@@ -28498,6 +28501,9 @@ export namespace vtctldata {
         /** MaterializeSettings materialization_intent. */
         public materialization_intent: vtctldata.MaterializationIntent;
 
+        /** MaterializeSettings sink. */
+        public sink: string;
+
         /**
          * Creates a new MaterializeSettings instance using the specified properties.
          * @param [properties] Properties to set